@@ -0,0 +1,494 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+"use strict";
+
+const aws = require("aws-sdk");
+
+// These are used for test purposes only
+let defaultResponseURL;
+let defaultLogGroup;
+let defaultLogStream;
+
+// Resource types handled by the function.
+const activeTaskDefinitionResourceType = "Custom::ActiveTaskDefinition";
+const activeTargetGroupResourceType = "Custom::ActiveTargetGroup";
+const deploymentResourceType = "Custom::BlueGreenDeploymentFunction";
+const waitResourceType = "Custom::BlueGreenDeploymentWait";
+
+// Polls the deployment for up to 14 minutes so that the function responds before its 15 minutes timeout.
+const deploymentWaiter = {
+  delay: 15,
+  maxAttempts: 56,
+};
+
+// Statuses of a CodeDeploy deployment that can still be stopped.
+const inProgressDeploymentStatuses = [
+  "Created",
+  "Queued",
+  "InProgress",
+  "Baking",
+  "Ready",
+];
+
+/**
+ * Upload a CloudFormation response object to S3.
+ *
+ * @param {object} event the Lambda event payload received by the handler function
+ * @param {object} context the Lambda context received by the handler function
+ * @param {string} responseStatus the response status, either 'SUCCESS' or 'FAILED'
+ * @param {string} physicalResourceId CloudFormation physical resource ID
+ * @param {object} [responseData] arbitrary response data object
+ * @param {string} [reason] reason for failure, if any, to convey to the user
+ * @returns {Promise} Promise that is resolved on success, or rejected on connection error or HTTP error response
+ */
+let report = function (
+  event,
+  context,
+  responseStatus,
+  physicalResourceId,
+  responseData,
+  reason
+) {
+  return new Promise((resolve, reject) => {
+    const https = require("https");
+    const { URL } = require("url");
+
+    var responseBody = JSON.stringify({
+      Status: responseStatus,
+      Reason: reason,
+      PhysicalResourceId: physicalResourceId || context.logStreamName,
+      StackId: event.StackId,
+      RequestId: event.RequestId,
+      LogicalResourceId: event.LogicalResourceId,
+      Data: responseData,
+    });
+
+    const parsedUrl = new URL(event.ResponseURL || defaultResponseURL);
+    const options = {
+      hostname: parsedUrl.hostname,
+      port: 443,
+      path: parsedUrl.pathname + parsedUrl.search,
+      method: "PUT",
+      headers: {
+        "Content-Type": "",
+        "Content-Length": responseBody.length,
+      },
+    };
+
+    https
+      .request(options)
+      .on("error", reject)
+      .on("response", (res) => {
+        res.resume();
+        if (res.statusCode >= 400) {
+          reject(new Error(`Error ${res.statusCode}: ${res.statusMessage}`));
+        } else {
+          resolve();
+        }
+      })
+      .end(responseBody, "utf8");
+  });
+};
+
+/**
+ * Returns true if the stack is rolling back.
+ *
+ * @param {string} stackId ID of the stack that the custom resource belongs to.
+ * @returns {boolean} Whether the stack is rolling back.
+ */
+const isRollingBack = async function (stackId) {
+  const cfn = new aws.CloudFormation();
+  const resp = await cfn
+    .describeStacks({
+      StackName: stackId,
+    })
+    .promise();
+  if (resp.Stacks.length !== 1) {
+    throw new Error(`Cannot find stack ${stackId}`);
+  }
+  return resp.Stacks[0].StackStatus === "UPDATE_ROLLBACK_IN_PROGRESS";
+};
+
+/**
+ * Returns the task definition of the service's primary task set.
+ *
+ * @param {string} cluster Name of the cluster.
+ * @param {string} service Name of the service.
+ * @returns {string} The ARN of the task definition.
+ */
+const serviceTaskDefinition = async function (cluster, service) {
+  const ecs = new aws.ECS();
+  const resp = await ecs
+    .describeServices({
+      cluster: cluster,
+      services: [service],
+    })
+    .promise();
+  if (resp.services.length !== 1) {
+    throw new Error(`Cannot find service ${service} in cluster ${cluster}`);
+  }
+  return resp.services[0].taskDefinition;
+};
+
+/**
+ * Starts a CodeDeploy blue/green deployment that replaces the service's tasks with the new task definition.
+ *
+ * @param {object} props Resource properties of the custom resource.
+ * @returns {string} The ID of the deployment.
+ */
+const createDeployment = async function (props) {
+  const appSpec = {
+    version: 1,
+    Resources: [
+      {
+        TargetService: {
+          Type: "AWS::ECS::Service",
+          Properties: {
+            TaskDefinition: props.TaskDefinition,
+            LoadBalancerInfo: {
+              ContainerName: props.ContainerName,
+              ContainerPort: Number(props.ContainerPort),
+            },
+            PlatformVersion: props.PlatformVersion,
+          },
+        },
+      },
+    ],
+  };
+  const codedeploy = new aws.CodeDeploy();
+  const resp = await codedeploy
+    .createDeployment({
+      applicationName: props.ApplicationName,
+      deploymentGroupName: props.DeploymentGroupName,
+      revision: {
+        revisionType: "AppSpecContent",
+        appSpecContent: {
+          content: JSON.stringify(appSpec),
+        },
+      },
+    })
+    .promise();
+  return resp.deploymentId;
+};
+
+/**
+ * Stops a deployment that is still in progress and rolls the service back to the original task set.
+ *
+ * @param {string} deploymentId ID of the deployment.
+ */
+const stopDeployment = async function (deploymentId) {
+  const codedeploy = new aws.CodeDeploy();
+  const resp = await codedeploy
+    .getDeployment({
+      deploymentId: deploymentId,
+    })
+    .promise();
+  if (!inProgressDeploymentStatuses.includes(resp.deploymentInfo.status)) {
+    return;
+  }
+  await codedeploy
+    .stopDeployment({
+      deploymentId: deploymentId,
+      autoRollbackEnabled: true,
+    })
+    .promise();
+};
+
+/**
+ * Waits until the deployment shifted all the production traffic to the new task set.
+ * The deployment is stopped, and the service rolled back, if traffic is not shifted in time.
+ *
+ * @param {string} deploymentId ID of the deployment.
+ */
+const waitForDeployment = async function (deploymentId) {
+  const codedeploy = new aws.CodeDeploy();
+  for (let i = 0; i < deploymentWaiter.maxAttempts; i++) {
+    const resp = await codedeploy
+      .getDeployment({
+        deploymentId: deploymentId,
+      })
+      .promise();
+    const info = resp.deploymentInfo;
+    if (
+      info.status === "Succeeded" ||
+      info.instanceTerminationWaitTimeStarted
+    ) {
+      // The original task set only serves traffic again if the deployment is rolled back.
+      return;
+    }
+    if (!inProgressDeploymentStatuses.includes(info.status)) {
+      const reason = (info.errorInformation || {}).message;
+      throw new Error(
+        `Deployment ${deploymentId} is ${info.status.toLowerCase()}${
+          reason ? `: ${reason}` : ""
+        }`
+      );
+    }
+    await exports.sleep(deploymentWaiter.delay * 1000);
+  }
+  await stopDeployment(deploymentId);
+  throw new Error(
+    `Deployment ${deploymentId} did not shift traffic within 14 minutes and was stopped`
+  );
+};
+
+/**
+ * Returns the task definition that the ECS service should be configured with.
+ * CloudFormation can't update the task definition of a service using the CODE_DEPLOY deployment controller,
+ * so the task definition only changes when the service is created.
+ */
+const handleActiveTaskDefinition = async function (event) {
+  switch (event.RequestType) {
+    case "Create":
+      return {
+        physicalResourceId: event.ResourceProperties.TaskDefinition,
+        data: { Arn: event.ResourceProperties.TaskDefinition },
+      };
+    case "Update":
+      return {
+        physicalResourceId: event.PhysicalResourceId,
+        data: { Arn: event.PhysicalResourceId },
+      };
+    case "Delete":
+      return { physicalResourceId: event.PhysicalResourceId };
+    default:
+      throw new Error(`Unsupported request type ${event.RequestType}`);
+  }
+};
+
+/**
+ * Returns the weight of the target group in the forward action of a listener rule.
+ *
+ * @param {object} action Action of the listener rule.
+ * @param {string} targetGroup ARN of the target group.
+ * @returns {number} The weight of the target group, zero if the action doesn't forward to it.
+ */
+const forwardWeight = function (action, targetGroup) {
+  if (action.Type !== "forward") {
+    return 0;
+  }
+  if (action.TargetGroupArn === targetGroup) {
+    return 1;
+  }
+  const targetGroups = (action.ForwardConfig || {}).TargetGroups || [];
+  const entry = targetGroups.find((tg) => tg.TargetGroupArn === targetGroup);
+  if (!entry) {
+    return 0;
+  }
+  return entry.Weight === undefined ? 1 : entry.Weight;
+};
+
+/**
+ * Returns the target group that serves the production traffic of the listener.
+ * CodeDeploy swaps the target groups of the listener rules on every deployment,
+ * so the rules must be updated with the target group found on the listener rather than a fixed one.
+ *
+ * @param {string} listener ARN of the production listener.
+ * @param {string[]} targetGroups ARNs of the original and replacement target groups.
+ * @returns {string} The ARN of the active target group, the first one if no rule forwards to them yet.
+ */
+const activeTargetGroup = async function (listener, targetGroups) {
+  const elbv2 = new aws.ELBv2();
+  const weights = {};
+  let marker;
+  do {
+    const resp = await elbv2
+      .describeRules({
+        ListenerArn: listener,
+        Marker: marker,
+      })
+      .promise();
+    for (const rule of resp.Rules) {
+      for (const action of rule.Actions || []) {
+        for (const tg of targetGroups) {
+          weights[tg] = (weights[tg] || 0) + forwardWeight(action, tg);
+        }
+      }
+    }
+    marker = resp.NextMarker;
+  } while (marker);
+  let active = targetGroups[0];
+  for (const tg of targetGroups) {
+    if ((weights[tg] || 0) > (weights[active] || 0)) {
+      active = tg;
+    }
+  }
+  return active;
+};
+
+/**
+ * Returns the target group that serves the production traffic, and the one that CodeDeploy shifts traffic to next.
+ */
+const handleActiveTargetGroup = async function (event) {
+  const props = event.ResourceProperties;
+  const physicalResourceId =
+    event.PhysicalResourceId ||
+    `activetargetgroup/${event.StackId}/${event.LogicalResourceId}`;
+  switch (event.RequestType) {
+    case "Create":
+    case "Update": {
+      const active = await activeTargetGroup(
+        props.ListenerArn,
+        props.TargetGroups
+      );
+      return {
+        physicalResourceId: physicalResourceId,
+        data: {
+          Arn: active,
+          InactiveArn: props.TargetGroups.find((tg) => tg !== active),
+        },
+      };
+    }
+    case "Delete":
+      return { physicalResourceId: physicalResourceId };
+    default:
+      throw new Error(`Unsupported request type ${event.RequestType}`);
+  }
+};
+
+/**
+ * Starts a blue/green deployment if the service is not running the new task definition,
+ * or stops the ongoing deployment if the stack is rolling back.
+ * The physical ID of the resource is the ID of the latest deployment so that the CLI can track its progress.
+ * The resource doesn't wait for the deployment, the wait resource does so that the ID is known while traffic shifts.
+ */
+const handleDeployment = async function (event) {
+  const props = event.ResourceProperties;
+  const physicalResourceId =
+    event.PhysicalResourceId ||
+    `bluegreen/${event.StackId}/${event.LogicalResourceId}`;
+  switch (event.RequestType) {
+    case "Create":
+      // The service was just created with the new task definition.
+      return {
+        physicalResourceId: physicalResourceId,
+        data: { DeploymentId: "" },
+      };
+    case "Update": {
+      if (await isRollingBack(event.StackId)) {
+        if (physicalResourceId.startsWith("d-")) {
+          await stopDeployment(physicalResourceId);
+        }
+        return {
+          physicalResourceId: physicalResourceId,
+          data: { DeploymentId: "" },
+        };
+      }
+      const taskDefinition = await serviceTaskDefinition(
+        props.Cluster,
+        props.Service
+      );
+      if (taskDefinition === props.TaskDefinition) {
+        return {
+          physicalResourceId: physicalResourceId,
+          data: { DeploymentId: "" },
+        };
+      }
+      const deploymentId = await createDeployment(props);
+      return {
+        physicalResourceId: deploymentId,
+        data: { DeploymentId: deploymentId },
+      };
+    }
+    case "Delete":
+      return { physicalResourceId: physicalResourceId };
+    default:
+      throw new Error(`Unsupported request type ${event.RequestType}`);
+  }
+};
+
+/**
+ * Waits for the deployment started by the deployment resource so that the stack fails if the deployment fails.
+ */
+const handleWait = async function (event) {
+  const deploymentId = event.ResourceProperties.DeploymentId || "";
+  const physicalResourceId =
+    event.PhysicalResourceId ||
+    `bluegreenwait/${event.StackId}/${event.LogicalResourceId}`;
+  switch (event.RequestType) {
+    case "Create":
+    case "Update":
+      if (!deploymentId.startsWith("d-")) {
+        // No deployment was started.
+        return { physicalResourceId: physicalResourceId };
+      }
+      if (await isRollingBack(event.StackId)) {
+        // The deployment resource stops the deployment while the stack is rolling back.
+        return { physicalResourceId: physicalResourceId };
+      }
+      await waitForDeployment(deploymentId);
+      return { physicalResourceId: physicalResourceId };
+    case "Delete":
+      return { physicalResourceId: physicalResourceId };
+    default:
+      throw new Error(`Unsupported request type ${event.RequestType}`);
+  }
+};
+
+/**
+ * Blue/green deployment handler, invoked by Lambda.
+ */
+exports.handler = async function (event, context) {
+  let physicalResourceId = event.PhysicalResourceId;
+  try {
+    let resp;
+    switch (event.ResourceType) {
+      case activeTaskDefinitionResourceType:
+        resp = await handleActiveTaskDefinition(event);
+        break;
+      case activeTargetGroupResourceType:
+        resp = await handleActiveTargetGroup(event);
+        break;
+      case deploymentResourceType:
+        resp = await handleDeployment(event);
+        break;
+      case waitResourceType:
+        resp = await handleWait(event);
+        break;
+      default:
+        throw new Error(`Unsupported resource type ${event.ResourceType}`);
+    }
+    physicalResourceId = resp.physicalResourceId;
+    await report(event, context, "SUCCESS", physicalResourceId, resp.data);
+  } catch (err) {
+    console.log(`Caught error ${err}.`);
+    console.log(
+      `Responding FAILED for physical resource id: ${physicalResourceId}`
+    );
+    await report(
+      event,
+      context,
+      "FAILED",
+      physicalResourceId,
+      null,
+      `${err.message} (Log: ${defaultLogGroup || context.logGroupName}/${
+        defaultLogStream || context.logStreamName
+      })`
+    );
+  }
+};
+
+exports.sleep = function (ms) {
+  return new Promise((resolve) => setTimeout(resolve, ms));
+};
+
+/**
+ * @private
+ */
+exports.withDefaultResponseURL = function (url) {
+  defaultResponseURL = url;
+};
+
+/**
+ * @private
+ */
+exports.withDefaultLogStream = function (logStream) {
+  defaultLogStream = logStream;
+};
+
+/**
+ * @private
+ */
+exports.withDefaultLogGroup = function (logGroup) {
+  defaultLogGroup = logGroup;
+};
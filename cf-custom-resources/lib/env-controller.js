@@ -15,6 +15,8 @@ const updateStackWaiter = {
 };
 
 const AliasParamKey = "Aliases";
+// The environment stack parameter that records the workload whose parameter values are applied.
+const ParameterValuesOwnerParamKey = "EFSOptionsOwner";
 
 /**
  * Upload a CloudFormation response object to S3.
@@ -82,6 +84,8 @@ let report = function (
  * @param {string} stackName Name of the stack.
  * @param {string} workload Name of the copilot workload.
  * @param {string[]} envControllerParameters List of parameters from the environment stack to update.
+ * @param {object} parameterValues Values of environment stack parameters to set, keyed by parameter name.
+ * @param {object} prevParameterValues Values of environment stack parameters previously set by {workload}.
  *
  * @returns {parameters} The updated parameters.
  */
@@ -89,11 +93,15 @@ const controlEnv = async function (
   stackName,
   workload,
   aliases,
-  envControllerParameters
+  envControllerParameters,
+  parameterValues,
+  prevParameterValues
 ) {
   var cfn = new aws.CloudFormation();
   aliases = aliases || [];
   envControllerParameters = envControllerParameters || [];
+  parameterValues = parameterValues || {};
+  prevParameterValues = prevParameterValues || {};
   while (true) {
     var describeStackResp = await cfn
       .describeStacks({
@@ -119,9 +127,17 @@ const controlEnv = async function (
     const exportedValues = getExportedValues(updatedEnvStack);
     // Return if there are no parameter changes.
     const shouldUpdateAliases = needUpdateAliases(envParams, workload, aliases);
+    const valuesToSet = parameterValuesToSet(
+      envParams,
+      stackName,
+      workload,
+      parameterValues,
+      prevParameterValues
+    );
     if (
       parametersToRemove.length + parametersToAdd.length === 0 &&
-      !shouldUpdateAliases
+      !shouldUpdateAliases &&
+      Object.keys(valuesToSet).length === 0
     ) {
       return exportedValues;
     }
@@ -137,6 +153,10 @@ const controlEnv = async function (
         }
         continue;
       }
+      if (envParam.ParameterKey in valuesToSet) {
+        envParam.ParameterValue = valuesToSet[envParam.ParameterKey];
+        continue;
+      }
       if (parametersToRemove.includes(envParam.ParameterKey)) {
         const values = new Set(
           envParam.ParameterValue.split(",").filter(Boolean)
@@ -219,7 +239,8 @@ exports.handler = async function (event, context) {
             props.EnvStack,
             props.Workload,
             props.Aliases,
-            props.Parameters
+            props.Parameters,
+            props.ParameterValues
           ),
         ]);
         break;
@@ -230,7 +251,9 @@ exports.handler = async function (event, context) {
             props.EnvStack,
             props.Workload,
             props.Aliases,
-            props.Parameters
+            props.Parameters,
+            props.ParameterValues,
+            (event.OldResourceProperties || {}).ParameterValues
           ),
         ]);
         break;
@@ -240,7 +263,10 @@ exports.handler = async function (event, context) {
           controlEnv(
             props.EnvStack,
             props.Workload,
-            [] // Set to empty to denote that Workload should not be included in any env stack parameter.
+            [], // Set to empty to remove the aliases of Workload.
+            [], // Set to empty to denote that Workload should not be included in any env stack parameter.
+            {}, // Set to empty to reset the parameter values set by Workload.
+            props.ParameterValues
           ),
         ]);
         break;
@@ -281,6 +307,52 @@ function setOfParameterKeysWithWorkload(cfnParams, workload) {
   return envSet;
 }
 
+// parameterValuesToSet returns the environment stack parameters whose values need to change, keyed by parameter name.
+// The parameters configure resources shared by the whole environment, so only the workload recorded as their owner can set them.
+// Once the owner stops setting them, the values are reset to empty so that the environment falls back to its defaults.
+// Parameters missing from the environment stack, for example in environments that haven't been upgraded yet, are ignored.
+function parameterValuesToSet(
+  cfnParams,
+  stackName,
+  workload,
+  parameterValues,
+  prevParameterValues
+) {
+  const ownerParam = cfnParams.find(
+    (param) => param.ParameterKey === ParameterValuesOwnerParamKey
+  );
+  if (!ownerParam) {
+    return {};
+  }
+  const owner = ownerParam.ParameterValue;
+  const values = {};
+  if (Object.keys(parameterValues).length !== 0) {
+    if (owner !== "" && owner !== workload) {
+      throw new Error(
+        `EFS settings of environment stack ${stackName} are already configured by workload ${owner}; remove them from the manifest of ${workload} or ${owner}`
+      );
+    }
+    Object.assign(values, parameterValues);
+    values[ParameterValuesOwnerParamKey] = workload;
+  } else if (owner === workload) {
+    for (const key of Object.keys(prevParameterValues)) {
+      values[key] = "";
+    }
+    values[ParameterValuesOwnerParamKey] = "";
+  }
+
+  const valuesToSet = {};
+  for (const param of cfnParams) {
+    if (
+      param.ParameterKey in values &&
+      param.ParameterValue !== values[param.ParameterKey]
+    ) {
+      valuesToSet[param.ParameterKey] = values[param.ParameterKey];
+    }
+  }
+  return valuesToSet;
+}
+
 function needUpdateAliases(cfnParams, workload, aliases) {
   for (const param of cfnParams) {
     if (param.ParameterKey !== AliasParamKey) {
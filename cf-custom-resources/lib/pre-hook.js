@@ -0,0 +1,283 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+"use strict";
+
+const aws = require("aws-sdk");
+
+// These are used for test purposes only
+let defaultResponseURL;
+let defaultLogGroup;
+let defaultLogStream;
+
+// Wait up to 14 minutes for the task to stop.
+const taskStoppedWaiter = {
+  delay: 6,
+  maxAttempts: 140,
+};
+
+// The value of "startedBy" for every task run by the function.
+// The CLI looks for tasks started with this value to stream their logs.
+const taskStartedBy = "copilot-pre-hook";
+
+/**
+ * Upload a CloudFormation response object to S3.
+ *
+ * @param {object} event the Lambda event payload received by the handler function
+ * @param {object} context the Lambda context received by the handler function
+ * @param {string} responseStatus the response status, either 'SUCCESS' or 'FAILED'
+ * @param {string} physicalResourceId CloudFormation physical resource ID
+ * @param {object} [responseData] arbitrary response data object
+ * @param {string} [reason] reason for failure, if any, to convey to the user
+ * @returns {Promise} Promise that is resolved on success, or rejected on connection error or HTTP error response
+ */
+let report = function (
+  event,
+  context,
+  responseStatus,
+  physicalResourceId,
+  responseData,
+  reason
+) {
+  return new Promise((resolve, reject) => {
+    const https = require("https");
+    const { URL } = require("url");
+
+    var responseBody = JSON.stringify({
+      Status: responseStatus,
+      Reason: reason,
+      PhysicalResourceId: physicalResourceId || context.logStreamName,
+      StackId: event.StackId,
+      RequestId: event.RequestId,
+      LogicalResourceId: event.LogicalResourceId,
+      Data: responseData,
+    });
+
+    const parsedUrl = new URL(event.ResponseURL || defaultResponseURL);
+    const options = {
+      hostname: parsedUrl.hostname,
+      port: 443,
+      path: parsedUrl.pathname + parsedUrl.search,
+      method: "PUT",
+      headers: {
+        "Content-Type": "",
+        "Content-Length": responseBody.length,
+      },
+    };
+
+    https
+      .request(options)
+      .on("error", reject)
+      .on("response", (res) => {
+        res.resume();
+        if (res.statusCode >= 400) {
+          reject(new Error(`Error ${res.statusCode}: ${res.statusMessage}`));
+        } else {
+          resolve();
+        }
+      })
+      .end(responseBody, "utf8");
+  });
+};
+
+/**
+ * Returns true if the stack is rolling back, in which case the hook should not run again
+ * with the previous task definition.
+ *
+ * @param {string} stackId ID of the stack that the custom resource belongs to.
+ * @returns {boolean} Whether the stack is rolling back.
+ */
+const isRollingBack = async function (stackId) {
+  const cfn = new aws.CloudFormation();
+  const resp = await cfn
+    .describeStacks({
+      StackName: stackId,
+    })
+    .promise();
+  if (resp.Stacks.length !== 1) {
+    throw new Error(`Cannot find stack ${stackId}`);
+  }
+  return resp.Stacks[0].StackStatus === "UPDATE_ROLLBACK_IN_PROGRESS";
+};
+
+/**
+ * Runs a one-off task with an overridden command and waits until it stops.
+ *
+ * @param {object} props Resource properties of the custom resource.
+ * @param {object} run Records the ARN of the task once it is started and whether it stopped.
+ * @returns {string} The ARN of the task.
+ */
+const runHook = async function (props, run) {
+  const ecs = new aws.ECS();
+  const resp = await ecs
+    .runTask({
+      cluster: props.Cluster,
+      taskDefinition: props.TaskDefinition,
+      launchType: "FARGATE",
+      platformVersion: props.PlatformVersion,
+      startedBy: taskStartedBy,
+      networkConfiguration: {
+        awsvpcConfiguration: {
+          assignPublicIp: props.AssignPublicIp,
+          subnets: props.Subnets,
+          securityGroups: props.SecurityGroups,
+        },
+      },
+      overrides: {
+        containerOverrides: [
+          {
+            name: props.ContainerName,
+            command: props.Command,
+          },
+        ],
+      },
+    })
+    .promise();
+  if (resp.failures && resp.failures.length !== 0) {
+    const failure = resp.failures[0];
+    throw new Error(`Failed to run task: ${failure.reason}`);
+  }
+  const taskARN = resp.tasks[0].taskArn;
+  run.taskARN = taskARN;
+
+  const stopped = await ecs
+    .waitFor("tasksStopped", {
+      cluster: props.Cluster,
+      tasks: [taskARN],
+      $waiter: taskStoppedWaiter,
+    })
+    .promise();
+  run.stopped = true;
+  const task = stopped.tasks[0];
+  const container = task.containers.find(
+    (c) => c.name === props.ContainerName
+  );
+  if (!container || container.exitCode === undefined) {
+    throw new Error(
+      `Task ${taskARN} stopped before the command completed: ${task.stoppedReason}`
+    );
+  }
+  if (container.exitCode !== 0) {
+    throw new Error(
+      `Task ${taskARN} exited with code ${container.exitCode}: ${
+        container.reason || task.stoppedReason
+      }`
+    );
+  }
+  return taskARN;
+};
+
+/**
+ * Runs the hook until the Lambda deadline. If the deadline expires first, the
+ * task is stopped so that it doesn't keep running after the deployment fails.
+ *
+ * @param {object} props Resource properties of the custom resource.
+ * @returns {string} The ARN of the task.
+ */
+const runHookBeforeDeadline = async function (props) {
+  const run = {};
+  try {
+    return await Promise.race([exports.deadlineExpired(), runHook(props, run)]);
+  } catch (err) {
+    if (run.taskARN && !run.stopped) {
+      await stopHook(props.Cluster, run.taskARN);
+    }
+    throw err;
+  }
+};
+
+/**
+ * Stops the task of the hook. Errors are only logged as the hook already failed.
+ *
+ * @param {string} cluster Name of the cluster that runs the task.
+ * @param {string} taskARN ARN of the task.
+ */
+const stopHook = async function (cluster, taskARN) {
+  const ecs = new aws.ECS();
+  try {
+    await ecs
+      .stopTask({
+        cluster: cluster,
+        task: taskARN,
+        reason: "Pre-deployment hook did not complete in time",
+      })
+      .promise();
+  } catch (err) {
+    console.log(`Failed to stop task ${taskARN}: ${err}.`);
+  }
+};
+
+/**
+ * Pre-deployment hook handler, invoked by Lambda.
+ */
+exports.handler = async function (event, context) {
+  var responseData = {};
+  const props = event.ResourceProperties;
+  const physicalResourceId =
+    event.PhysicalResourceId ||
+    `prehook/${event.StackId}/${event.LogicalResourceId}`;
+
+  try {
+    switch (event.RequestType) {
+      case "Create":
+        responseData.TaskARN = await runHookBeforeDeadline(props);
+        break;
+      case "Update":
+        if (await isRollingBack(event.StackId)) {
+          break;
+        }
+        responseData.TaskARN = await runHookBeforeDeadline(props);
+        break;
+      case "Delete":
+        break;
+      default:
+        throw new Error(`Unsupported request type ${event.RequestType}`);
+    }
+    await report(event, context, "SUCCESS", physicalResourceId, responseData);
+  } catch (err) {
+    console.log(`Caught error ${err}.`);
+    console.log(
+      `Responding FAILED for physical resource id: ${physicalResourceId}`
+    );
+    await report(
+      event,
+      context,
+      "FAILED",
+      physicalResourceId,
+      null,
+      `${err.message} (Log: ${defaultLogGroup || context.logGroupName}/${
+        defaultLogStream || context.logStreamName
+      })`
+    );
+  }
+};
+
+exports.deadlineExpired = function () {
+  return new Promise(function (resolve, reject) {
+    setTimeout(
+      reject,
+      14 * 60 * 1000 + 30 * 1000 /* 14.5 minutes*/,
+      new Error("Lambda took longer than 14.5 minutes to run the task")
+    );
+  });
+};
+
+/**
+ * @private
+ */
+exports.withDefaultResponseURL = function (url) {
+  defaultResponseURL = url;
+};
+
+/**
+ * @private
+ */
+exports.withDefaultLogStream = function (logStream) {
+  defaultLogStream = logStream;
+};
+
+/**
+ * @private
+ */
+exports.withDefaultLogGroup = function (logGroup) {
+  defaultLogGroup = logGroup;
+};
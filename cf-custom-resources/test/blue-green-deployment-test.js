@@ -0,0 +1,669 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+"use strict";
+
+describe("Blue Green Deployment Handler", () => {
+  const AWS = require("aws-sdk-mock");
+  const sinon = require("sinon");
+  const BlueGreen = require("../lib/blue-green-deployment");
+  const LambdaTester = require("lambda-tester").noVersionCheck();
+  const nock = require("nock");
+  const ResponseURL = "https://cloudwatch-response-mock.example.com/";
+  const LogGroup = "/aws/lambda/testLambda";
+  const LogStream = "2021/06/28/[$LATEST]9b93a7dca7344adeb193d15c092dbbfd";
+  const testRequestId = "f4ef1b10-c39a-44e3-99c0-fbf7e53c3943";
+  const testStackId =
+    "arn:aws:cloudformation:us-west-2:123456789012:stack/app-test-api/1234";
+  const oldTaskDefinition =
+    "arn:aws:ecs:us-west-2:123456789012:task-definition/app-test-api:1";
+  const newTaskDefinition =
+    "arn:aws:ecs:us-west-2:123456789012:task-definition/app-test-api:2";
+  const testListener =
+    "arn:aws:elasticloadbalancing:us-west-2:123456789012:listener/app/lb/1234/5678";
+  const blueTargetGroup =
+    "arn:aws:elasticloadbalancing:us-west-2:123456789012:targetgroup/blue/1234";
+  const greenTargetGroup =
+    "arn:aws:elasticloadbalancing:us-west-2:123456789012:targetgroup/green/5678";
+  const otherTargetGroup =
+    "arn:aws:elasticloadbalancing:us-west-2:123456789012:targetgroup/other/9012";
+  let origLog = console.log;
+
+  const testDeploymentProps = {
+    ApplicationName: "app-test-api",
+    DeploymentGroupName: "app-test-api",
+    Cluster: "cluster",
+    Service: "app-test-api-Service-1234",
+    TaskDefinition: newTaskDefinition,
+    ContainerName: "api",
+    ContainerPort: "8080",
+    PlatformVersion: "LATEST",
+  };
+
+  const testTargetGroupProps = {
+    ListenerArn: testListener,
+    TargetGroups: [blueTargetGroup, greenTargetGroup],
+  };
+
+  beforeEach(() => {
+    BlueGreen.withDefaultResponseURL(ResponseURL);
+    BlueGreen.withDefaultLogGroup(LogGroup);
+    BlueGreen.withDefaultLogStream(LogStream);
+    BlueGreen.sleep = function () {
+      return Promise.resolve();
+    };
+    // Prevent logging.
+    console.log = function () {};
+  });
+  afterEach(() => {
+    // Restore logger
+    AWS.restore();
+    console.log = origLog;
+  });
+
+  test("invalid resource type", () => {
+    const request = nock(ResponseURL)
+      .put("/", (body) => {
+        return (
+          body.Status === "FAILED" &&
+          body.Reason ===
+            "Unsupported resource type Custom::Oops (Log: /aws/lambda/testLambda/2021/06/28/[$LATEST]9b93a7dca7344adeb193d15c092dbbfd)"
+        );
+      })
+      .reply(200);
+
+    return LambdaTester(BlueGreen.handler)
+      .event({
+        RequestType: "Create",
+        ResourceType: "Custom::Oops",
+        RequestId: testRequestId,
+        ResponseURL: ResponseURL,
+        ResourceProperties: {},
+      })
+      .expectResolve(() => {
+        expect(request.isDone()).toBe(true);
+      });
+  });
+
+  test("use the new task definition when the service is created", () => {
+    const request = nock(ResponseURL)
+      .put("/", (body) => {
+        return (
+          body.Status === "SUCCESS" &&
+          body.PhysicalResourceId === newTaskDefinition &&
+          body.Data.Arn === newTaskDefinition
+        );
+      })
+      .reply(200);
+
+    return LambdaTester(BlueGreen.handler)
+      .event({
+        RequestType: "Create",
+        ResourceType: "Custom::ActiveTaskDefinition",
+        RequestId: testRequestId,
+        StackId: testStackId,
+        ResponseURL: ResponseURL,
+        ResourceProperties: { TaskDefinition: newTaskDefinition },
+      })
+      .expectResolve(() => {
+        expect(request.isDone()).toBe(true);
+      });
+  });
+
+  test("keep the original task definition when the service is updated", () => {
+    const request = nock(ResponseURL)
+      .put("/", (body) => {
+        return (
+          body.Status === "SUCCESS" &&
+          body.PhysicalResourceId === oldTaskDefinition &&
+          body.Data.Arn === oldTaskDefinition
+        );
+      })
+      .reply(200);
+
+    return LambdaTester(BlueGreen.handler)
+      .event({
+        RequestType: "Update",
+        ResourceType: "Custom::ActiveTaskDefinition",
+        RequestId: testRequestId,
+        StackId: testStackId,
+        PhysicalResourceId: oldTaskDefinition,
+        ResponseURL: ResponseURL,
+        ResourceProperties: { TaskDefinition: newTaskDefinition },
+      })
+      .expectResolve(() => {
+        expect(request.isDone()).toBe(true);
+      });
+  });
+
+  test("use the first target group when no rule forwards to them yet", () => {
+    const describeRulesFake = sinon.fake.resolves({
+      Rules: [
+        {
+          Actions: [
+            {
+              Type: "forward",
+              TargetGroupArn: otherTargetGroup,
+            },
+          ],
+        },
+      ],
+    });
+    AWS.mock("ELBv2", "describeRules", describeRulesFake);
+    const request = nock(ResponseURL)
+      .put("/", (body) => {
+        return (
+          body.Status === "SUCCESS" &&
+          body.Data.Arn === blueTargetGroup &&
+          body.Data.InactiveArn === greenTargetGroup
+        );
+      })
+      .reply(200);
+
+    return LambdaTester(BlueGreen.handler)
+      .event({
+        RequestType: "Create",
+        ResourceType: "Custom::ActiveTargetGroup",
+        RequestId: testRequestId,
+        StackId: testStackId,
+        ResponseURL: ResponseURL,
+        ResourceProperties: testTargetGroupProps,
+      })
+      .expectResolve(() => {
+        sinon.assert.calledWith(
+          describeRulesFake,
+          sinon.match({ ListenerArn: testListener })
+        );
+        expect(request.isDone()).toBe(true);
+      });
+  });
+
+  test("use the target group that serves production traffic", () => {
+    const describeRulesFake = sinon.stub();
+    describeRulesFake.onFirstCall().resolves({
+      Rules: [
+        {
+          Actions: [
+            {
+              Type: "forward",
+              TargetGroupArn: otherTargetGroup,
+            },
+          ],
+        },
+      ],
+      NextMarker: "next",
+    });
+    describeRulesFake.onSecondCall().resolves({
+      Rules: [
+        {
+          Actions: [
+            {
+              Type: "forward",
+              ForwardConfig: {
+                TargetGroups: [
+                  { TargetGroupArn: blueTargetGroup, Weight: 0 },
+                  { TargetGroupArn: greenTargetGroup, Weight: 1 },
+                ],
+              },
+            },
+          ],
+        },
+      ],
+    });
+    AWS.mock("ELBv2", "describeRules", describeRulesFake);
+    const request = nock(ResponseURL)
+      .put("/", (body) => {
+        return (
+          body.Status === "SUCCESS" &&
+          body.Data.Arn === greenTargetGroup &&
+          body.Data.InactiveArn === blueTargetGroup
+        );
+      })
+      .reply(200);
+
+    return LambdaTester(BlueGreen.handler)
+      .event({
+        RequestType: "Update",
+        ResourceType: "Custom::ActiveTargetGroup",
+        RequestId: testRequestId,
+        StackId: testStackId,
+        PhysicalResourceId: "mockID",
+        ResponseURL: ResponseURL,
+        ResourceProperties: testTargetGroupProps,
+      })
+      .expectResolve(() => {
+        sinon.assert.calledWith(
+          describeRulesFake.secondCall,
+          sinon.match({ ListenerArn: testListener, Marker: "next" })
+        );
+        expect(request.isDone()).toBe(true);
+      });
+  });
+
+  test("do not start a deployment when the service is created", () => {
+    const createDeploymentFake = sinon.fake.resolves({});
+    AWS.mock("CodeDeploy", "createDeployment", createDeploymentFake);
+    const request = nock(ResponseURL)
+      .put("/", (body) => {
+        return (
+          body.Status === "SUCCESS" &&
+          body.PhysicalResourceId ===
+            `bluegreen/${testStackId}/BlueGreenDeploymentAction`
+        );
+      })
+      .reply(200);
+
+    return LambdaTester(BlueGreen.handler)
+      .event({
+        RequestType: "Create",
+        ResourceType: "Custom::BlueGreenDeploymentFunction",
+        RequestId: testRequestId,
+        StackId: testStackId,
+        LogicalResourceId: "BlueGreenDeploymentAction",
+        ResponseURL: ResponseURL,
+        ResourceProperties: testDeploymentProps,
+      })
+      .expectResolve(() => {
+        sinon.assert.notCalled(createDeploymentFake);
+        expect(request.isDone()).toBe(true);
+      });
+  });
+
+  test("start a deployment if the service is not running the new task definition", () => {
+    AWS.mock(
+      "CloudFormation",
+      "describeStacks",
+      sinon.fake.resolves({
+        Stacks: [{ StackStatus: "UPDATE_IN_PROGRESS" }],
+      })
+    );
+    AWS.mock(
+      "ECS",
+      "describeServices",
+      sinon.fake.resolves({
+        services: [{ taskDefinition: oldTaskDefinition }],
+      })
+    );
+    const createDeploymentFake = sinon.fake.resolves({
+      deploymentId: "d-ABCDEF123",
+    });
+    AWS.mock("CodeDeploy", "createDeployment", createDeploymentFake);
+    const request = nock(ResponseURL)
+      .put("/", (body) => {
+        return (
+          body.Status === "SUCCESS" &&
+          body.PhysicalResourceId === "d-ABCDEF123" &&
+          body.Data.DeploymentId === "d-ABCDEF123"
+        );
+      })
+      .reply(200);
+
+    return LambdaTester(BlueGreen.handler)
+      .event({
+        RequestType: "Update",
+        ResourceType: "Custom::BlueGreenDeploymentFunction",
+        RequestId: testRequestId,
+        StackId: testStackId,
+        PhysicalResourceId: "mockID",
+        ResponseURL: ResponseURL,
+        ResourceProperties: testDeploymentProps,
+      })
+      .expectResolve(() => {
+        sinon.assert.calledWith(
+          createDeploymentFake,
+          sinon.match({
+            applicationName: "app-test-api",
+            deploymentGroupName: "app-test-api",
+            revision: {
+              revisionType: "AppSpecContent",
+              appSpecContent: {
+                content: JSON.stringify({
+                  version: 1,
+                  Resources: [
+                    {
+                      TargetService: {
+                        Type: "AWS::ECS::Service",
+                        Properties: {
+                          TaskDefinition: newTaskDefinition,
+                          LoadBalancerInfo: {
+                            ContainerName: "api",
+                            ContainerPort: 8080,
+                          },
+                          PlatformVersion: "LATEST",
+                        },
+                      },
+                    },
+                  ],
+                }),
+              },
+            },
+          })
+        );
+        expect(request.isDone()).toBe(true);
+      });
+  });
+
+  test("do not start a deployment if the service already runs the new task definition", () => {
+    AWS.mock(
+      "CloudFormation",
+      "describeStacks",
+      sinon.fake.resolves({
+        Stacks: [{ StackStatus: "UPDATE_IN_PROGRESS" }],
+      })
+    );
+    AWS.mock(
+      "ECS",
+      "describeServices",
+      sinon.fake.resolves({
+        services: [{ taskDefinition: newTaskDefinition }],
+      })
+    );
+    const createDeploymentFake = sinon.fake.resolves({});
+    AWS.mock("CodeDeploy", "createDeployment", createDeploymentFake);
+    const request = nock(ResponseURL)
+      .put("/", (body) => {
+        return (
+          body.Status === "SUCCESS" && body.PhysicalResourceId === "d-OLD"
+        );
+      })
+      .reply(200);
+
+    return LambdaTester(BlueGreen.handler)
+      .event({
+        RequestType: "Update",
+        ResourceType: "Custom::BlueGreenDeploymentFunction",
+        RequestId: testRequestId,
+        StackId: testStackId,
+        PhysicalResourceId: "d-OLD",
+        ResponseURL: ResponseURL,
+        ResourceProperties: testDeploymentProps,
+      })
+      .expectResolve(() => {
+        sinon.assert.notCalled(createDeploymentFake);
+        expect(request.isDone()).toBe(true);
+      });
+  });
+
+  test("fail if the deployment can't be created", () => {
+    AWS.mock(
+      "CloudFormation",
+      "describeStacks",
+      sinon.fake.resolves({
+        Stacks: [{ StackStatus: "UPDATE_IN_PROGRESS" }],
+      })
+    );
+    AWS.mock(
+      "ECS",
+      "describeServices",
+      sinon.fake.resolves({
+        services: [{ taskDefinition: oldTaskDefinition }],
+      })
+    );
+    AWS.mock(
+      "CodeDeploy",
+      "createDeployment",
+      sinon.fake.rejects(new Error("deployment already in progress"))
+    );
+    const request = nock(ResponseURL)
+      .put("/", (body) => {
+        return (
+          body.Status === "FAILED" &&
+          body.Reason.startsWith("deployment already in progress")
+        );
+      })
+      .reply(200);
+
+    return LambdaTester(BlueGreen.handler)
+      .event({
+        RequestType: "Update",
+        ResourceType: "Custom::BlueGreenDeploymentFunction",
+        RequestId: testRequestId,
+        StackId: testStackId,
+        PhysicalResourceId: "mockID",
+        ResponseURL: ResponseURL,
+        ResourceProperties: testDeploymentProps,
+      })
+      .expectResolve(() => {
+        expect(request.isDone()).toBe(true);
+      });
+  });
+
+  test("stop the ongoing deployment while the stack is rolling back", () => {
+    AWS.mock(
+      "CloudFormation",
+      "describeStacks",
+      sinon.fake.resolves({
+        Stacks: [{ StackStatus: "UPDATE_ROLLBACK_IN_PROGRESS" }],
+      })
+    );
+    AWS.mock(
+      "CodeDeploy",
+      "getDeployment",
+      sinon.fake.resolves({
+        deploymentInfo: { status: "InProgress" },
+      })
+    );
+    const stopDeploymentFake = sinon.fake.resolves({});
+    AWS.mock("CodeDeploy", "stopDeployment", stopDeploymentFake);
+    const request = nock(ResponseURL)
+      .put("/", (body) => {
+        return (
+          body.Status === "SUCCESS" && body.PhysicalResourceId === "d-ABCDEF123"
+        );
+      })
+      .reply(200);
+
+    return LambdaTester(BlueGreen.handler)
+      .event({
+        RequestType: "Update",
+        ResourceType: "Custom::BlueGreenDeploymentFunction",
+        RequestId: testRequestId,
+        StackId: testStackId,
+        PhysicalResourceId: "d-ABCDEF123",
+        ResponseURL: ResponseURL,
+        ResourceProperties: testDeploymentProps,
+      })
+      .expectResolve(() => {
+        sinon.assert.calledWith(
+          stopDeploymentFake,
+          sinon.match({
+            deploymentId: "d-ABCDEF123",
+            autoRollbackEnabled: true,
+          })
+        );
+        expect(request.isDone()).toBe(true);
+      });
+  });
+
+  test("do nothing on delete", () => {
+    const createDeploymentFake = sinon.fake.resolves({});
+    AWS.mock("CodeDeploy", "createDeployment", createDeploymentFake);
+    const request = nock(ResponseURL)
+      .put("/", (body) => {
+        return body.Status === "SUCCESS";
+      })
+      .reply(200);
+
+    return LambdaTester(BlueGreen.handler)
+      .event({
+        RequestType: "Delete",
+        ResourceType: "Custom::BlueGreenDeploymentFunction",
+        RequestId: testRequestId,
+        StackId: testStackId,
+        PhysicalResourceId: "d-ABCDEF123",
+        ResponseURL: ResponseURL,
+        ResourceProperties: testDeploymentProps,
+      })
+      .expectResolve(() => {
+        sinon.assert.notCalled(createDeploymentFake);
+        expect(request.isDone()).toBe(true);
+      });
+  });
+
+  test("do not wait if no deployment was started", () => {
+    const getDeploymentFake = sinon.fake.resolves({});
+    AWS.mock("CodeDeploy", "getDeployment", getDeploymentFake);
+    const request = nock(ResponseURL)
+      .put("/", (body) => {
+        return (
+          body.Status === "SUCCESS" &&
+          body.PhysicalResourceId ===
+            `bluegreenwait/${testStackId}/BlueGreenDeploymentWaitAction`
+        );
+      })
+      .reply(200);
+
+    return LambdaTester(BlueGreen.handler)
+      .event({
+        RequestType: "Update",
+        ResourceType: "Custom::BlueGreenDeploymentWait",
+        RequestId: testRequestId,
+        StackId: testStackId,
+        LogicalResourceId: "BlueGreenDeploymentWaitAction",
+        ResponseURL: ResponseURL,
+        ResourceProperties: { DeploymentId: "" },
+      })
+      .expectResolve(() => {
+        sinon.assert.notCalled(getDeploymentFake);
+        expect(request.isDone()).toBe(true);
+      });
+  });
+
+  test("wait until traffic is shifted to the new task set", () => {
+    AWS.mock(
+      "CloudFormation",
+      "describeStacks",
+      sinon.fake.resolves({
+        Stacks: [{ StackStatus: "UPDATE_IN_PROGRESS" }],
+      })
+    );
+    const getDeploymentFake = sinon.stub();
+    getDeploymentFake.onFirstCall().resolves({
+      deploymentInfo: { status: "InProgress" },
+    });
+    getDeploymentFake.onSecondCall().resolves({
+      deploymentInfo: {
+        status: "InProgress",
+        instanceTerminationWaitTimeStarted: true,
+      },
+    });
+    AWS.mock("CodeDeploy", "getDeployment", getDeploymentFake);
+    const request = nock(ResponseURL)
+      .put("/", (body) => {
+        return body.Status === "SUCCESS";
+      })
+      .reply(200);
+
+    return LambdaTester(BlueGreen.handler)
+      .event({
+        RequestType: "Update",
+        ResourceType: "Custom::BlueGreenDeploymentWait",
+        RequestId: testRequestId,
+        StackId: testStackId,
+        PhysicalResourceId: "mockID",
+        ResponseURL: ResponseURL,
+        ResourceProperties: { DeploymentId: "d-ABCDEF123" },
+      })
+      .expectResolve(() => {
+        sinon.assert.calledTwice(getDeploymentFake);
+        sinon.assert.calledWith(
+          getDeploymentFake,
+          sinon.match({ deploymentId: "d-ABCDEF123" })
+        );
+        expect(request.isDone()).toBe(true);
+      });
+  });
+
+  test("fail if the deployment fails", () => {
+    AWS.mock(
+      "CloudFormation",
+      "describeStacks",
+      sinon.fake.resolves({
+        Stacks: [{ StackStatus: "UPDATE_IN_PROGRESS" }],
+      })
+    );
+    AWS.mock(
+      "CodeDeploy",
+      "getDeployment",
+      sinon.fake.resolves({
+        deploymentInfo: {
+          status: "Failed",
+          errorInformation: { message: "alarm api-5xx was triggered" },
+        },
+      })
+    );
+    const request = nock(ResponseURL)
+      .put("/", (body) => {
+        return (
+          body.Status === "FAILED" &&
+          body.Reason.startsWith(
+            "Deployment d-ABCDEF123 is failed: alarm api-5xx was triggered"
+          )
+        );
+      })
+      .reply(200);
+
+    return LambdaTester(BlueGreen.handler)
+      .event({
+        RequestType: "Update",
+        ResourceType: "Custom::BlueGreenDeploymentWait",
+        RequestId: testRequestId,
+        StackId: testStackId,
+        PhysicalResourceId: "mockID",
+        ResponseURL: ResponseURL,
+        ResourceProperties: { DeploymentId: "d-ABCDEF123" },
+      })
+      .expectResolve(() => {
+        expect(request.isDone()).toBe(true);
+      });
+  });
+
+  test("stop the deployment if traffic is not shifted in time", () => {
+    AWS.mock(
+      "CloudFormation",
+      "describeStacks",
+      sinon.fake.resolves({
+        Stacks: [{ StackStatus: "UPDATE_IN_PROGRESS" }],
+      })
+    );
+    AWS.mock(
+      "CodeDeploy",
+      "getDeployment",
+      sinon.fake.resolves({
+        deploymentInfo: { status: "InProgress" },
+      })
+    );
+    const stopDeploymentFake = sinon.fake.resolves({});
+    AWS.mock("CodeDeploy", "stopDeployment", stopDeploymentFake);
+    const request = nock(ResponseURL)
+      .put("/", (body) => {
+        return (
+          body.Status === "FAILED" &&
+          body.Reason.startsWith(
+            "Deployment d-ABCDEF123 did not shift traffic within 14 minutes and was stopped"
+          )
+        );
+      })
+      .reply(200);
+
+    return LambdaTester(BlueGreen.handler)
+      .event({
+        RequestType: "Update",
+        ResourceType: "Custom::BlueGreenDeploymentWait",
+        RequestId: testRequestId,
+        StackId: testStackId,
+        PhysicalResourceId: "mockID",
+        ResponseURL: ResponseURL,
+        ResourceProperties: { DeploymentId: "d-ABCDEF123" },
+      })
+      .expectResolve(() => {
+        sinon.assert.calledWith(
+          stopDeploymentFake,
+          sinon.match({
+            deploymentId: "d-ABCDEF123",
+            autoRollbackEnabled: true,
+          })
+        );
+        expect(request.isDone()).toBe(true);
+      });
+  });
+});
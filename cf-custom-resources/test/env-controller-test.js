@@ -490,6 +490,248 @@ describe("Env Controller Handler", () => {
     });
   });
 
+  test("Set the values of environment parameters that differ and record the workload as their owner", () => {
+    // GIVEN
+    const fakeDescribeStacks = sinon.fake.resolves({
+      Stacks: [
+        {
+          StackName: "mockEnvStack",
+          Parameters: [
+            {
+              ParameterKey: "AppName",
+              ParameterValue: "demo",
+            },
+            {
+              ParameterKey: "EFSWorkloads",
+              ParameterValue: "frontend",
+            },
+            {
+              ParameterKey: "EFSOptionsOwner",
+              ParameterValue: "",
+            },
+            {
+              ParameterKey: "EFSBackupPolicy",
+              ParameterValue: "",
+            },
+            {
+              ParameterKey: "EFSThroughputMode",
+              ParameterValue: "elastic",
+            },
+          ],
+          Outputs: testOutputs,
+        },
+      ],
+    });
+    const fakeUpdateStack = sinon.fake.resolves({});
+    const fakeWaitFor = sinon.fake.resolves({});
+
+    AWS.mock("CloudFormation", "describeStacks", fakeDescribeStacks);
+    AWS.mock("CloudFormation", "updateStack", fakeUpdateStack);
+    AWS.mock("CloudFormation", "waitFor", fakeWaitFor);
+
+    const wantedRequest = nock(ResponseURL)
+      .put("/", (body) => {
+        return body.Status === "SUCCESS";
+      })
+      .reply(200);
+
+    // WHEN
+    const lambda = LambdaTester(EnvController.handler).event({
+      RequestType: "Update",
+      RequestId: testRequestId,
+      ResponseURL: ResponseURL,
+      ResourceProperties: {
+        EnvStack: "demo-test",
+        Workload: "frontend",
+        Parameters: ["EFSWorkloads"],
+        ParameterValues: {
+          EFSBackupPolicy: "DISABLED",
+          EFSThroughputMode: "elastic",
+          EFSTransitionToIA: "AFTER_7_DAYS", // Ignored since the environment stack doesn't have the parameter.
+        },
+      },
+    });
+
+    // THEN
+    return lambda.expectResolve(() => {
+      sinon.assert.calledWith(
+        fakeUpdateStack,
+        sinon.match({
+          Parameters: [
+            {
+              ParameterKey: "AppName",
+              ParameterValue: "demo",
+            },
+            {
+              ParameterKey: "EFSWorkloads",
+              ParameterValue: "frontend",
+            },
+            {
+              ParameterKey: "EFSOptionsOwner",
+              ParameterValue: "frontend",
+            },
+            {
+              ParameterKey: "EFSBackupPolicy",
+              ParameterValue: "DISABLED",
+            },
+            {
+              ParameterKey: "EFSThroughputMode",
+              ParameterValue: "elastic",
+            },
+          ],
+          StackName: "demo-test",
+          UsePreviousTemplate: true,
+        })
+      );
+      expect(wantedRequest.isDone()).toBe(true);
+    });
+  });
+
+  test("Fail if the parameter values are owned by another workload", () => {
+    // GIVEN
+    const fakeDescribeStacks = sinon.fake.resolves({
+      Stacks: [
+        {
+          StackName: "mockEnvStack",
+          Parameters: [
+            {
+              ParameterKey: "EFSWorkloads",
+              ParameterValue: "frontend,backend",
+            },
+            {
+              ParameterKey: "EFSOptionsOwner",
+              ParameterValue: "backend",
+            },
+            {
+              ParameterKey: "EFSBackupPolicy",
+              ParameterValue: "DISABLED",
+            },
+          ],
+          Outputs: testOutputs,
+        },
+      ],
+    });
+    const fakeUpdateStack = sinon.fake.resolves({});
+
+    AWS.mock("CloudFormation", "describeStacks", fakeDescribeStacks);
+    AWS.mock("CloudFormation", "updateStack", fakeUpdateStack);
+
+    const wantedRequest = nock(ResponseURL)
+      .put("/", (body) => {
+        return (
+          body.Status === "FAILED" &&
+          body.Reason ===
+            "EFS settings of environment stack demo-test are already configured by workload backend; remove them from the manifest of frontend or backend (Log: /aws/lambda/testLambda/2021/06/28/[$LATEST]9b93a7dca7344adeb193d15c092dbbfd)"
+        );
+      })
+      .reply(200);
+
+    // WHEN
+    const lambda = LambdaTester(EnvController.handler).event({
+      RequestType: "Update",
+      RequestId: testRequestId,
+      ResponseURL: ResponseURL,
+      ResourceProperties: {
+        EnvStack: "demo-test",
+        Workload: "frontend",
+        Parameters: ["EFSWorkloads"],
+        ParameterValues: {
+          EFSBackupPolicy: "ENABLED",
+        },
+      },
+    });
+
+    // THEN
+    return lambda.expectResolve(() => {
+      sinon.assert.notCalled(fakeUpdateStack);
+      expect(wantedRequest.isDone()).toBe(true);
+    });
+  });
+
+  test("Reset the parameter values once the owner stops setting them", () => {
+    // GIVEN
+    const fakeDescribeStacks = sinon.fake.resolves({
+      Stacks: [
+        {
+          StackName: "mockEnvStack",
+          Parameters: [
+            {
+              ParameterKey: "EFSWorkloads",
+              ParameterValue: "frontend",
+            },
+            {
+              ParameterKey: "EFSOptionsOwner",
+              ParameterValue: "frontend",
+            },
+            {
+              ParameterKey: "EFSBackupPolicy",
+              ParameterValue: "DISABLED",
+            },
+          ],
+          Outputs: testOutputs,
+        },
+      ],
+    });
+    const fakeUpdateStack = sinon.fake.resolves({});
+    const fakeWaitFor = sinon.fake.resolves({});
+
+    AWS.mock("CloudFormation", "describeStacks", fakeDescribeStacks);
+    AWS.mock("CloudFormation", "updateStack", fakeUpdateStack);
+    AWS.mock("CloudFormation", "waitFor", fakeWaitFor);
+
+    const wantedRequest = nock(ResponseURL)
+      .put("/", (body) => {
+        return body.Status === "SUCCESS";
+      })
+      .reply(200);
+
+    // WHEN
+    const lambda = LambdaTester(EnvController.handler).event({
+      RequestType: "Update",
+      RequestId: testRequestId,
+      ResponseURL: ResponseURL,
+      ResourceProperties: {
+        EnvStack: "demo-test",
+        Workload: "frontend",
+        Parameters: ["EFSWorkloads"],
+      },
+      OldResourceProperties: {
+        EnvStack: "demo-test",
+        Workload: "frontend",
+        Parameters: ["EFSWorkloads"],
+        ParameterValues: {
+          EFSBackupPolicy: "DISABLED",
+        },
+      },
+    });
+
+    // THEN
+    return lambda.expectResolve(() => {
+      sinon.assert.calledWith(
+        fakeUpdateStack,
+        sinon.match({
+          Parameters: [
+            {
+              ParameterKey: "EFSWorkloads",
+              ParameterValue: "frontend",
+            },
+            {
+              ParameterKey: "EFSOptionsOwner",
+              ParameterValue: "",
+            },
+            {
+              ParameterKey: "EFSBackupPolicy",
+              ParameterValue: "",
+            },
+          ],
+          StackName: "demo-test",
+          UsePreviousTemplate: true,
+        })
+      );
+      expect(wantedRequest.isDone()).toBe(true);
+    });
+  });
+
   test("Wait if the stack is updating in progress", () => {
     const describeStacksFake = sinon.fake.resolves({
       Stacks: [
@@ -653,4 +895,79 @@ describe("Env Controller Handler", () => {
         expect(request.isDone()).toBe(true);
       });
   });
+
+  test("Reset the parameter values of the owner on delete", () => {
+    const describeStacksFake = sinon.fake.resolves({
+      Stacks: [
+        {
+          StackName: "mockEnvStack",
+          Parameters: [
+            {
+              ParameterKey: "EFSWorkloads",
+              ParameterValue: "my-svc,my-other-svc",
+            },
+            {
+              ParameterKey: "EFSOptionsOwner",
+              ParameterValue: "my-svc",
+            },
+            {
+              ParameterKey: "EFSThroughputMode",
+              ParameterValue: "elastic",
+            },
+          ],
+          Outputs: [],
+        },
+      ],
+    });
+    AWS.mock("CloudFormation", "describeStacks", describeStacksFake);
+    const updateStackFake = sinon.fake.resolves({});
+    AWS.mock("CloudFormation", "updateStack", updateStackFake);
+    const waitForFake = sinon.fake.resolves({});
+    AWS.mock("CloudFormation", "waitFor", waitForFake);
+
+    const request = nock(ResponseURL)
+      .put("/", (body) => {
+        return body.Status === "SUCCESS";
+      })
+      .reply(200);
+
+    return LambdaTester(EnvController.handler)
+      .event({
+        RequestType: "Delete",
+        RequestId: testRequestId,
+        ResponseURL: ResponseURL,
+        ResourceProperties: {
+          EnvStack: testEnvStack,
+          Workload: "my-svc",
+          Parameters: ["EFSWorkloads"],
+          ParameterValues: {
+            EFSThroughputMode: "elastic",
+          },
+        },
+      })
+      .expectResolve(() => {
+        sinon.assert.calledWith(
+          updateStackFake,
+          sinon.match({
+            Parameters: [
+              {
+                ParameterKey: "EFSWorkloads",
+                ParameterValue: "my-other-svc",
+              },
+              {
+                ParameterKey: "EFSOptionsOwner",
+                ParameterValue: "",
+              },
+              {
+                ParameterKey: "EFSThroughputMode",
+                ParameterValue: "",
+              },
+            ],
+            StackName: "mockEnvStack",
+            UsePreviousTemplate: true,
+          })
+        );
+        expect(request.isDone()).toBe(true);
+      });
+  });
 });
@@ -0,0 +1,430 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+"use strict";
+
+describe("Pre Hook Handler", () => {
+  const AWS = require("aws-sdk-mock");
+  const sinon = require("sinon");
+  const PreHook = require("../lib/pre-hook");
+  const LambdaTester = require("lambda-tester").noVersionCheck();
+  const nock = require("nock");
+  const ResponseURL = "https://cloudwatch-response-mock.example.com/";
+  const LogGroup = "/aws/lambda/testLambda";
+  const LogStream = "2021/06/28/[$LATEST]9b93a7dca7344adeb193d15c092dbbfd";
+  const testRequestId = "f4ef1b10-c39a-44e3-99c0-fbf7e53c3943";
+  const testStackId =
+    "arn:aws:cloudformation:us-west-2:123456789012:stack/app-test-api/1234";
+  const testTaskARN = "arn:aws:ecs:us-west-2:123456789012:task/cluster/abc";
+  let origLog = console.log;
+
+  const testProps = {
+    Cluster: "cluster",
+    TaskDefinition:
+      "arn:aws:ecs:us-west-2:123456789012:task-definition/app-test-api:2",
+    ContainerName: "api",
+    Command: ["./migrate", "up"],
+    PlatformVersion: "LATEST",
+    AssignPublicIp: "DISABLED",
+    Subnets: ["subnet-1", "subnet-2"],
+    SecurityGroups: ["sg-1"],
+  };
+
+  const stoppedTask = function (exitCode) {
+    return {
+      tasks: [
+        {
+          taskArn: testTaskARN,
+          stoppedReason: "Essential container in task exited",
+          containers: [
+            {
+              name: "api",
+              exitCode: exitCode,
+            },
+          ],
+        },
+      ],
+    };
+  };
+
+  beforeEach(() => {
+    PreHook.withDefaultResponseURL(ResponseURL);
+    PreHook.withDefaultLogGroup(LogGroup);
+    PreHook.withDefaultLogStream(LogStream);
+    PreHook.deadlineExpired = function () {
+      return new Promise(function (resolve, reject) {});
+    };
+    // Prevent logging.
+    console.log = function () {};
+  });
+  afterEach(() => {
+    // Restore logger
+    AWS.restore();
+    console.log = origLog;
+  });
+
+  test("invalid operation", () => {
+    const request = nock(ResponseURL)
+      .put("/", (body) => {
+        return (
+          body.Status === "FAILED" &&
+          body.Reason ===
+            "Unsupported request type OOPS (Log: /aws/lambda/testLambda/2021/06/28/[$LATEST]9b93a7dca7344adeb193d15c092dbbfd)"
+        );
+      })
+      .reply(200);
+
+    return LambdaTester(PreHook.handler)
+      .event({
+        RequestType: "OOPS",
+        RequestId: testRequestId,
+        ResponseURL: ResponseURL,
+        ResourceProperties: testProps,
+      })
+      .expectResolve(() => {
+        expect(request.isDone()).toBe(true);
+      });
+  });
+
+  test("run the task with the overridden command on create", () => {
+    const runTaskFake = sinon.fake.resolves({
+      tasks: [{ taskArn: testTaskARN }],
+      failures: [],
+    });
+    const waitForFake = sinon.fake.resolves(stoppedTask(0));
+    AWS.mock("ECS", "runTask", runTaskFake);
+    AWS.mock("ECS", "waitFor", waitForFake);
+    const request = nock(ResponseURL)
+      .put("/", (body) => {
+        return body.Status === "SUCCESS" && body.Data.TaskARN === testTaskARN;
+      })
+      .reply(200);
+
+    return LambdaTester(PreHook.handler)
+      .event({
+        RequestType: "Create",
+        RequestId: testRequestId,
+        StackId: testStackId,
+        ResponseURL: ResponseURL,
+        ResourceProperties: testProps,
+      })
+      .expectResolve(() => {
+        sinon.assert.calledWith(
+          runTaskFake,
+          sinon.match({
+            cluster: "cluster",
+            taskDefinition: testProps.TaskDefinition,
+            launchType: "FARGATE",
+            platformVersion: "LATEST",
+            startedBy: "copilot-pre-hook",
+            networkConfiguration: {
+              awsvpcConfiguration: {
+                assignPublicIp: "DISABLED",
+                subnets: ["subnet-1", "subnet-2"],
+                securityGroups: ["sg-1"],
+              },
+            },
+            overrides: {
+              containerOverrides: [
+                {
+                  name: "api",
+                  command: ["./migrate", "up"],
+                },
+              ],
+            },
+          })
+        );
+        sinon.assert.calledWith(
+          waitForFake,
+          "tasksStopped",
+          sinon.match({
+            cluster: "cluster",
+            tasks: [testTaskARN],
+          })
+        );
+        expect(request.isDone()).toBe(true);
+      });
+  });
+
+  test("fail if the task can't be placed", () => {
+    AWS.mock(
+      "ECS",
+      "runTask",
+      sinon.fake.resolves({
+        tasks: [],
+        failures: [{ reason: "RESOURCE:MEMORY" }],
+      })
+    );
+    const request = nock(ResponseURL)
+      .put("/", (body) => {
+        return (
+          body.Status === "FAILED" &&
+          body.Reason.startsWith("Failed to run task: RESOURCE:MEMORY")
+        );
+      })
+      .reply(200);
+
+    return LambdaTester(PreHook.handler)
+      .event({
+        RequestType: "Create",
+        RequestId: testRequestId,
+        StackId: testStackId,
+        ResponseURL: ResponseURL,
+        ResourceProperties: testProps,
+      })
+      .expectResolve(() => {
+        expect(request.isDone()).toBe(true);
+      });
+  });
+
+  test("fail if the command exits with a non-zero code", () => {
+    AWS.mock(
+      "CloudFormation",
+      "describeStacks",
+      sinon.fake.resolves({
+        Stacks: [{ StackStatus: "UPDATE_IN_PROGRESS" }],
+      })
+    );
+    AWS.mock(
+      "ECS",
+      "runTask",
+      sinon.fake.resolves({ tasks: [{ taskArn: testTaskARN }] })
+    );
+    AWS.mock("ECS", "waitFor", sinon.fake.resolves(stoppedTask(1)));
+    const request = nock(ResponseURL)
+      .put("/", (body) => {
+        return (
+          body.Status === "FAILED" &&
+          body.Reason.startsWith(
+            `Task ${testTaskARN} exited with code 1: Essential container in task exited`
+          )
+        );
+      })
+      .reply(200);
+
+    return LambdaTester(PreHook.handler)
+      .event({
+        RequestType: "Update",
+        RequestId: testRequestId,
+        StackId: testStackId,
+        PhysicalResourceId: "mockID",
+        ResponseURL: ResponseURL,
+        ResourceProperties: testProps,
+      })
+      .expectResolve(() => {
+        expect(request.isDone()).toBe(true);
+      });
+  });
+
+  test("fail if the container never ran the command", () => {
+    AWS.mock(
+      "ECS",
+      "runTask",
+      sinon.fake.resolves({ tasks: [{ taskArn: testTaskARN }] })
+    );
+    AWS.mock("ECS", "waitFor", sinon.fake.resolves(stoppedTask(undefined)));
+    const request = nock(ResponseURL)
+      .put("/", (body) => {
+        return (
+          body.Status === "FAILED" &&
+          body.Reason.startsWith(
+            `Task ${testTaskARN} stopped before the command completed`
+          )
+        );
+      })
+      .reply(200);
+
+    return LambdaTester(PreHook.handler)
+      .event({
+        RequestType: "Create",
+        RequestId: testRequestId,
+        StackId: testStackId,
+        ResponseURL: ResponseURL,
+        ResourceProperties: testProps,
+      })
+      .expectResolve(() => {
+        expect(request.isDone()).toBe(true);
+      });
+  });
+
+  test("stop the task if the deadline expires", () => {
+    AWS.mock(
+      "ECS",
+      "runTask",
+      sinon.fake.resolves({ tasks: [{ taskArn: testTaskARN }] })
+    );
+    AWS.mock(
+      "ECS",
+      "waitFor",
+      sinon.fake.returns(new Promise(function (resolve, reject) {}))
+    );
+    const stopTaskFake = sinon.fake.resolves({});
+    AWS.mock("ECS", "stopTask", stopTaskFake);
+    PreHook.deadlineExpired = function () {
+      return new Promise(function (resolve, reject) {
+        setImmediate(
+          reject,
+          new Error("Lambda took longer than 14.5 minutes to run the task")
+        );
+      });
+    };
+    const request = nock(ResponseURL)
+      .put("/", (body) => {
+        return (
+          body.Status === "FAILED" &&
+          body.Reason.startsWith(
+            "Lambda took longer than 14.5 minutes to run the task"
+          )
+        );
+      })
+      .reply(200);
+
+    return LambdaTester(PreHook.handler)
+      .event({
+        RequestType: "Create",
+        RequestId: testRequestId,
+        StackId: testStackId,
+        ResponseURL: ResponseURL,
+        ResourceProperties: testProps,
+      })
+      .expectResolve(() => {
+        sinon.assert.calledWith(
+          stopTaskFake,
+          sinon.match({
+            cluster: "cluster",
+            task: testTaskARN,
+          })
+        );
+        expect(request.isDone()).toBe(true);
+      });
+  });
+
+  test("report the deadline error even if the task can't be stopped", () => {
+    AWS.mock(
+      "ECS",
+      "runTask",
+      sinon.fake.resolves({ tasks: [{ taskArn: testTaskARN }] })
+    );
+    AWS.mock(
+      "ECS",
+      "waitFor",
+      sinon.fake.returns(new Promise(function (resolve, reject) {}))
+    );
+    AWS.mock("ECS", "stopTask", sinon.fake.rejects("AccessDenied"));
+    PreHook.deadlineExpired = function () {
+      return new Promise(function (resolve, reject) {
+        setImmediate(
+          reject,
+          new Error("Lambda took longer than 14.5 minutes to run the task")
+        );
+      });
+    };
+    const request = nock(ResponseURL)
+      .put("/", (body) => {
+        return (
+          body.Status === "FAILED" &&
+          body.Reason.startsWith(
+            "Lambda took longer than 14.5 minutes to run the task"
+          )
+        );
+      })
+      .reply(200);
+
+    return LambdaTester(PreHook.handler)
+      .event({
+        RequestType: "Create",
+        RequestId: testRequestId,
+        StackId: testStackId,
+        ResponseURL: ResponseURL,
+        ResourceProperties: testProps,
+      })
+      .expectResolve(() => {
+        expect(request.isDone()).toBe(true);
+      });
+  });
+
+  test("do not stop the task if it already stopped", () => {
+    AWS.mock(
+      "ECS",
+      "runTask",
+      sinon.fake.resolves({ tasks: [{ taskArn: testTaskARN }] })
+    );
+    AWS.mock("ECS", "waitFor", sinon.fake.resolves(stoppedTask(1)));
+    const stopTaskFake = sinon.fake.resolves({});
+    AWS.mock("ECS", "stopTask", stopTaskFake);
+    const request = nock(ResponseURL)
+      .put("/", (body) => {
+        return body.Status === "FAILED";
+      })
+      .reply(200);
+
+    return LambdaTester(PreHook.handler)
+      .event({
+        RequestType: "Create",
+        RequestId: testRequestId,
+        StackId: testStackId,
+        ResponseURL: ResponseURL,
+        ResourceProperties: testProps,
+      })
+      .expectResolve(() => {
+        sinon.assert.notCalled(stopTaskFake);
+        expect(request.isDone()).toBe(true);
+      });
+  });
+
+  test("do not run the task again while the stack is rolling back", () => {
+    const runTaskFake = sinon.fake.resolves({});
+    AWS.mock(
+      "CloudFormation",
+      "describeStacks",
+      sinon.fake.resolves({
+        Stacks: [{ StackStatus: "UPDATE_ROLLBACK_IN_PROGRESS" }],
+      })
+    );
+    AWS.mock("ECS", "runTask", runTaskFake);
+    const request = nock(ResponseURL)
+      .put("/", (body) => {
+        return (
+          body.Status === "SUCCESS" && body.PhysicalResourceId === "mockID"
+        );
+      })
+      .reply(200);
+
+    return LambdaTester(PreHook.handler)
+      .event({
+        RequestType: "Update",
+        RequestId: testRequestId,
+        StackId: testStackId,
+        PhysicalResourceId: "mockID",
+        ResponseURL: ResponseURL,
+        ResourceProperties: testProps,
+      })
+      .expectResolve(() => {
+        sinon.assert.notCalled(runTaskFake);
+        expect(request.isDone()).toBe(true);
+      });
+  });
+
+  test("do nothing on delete", () => {
+    const runTaskFake = sinon.fake.resolves({});
+    AWS.mock("ECS", "runTask", runTaskFake);
+    const request = nock(ResponseURL)
+      .put("/", (body) => {
+        return body.Status === "SUCCESS";
+      })
+      .reply(200);
+
+    return LambdaTester(PreHook.handler)
+      .event({
+        RequestType: "Delete",
+        RequestId: testRequestId,
+        StackId: testStackId,
+        PhysicalResourceId: "mockID",
+        ResponseURL: ResponseURL,
+        ResourceProperties: testProps,
+      })
+      .expectResolve(() => {
+        sinon.assert.notCalled(runTaskFake);
+        expect(request.isDone()).toBe(true);
+      });
+  });
+});
@@ -33,6 +33,31 @@ func TestAddons(t *testing.T) {
 			}),
 			outFileName: "aurora.yml",
 		},
+		"provisioned aurora with readers restored from a snapshot": {
+			addonMarshaler: addon.NewRDSTemplate(addon.RDSProps{
+				ClusterName:           "orders-db",
+				Engine:                "PostgreSQL",
+				Envs:                  []string{"test"},
+				ClusterType:           addon.RDSClusterTypeProvisioned,
+				InstanceClass:         "db.r6g.large",
+				Readers:               2,
+				BackupRetentionPeriod: 14,
+				DeletionProtection:    true,
+				SnapshotIdentifier:    "arn:aws:rds:us-west-2:123456789012:cluster-snapshot:orders",
+			}),
+			outFileName: "aurora-provisioned.yml",
+		},
+		"aurora serverless v2": {
+			addonMarshaler: addon.NewRDSTemplate(addon.RDSProps{
+				ClusterName:   "aurora",
+				Engine:        "MySQL",
+				InitialDBName: "main",
+				Envs:          []string{"test"},
+				ClusterType:   addon.RDSClusterTypeServerlessV2,
+				Readers:       1,
+			}),
+			outFileName: "aurora-serverless-v2.yml",
+		},
 		"ddb": {
 			addonMarshaler: addon.NewDDBTemplate(&addon.DynamoDBProps{
 				StorageProps: &addon.StorageProps{
@@ -65,6 +90,24 @@ func TestAddons(t *testing.T) {
 			}),
 			outFileName: "ddb.yml",
 		},
+		"provisioned ddb with global secondary indexes, ttl and stream": {
+			addonMarshaler: func() encoding.BinaryMarshaler {
+				props := &addon.DynamoDBProps{
+					StorageProps: &addon.StorageProps{
+						Name: "orders",
+					},
+					BillingMode:    addon.DDBBillingModeProvisioned,
+					TTLAttribute:   "expiresAt",
+					StreamViewType: "NEW_AND_OLD_IMAGES",
+					Envs:           []string{"test", "prod"},
+				}
+				_ = props.BuildPartitionKey("id:S")
+				_, _ = props.BuildSortKey(false, "createdAt:N")
+				_ = props.BuildGlobalSecondaryIndex([]string{"customer:S,createdAt:N", "status:S"})
+				return addon.NewDDBTemplate(props)
+			}(),
+			outFileName: "ddb-provisioned.yml",
+		},
 		"s3": {
 			addonMarshaler: addon.NewS3Template(&addon.S3Props{
 				StorageProps: &addon.StorageProps{
@@ -73,6 +116,83 @@ func TestAddons(t *testing.T) {
 			}),
 			outFileName: "bucket.yml",
 		},
+		"env-scoped ddb": {
+			addonMarshaler: addon.NewDDBTemplate(&addon.DynamoDBProps{
+				StorageProps: &addon.StorageProps{
+					Name:      "users",
+					EnvScoped: true,
+				},
+				Attributes: []addon.DDBAttribute{
+					{
+						Name:     aws.String("id"),
+						DataType: aws.String("S"),
+					},
+				},
+				PartitionKey: aws.String("id"),
+			}),
+			outFileName: "env-ddb.yml",
+		},
+		"env-scoped s3": {
+			addonMarshaler: addon.NewS3Template(&addon.S3Props{
+				StorageProps: &addon.StorageProps{
+					Name:      "assets",
+					EnvScoped: true,
+				},
+			}),
+			outFileName: "env-bucket.yml",
+		},
+		"s3 with versioning, lifecycle, cors and cloudfront": {
+			addonMarshaler: addon.NewS3Template(&addon.S3Props{
+				StorageProps: &addon.StorageProps{
+					Name: "assets",
+				},
+				Versioning:     true,
+				ExpirationDays: 365,
+				Transitions: []addon.S3Transition{
+					{StorageClass: "STANDARD_IA", Days: 30},
+					{StorageClass: "GLACIER", Days: 90},
+				},
+				CORSAllowedOrigins: []string{"https://example.com"},
+				CloudFront:         true,
+			}),
+			outFileName: "bucket-cloudfront.yml",
+		},
+		"s3 with kms encryption": {
+			addonMarshaler: addon.NewS3Template(&addon.S3Props{
+				StorageProps: &addon.StorageProps{
+					Name: "reports",
+				},
+				KMSEncryption: true,
+			}),
+			outFileName: "bucket-kms.yml",
+		},
+		"redis": {
+			addonMarshaler: addon.NewRedisTemplate(&addon.RedisProps{
+				StorageProps: &addon.StorageProps{
+					Name: "cache",
+				},
+				NodeType: "cache.t3.micro",
+			}),
+			outFileName: "redis.yml",
+		},
+		"opensearch": {
+			addonMarshaler: addon.NewOpenSearchTemplate(&addon.OpenSearchProps{
+				StorageProps: &addon.StorageProps{
+					Name: "search",
+				},
+				InstanceType: "t3.small.search",
+			}),
+			outFileName: "opensearch.yml",
+		},
+		"fifo sqs": {
+			addonMarshaler: addon.NewSQSTemplate(&addon.SQSProps{
+				StorageProps: &addon.StorageProps{
+					Name: "orders",
+				},
+				FIFO: true,
+			}),
+			outFileName: "sqs.yml",
+		},
 	}
 
 	for name, tc := range testCases {
@@ -18,6 +18,8 @@ import (
 const (
 	// StackName is the name of the addons nested stack resource.
 	StackName = "AddonsStack"
+
+	environmentsAddonsOwner = "environments" // Displayed in error messages instead of a workload name.
 )
 
 var (
@@ -37,6 +39,11 @@ type workspaceReader interface {
 	ReadAddon(svcName, fileName string) ([]byte, error)
 }
 
+type envWorkspaceReader interface {
+	ReadEnvironmentAddonsDir() ([]string, error)
+	ReadEnvironmentAddon(fileName string) ([]byte, error)
+}
+
 // Addons represents additional resources for a workload.
 type Addons struct {
 	wlName string
@@ -79,25 +86,9 @@ func (a *Addons) Template() (string, error) {
 		}
 	}
 
-	mergedTemplate := newCFNTemplate("merged")
-	for _, fname := range templateFiles {
-		out, err := a.ws.ReadAddon(a.wlName, fname)
-		if err != nil {
-			return "", fmt.Errorf("read addon %s under %s: %w", fname, a.wlName, err)
-		}
-		tpl := newCFNTemplate(fname)
-		if err := yaml.Unmarshal(out, tpl); err != nil {
-			return "", fmt.Errorf("unmarshal addon %s under %s: %w", fname, a.wlName, err)
-		}
-		if err := mergedTemplate.merge(tpl); err != nil {
-			return "", err
-		}
-	}
-	out, err := yaml.Marshal(mergedTemplate)
-	if err != nil {
-		return "", fmt.Errorf("marshal merged addons template: %w", err)
-	}
-	return string(out), nil
+	return mergeTemplates(templateFiles, func(fname string) ([]byte, error) {
+		return a.ws.ReadAddon(a.wlName, fname)
+	}, a.wlName)
 }
 
 // Parameters returns the content of user-defined additional CloudFormation Parameters
@@ -166,6 +157,67 @@ func (a *Addons) validateReservedParameters(params yaml.Node, fname string) erro
 	return nil
 }
 
+// EnvironmentAddons represents additional resources shared by all the workloads in an environment.
+type EnvironmentAddons struct {
+	ws envWorkspaceReader
+}
+
+// NewEnvironment creates an EnvironmentAddons object.
+func NewEnvironment() (*EnvironmentAddons, error) {
+	ws, err := workspace.New()
+	if err != nil {
+		return nil, fmt.Errorf("workspace cannot be created: %w", err)
+	}
+	return &EnvironmentAddons{
+		ws: ws,
+	}, nil
+}
+
+// Template merges CloudFormation templates under the "environments/addons/" directory
+// into a single CloudFormation template and returns it.
+//
+// If the addons directory doesn't exist or is empty, it returns the empty string and ErrAddonsNotFound.
+func (a *EnvironmentAddons) Template() (string, error) {
+	fnames, err := a.ws.ReadEnvironmentAddonsDir()
+	if err != nil {
+		return "", &ErrAddonsNotFound{
+			WlName:    environmentsAddonsOwner,
+			ParentErr: err,
+		}
+	}
+
+	templateFiles := filterFiles(fnames, yamlMatcher, nonParamsMatcher)
+	if len(templateFiles) == 0 {
+		return "", &ErrAddonsNotFound{
+			WlName: environmentsAddonsOwner,
+		}
+	}
+	return mergeTemplates(templateFiles, a.ws.ReadEnvironmentAddon, environmentsAddonsOwner)
+}
+
+// mergeTemplates reads each file with read and merges them into a single CloudFormation template.
+func mergeTemplates(fnames []string, read func(fname string) ([]byte, error), owner string) (string, error) {
+	mergedTemplate := newCFNTemplate("merged")
+	for _, fname := range fnames {
+		out, err := read(fname)
+		if err != nil {
+			return "", fmt.Errorf("read addon %s under %s: %w", fname, owner, err)
+		}
+		tpl := newCFNTemplate(fname)
+		if err := yaml.Unmarshal(out, tpl); err != nil {
+			return "", fmt.Errorf("unmarshal addon %s under %s: %w", fname, owner, err)
+		}
+		if err := mergedTemplate.merge(tpl); err != nil {
+			return "", err
+		}
+	}
+	out, err := yaml.Marshal(mergedTemplate)
+	if err != nil {
+		return "", fmt.Errorf("marshal merged addons template: %w", err)
+	}
+	return string(out), nil
+}
+
 func filterFiles(files []string, matchers ...func(string) bool) []string {
 	var matchedFiles []string
 	for _, f := range files {
@@ -258,6 +258,77 @@ func TestAddons_Template(t *testing.T) {
 	}
 }
 
+func TestEnvironmentAddons_Template(t *testing.T) {
+	testErr := errors.New("some error")
+	testCases := map[string]struct {
+		mockWS func(ws *mocks.MockenvWorkspaceReader)
+
+		wantedTemplate string
+		wantedErr      error
+	}{
+		"return ErrAddonsNotFound if the environments addons directory doesn't exist": {
+			mockWS: func(ws *mocks.MockenvWorkspaceReader) {
+				ws.EXPECT().ReadEnvironmentAddonsDir().Return(nil, testErr)
+			},
+			wantedErr: errors.New("read addons directory for environments: some error"),
+		},
+		"return ErrAddonsNotFound if the environments addons directory only contains parameters": {
+			mockWS: func(ws *mocks.MockenvWorkspaceReader) {
+				ws.EXPECT().ReadEnvironmentAddonsDir().Return([]string{"addons.parameters.yml", ".gitkeep"}, nil)
+			},
+			wantedErr: &ErrAddonsNotFound{
+				WlName: "environments",
+			},
+		},
+		"wrap error if an addon cannot be read": {
+			mockWS: func(ws *mocks.MockenvWorkspaceReader) {
+				ws.EXPECT().ReadEnvironmentAddonsDir().Return([]string{"first.yaml"}, nil)
+				ws.EXPECT().ReadEnvironmentAddon("first.yaml").Return(nil, testErr)
+			},
+			wantedErr: errors.New("read addon first.yaml under environments: some error"),
+		},
+		"merge fields successfully": {
+			mockWS: func(ws *mocks.MockenvWorkspaceReader) {
+				ws.EXPECT().ReadEnvironmentAddonsDir().Return([]string{"first.yaml", "second.yaml"}, nil)
+
+				first, _ := ioutil.ReadFile(filepath.Join("testdata", "merge", "first.yaml"))
+				ws.EXPECT().ReadEnvironmentAddon("first.yaml").Return(first, nil)
+
+				second, _ := ioutil.ReadFile(filepath.Join("testdata", "merge", "second.yaml"))
+				ws.EXPECT().ReadEnvironmentAddon("second.yaml").Return(second, nil)
+			},
+			wantedTemplate: func() string {
+				wanted, _ := ioutil.ReadFile(filepath.Join("testdata", "merge", "wanted.yaml"))
+				return string(wanted)
+			}(),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			ws := mocks.NewMockenvWorkspaceReader(ctrl)
+			tc.mockWS(ws)
+			addons := &EnvironmentAddons{
+				ws: ws,
+			}
+
+			// WHEN
+			actualTemplate, actualErr := addons.Template()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, actualErr, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, actualErr)
+				require.Equal(t, tc.wantedTemplate, actualTemplate)
+			}
+		})
+	}
+}
+
 func TestAddons_Parameters(t *testing.T) {
 	testCases := map[string]struct {
 		mockAddons func(ctrl *gomock.Controller) *Addons
@@ -28,6 +28,9 @@ type Output struct {
 	IsManagedPolicy bool
 	// SecurityGroup is true if the output value refers a SecurityGroup ARN. Otherwise, false.
 	IsSecurityGroup bool
+	// ResourceType is the CloudFormation type of the resource that the output value refers to with "Ref" or "Fn::GetAtt",
+	// such as "AWS::S3::Bucket". Empty if the output value does not refer to a resource.
+	ResourceType string
 }
 
 // Outputs parses the Outputs section of a CloudFormation template to extract logical IDs and returns them.
@@ -64,6 +67,10 @@ func Outputs(template string) ([]Output, error) {
 			output.IsSecret = typeFor[ref] == secretManagerSecretType
 			output.IsManagedPolicy = typeFor[ref] == iamManagedPolicyType
 			output.IsSecurityGroup = typeFor[ref] == securityGroupType
+			output.ResourceType = typeFor[ref]
+		}
+		if logicalID, ok := outputNode.getAtt(); ok {
+			output.ResourceType = typeFor[logicalID]
 		}
 		outputs = append(outputs, output)
 	}
@@ -152,3 +159,48 @@ func (n *outputNode) ref() (string, bool) {
 		return "", false
 	}
 }
+
+// getAtt returns the logical ID of the resource whose attribute is retrieved by the output value.
+func (n *outputNode) getAtt() (string, bool) {
+	switch n.valueNode.Kind {
+	case yaml.ScalarNode:
+		// It's a string like "!GetAtt MyDynamoDBTable.Arn"
+		if n.valueNode.Tag != "!GetAtt" {
+			return "", false
+		}
+		return logicalIDOfAttribute(n.valueNode.Value)
+	case yaml.SequenceNode:
+		// It's a list like "!GetAtt [MyDynamoDBTable, Arn]"
+		if n.valueNode.Tag != "!GetAtt" || len(n.valueNode.Content) == 0 {
+			return "", false
+		}
+		return logicalIDOfAttribute(n.valueNode.Content[0].Value)
+	case yaml.MappingNode:
+		// Check if it's a map like "Fn::GetAtt: MyDynamoDBTable.Arn" or "Fn::GetAtt: [MyDynamoDBTable, Arn]"
+		fields := struct {
+			GetAtt yaml.Node `yaml:"Fn::GetAtt"`
+		}{}
+		_ = n.valueNode.Decode(&fields)
+		switch fields.GetAtt.Kind {
+		case yaml.ScalarNode:
+			return logicalIDOfAttribute(fields.GetAtt.Value)
+		case yaml.SequenceNode:
+			if len(fields.GetAtt.Content) == 0 {
+				return "", false
+			}
+			return logicalIDOfAttribute(fields.GetAtt.Content[0].Value)
+		}
+		return "", false
+	default:
+		return "", false
+	}
+}
+
+// logicalIDOfAttribute returns the logical ID out of an attribute reference like "MyDynamoDBTable.Arn".
+func logicalIDOfAttribute(attr string) (string, bool) {
+	logicalID := strings.Split(strings.TrimSpace(attr), ".")[0]
+	if logicalID == "" {
+		return "", false
+	}
+	return logicalID, true
+}
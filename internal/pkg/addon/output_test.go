@@ -96,6 +96,39 @@ Outputs:
 				},
 			},
 		},
+		"sets the resource type of outputs that retrieve an attribute": {
+			template: `Resources:
+  MyQueue:
+    Type: AWS::SQS::Queue
+  MyCache:
+    Type: AWS::ElastiCache::ReplicationGroup
+  MyDomain:
+    Type: AWS::OpenSearchService::Domain
+
+Outputs:
+  MyQueueArn:
+    Value: !GetAtt [MyQueue, Arn]
+  MyCacheEndpoint:
+    Value:
+      Fn::GetAtt: MyCache.PrimaryEndPoint.Address
+  MyDomainEndpoint:
+    Value:
+      Fn::GetAtt: [MyDomain, DomainEndpoint]`,
+			wantedOut: []Output{
+				{
+					Name:         "MyQueueArn",
+					ResourceType: "AWS::SQS::Queue",
+				},
+				{
+					Name:         "MyCacheEndpoint",
+					ResourceType: "AWS::ElastiCache::ReplicationGroup",
+				},
+				{
+					Name:         "MyDomainEndpoint",
+					ResourceType: "AWS::OpenSearchService::Domain",
+				},
+			},
+		},
 		"parses CFN template with an IAM managed policy and secret": {
 			testdataFileName: "template.yml",
 
@@ -103,16 +136,20 @@ Outputs:
 				{
 					Name:            "AdditionalResourcesPolicyArn",
 					IsManagedPolicy: true,
+					ResourceType:    "AWS::IAM::ManagedPolicy",
 				},
 				{
-					Name:     "MyRDSInstanceRotationSecretArn",
-					IsSecret: true,
+					Name:         "MyRDSInstanceRotationSecretArn",
+					IsSecret:     true,
+					ResourceType: "AWS::SecretsManager::Secret",
 				},
 				{
-					Name: "MyDynamoDBTableName",
+					Name:         "MyDynamoDBTableName",
+					ResourceType: "AWS::DynamoDB::Table",
 				},
 				{
-					Name: "MyDynamoDBTableArn",
+					Name:         "MyDynamoDBTableArn",
+					ResourceType: "AWS::DynamoDB::Table",
 				},
 				{
 					Name: "TestExport",
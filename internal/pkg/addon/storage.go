@@ -7,19 +7,24 @@ package addon
 import (
 	"fmt"
 	"regexp"
+	"strconv"
 	"strings"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
 
 	"github.com/aws/copilot-cli/internal/pkg/template"
 )
 
 const (
-	dynamoDbTemplatePath = "addons/ddb/cf.yml"
-	s3TemplatePath       = "addons/s3/cf.yml"
-	rdsTemplatePath      = "addons/aurora/cf.yml"
-	rdsRDWSTemplatePath  = "addons/aurora/rdws/cf.yml"
-	rdsRDWSParamsPath    = "addons/aurora/rdws/addons.parameters.yml"
+	dynamoDbTemplatePath   = "addons/ddb/cf.yml"
+	s3TemplatePath         = "addons/s3/cf.yml"
+	rdsTemplatePath        = "addons/aurora/cf.yml"
+	rdsRDWSTemplatePath    = "addons/aurora/rdws/cf.yml"
+	rdsRDWSParamsPath      = "addons/aurora/rdws/addons.parameters.yml"
+	redisTemplatePath      = "addons/redis/cf.yml"
+	openSearchTemplatePath = "addons/opensearch/cf.yml"
+	sqsTemplatePath        = "addons/sqs/cf.yml"
 )
 
 const (
@@ -28,7 +33,33 @@ const (
 	RDSEngineTypePostgreSQL = "PostgreSQL"
 )
 
-var regexpMatchAttribute = regexp.MustCompile(`^(\S+):([sbnSBN])`)
+const (
+	// Cluster types for RDS Aurora.
+	RDSClusterTypeServerless   = "Serverless"
+	RDSClusterTypeServerlessV2 = "ServerlessV2"
+	RDSClusterTypeProvisioned  = "Provisioned"
+)
+
+const (
+	// Billing modes of a DynamoDB table.
+	DDBBillingModePayPerRequest = "PAY_PER_REQUEST"
+	DDBBillingModeProvisioned   = "PROVISIONED"
+)
+
+// S3StorageClasses are the storage classes that objects of an S3 bucket can transition to.
+var S3StorageClasses = []string{
+	"STANDARD_IA",
+	"ONEZONE_IA",
+	"INTELLIGENT_TIERING",
+	"GLACIER_IR",
+	"GLACIER",
+	"DEEP_ARCHIVE",
+}
+
+var (
+	regexpMatchAttribute  = regexp.MustCompile(`^(\S+):([sbnSBN])`)
+	regexpMatchTransition = regexp.MustCompile(`^([A-Z_]+):([1-9][0-9]*)$`)
+)
 
 var storageTemplateFunctions = map[string]interface{}{
 	"logicalIDSafe": template.StripNonAlphaNumFunc,
@@ -71,7 +102,7 @@ func (s *S3Template) MarshalBinary() ([]byte, error) {
 	return content.Bytes(), nil
 }
 
-// RDSTemplate contains configuration options which fully describe a RDS Aurora Serverless cluster.
+// RDSTemplate contains configuration options which fully describe a RDS Aurora cluster.
 // Implements the encoding.BinaryMarshaler interface.
 type RDSTemplate struct {
 	RDSProps
@@ -92,6 +123,57 @@ func (r *RDSTemplate) MarshalBinary() ([]byte, error) {
 	return content.Bytes(), nil
 }
 
+// RedisTemplate contains configuration options which fully describe an ElastiCache Redis cluster.
+// Implements the encoding.BinaryMarshaler interface.
+type RedisTemplate struct {
+	RedisProps
+
+	parser template.Parser
+}
+
+// MarshalBinary serializes the content of the template into binary.
+func (r *RedisTemplate) MarshalBinary() ([]byte, error) {
+	content, err := r.parser.Parse(redisTemplatePath, *r, template.WithFuncs(storageTemplateFunctions))
+	if err != nil {
+		return nil, err
+	}
+	return content.Bytes(), nil
+}
+
+// OpenSearchTemplate contains configuration options which fully describe an OpenSearch domain.
+// Implements the encoding.BinaryMarshaler interface.
+type OpenSearchTemplate struct {
+	OpenSearchProps
+
+	parser template.Parser
+}
+
+// MarshalBinary serializes the content of the template into binary.
+func (o *OpenSearchTemplate) MarshalBinary() ([]byte, error) {
+	content, err := o.parser.Parse(openSearchTemplatePath, *o, template.WithFuncs(storageTemplateFunctions))
+	if err != nil {
+		return nil, err
+	}
+	return content.Bytes(), nil
+}
+
+// SQSTemplate contains configuration options which fully describe an SQS queue.
+// Implements the encoding.BinaryMarshaler interface.
+type SQSTemplate struct {
+	SQSProps
+
+	parser template.Parser
+}
+
+// MarshalBinary serializes the content of the template into binary.
+func (q *SQSTemplate) MarshalBinary() ([]byte, error) {
+	content, err := q.parser.Parse(sqsTemplatePath, *q, template.WithFuncs(storageTemplateFunctions))
+	if err != nil {
+		return nil, err
+	}
+	return content.Bytes(), nil
+}
+
 // RDSParams represents the addons.parameters.yml file for a RDS Aurora Serverless cluster.
 type RDSParams struct {
 	parser template.Parser
@@ -108,12 +190,41 @@ func (r *RDSParams) MarshalBinary() ([]byte, error) {
 
 // StorageProps holds basic input properties for addon.NewDDBTemplate() or addon.NewS3Template().
 type StorageProps struct {
-	Name string
+	Name      string
+	EnvScoped bool // True if the storage is deployed with the environment stack and shared by its workloads.
 }
 
 // S3Props contains S3-specific properties for addon.NewS3Template().
 type S3Props struct {
 	*StorageProps
+	Versioning         bool           // True if the bucket keeps every version of its objects.
+	ExpirationDays     int            // The number of days after which objects expire. Zero if objects don't expire.
+	Transitions        []S3Transition // The transitions of objects to other storage classes.
+	CORSAllowedOrigins []string       // The origins allowed to make cross-origin requests to the bucket.
+	KMSEncryption      bool           // True if objects are encrypted with a customer managed KMS key instead of AES256.
+	CloudFront         bool           // True if a CloudFront distribution serves the objects of the bucket.
+}
+
+// S3Transition holds a lifecycle rule that moves objects to another storage class.
+type S3Transition struct {
+	StorageClass string
+	Days         int
+}
+
+// S3TransitionFromString parses the storage class and the number of days out of transitions specified in the form "GLACIER:90".
+func S3TransitionFromString(input string) (S3Transition, error) {
+	parts := regexpMatchTransition.FindStringSubmatch(input)
+	if len(parts) == 0 {
+		return S3Transition{}, fmt.Errorf("parse transition: %s", input)
+	}
+	days, err := strconv.Atoi(parts[2])
+	if err != nil {
+		return S3Transition{}, fmt.Errorf("parse days of transition %s: %w", input, err)
+	}
+	return S3Transition{
+		StorageClass: parts[1],
+		Days:         days,
+	}, nil
 }
 
 // NewS3Template creates a new S3 marshaler which can be used to write CF via addonWriter.
@@ -130,9 +241,15 @@ type DynamoDBProps struct {
 	*StorageProps
 	Attributes   []DDBAttribute
 	LSIs         []DDBLocalSecondaryIndex
+	GSIs         []DDBGlobalSecondaryIndex
 	SortKey      *string
 	PartitionKey *string
 	HasLSI       bool
+
+	TTLAttribute   string   // The attribute holding the expiration time of items. Empty if TTL is disabled.
+	StreamViewType string   // The information written to the stream when an item is modified. Empty if streams are disabled.
+	BillingMode    string   // Either DDBBillingModePayPerRequest or DDBBillingModeProvisioned.
+	Envs           []string // The copilot environments found inside the current app, used to configure provisioned capacity.
 }
 
 // NewDDBTemplate creates a DynamoDB cloudformation template specifying attributes,
@@ -149,14 +266,34 @@ func NewDDBTemplate(input *DynamoDBProps) *DynamoDBTemplate {
 type RDSProps struct {
 	WorkloadType   string   // The type of the workload associated with the RDS addon.
 	ClusterName    string   // The name of the cluster.
-	Engine         string   // The engine type of the RDS Aurora Serverless cluster.
+	Engine         string   // The engine type of the RDS Aurora cluster.
 	InitialDBName  string   // The name of the initial database created inside the cluster.
 	ParameterGroup string   // The parameter group to use for the cluster.
 	Envs           []string // The copilot environments found inside the current app.
+
+	ClusterType           string // One of RDSClusterTypeServerless, RDSClusterTypeServerlessV2 or RDSClusterTypeProvisioned.
+	InstanceClass         string // The instance class of a provisioned cluster, such as "db.r6g.large".
+	Readers               int    // The number of reader instances of a provisioned or Serverless v2 cluster.
+	BackupRetentionPeriod int    // The number of days automated backups are kept. Zero to use the RDS default.
+	DeletionProtection    bool   // True if the cluster cannot be deleted.
+	SnapshotIdentifier    string // The snapshot to restore the cluster from. Empty to create an empty cluster.
+}
+
+// ReaderInstanceIDs returns the logical IDs of the reader instances of the cluster.
+func (r RDSProps) ReaderInstanceIDs() []string {
+	ids := make([]string, r.Readers)
+	for i := range ids {
+		ids[i] = fmt.Sprintf("%sDBReaderInstance%d", template.StripNonAlphaNumFunc(r.ClusterName), i+1)
+	}
+	return ids
 }
 
 // NewRDSTemplate creates a new RDS marshaler which can be used to write a RDS CloudFormation template.
+// The cluster is an Aurora Serverless v1 cluster if input.ClusterType is empty.
 func NewRDSTemplate(input RDSProps) *RDSTemplate {
+	if input.ClusterType == "" {
+		input.ClusterType = RDSClusterTypeServerless
+	}
 	return &RDSTemplate{
 		RDSProps: input,
 
@@ -164,6 +301,51 @@ func NewRDSTemplate(input RDSProps) *RDSTemplate {
 	}
 }
 
+// RedisProps contains ElastiCache Redis-specific properties for addon.NewRedisTemplate().
+type RedisProps struct {
+	*StorageProps
+	NodeType string // The compute and memory capacity of the nodes, such as "cache.t3.micro".
+}
+
+// NewRedisTemplate creates a new ElastiCache Redis marshaler which can be used to write CF via addonWriter.
+func NewRedisTemplate(input *RedisProps) *RedisTemplate {
+	return &RedisTemplate{
+		RedisProps: *input,
+
+		parser: template.New(),
+	}
+}
+
+// OpenSearchProps contains OpenSearch-specific properties for addon.NewOpenSearchTemplate().
+type OpenSearchProps struct {
+	*StorageProps
+	InstanceType string // The instance type of the data nodes, such as "t3.small.search".
+}
+
+// NewOpenSearchTemplate creates a new OpenSearch marshaler which can be used to write CF via addonWriter.
+func NewOpenSearchTemplate(input *OpenSearchProps) *OpenSearchTemplate {
+	return &OpenSearchTemplate{
+		OpenSearchProps: *input,
+
+		parser: template.New(),
+	}
+}
+
+// SQSProps contains SQS-specific properties for addon.NewSQSTemplate().
+type SQSProps struct {
+	*StorageProps
+	FIFO bool // True if the queue preserves the order of messages and delivers them exactly once.
+}
+
+// NewSQSTemplate creates a new SQS marshaler which can be used to write CF via addonWriter.
+func NewSQSTemplate(input *SQSProps) *SQSTemplate {
+	return &SQSTemplate{
+		SQSProps: *input,
+
+		parser: template.New(),
+	}
+}
+
 // NewRDSParams creates a new RDS parameters marshaler.
 func NewRDSParams() *RDSParams {
 	return &RDSParams{
@@ -227,6 +409,106 @@ func (p *DynamoDBProps) BuildLocalSecondaryIndex(noLSI bool, lsiSorts []string)
 	return true, nil
 }
 
+// BuildGlobalSecondaryIndex generates the GlobalSecondaryIndex property configuration from keys specified
+// in the form "Email:S" or "Email:S,CreatedAt:N". BuildGlobalSecondaryIndex should be called last, after BuildPartitionKey,
+// BuildSortKey and BuildLocalSecondaryIndex so that attributes already defined aren't duplicated.
+func (p *DynamoDBProps) BuildGlobalSecondaryIndex(gsis []string) error {
+	for _, gsi := range gsis {
+		keys := strings.Split(gsi, ",")
+		if len(keys) > 2 {
+			return fmt.Errorf("parse global secondary index %s: must have at most a partition key and a sort key", gsi)
+		}
+		var index DDBGlobalSecondaryIndex
+		var names []string
+		for i, key := range keys {
+			attr, err := DDBAttributeFromKey(key)
+			if err != nil {
+				return err
+			}
+			if err := p.addAttribute(attr); err != nil {
+				return fmt.Errorf("parse global secondary index %s: %w", gsi, err)
+			}
+			names = append(names, *attr.Name)
+			if i == 0 {
+				index.PartitionKey = attr.Name
+				continue
+			}
+			index.SortKey = attr.Name
+		}
+		name := strings.Join(names, "-")
+		if p.hasIndex(name) {
+			return fmt.Errorf("parse global secondary index %s: an index named %s is already defined", gsi, name)
+		}
+		index.Name = &name
+		p.GSIs = append(p.GSIs, index)
+	}
+	return nil
+}
+
+// addAttribute adds the attribute definition unless an attribute with the same name is already defined.
+// It returns an error if the attribute is already defined with a different type.
+func (p *DynamoDBProps) addAttribute(attr DDBAttribute) error {
+	for _, existing := range p.Attributes {
+		if aws.StringValue(existing.Name) != aws.StringValue(attr.Name) {
+			continue
+		}
+		if aws.StringValue(existing.DataType) != aws.StringValue(attr.DataType) {
+			return fmt.Errorf("attribute %s is already defined with type %s", aws.StringValue(attr.Name), aws.StringValue(existing.DataType))
+		}
+		return nil
+	}
+	p.Attributes = append(p.Attributes, attr)
+	return nil
+}
+
+// hasIndex returns true if a local or global secondary index with the name is already defined.
+// Index names must be unique within a table.
+func (p *DynamoDBProps) hasIndex(name string) bool {
+	for _, lsi := range p.LSIs {
+		if aws.StringValue(lsi.Name) == name {
+			return true
+		}
+	}
+	for _, gsi := range p.GSIs {
+		if aws.StringValue(gsi.Name) == name {
+			return true
+		}
+	}
+	return false
+}
+
+// DDBScalableTarget holds the read or write capacity of a table or global secondary index that scales with its utilization.
+type DDBScalableTarget struct {
+	LogicalID   string // Prefix of the logical IDs of the scalable target and its scaling policy.
+	IndexName   string // Empty if the capacity is the table's.
+	Capacity    string // Either "Read" or "Write".
+	Description string
+}
+
+// ScalableTargets returns the read and write capacities of the table and its global secondary indexes.
+func (p DynamoDBProps) ScalableTargets() []DDBScalableTarget {
+	tableID := template.StripNonAlphaNumFunc(p.Name)
+	targets := newDDBScalableTargets(tableID, "", fmt.Sprintf("the %s table", p.Name))
+	for _, gsi := range p.GSIs {
+		name := aws.StringValue(gsi.Name)
+		targets = append(targets, newDDBScalableTargets(tableID+template.StripNonAlphaNumFunc(name), name, fmt.Sprintf("the %s index", name))...)
+	}
+	return targets
+}
+
+func newDDBScalableTargets(logicalID, indexName, description string) []DDBScalableTarget {
+	var targets []DDBScalableTarget
+	for _, capacity := range []string{"Read", "Write"} {
+		targets = append(targets, DDBScalableTarget{
+			LogicalID:   logicalID + capacity,
+			IndexName:   indexName,
+			Capacity:    capacity,
+			Description: fmt.Sprintf("the %s capacity of %s", strings.ToLower(capacity), description),
+		})
+	}
+	return targets
+}
+
 // DDBAttribute holds the attribute definition of a DynamoDB attribute (keys, local secondary indices).
 type DDBAttribute struct {
 	Name     *string
@@ -253,6 +535,13 @@ type DDBLocalSecondaryIndex struct {
 	Name         *string
 }
 
+// DDBGlobalSecondaryIndex holds a representation of a GSI.
+type DDBGlobalSecondaryIndex struct {
+	PartitionKey *string
+	SortKey      *string // Optional.
+	Name         *string
+}
+
 func newLSI(partitionKey string, lsis []string) ([]DDBLocalSecondaryIndex, error) {
 	var output []DDBLocalSecondaryIndex
 	for _, lsi := range lsis {
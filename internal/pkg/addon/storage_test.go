@@ -10,6 +10,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/copilot-cli/internal/pkg/template"
 	"github.com/aws/copilot-cli/internal/pkg/template/mocks"
 	"github.com/golang/mock/gomock"
@@ -108,6 +109,141 @@ func TestS3Template_MarshalBinary(t *testing.T) {
 	}
 }
 
+func TestRedisTemplate_MarshalBinary(t *testing.T) {
+	testCases := map[string]struct {
+		mockDependencies func(ctrl *gomock.Controller, redis *RedisTemplate)
+
+		wantedBinary []byte
+		wantedError  error
+	}{
+		"error parsing template": {
+			mockDependencies: func(ctrl *gomock.Controller, redis *RedisTemplate) {
+				m := mocks.NewMockParser(ctrl)
+				redis.parser = m
+				m.EXPECT().Parse(redisTemplatePath, *redis, gomock.Any()).Return(nil, errors.New("some error"))
+			},
+
+			wantedError: errors.New("some error"),
+		},
+		"returns rendered content": {
+			mockDependencies: func(ctrl *gomock.Controller, redis *RedisTemplate) {
+				m := mocks.NewMockParser(ctrl)
+				redis.parser = m
+				m.EXPECT().Parse(redisTemplatePath, *redis, gomock.Any()).Return(&template.Content{Buffer: bytes.NewBufferString("hello")}, nil)
+			},
+
+			wantedBinary: []byte("hello"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			addon := &RedisTemplate{}
+			tc.mockDependencies(ctrl, addon)
+
+			// WHEN
+			b, err := addon.MarshalBinary()
+
+			// THEN
+			require.Equal(t, tc.wantedError, err)
+			require.Equal(t, tc.wantedBinary, b)
+		})
+	}
+}
+
+func TestOpenSearchTemplate_MarshalBinary(t *testing.T) {
+	testCases := map[string]struct {
+		mockDependencies func(ctrl *gomock.Controller, domain *OpenSearchTemplate)
+
+		wantedBinary []byte
+		wantedError  error
+	}{
+		"error parsing template": {
+			mockDependencies: func(ctrl *gomock.Controller, domain *OpenSearchTemplate) {
+				m := mocks.NewMockParser(ctrl)
+				domain.parser = m
+				m.EXPECT().Parse(openSearchTemplatePath, *domain, gomock.Any()).Return(nil, errors.New("some error"))
+			},
+
+			wantedError: errors.New("some error"),
+		},
+		"returns rendered content": {
+			mockDependencies: func(ctrl *gomock.Controller, domain *OpenSearchTemplate) {
+				m := mocks.NewMockParser(ctrl)
+				domain.parser = m
+				m.EXPECT().Parse(openSearchTemplatePath, *domain, gomock.Any()).Return(&template.Content{Buffer: bytes.NewBufferString("hello")}, nil)
+			},
+
+			wantedBinary: []byte("hello"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			addon := &OpenSearchTemplate{}
+			tc.mockDependencies(ctrl, addon)
+
+			// WHEN
+			b, err := addon.MarshalBinary()
+
+			// THEN
+			require.Equal(t, tc.wantedError, err)
+			require.Equal(t, tc.wantedBinary, b)
+		})
+	}
+}
+
+func TestSQSTemplate_MarshalBinary(t *testing.T) {
+	testCases := map[string]struct {
+		mockDependencies func(ctrl *gomock.Controller, queue *SQSTemplate)
+
+		wantedBinary []byte
+		wantedError  error
+	}{
+		"error parsing template": {
+			mockDependencies: func(ctrl *gomock.Controller, queue *SQSTemplate) {
+				m := mocks.NewMockParser(ctrl)
+				queue.parser = m
+				m.EXPECT().Parse(sqsTemplatePath, *queue, gomock.Any()).Return(nil, errors.New("some error"))
+			},
+
+			wantedError: errors.New("some error"),
+		},
+		"returns rendered content": {
+			mockDependencies: func(ctrl *gomock.Controller, queue *SQSTemplate) {
+				m := mocks.NewMockParser(ctrl)
+				queue.parser = m
+				m.EXPECT().Parse(sqsTemplatePath, *queue, gomock.Any()).Return(&template.Content{Buffer: bytes.NewBufferString("hello")}, nil)
+			},
+
+			wantedBinary: []byte("hello"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			addon := &SQSTemplate{}
+			tc.mockDependencies(ctrl, addon)
+
+			// WHEN
+			b, err := addon.MarshalBinary()
+
+			// THEN
+			require.Equal(t, tc.wantedError, err)
+			require.Equal(t, tc.wantedBinary, b)
+		})
+	}
+}
+
 func TestRDSTemplate_MarshalBinary(t *testing.T) {
 	testCases := map[string]struct {
 		workloadType     string
@@ -494,3 +630,198 @@ func TestBuildLocalSecondaryIndex(t *testing.T) {
 		})
 	}
 }
+
+func TestBuildGlobalSecondaryIndex(t *testing.T) {
+	testCases := map[string]struct {
+		inGSIs []string
+
+		wantedGSIs       []DDBGlobalSecondaryIndex
+		wantedAttributes []DDBAttribute
+		wantedError      error
+	}{
+		"error if a key is malformed": {
+			inGSIs:      []string{"email"},
+			wantedError: errors.New("parse attribute from key: email"),
+		},
+		"error if there are more than two keys": {
+			inGSIs:      []string{"email:S,name:S,age:N"},
+			wantedError: errors.New("parse global secondary index email:S,name:S,age:N: must have at most a partition key and a sort key"),
+		},
+		"error if an attribute is already defined with a different type": {
+			inGSIs:      []string{"id:N"},
+			wantedError: errors.New("parse global secondary index id:N: attribute id is already defined with type S"),
+		},
+		"error if an index name is defined more than once": {
+			inGSIs:      []string{"email:S", "email:S"},
+			wantedError: errors.New("parse global secondary index email:S: an index named email is already defined"),
+		},
+		"error if an index name is already used by a local secondary index": {
+			inGSIs:      []string{"createdAt:N"},
+			wantedError: errors.New("parse global secondary index createdAt:N: an index named createdAt is already defined"),
+		},
+		"builds indexes without duplicating attributes": {
+			inGSIs: []string{"email:S", "customer:S,id:S"},
+
+			wantedGSIs: []DDBGlobalSecondaryIndex{
+				{
+					Name:         aws.String("email"),
+					PartitionKey: aws.String("email"),
+				},
+				{
+					Name:         aws.String("customer-id"),
+					PartitionKey: aws.String("customer"),
+					SortKey:      aws.String("id"),
+				},
+			},
+			wantedAttributes: []DDBAttribute{
+				{
+					Name:     aws.String("id"),
+					DataType: aws.String("S"),
+				},
+				{
+					Name:     aws.String("email"),
+					DataType: aws.String("S"),
+				},
+				{
+					Name:     aws.String("customer"),
+					DataType: aws.String("S"),
+				},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			props := DynamoDBProps{
+				Attributes: []DDBAttribute{
+					{
+						Name:     aws.String("id"),
+						DataType: aws.String("S"),
+					},
+				},
+				LSIs: []DDBLocalSecondaryIndex{
+					{
+						Name:         aws.String("createdAt"),
+						PartitionKey: aws.String("id"),
+						SortKey:      aws.String("createdAt"),
+					},
+				},
+			}
+
+			// WHEN
+			err := props.BuildGlobalSecondaryIndex(tc.inGSIs)
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedGSIs, props.GSIs)
+			require.Equal(t, tc.wantedAttributes, props.Attributes)
+		})
+	}
+}
+
+func TestDynamoDBProps_ScalableTargets(t *testing.T) {
+	props := DynamoDBProps{
+		StorageProps: &StorageProps{
+			Name: "my-table",
+		},
+		GSIs: []DDBGlobalSecondaryIndex{
+			{
+				Name:         aws.String("email"),
+				PartitionKey: aws.String("email"),
+			},
+		},
+	}
+
+	require.Equal(t, []DDBScalableTarget{
+		{
+			LogicalID:   "mytableRead",
+			Capacity:    "Read",
+			Description: "the read capacity of the my-table table",
+		},
+		{
+			LogicalID:   "mytableWrite",
+			Capacity:    "Write",
+			Description: "the write capacity of the my-table table",
+		},
+		{
+			LogicalID:   "mytableemailRead",
+			IndexName:   "email",
+			Capacity:    "Read",
+			Description: "the read capacity of the email index",
+		},
+		{
+			LogicalID:   "mytableemailWrite",
+			IndexName:   "email",
+			Capacity:    "Write",
+			Description: "the write capacity of the email index",
+		},
+	}, props.ScalableTargets())
+}
+
+func TestRDSProps_ReaderInstanceIDs(t *testing.T) {
+	testCases := map[string]struct {
+		readers int
+
+		wantedIDs []string
+	}{
+		"no readers": {
+			wantedIDs: []string{},
+		},
+		"multiple readers": {
+			readers:   2,
+			wantedIDs: []string{"mydbDBReaderInstance1", "mydbDBReaderInstance2"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			props := RDSProps{
+				ClusterName: "my-db",
+				Readers:     tc.readers,
+			}
+
+			require.Equal(t, tc.wantedIDs, props.ReaderInstanceIDs())
+		})
+	}
+}
+
+func TestS3TransitionFromString(t *testing.T) {
+	testCases := map[string]struct {
+		input string
+
+		wanted      S3Transition
+		wantedError error
+	}{
+		"good case": {
+			input: "GLACIER:90",
+			wanted: S3Transition{
+				StorageClass: "GLACIER",
+				Days:         90,
+			},
+		},
+		"missing days": {
+			input:       "GLACIER",
+			wantedError: fmt.Errorf("parse transition: %s", "GLACIER"),
+		},
+		"zero days": {
+			input:       "STANDARD_IA:0",
+			wantedError: fmt.Errorf("parse transition: %s", "STANDARD_IA:0"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := S3TransitionFromString(tc.input)
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wanted, got)
+			}
+		})
+	}
+}
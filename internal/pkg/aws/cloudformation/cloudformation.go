@@ -276,11 +276,15 @@ func (c *CloudFormation) Events(stackName string) ([]StackEvent, error) {
 }
 
 // StackResources returns the list of resources created as part of a CloudFormation stack.
+// If the stack does not exist, returns ErrStackNotFound.
 func (c *CloudFormation) StackResources(name string) ([]*StackResource, error) {
 	out, err := c.DescribeStackResources(&cloudformation.DescribeStackResourcesInput{
 		StackName: aws.String(name),
 	})
 	if err != nil {
+		if stackDoesNotExist(err) {
+			return nil, &ErrStackNotFound{name: name}
+		}
 		return nil, fmt.Errorf("describe resources for stack %s: %w", name, err)
 	}
 	var resources []*StackResource
@@ -1148,6 +1148,14 @@ func TestStackDescriber_StackResources(t *testing.T) {
 			},
 			wantedError: fmt.Errorf("describe resources for stack phonetool-test-api: some error"),
 		},
+		"return ErrStackNotFound if stack does not exist": {
+			createMock: func(ctrl *gomock.Controller) client {
+				m := mocks.NewMockclient(ctrl)
+				m.EXPECT().DescribeStackResources(gomock.Any()).Return(nil, errDoesNotExist)
+				return m
+			},
+			wantedError: &ErrStackNotFound{name: "phonetool-test-api"},
+		},
 		"returns type-casted stack resources on success": {
 			createMock: func(ctrl *gomock.Controller) client {
 				m := mocks.NewMockclient(ctrl)
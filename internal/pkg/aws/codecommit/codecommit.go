@@ -0,0 +1,44 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package codecommit provides a client to make API requests to AWS CodeCommit.
+package codecommit
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/codecommit"
+)
+
+type api interface {
+	GetCommit(input *codecommit.GetCommitInput) (*codecommit.GetCommitOutput, error)
+}
+
+// CodeCommit wraps an AWS CodeCommit client.
+type CodeCommit struct {
+	client api
+}
+
+// New returns CodeCommit configured against the input session.
+func New(s *session.Session) *CodeCommit {
+	return &CodeCommit{
+		client: codecommit.New(s),
+	}
+}
+
+// CommitAuthor returns the name of the author of a commit in a repository.
+func (c *CodeCommit) CommitAuthor(repository, commitID string) (string, error) {
+	out, err := c.client.GetCommit(&codecommit.GetCommitInput{
+		RepositoryName: aws.String(repository),
+		CommitId:       aws.String(commitID),
+	})
+	if err != nil {
+		return "", fmt.Errorf("get commit %s in repository %s: %w", commitID, repository, err)
+	}
+	if out.Commit == nil || out.Commit.Author == nil {
+		return "", nil
+	}
+	return aws.StringValue(out.Commit.Author.Name), nil
+}
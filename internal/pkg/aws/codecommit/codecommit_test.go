@@ -0,0 +1,71 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package codecommit
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/codecommit"
+	"github.com/aws/copilot-cli/internal/pkg/aws/codecommit/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCodeCommit_CommitAuthor(t *testing.T) {
+	testCases := map[string]struct {
+		mockClient func(m *mocks.Mockapi)
+
+		wantedAuthor string
+		wantedError  error
+	}{
+		"returns wrapped error if fail to get the commit": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().GetCommit(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("get commit abcdef123456 in repository badgoose: some error"),
+		},
+		"returns the name of the author": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().GetCommit(&codecommit.GetCommitInput{
+					RepositoryName: aws.String("badgoose"),
+					CommitId:       aws.String("abcdef123456"),
+				}).Return(&codecommit.GetCommitOutput{
+					Commit: &codecommit.Commit{
+						Author: &codecommit.UserInfo{
+							Name: aws.String("Alice"),
+						},
+					},
+				}, nil)
+			},
+			wantedAuthor: "Alice",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockClient := mocks.NewMockapi(ctrl)
+			tc.mockClient(mockClient)
+			cc := CodeCommit{
+				client: mockClient,
+			}
+
+			// WHEN
+			author, err := cc.CommitAuthor("badgoose", "abcdef123456")
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedAuthor, author)
+			}
+		})
+	}
+}
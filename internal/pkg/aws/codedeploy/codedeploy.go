@@ -0,0 +1,118 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package codedeploy provides a client to make API requests to AWS CodeDeploy.
+package codedeploy
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/codedeploy"
+)
+
+type api interface {
+	GetDeployment(input *codedeploy.GetDeploymentInput) (*codedeploy.GetDeploymentOutput, error)
+	ListDeploymentTargets(input *codedeploy.ListDeploymentTargetsInput) (*codedeploy.ListDeploymentTargetsOutput, error)
+	GetDeploymentTarget(input *codedeploy.GetDeploymentTargetInput) (*codedeploy.GetDeploymentTargetOutput, error)
+}
+
+// CodeDeploy wraps an AWS CodeDeploy client.
+type CodeDeploy struct {
+	client api
+}
+
+// ECSDeployment represents a blue/green deployment of an ECS service.
+type ECSDeployment struct {
+	ID                     string
+	Status                 string
+	ErrorMessage           string
+	TerminationWaitStarted bool // True once the traffic is shifted and the original task set waits to be terminated.
+	TaskSets               []ECSTaskSet
+}
+
+// IsDone returns true if the deployment reached a final status.
+func (d *ECSDeployment) IsDone() bool {
+	switch d.Status {
+	case codedeploy.DeploymentStatusSucceeded, codedeploy.DeploymentStatusFailed, codedeploy.DeploymentStatusStopped:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsSuccess returns true if the deployment succeeded.
+func (d *ECSDeployment) IsSuccess() bool {
+	return d.Status == codedeploy.DeploymentStatusSucceeded
+}
+
+// IsTrafficShifted returns true if the replacement task set serves all the production traffic.
+// The original task set only serves traffic again if the deployment is rolled back.
+func (d *ECSDeployment) IsTrafficShifted() bool {
+	return d.IsSuccess() || d.TerminationWaitStarted
+}
+
+// ECSTaskSet represents the original (blue) or replacement (green) set of tasks in a deployment.
+type ECSTaskSet struct {
+	Label         string // Either "Blue" or "Green".
+	DesiredCount  int
+	RunningCount  int
+	PendingCount  int
+	TrafficWeight float64 // Percentage of the production traffic served by the task set.
+}
+
+// New returns a CodeDeploy client configured against the input session.
+func New(s *session.Session) *CodeDeploy {
+	return &CodeDeploy{
+		client: codedeploy.New(s),
+	}
+}
+
+// ECSDeployment returns the status of a deployment along with the task sets of the ECS service being deployed.
+func (c *CodeDeploy) ECSDeployment(deploymentID string) (*ECSDeployment, error) {
+	out, err := c.client.GetDeployment(&codedeploy.GetDeploymentInput{
+		DeploymentId: aws.String(deploymentID),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get deployment %s: %w", deploymentID, err)
+	}
+	deployment := &ECSDeployment{
+		ID:                     deploymentID,
+		Status:                 aws.StringValue(out.DeploymentInfo.Status),
+		TerminationWaitStarted: aws.BoolValue(out.DeploymentInfo.InstanceTerminationWaitTimeStarted),
+	}
+	if info := out.DeploymentInfo.ErrorInformation; info != nil {
+		deployment.ErrorMessage = aws.StringValue(info.Message)
+	}
+	targets, err := c.client.ListDeploymentTargets(&codedeploy.ListDeploymentTargetsInput{
+		DeploymentId: aws.String(deploymentID),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list targets of deployment %s: %w", deploymentID, err)
+	}
+	if len(targets.TargetIds) == 0 {
+		// The service hasn't been picked up by the deployment yet.
+		return deployment, nil
+	}
+	target, err := c.client.GetDeploymentTarget(&codedeploy.GetDeploymentTargetInput{
+		DeploymentId: aws.String(deploymentID),
+		TargetId:     targets.TargetIds[0],
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get target %s of deployment %s: %w", aws.StringValue(targets.TargetIds[0]), deploymentID, err)
+	}
+	if target.DeploymentTarget.EcsTarget == nil {
+		return deployment, nil
+	}
+	for _, taskSet := range target.DeploymentTarget.EcsTarget.TaskSetsInfo {
+		deployment.TaskSets = append(deployment.TaskSets, ECSTaskSet{
+			Label:         aws.StringValue(taskSet.TaskSetLabel),
+			DesiredCount:  int(aws.Int64Value(taskSet.DesiredCount)),
+			RunningCount:  int(aws.Int64Value(taskSet.RunningCount)),
+			PendingCount:  int(aws.Int64Value(taskSet.PendingCount)),
+			TrafficWeight: aws.Float64Value(taskSet.TrafficWeight),
+		})
+	}
+	return deployment, nil
+}
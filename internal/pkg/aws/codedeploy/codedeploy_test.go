@@ -0,0 +1,174 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package codedeploy
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/codedeploy"
+	"github.com/aws/copilot-cli/internal/pkg/aws/codedeploy/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCodeDeploy_ECSDeployment(t *testing.T) {
+	const mockDeploymentID = "d-ABCDEF123"
+	testCases := map[string]struct {
+		setupMocks func(m *mocks.Mockapi)
+
+		wantedDeployment *ECSDeployment
+		wantedError      error
+	}{
+		"errors if fail to get the deployment": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().GetDeployment(&codedeploy.GetDeploymentInput{
+					DeploymentId: aws.String(mockDeploymentID),
+				}).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("get deployment d-ABCDEF123: some error"),
+		},
+		"errors if fail to list the deployment targets": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().GetDeployment(gomock.Any()).Return(&codedeploy.GetDeploymentOutput{
+					DeploymentInfo: &codedeploy.DeploymentInfo{
+						Status: aws.String("InProgress"),
+					},
+				}, nil)
+				m.EXPECT().ListDeploymentTargets(&codedeploy.ListDeploymentTargetsInput{
+					DeploymentId: aws.String(mockDeploymentID),
+				}).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("list targets of deployment d-ABCDEF123: some error"),
+		},
+		"returns the status only if the deployment has no targets yet": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().GetDeployment(gomock.Any()).Return(&codedeploy.GetDeploymentOutput{
+					DeploymentInfo: &codedeploy.DeploymentInfo{
+						Status: aws.String("Created"),
+					},
+				}, nil)
+				m.EXPECT().ListDeploymentTargets(gomock.Any()).Return(&codedeploy.ListDeploymentTargetsOutput{}, nil)
+			},
+			wantedDeployment: &ECSDeployment{
+				ID:     mockDeploymentID,
+				Status: "Created",
+			},
+		},
+		"reports that the original task set waits to be terminated": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().GetDeployment(gomock.Any()).Return(&codedeploy.GetDeploymentOutput{
+					DeploymentInfo: &codedeploy.DeploymentInfo{
+						Status:                             aws.String("InProgress"),
+						InstanceTerminationWaitTimeStarted: aws.Bool(true),
+					},
+				}, nil)
+				m.EXPECT().ListDeploymentTargets(gomock.Any()).Return(&codedeploy.ListDeploymentTargetsOutput{}, nil)
+			},
+			wantedDeployment: &ECSDeployment{
+				ID:                     mockDeploymentID,
+				Status:                 "InProgress",
+				TerminationWaitStarted: true,
+			},
+		},
+		"errors if fail to get the deployment target": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().GetDeployment(gomock.Any()).Return(&codedeploy.GetDeploymentOutput{
+					DeploymentInfo: &codedeploy.DeploymentInfo{
+						Status: aws.String("InProgress"),
+					},
+				}, nil)
+				m.EXPECT().ListDeploymentTargets(gomock.Any()).Return(&codedeploy.ListDeploymentTargetsOutput{
+					TargetIds: aws.StringSlice([]string{"cluster:service"}),
+				}, nil)
+				m.EXPECT().GetDeploymentTarget(&codedeploy.GetDeploymentTargetInput{
+					DeploymentId: aws.String(mockDeploymentID),
+					TargetId:     aws.String("cluster:service"),
+				}).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("get target cluster:service of deployment d-ABCDEF123: some error"),
+		},
+		"returns the task sets of the ECS service": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().GetDeployment(gomock.Any()).Return(&codedeploy.GetDeploymentOutput{
+					DeploymentInfo: &codedeploy.DeploymentInfo{
+						Status: aws.String("Stopped"),
+						ErrorInformation: &codedeploy.ErrorInformation{
+							Message: aws.String("One or more alarms have been activated"),
+						},
+					},
+				}, nil)
+				m.EXPECT().ListDeploymentTargets(gomock.Any()).Return(&codedeploy.ListDeploymentTargetsOutput{
+					TargetIds: aws.StringSlice([]string{"cluster:service"}),
+				}, nil)
+				m.EXPECT().GetDeploymentTarget(gomock.Any()).Return(&codedeploy.GetDeploymentTargetOutput{
+					DeploymentTarget: &codedeploy.DeploymentTarget{
+						EcsTarget: &codedeploy.ECSTarget{
+							TaskSetsInfo: []*codedeploy.ECSTaskSet{
+								{
+									TaskSetLabel:  aws.String("Blue"),
+									DesiredCount:  aws.Int64(2),
+									RunningCount:  aws.Int64(2),
+									TrafficWeight: aws.Float64(90),
+								},
+								{
+									TaskSetLabel:  aws.String("Green"),
+									DesiredCount:  aws.Int64(2),
+									RunningCount:  aws.Int64(1),
+									PendingCount:  aws.Int64(1),
+									TrafficWeight: aws.Float64(10),
+								},
+							},
+						},
+					},
+				}, nil)
+			},
+			wantedDeployment: &ECSDeployment{
+				ID:           mockDeploymentID,
+				Status:       "Stopped",
+				ErrorMessage: "One or more alarms have been activated",
+				TaskSets: []ECSTaskSet{
+					{
+						Label:         "Blue",
+						DesiredCount:  2,
+						RunningCount:  2,
+						TrafficWeight: 90,
+					},
+					{
+						Label:         "Green",
+						DesiredCount:  2,
+						RunningCount:  1,
+						PendingCount:  1,
+						TrafficWeight: 10,
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockapi(ctrl)
+			tc.setupMocks(m)
+			cd := CodeDeploy{
+				client: m,
+			}
+
+			// WHEN
+			deployment, err := cd.ECSDeployment(mockDeploymentID)
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedDeployment, deployment)
+		})
+	}
+}
@@ -5,8 +5,11 @@
 package codepipeline
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/xlab/treeprint"
@@ -21,13 +24,20 @@ import (
 
 const (
 	pipelineResourceType = "codepipeline:pipeline"
+
+	// Post deployment actions of a stage are named after their checks with this prefix.
+	postDeploymentActionPrefix = "PostDeployment-"
 )
 
 type api interface {
 	GetPipeline(*cp.GetPipelineInput) (*cp.GetPipelineOutput, error)
 	GetPipelineState(*cp.GetPipelineStateInput) (*cp.GetPipelineStateOutput, error)
 	ListPipelineExecutions(input *cp.ListPipelineExecutionsInput) (*cp.ListPipelineExecutionsOutput, error)
+	GetPipelineExecution(input *cp.GetPipelineExecutionInput) (*cp.GetPipelineExecutionOutput, error)
+	ListActionExecutions(input *cp.ListActionExecutionsInput) (*cp.ListActionExecutionsOutput, error)
 	RetryStageExecution(input *cp.RetryStageExecutionInput) (*cp.RetryStageExecutionOutput, error)
+	PutApprovalResult(input *cp.PutApprovalResultInput) (*cp.PutApprovalResultOutput, error)
+	StartPipelineExecution(input *cp.StartPipelineExecutionInput) (*cp.StartPipelineExecutionOutput, error)
 }
 
 type resourceGetter interface {
@@ -52,10 +62,11 @@ type Pipeline struct {
 
 // Stage wraps the codepipeline pipeline stage.
 type Stage struct {
-	Name     string `json:"name"`
-	Category string `json:"category"`
-	Provider string `json:"provider"`
-	Details  string `json:"details"`
+	Name     string   `json:"name"`
+	Category string   `json:"category"`
+	Provider string   `json:"provider"`
+	Regions  []string `json:"regions,omitempty"` // Regions targeted by the actions of the stage.
+	Details  string   `json:"details"`
 }
 
 // PipelineState represents a Pipeline's status.
@@ -78,6 +89,79 @@ type StageAction struct {
 	Status string `json:"status"`
 }
 
+// PipelineExecution represents an execution of a pipeline.
+type PipelineExecution struct {
+	ID     string
+	Status string
+}
+
+// IsDone returns true if the execution has reached a final status.
+func (e PipelineExecution) IsDone() bool {
+	return e.Status != cp.PipelineExecutionStatusInProgress && e.Status != cp.PipelineExecutionStatusStopping
+}
+
+// ActionExecution represents the execution of an action during a pipeline execution.
+type ActionExecution struct {
+	StageName  string
+	ActionName string
+	Provider   string
+	Status     string
+	StartedAt  time.Time
+	UpdatedAt  time.Time
+	// The ID of the execution in the action's provider. For CodeBuild actions, it's the ID of the build.
+	ExternalExecutionID string
+}
+
+// ExecutionSummary summarizes a past execution of a pipeline.
+type ExecutionSummary struct {
+	ID        string           `json:"id"`
+	Status    string           `json:"status"`
+	StartedAt time.Time        `json:"startedAt"`
+	UpdatedAt time.Time        `json:"updatedAt"`
+	Revisions []SourceRevision `json:"sourceRevisions,omitempty"`
+	// How the execution was started, e.g. "Webhook" or "StartPipelineExecution", and by whom.
+	Trigger     string `json:"trigger"`
+	TriggeredBy string `json:"triggeredBy,omitempty"`
+}
+
+// SourceRevision is the revision of a source artifact that triggered an execution, e.g. a commit.
+type SourceRevision struct {
+	ActionName string `json:"actionName"`
+	Provider   string `json:"provider,omitempty"`   // Provider of the source action, e.g. "CodeCommit".
+	Repository string `json:"repository,omitempty"` // Repository the source action pulls from.
+	ID         string `json:"id"`
+	Message    string `json:"message,omitempty"`
+	Author     string `json:"author,omitempty"` // Not returned by CodePipeline, can be filled from the repository provider.
+	URL        string `json:"url,omitempty"`
+}
+
+// Duration returns how long the execution took, or has been running for so far.
+func (e ExecutionSummary) Duration() time.Duration {
+	return e.UpdatedAt.Sub(e.StartedAt)
+}
+
+// PendingApproval represents a manual approval action of a pipeline that is waiting for a response.
+type PendingApproval struct {
+	StageName  string
+	ActionName string
+	Token      string
+}
+
+// PostDeploymentChecks returns the post deployment actions of the stage, named after the checks they run.
+func (ss StageState) PostDeploymentChecks() []StageAction {
+	var checks []StageAction
+	for _, action := range ss.Actions {
+		if !strings.HasPrefix(action.Name, postDeploymentActionPrefix) {
+			continue
+		}
+		checks = append(checks, StageAction{
+			Name:   strings.TrimPrefix(action.Name, postDeploymentActionPrefix),
+			Status: action.Status,
+		})
+	}
+	return checks
+}
+
 // AggregateStatus returns the collective status of a stage by looking at each individual action's status.
 // It returns "InProgress" if there are any actions that are in progress.
 // It returns "Failed" if there are actions that failed or were abandoned.
@@ -134,7 +218,7 @@ func (c *CodePipeline) GetPipeline(name string) (*Pipeline, error) {
 
 	var stages []*Stage
 	for _, s := range pipeline.Stages {
-		stage, err := c.getStage(s)
+		stage, err := c.getStage(s, parsedArn.Region)
 		if err != nil {
 			return nil, fmt.Errorf("get stage for pipeline: %s", pipelineArn)
 		}
@@ -153,9 +237,13 @@ func (c *CodePipeline) GetPipeline(name string) (*Pipeline, error) {
 
 // HumanString returns the stringified Stage struct with human readable format.
 // Example output:
-//   DeployTo-test	Deploy	Cloudformation	stackname: dinder-test-test
+//   DeployTo-test	Deploy	Cloudformation	us-west-2	stackname: dinder-test-test
 func (s *Stage) HumanString() string {
-	return fmt.Sprintf("%s\t%s\t%s\t%s\n", s.Name, s.Category, s.Provider, s.Details)
+	regions := "-"
+	if len(s.Regions) > 0 {
+		regions = strings.Join(s.Regions, ", ")
+	}
+	return fmt.Sprintf("%s\t%s\t%s\t%s\t%s\n", s.Name, s.Category, s.Provider, regions, s.Details)
 }
 
 // ListPipelineNamesByTags retrieves the names of all pipelines for an application.
@@ -198,6 +286,55 @@ func (c *CodePipeline) RetryStageExecution(pipelineName, stageName string) error
 	return nil
 }
 
+// PendingApprovals returns the manual approval actions of the pipeline that are waiting for a response, in stage order.
+func (c *CodePipeline) PendingApprovals(pipelineName string) ([]PendingApproval, error) {
+	resp, err := c.client.GetPipelineState(&cp.GetPipelineStateInput{
+		Name: aws.String(pipelineName),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get pipeline state %s: %w", pipelineName, err)
+	}
+	var approvals []PendingApproval
+	for _, stage := range resp.StageStates {
+		for _, action := range stage.ActionStates {
+			execution := action.LatestExecution
+			if execution == nil || execution.Token == nil {
+				continue
+			}
+			if aws.StringValue(execution.Status) != cp.ActionExecutionStatusInProgress {
+				continue
+			}
+			approvals = append(approvals, PendingApproval{
+				StageName:  aws.StringValue(stage.StageName),
+				ActionName: aws.StringValue(action.ActionName),
+				Token:      aws.StringValue(execution.Token),
+			})
+		}
+	}
+	return approvals, nil
+}
+
+// PutApprovalResult approves or rejects a pending manual approval action of the pipeline with a comment.
+func (c *CodePipeline) PutApprovalResult(pipelineName string, approval PendingApproval, approved bool, comment string) error {
+	status := cp.ApprovalStatusRejected
+	if approved {
+		status = cp.ApprovalStatusApproved
+	}
+	if _, err := c.client.PutApprovalResult(&cp.PutApprovalResultInput{
+		PipelineName: aws.String(pipelineName),
+		StageName:    aws.String(approval.StageName),
+		ActionName:   aws.String(approval.ActionName),
+		Token:        aws.String(approval.Token),
+		Result: &cp.ApprovalResult{
+			Status:  aws.String(status),
+			Summary: aws.String(comment),
+		},
+	}); err != nil {
+		return fmt.Errorf("put approval result for action %s of stage %s in pipeline %s: %w", approval.ActionName, approval.StageName, pipelineName, err)
+	}
+	return nil
+}
+
 // GetPipelineByTags retrieves all of pipelines for an application.
 func (c *CodePipeline) GetPipelinesByTags(tags map[string]string) ([]*Pipeline, error) {
 	var pipelines []*Pipeline
@@ -277,9 +414,23 @@ func (ss *StageState) HumanString() string {
 	return tree.String()
 }
 
-func (c *CodePipeline) getStage(s *cp.StageDeclaration) (*Stage, error) {
+// getStage converts a stage declaration to a Stage.
+// Actions that don't declare a region run in the region of the pipeline.
+func (c *CodePipeline) getStage(s *cp.StageDeclaration, pipelineRegion string) (*Stage, error) {
 	name := aws.StringValue(s.Name)
 	var category, provider, details string
+	var regions []string
+	seenRegions := make(map[string]bool)
+	for _, action := range s.Actions {
+		region := aws.StringValue(action.Region)
+		if region == "" {
+			region = pipelineRegion
+		}
+		if !seenRegions[region] {
+			regions = append(regions, region)
+			seenRegions[region] = true
+		}
+	}
 
 	if len(s.Actions) > 0 {
 		// Currently, we only support Source, Build and Deploy stages, all of which must contain at least one action.
@@ -292,14 +443,8 @@ func (c *CodePipeline) getStage(s *cp.StageDeclaration) (*Stage, error) {
 		switch category {
 
 		case "Source":
-			// https://docs.aws.amazon.com/codepipeline/latest/userguide/reference-pipeline-structure.html#structure-configuration-examples
-			switch provider {
-			case "GitHub":
-				details = fmt.Sprintf("Repository: %s/%s", aws.StringValue(config["Owner"]), aws.StringValue(config["Repo"]))
-			case "CodeCommit":
-				details = fmt.Sprintf("Repository: %s", aws.StringValue(config["RepositoryName"]))
-			case "CodeStarSourceConnection":
-				details = fmt.Sprintf("Repository: %s", aws.StringValue(config["FullRepositoryId"]))
+			if repo := sourceRepository(action); repo != "" {
+				details = fmt.Sprintf("Repository: %s", repo)
 			}
 		case "Build":
 			// Currently, we use CodeBuild only for the build stage: https://docs.aws.amazon.com/codepipeline/latest/userguide/action-reference-CodeBuild.html#action-reference-CodeBuild-config
@@ -314,25 +459,237 @@ func (c *CodePipeline) getStage(s *cp.StageDeclaration) (*Stage, error) {
 		Name:     name,
 		Category: category,
 		Provider: provider,
+		Regions:  regions,
 		Details:  details,
 	}
 	return stage, nil
 }
 
-// pipelineExecutionID returns the ExecutionID of the most recent execution of a pipeline.
-func (c *CodePipeline) pipelineExecutionID(pipelineName string) (string, error) {
+// LatestPipelineExecution returns the most recent execution of a pipeline.
+func (c *CodePipeline) LatestPipelineExecution(pipelineName string) (*PipelineExecution, error) {
 	input := &cp.ListPipelineExecutionsInput{
 		MaxResults:   aws.Int64(1),
 		PipelineName: &pipelineName,
 	}
 	output, err := c.client.ListPipelineExecutions(input)
 	if err != nil {
-		return "", fmt.Errorf("list pipeline execution for %s: %w", pipelineName, err)
+		return nil, fmt.Errorf("list pipeline execution for %s: %w", pipelineName, err)
 	}
 	if len(output.PipelineExecutionSummaries) == 0 {
-		return "", fmt.Errorf("no pipeline execution IDs found for %s", pipelineName)
+		return nil, fmt.Errorf("no pipeline execution IDs found for %s", pipelineName)
+	}
+	summary := output.PipelineExecutionSummaries[0]
+	return &PipelineExecution{
+		ID:     aws.StringValue(summary.PipelineExecutionId),
+		Status: aws.StringValue(summary.Status),
+	}, nil
+}
+
+// PipelineExecution returns the execution of a pipeline with the given ID.
+func (c *CodePipeline) PipelineExecution(pipelineName, executionID string) (*PipelineExecution, error) {
+	output, err := c.client.GetPipelineExecution(&cp.GetPipelineExecutionInput{
+		PipelineName:        aws.String(pipelineName),
+		PipelineExecutionId: aws.String(executionID),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get execution %s of pipeline %s: %w", executionID, pipelineName, err)
+	}
+	return &PipelineExecution{
+		ID:     aws.StringValue(output.PipelineExecution.PipelineExecutionId),
+		Status: aws.StringValue(output.PipelineExecution.Status),
+	}, nil
+}
+
+// ListActionExecutions returns the executions of the actions during a pipeline execution, sorted by start time.
+func (c *CodePipeline) ListActionExecutions(pipelineName, executionID string) ([]ActionExecution, error) {
+	input := &cp.ListActionExecutionsInput{
+		PipelineName: aws.String(pipelineName),
+		Filter: &cp.ActionExecutionFilter{
+			PipelineExecutionId: aws.String(executionID),
+		},
+	}
+	var executions []ActionExecution
+	for {
+		output, err := c.client.ListActionExecutions(input)
+		if err != nil {
+			return nil, fmt.Errorf("list action executions of pipeline %s for execution %s: %w", pipelineName, executionID, err)
+		}
+		for _, detail := range output.ActionExecutionDetails {
+			execution := ActionExecution{
+				StageName:  aws.StringValue(detail.StageName),
+				ActionName: aws.StringValue(detail.ActionName),
+				Status:     aws.StringValue(detail.Status),
+				StartedAt:  aws.TimeValue(detail.StartTime),
+				UpdatedAt:  aws.TimeValue(detail.LastUpdateTime),
+			}
+			if detail.Input != nil && detail.Input.ActionTypeId != nil {
+				execution.Provider = aws.StringValue(detail.Input.ActionTypeId.Provider)
+			}
+			if detail.Output != nil && detail.Output.ExecutionResult != nil {
+				execution.ExternalExecutionID = aws.StringValue(detail.Output.ExecutionResult.ExternalExecutionId)
+			}
+			executions = append(executions, execution)
+		}
+		if output.NextToken == nil {
+			break
+		}
+		input.NextToken = output.NextToken
+	}
+	sort.SliceStable(executions, func(i, j int) bool {
+		return executions[i].StartedAt.Before(executions[j].StartedAt)
+	})
+	return executions, nil
+}
+
+// ListExecutions returns up to maxResults of the most recent executions of a pipeline, the most recent first.
+// The source revisions of each execution are annotated with the provider and repository of their source action.
+func (c *CodePipeline) ListExecutions(pipelineName string, maxResults int) ([]ExecutionSummary, error) {
+	resp, err := c.client.GetPipeline(&cp.GetPipelineInput{
+		Name: aws.String(pipelineName),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get pipeline %s: %w", pipelineName, err)
+	}
+	sources := make(map[string]*cp.ActionDeclaration)
+	for _, stage := range resp.Pipeline.Stages {
+		for _, action := range stage.Actions {
+			if aws.StringValue(action.ActionTypeId.Category) == cp.ActionCategorySource {
+				sources[aws.StringValue(action.Name)] = action
+			}
+		}
+	}
+	input := &cp.ListPipelineExecutionsInput{
+		PipelineName: aws.String(pipelineName),
+	}
+	var executions []ExecutionSummary
+	for len(executions) < maxResults {
+		output, err := c.client.ListPipelineExecutions(input)
+		if err != nil {
+			return nil, fmt.Errorf("list executions of pipeline %s: %w", pipelineName, err)
+		}
+		for _, summary := range output.PipelineExecutionSummaries {
+			execution := newExecutionSummary(summary)
+			for i, revision := range execution.Revisions {
+				if action, ok := sources[revision.ActionName]; ok {
+					execution.Revisions[i].Provider = aws.StringValue(action.ActionTypeId.Provider)
+					execution.Revisions[i].Repository = sourceRepository(action)
+				}
+			}
+			executions = append(executions, execution)
+		}
+		if output.NextToken == nil {
+			break
+		}
+		input.NextToken = output.NextToken
+	}
+	if len(executions) > maxResults {
+		executions = executions[:maxResults]
+	}
+	return executions, nil
+}
+
+// RetryFailedActions retries the failed actions of a stage in the latest execution of the pipeline that ran the stage.
+// It returns the ID of the retried execution.
+func (c *CodePipeline) RetryFailedActions(pipelineName, stageName string) (string, error) {
+	state, err := c.client.GetPipelineState(&cp.GetPipelineStateInput{
+		Name: aws.String(pipelineName),
+	})
+	if err != nil {
+		return "", fmt.Errorf("get pipeline state %s: %w", pipelineName, err)
+	}
+	var executionID string
+	for _, stage := range state.StageStates {
+		if aws.StringValue(stage.StageName) == stageName && stage.LatestExecution != nil {
+			executionID = aws.StringValue(stage.LatestExecution.PipelineExecutionId)
+		}
+	}
+	if executionID == "" {
+		return "", fmt.Errorf("no execution of stage %s found in pipeline %s", stageName, pipelineName)
+	}
+	output, err := c.client.RetryStageExecution(&cp.RetryStageExecutionInput{
+		PipelineExecutionId: aws.String(executionID),
+		PipelineName:        aws.String(pipelineName),
+		RetryMode:           aws.String(cp.StageRetryModeFailedActions),
+		StageName:           aws.String(stageName),
+	})
+	if err != nil {
+		notRetryable := &cp.StageNotRetryableException{}
+		if errors.As(err, &notRetryable) {
+			return "", fmt.Errorf("stage %s in execution %s of pipeline %s has no failed actions to retry", stageName, executionID, pipelineName)
+		}
+		return "", fmt.Errorf("retry failed actions of stage %s in pipeline %s: %w", stageName, pipelineName, err)
+	}
+	return aws.StringValue(output.PipelineExecutionId), nil
+}
+
+// StartExecution starts a new execution of the pipeline with the latest revisions of its sources, and returns its ID.
+func (c *CodePipeline) StartExecution(pipelineName string) (string, error) {
+	output, err := c.client.StartPipelineExecution(&cp.StartPipelineExecutionInput{
+		Name: aws.String(pipelineName),
+	})
+	if err != nil {
+		return "", fmt.Errorf("start execution of pipeline %s: %w", pipelineName, err)
+	}
+	return aws.StringValue(output.PipelineExecutionId), nil
+}
+
+func newExecutionSummary(summary *cp.PipelineExecutionSummary) ExecutionSummary {
+	execution := ExecutionSummary{
+		ID:        aws.StringValue(summary.PipelineExecutionId),
+		Status:    aws.StringValue(summary.Status),
+		StartedAt: aws.TimeValue(summary.StartTime),
+		UpdatedAt: aws.TimeValue(summary.LastUpdateTime),
+	}
+	if trigger := summary.Trigger; trigger != nil {
+		execution.Trigger = aws.StringValue(trigger.TriggerType)
+		execution.TriggeredBy = aws.StringValue(trigger.TriggerDetail)
+	}
+	for _, revision := range summary.SourceRevisions {
+		execution.Revisions = append(execution.Revisions, SourceRevision{
+			ActionName: aws.StringValue(revision.ActionName),
+			ID:         aws.StringValue(revision.RevisionId),
+			Message:    revisionMessage(aws.StringValue(revision.RevisionSummary)),
+			URL:        aws.StringValue(revision.RevisionUrl),
+		})
+	}
+	return execution
+}
+
+// sourceRepository returns the repository that a source action pulls from, or an empty string if the provider doesn't have one.
+// https://docs.aws.amazon.com/codepipeline/latest/userguide/reference-pipeline-structure.html#structure-configuration-examples
+func sourceRepository(action *cp.ActionDeclaration) string {
+	config := action.Configuration
+	switch aws.StringValue(action.ActionTypeId.Provider) {
+	case "GitHub":
+		return fmt.Sprintf("%s/%s", aws.StringValue(config["Owner"]), aws.StringValue(config["Repo"]))
+	case "CodeCommit":
+		return aws.StringValue(config["RepositoryName"])
+	case "CodeStarSourceConnection":
+		return aws.StringValue(config["FullRepositoryId"])
+	}
+	return ""
+}
+
+// revisionMessage returns the commit message of a revision summary.
+// The summary of a CodeStar connection revision is a JSON document with the commit message,
+// other sources use the commit message as the summary.
+func revisionMessage(summary string) string {
+	var connectionSummary struct {
+		CommitMessage string
+	}
+	if err := json.Unmarshal([]byte(summary), &connectionSummary); err == nil && connectionSummary.CommitMessage != "" {
+		return connectionSummary.CommitMessage
+	}
+	return summary
+}
+
+// pipelineExecutionID returns the ExecutionID of the most recent execution of a pipeline.
+func (c *CodePipeline) pipelineExecutionID(pipelineName string) (string, error) {
+	execution, err := c.LatestPipelineExecution(pipelineName)
+	if err != nil {
+		return "", err
 	}
-	return aws.StringValue(output.PipelineExecutionSummaries[0].PipelineExecutionId), nil
+	return execution.ID, nil
 }
 
 func (c *CodePipeline) getPipelineName(resourceArn string) (string, error) {
@@ -105,7 +105,7 @@ func TestCodePipeline_GetPipeline(t *testing.T) {
 					{Name: aws.String("BuildOutput")},
 				},
 				Name:     aws.String("CreateOrUpdate-test-test"),
-				Region:   aws.String("us-west-2"),
+				Region:   aws.String("us-east-1"),
 				RoleArn:  aws.String("arn:aws:iam::12344567890:role/dinder-test-EnvManagerRole"),
 				RunOrder: aws.Int64(2),
 			},
@@ -153,18 +153,21 @@ func TestCodePipeline_GetPipeline(t *testing.T) {
 						Name:     "Source",
 						Category: "Source",
 						Provider: "GitHub",
+						Regions:  []string{"us-west-2"},
 						Details:  "Repository: badgoose/repo",
 					},
 					{
 						Name:     "Build",
 						Category: "Build",
 						Provider: "CodeBuild",
+						Regions:  []string{"us-west-2"},
 						Details:  "BuildProject: pipeline-dinder-badgoose-repo-BuildProject",
 					},
 					{
 						Name:     "DeployTo-test",
 						Category: "Deploy",
 						Provider: "CloudFormation",
+						Regions:  []string{"us-east-1"},
 						Details:  "StackName: dinder-test-test",
 					},
 				},
@@ -201,6 +204,7 @@ func TestCodePipeline_GetPipeline(t *testing.T) {
 						Name:     "Source",
 						Category: "Source",
 						Provider: "GitHub",
+						Regions:  []string{"us-west-2"},
 						Details:  "Repository: badgoose/repo",
 					},
 					{
@@ -657,3 +661,772 @@ func TestCodePipeline_RetryStageExecution(t *testing.T) {
 		})
 	}
 }
+
+func TestCodePipeline_LatestPipelineExecution(t *testing.T) {
+	const mockPipelineName = "pipeline-dinder-badgoose-repo"
+	testCases := map[string]struct {
+		callMocks func(m codepipelineMocks)
+
+		wanted    *PipelineExecution
+		wantedErr error
+	}{
+		"wraps error if fail to list executions": {
+			callMocks: func(m codepipelineMocks) {
+				m.cp.EXPECT().ListPipelineExecutions(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("list pipeline execution for pipeline-dinder-badgoose-repo: some error"),
+		},
+		"returns the most recent execution": {
+			callMocks: func(m codepipelineMocks) {
+				m.cp.EXPECT().ListPipelineExecutions(&codepipeline.ListPipelineExecutionsInput{
+					MaxResults:   aws.Int64(1),
+					PipelineName: aws.String(mockPipelineName),
+				}).Return(&codepipeline.ListPipelineExecutionsOutput{
+					PipelineExecutionSummaries: []*codepipeline.PipelineExecutionSummary{
+						{
+							PipelineExecutionId: aws.String("12345678"),
+							Status:              aws.String("InProgress"),
+						},
+					},
+				}, nil)
+			},
+			wanted: &PipelineExecution{
+				ID:     "12345678",
+				Status: "InProgress",
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := codepipelineMocks{
+				cp: mocks.NewMockapi(ctrl),
+			}
+			tc.callMocks(m)
+			cp := CodePipeline{
+				client: m.cp,
+			}
+
+			// WHEN
+			execution, err := cp.LatestPipelineExecution(mockPipelineName)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wanted, execution)
+			}
+		})
+	}
+}
+
+func TestCodePipeline_PipelineExecution(t *testing.T) {
+	const (
+		mockPipelineName = "pipeline-dinder-badgoose-repo"
+		mockExecutionID  = "12345678"
+	)
+	testCases := map[string]struct {
+		callMocks func(m codepipelineMocks)
+
+		wanted    *PipelineExecution
+		wantedErr error
+	}{
+		"wraps error if fail to get the execution": {
+			callMocks: func(m codepipelineMocks) {
+				m.cp.EXPECT().GetPipelineExecution(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("get execution 12345678 of pipeline pipeline-dinder-badgoose-repo: some error"),
+		},
+		"returns the execution": {
+			callMocks: func(m codepipelineMocks) {
+				m.cp.EXPECT().GetPipelineExecution(&codepipeline.GetPipelineExecutionInput{
+					PipelineName:        aws.String(mockPipelineName),
+					PipelineExecutionId: aws.String(mockExecutionID),
+				}).Return(&codepipeline.GetPipelineExecutionOutput{
+					PipelineExecution: &codepipeline.PipelineExecution{
+						PipelineExecutionId: aws.String(mockExecutionID),
+						Status:              aws.String("Failed"),
+					},
+				}, nil)
+			},
+			wanted: &PipelineExecution{
+				ID:     mockExecutionID,
+				Status: "Failed",
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := codepipelineMocks{
+				cp: mocks.NewMockapi(ctrl),
+			}
+			tc.callMocks(m)
+			cp := CodePipeline{
+				client: m.cp,
+			}
+
+			// WHEN
+			execution, err := cp.PipelineExecution(mockPipelineName, mockExecutionID)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wanted, execution)
+			}
+		})
+	}
+}
+
+func TestPipelineExecution_IsDone(t *testing.T) {
+	testCases := map[string]struct {
+		status string
+		wanted bool
+	}{
+		"in progress": {
+			status: "InProgress",
+			wanted: false,
+		},
+		"stopping": {
+			status: "Stopping",
+			wanted: false,
+		},
+		"succeeded": {
+			status: "Succeeded",
+			wanted: true,
+		},
+		"failed": {
+			status: "Failed",
+			wanted: true,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, PipelineExecution{Status: tc.status}.IsDone())
+		})
+	}
+}
+
+func TestCodePipeline_ListActionExecutions(t *testing.T) {
+	const (
+		mockPipelineName = "pipeline-dinder-badgoose-repo"
+		mockExecutionID  = "12345678"
+	)
+	mockStartTime := time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC)
+	testCases := map[string]struct {
+		callMocks func(m codepipelineMocks)
+
+		wanted    []ActionExecution
+		wantedErr error
+	}{
+		"wraps error if fail to list action executions": {
+			callMocks: func(m codepipelineMocks) {
+				m.cp.EXPECT().ListActionExecutions(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("list action executions of pipeline pipeline-dinder-badgoose-repo for execution 12345678: some error"),
+		},
+		"returns every page of action executions sorted by start time": {
+			callMocks: func(m codepipelineMocks) {
+				gomock.InOrder(
+					m.cp.EXPECT().ListActionExecutions(&codepipeline.ListActionExecutionsInput{
+						PipelineName: aws.String(mockPipelineName),
+						Filter: &codepipeline.ActionExecutionFilter{
+							PipelineExecutionId: aws.String(mockExecutionID),
+						},
+					}).Return(&codepipeline.ListActionExecutionsOutput{
+						ActionExecutionDetails: []*codepipeline.ActionExecutionDetail{
+							{
+								StageName:      aws.String("Build"),
+								ActionName:     aws.String("Build"),
+								Status:         aws.String("InProgress"),
+								StartTime:      aws.Time(mockStartTime.Add(time.Minute)),
+								LastUpdateTime: aws.Time(mockStartTime.Add(2 * time.Minute)),
+								Input: &codepipeline.ActionExecutionInput{
+									ActionTypeId: &codepipeline.ActionTypeId{
+										Provider: aws.String("CodeBuild"),
+									},
+								},
+								Output: &codepipeline.ActionExecutionOutput{
+									ExecutionResult: &codepipeline.ActionExecutionResult{
+										ExternalExecutionId: aws.String("project:1234"),
+									},
+								},
+							},
+						},
+						NextToken: aws.String("next"),
+					}, nil),
+					m.cp.EXPECT().ListActionExecutions(&codepipeline.ListActionExecutionsInput{
+						PipelineName: aws.String(mockPipelineName),
+						Filter: &codepipeline.ActionExecutionFilter{
+							PipelineExecutionId: aws.String(mockExecutionID),
+						},
+						NextToken: aws.String("next"),
+					}).Return(&codepipeline.ListActionExecutionsOutput{
+						ActionExecutionDetails: []*codepipeline.ActionExecutionDetail{
+							{
+								StageName:      aws.String("Source"),
+								ActionName:     aws.String("SourceCodeFor-dinder"),
+								Status:         aws.String("Succeeded"),
+								StartTime:      aws.Time(mockStartTime),
+								LastUpdateTime: aws.Time(mockStartTime.Add(time.Minute)),
+							},
+						},
+					}, nil),
+				)
+			},
+			wanted: []ActionExecution{
+				{
+					StageName:  "Source",
+					ActionName: "SourceCodeFor-dinder",
+					Status:     "Succeeded",
+					StartedAt:  mockStartTime,
+					UpdatedAt:  mockStartTime.Add(time.Minute),
+				},
+				{
+					StageName:           "Build",
+					ActionName:          "Build",
+					Provider:            "CodeBuild",
+					Status:              "InProgress",
+					StartedAt:           mockStartTime.Add(time.Minute),
+					UpdatedAt:           mockStartTime.Add(2 * time.Minute),
+					ExternalExecutionID: "project:1234",
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := codepipelineMocks{
+				cp: mocks.NewMockapi(ctrl),
+			}
+			tc.callMocks(m)
+			cp := CodePipeline{
+				client: m.cp,
+			}
+
+			// WHEN
+			executions, err := cp.ListActionExecutions(mockPipelineName, mockExecutionID)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wanted, executions)
+			}
+		})
+	}
+}
+
+func TestCodePipeline_PendingApprovals(t *testing.T) {
+	mockPipelineName := "pipeline-dinder-badgoose-repo"
+	testCases := map[string]struct {
+		callMocks func(m codepipelineMocks)
+
+		expectedOut   []PendingApproval
+		expectedError error
+	}{
+		"returns wrapped error if GetPipelineState fails": {
+			callMocks: func(m codepipelineMocks) {
+				m.cp.EXPECT().GetPipelineState(&codepipeline.GetPipelineStateInput{
+					Name: aws.String(mockPipelineName),
+				}).Return(nil, errors.New("some error"))
+			},
+			expectedError: errors.New("get pipeline state pipeline-dinder-badgoose-repo: some error"),
+		},
+		"returns the in progress actions waiting for a response": {
+			callMocks: func(m codepipelineMocks) {
+				m.cp.EXPECT().GetPipelineState(&codepipeline.GetPipelineStateInput{
+					Name: aws.String(mockPipelineName),
+				}).Return(&codepipeline.GetPipelineStateOutput{
+					StageStates: []*codepipeline.StageState{
+						{
+							StageName: aws.String("DeployTo-test"),
+							ActionStates: []*codepipeline.ActionState{
+								{
+									ActionName: aws.String("ApprovePromotionTo-test"),
+									LatestExecution: &codepipeline.ActionExecution{
+										Status: aws.String(codepipeline.ActionExecutionStatusSucceeded),
+										Token:  aws.String("old-token"),
+									},
+								},
+								{
+									ActionName: aws.String("CreateOrUpdate-api-test"),
+								},
+							},
+						},
+						{
+							StageName: aws.String("DeployTo-prod"),
+							ActionStates: []*codepipeline.ActionState{
+								{
+									ActionName: aws.String("ApprovePromotionTo-prod"),
+									LatestExecution: &codepipeline.ActionExecution{
+										Status: aws.String(codepipeline.ActionExecutionStatusInProgress),
+										Token:  aws.String("token"),
+									},
+								},
+							},
+						},
+					},
+				}, nil)
+			},
+			expectedOut: []PendingApproval{
+				{
+					StageName:  "DeployTo-prod",
+					ActionName: "ApprovePromotionTo-prod",
+					Token:      "token",
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockClient := mocks.NewMockapi(ctrl)
+			tc.callMocks(codepipelineMocks{
+				cp: mockClient,
+			})
+			cp := CodePipeline{
+				client: mockClient,
+			}
+
+			// WHEN
+			actual, err := cp.PendingApprovals(mockPipelineName)
+
+			// THEN
+			if tc.expectedError != nil {
+				require.EqualError(t, err, tc.expectedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expectedOut, actual)
+			}
+		})
+	}
+}
+
+func TestCodePipeline_PutApprovalResult(t *testing.T) {
+	mockPipelineName := "pipeline-dinder-badgoose-repo"
+	mockApproval := PendingApproval{
+		StageName:  "DeployTo-prod",
+		ActionName: "ApprovePromotionTo-prod",
+		Token:      "token",
+	}
+	testCases := map[string]struct {
+		approved  bool
+		callMocks func(m codepipelineMocks)
+
+		expectedError error
+	}{
+		"approves the action": {
+			approved: true,
+			callMocks: func(m codepipelineMocks) {
+				m.cp.EXPECT().PutApprovalResult(&codepipeline.PutApprovalResultInput{
+					PipelineName: aws.String(mockPipelineName),
+					StageName:    aws.String("DeployTo-prod"),
+					ActionName:   aws.String("ApprovePromotionTo-prod"),
+					Token:        aws.String("token"),
+					Result: &codepipeline.ApprovalResult{
+						Status:  aws.String(codepipeline.ApprovalStatusApproved),
+						Summary: aws.String("lgtm"),
+					},
+				}).Return(&codepipeline.PutApprovalResultOutput{}, nil)
+			},
+		},
+		"returns wrapped error if the action cannot be rejected": {
+			callMocks: func(m codepipelineMocks) {
+				m.cp.EXPECT().PutApprovalResult(&codepipeline.PutApprovalResultInput{
+					PipelineName: aws.String(mockPipelineName),
+					StageName:    aws.String("DeployTo-prod"),
+					ActionName:   aws.String("ApprovePromotionTo-prod"),
+					Token:        aws.String("token"),
+					Result: &codepipeline.ApprovalResult{
+						Status:  aws.String(codepipeline.ApprovalStatusRejected),
+						Summary: aws.String("lgtm"),
+					},
+				}).Return(nil, errors.New("some error"))
+			},
+			expectedError: errors.New("put approval result for action ApprovePromotionTo-prod of stage DeployTo-prod in pipeline pipeline-dinder-badgoose-repo: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockClient := mocks.NewMockapi(ctrl)
+			tc.callMocks(codepipelineMocks{
+				cp: mockClient,
+			})
+			cp := CodePipeline{
+				client: mockClient,
+			}
+
+			// WHEN
+			err := cp.PutApprovalResult(mockPipelineName, mockApproval, tc.approved, "lgtm")
+
+			// THEN
+			if tc.expectedError != nil {
+				require.EqualError(t, err, tc.expectedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestCodePipeline_ListExecutions(t *testing.T) {
+	mockPipelineName := "pipeline-dinder-badgoose-repo"
+	startTime := time.Date(2021, time.June, 1, 12, 0, 0, 0, time.UTC)
+	mockPipeline := &codepipeline.GetPipelineOutput{
+		Pipeline: &codepipeline.PipelineDeclaration{
+			Name: aws.String(mockPipelineName),
+			Stages: []*codepipeline.StageDeclaration{
+				{
+					Name: aws.String("Source"),
+					Actions: []*codepipeline.ActionDeclaration{
+						{
+							Name: aws.String("SourceCodeFor-dinder"),
+							ActionTypeId: &codepipeline.ActionTypeId{
+								Category: aws.String("Source"),
+								Provider: aws.String("CodeCommit"),
+							},
+							Configuration: map[string]*string{
+								"RepositoryName": aws.String("badgoose"),
+							},
+						},
+					},
+				},
+				{
+					Name: aws.String("Build"),
+					Actions: []*codepipeline.ActionDeclaration{
+						{
+							Name: aws.String("Build"),
+							ActionTypeId: &codepipeline.ActionTypeId{
+								Category: aws.String("Build"),
+								Provider: aws.String("CodeBuild"),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	testCases := map[string]struct {
+		maxResults int
+		callMocks  func(m codepipelineMocks)
+
+		expectedOut   []ExecutionSummary
+		expectedError error
+	}{
+		"returns wrapped error if GetPipeline fails": {
+			maxResults: 10,
+			callMocks: func(m codepipelineMocks) {
+				m.cp.EXPECT().GetPipeline(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			expectedError: errors.New("get pipeline pipeline-dinder-badgoose-repo: some error"),
+		},
+		"returns wrapped error if ListPipelineExecutions fails": {
+			maxResults: 10,
+			callMocks: func(m codepipelineMocks) {
+				m.cp.EXPECT().GetPipeline(gomock.Any()).Return(mockPipeline, nil)
+				m.cp.EXPECT().ListPipelineExecutions(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			expectedError: errors.New("list executions of pipeline pipeline-dinder-badgoose-repo: some error"),
+		},
+		"returns the executions across pages up to the max number of results": {
+			maxResults: 2,
+			callMocks: func(m codepipelineMocks) {
+				gomock.InOrder(
+					m.cp.EXPECT().GetPipeline(&codepipeline.GetPipelineInput{
+						Name: aws.String(mockPipelineName),
+					}).Return(mockPipeline, nil),
+					m.cp.EXPECT().ListPipelineExecutions(&codepipeline.ListPipelineExecutionsInput{
+						PipelineName: aws.String(mockPipelineName),
+					}).Return(&codepipeline.ListPipelineExecutionsOutput{
+						PipelineExecutionSummaries: []*codepipeline.PipelineExecutionSummary{
+							{
+								PipelineExecutionId: aws.String("2"),
+								Status:              aws.String("InProgress"),
+								StartTime:           aws.Time(startTime.Add(time.Hour)),
+								LastUpdateTime:      aws.Time(startTime.Add(time.Hour + time.Minute)),
+								Trigger: &codepipeline.ExecutionTrigger{
+									TriggerType:   aws.String("StartPipelineExecution"),
+									TriggerDetail: aws.String("arn:aws:sts::123456789012:assumed-role/Admin/alice"),
+								},
+							},
+						},
+						NextToken: aws.String("next"),
+					}, nil),
+					m.cp.EXPECT().ListPipelineExecutions(&codepipeline.ListPipelineExecutionsInput{
+						PipelineName: aws.String(mockPipelineName),
+						NextToken:    aws.String("next"),
+					}).Return(&codepipeline.ListPipelineExecutionsOutput{
+						PipelineExecutionSummaries: []*codepipeline.PipelineExecutionSummary{
+							{
+								PipelineExecutionId: aws.String("1"),
+								Status:              aws.String("Succeeded"),
+								StartTime:           aws.Time(startTime),
+								LastUpdateTime:      aws.Time(startTime.Add(10 * time.Minute)),
+								SourceRevisions: []*codepipeline.SourceRevision{
+									{
+										ActionName:      aws.String("SourceCodeFor-dinder"),
+										RevisionId:      aws.String("abcdef123456"),
+										RevisionSummary: aws.String("Add badgoose"),
+										RevisionUrl:     aws.String("https://console.aws.amazon.com/codecommit/home#/repository/badgoose/commit/abcdef123456"),
+									},
+									{
+										ActionName:      aws.String("RemovedSource"),
+										RevisionId:      aws.String("123456abcdef"),
+										RevisionSummary: aws.String(`{"ProviderType":"GitHub","CommitMessage":"Add dinder"}`),
+									},
+								},
+								Trigger: &codepipeline.ExecutionTrigger{
+									TriggerType: aws.String("Webhook"),
+								},
+							},
+							{
+								PipelineExecutionId: aws.String("0"),
+							},
+						},
+						NextToken: aws.String("next-next"),
+					}, nil),
+				)
+			},
+			expectedOut: []ExecutionSummary{
+				{
+					ID:          "2",
+					Status:      "InProgress",
+					StartedAt:   startTime.Add(time.Hour),
+					UpdatedAt:   startTime.Add(time.Hour + time.Minute),
+					Trigger:     "StartPipelineExecution",
+					TriggeredBy: "arn:aws:sts::123456789012:assumed-role/Admin/alice",
+				},
+				{
+					ID:        "1",
+					Status:    "Succeeded",
+					StartedAt: startTime,
+					UpdatedAt: startTime.Add(10 * time.Minute),
+					Revisions: []SourceRevision{
+						{
+							ActionName: "SourceCodeFor-dinder",
+							Provider:   "CodeCommit",
+							Repository: "badgoose",
+							ID:         "abcdef123456",
+							Message:    "Add badgoose",
+							URL:        "https://console.aws.amazon.com/codecommit/home#/repository/badgoose/commit/abcdef123456",
+						},
+						{
+							ActionName: "RemovedSource",
+							ID:         "123456abcdef",
+							Message:    "Add dinder",
+						},
+					},
+					Trigger: "Webhook",
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockClient := mocks.NewMockapi(ctrl)
+			tc.callMocks(codepipelineMocks{
+				cp: mockClient,
+			})
+			cp := CodePipeline{
+				client: mockClient,
+			}
+
+			// WHEN
+			actual, err := cp.ListExecutions(mockPipelineName, tc.maxResults)
+
+			// THEN
+			if tc.expectedError != nil {
+				require.EqualError(t, err, tc.expectedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expectedOut, actual)
+			}
+		})
+	}
+}
+
+func TestCodePipeline_RetryFailedActions(t *testing.T) {
+	mockPipelineName := "pipeline-dinder-badgoose-repo"
+	mockState := &codepipeline.GetPipelineStateOutput{
+		StageStates: []*codepipeline.StageState{
+			{
+				StageName: aws.String("DeployTo-test"),
+				LatestExecution: &codepipeline.StageExecution{
+					PipelineExecutionId: aws.String("1234"),
+					Status:              aws.String(codepipeline.StageExecutionStatusFailed),
+				},
+			},
+			{
+				StageName: aws.String("DeployTo-prod"),
+			},
+		},
+	}
+	testCases := map[string]struct {
+		stageName string
+		callMocks func(m codepipelineMocks)
+
+		expectedOut   string
+		expectedError error
+	}{
+		"returns wrapped error if GetPipelineState fails": {
+			stageName: "DeployTo-test",
+			callMocks: func(m codepipelineMocks) {
+				m.cp.EXPECT().GetPipelineState(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			expectedError: errors.New("get pipeline state pipeline-dinder-badgoose-repo: some error"),
+		},
+		"returns an error if the stage never ran": {
+			stageName: "DeployTo-prod",
+			callMocks: func(m codepipelineMocks) {
+				m.cp.EXPECT().GetPipelineState(gomock.Any()).Return(mockState, nil)
+			},
+			expectedError: errors.New("no execution of stage DeployTo-prod found in pipeline pipeline-dinder-badgoose-repo"),
+		},
+		"returns wrapped error if RetryStageExecution fails": {
+			stageName: "DeployTo-test",
+			callMocks: func(m codepipelineMocks) {
+				m.cp.EXPECT().GetPipelineState(gomock.Any()).Return(mockState, nil)
+				m.cp.EXPECT().RetryStageExecution(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			expectedError: errors.New("retry failed actions of stage DeployTo-test in pipeline pipeline-dinder-badgoose-repo: some error"),
+		},
+		"returns an error if the stage has no failed actions": {
+			stageName: "DeployTo-test",
+			callMocks: func(m codepipelineMocks) {
+				m.cp.EXPECT().GetPipelineState(gomock.Any()).Return(mockState, nil)
+				m.cp.EXPECT().RetryStageExecution(gomock.Any()).Return(nil, &codepipeline.StageNotRetryableException{})
+			},
+			expectedError: errors.New("stage DeployTo-test in execution 1234 of pipeline pipeline-dinder-badgoose-repo has no failed actions to retry"),
+		},
+		"retries the failed actions of the latest execution of the stage": {
+			stageName: "DeployTo-test",
+			callMocks: func(m codepipelineMocks) {
+				m.cp.EXPECT().GetPipelineState(&codepipeline.GetPipelineStateInput{
+					Name: aws.String(mockPipelineName),
+				}).Return(mockState, nil)
+				m.cp.EXPECT().RetryStageExecution(&codepipeline.RetryStageExecutionInput{
+					PipelineExecutionId: aws.String("1234"),
+					PipelineName:        aws.String(mockPipelineName),
+					RetryMode:           aws.String(codepipeline.StageRetryModeFailedActions),
+					StageName:           aws.String("DeployTo-test"),
+				}).Return(&codepipeline.RetryStageExecutionOutput{
+					PipelineExecutionId: aws.String("1234"),
+				}, nil)
+			},
+			expectedOut: "1234",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockClient := mocks.NewMockapi(ctrl)
+			tc.callMocks(codepipelineMocks{
+				cp: mockClient,
+			})
+			cp := CodePipeline{
+				client: mockClient,
+			}
+
+			// WHEN
+			actual, err := cp.RetryFailedActions(mockPipelineName, tc.stageName)
+
+			// THEN
+			if tc.expectedError != nil {
+				require.EqualError(t, err, tc.expectedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expectedOut, actual)
+			}
+		})
+	}
+}
+
+func TestCodePipeline_StartExecution(t *testing.T) {
+	mockPipelineName := "pipeline-dinder-badgoose-repo"
+	testCases := map[string]struct {
+		callMocks func(m codepipelineMocks)
+
+		expectedOut   string
+		expectedError error
+	}{
+		"returns wrapped error if StartPipelineExecution fails": {
+			callMocks: func(m codepipelineMocks) {
+				m.cp.EXPECT().StartPipelineExecution(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			expectedError: errors.New("start execution of pipeline pipeline-dinder-badgoose-repo: some error"),
+		},
+		"returns the ID of the new execution": {
+			callMocks: func(m codepipelineMocks) {
+				m.cp.EXPECT().StartPipelineExecution(&codepipeline.StartPipelineExecutionInput{
+					Name: aws.String(mockPipelineName),
+				}).Return(&codepipeline.StartPipelineExecutionOutput{
+					PipelineExecutionId: aws.String("5678"),
+				}, nil)
+			},
+			expectedOut: "5678",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockClient := mocks.NewMockapi(ctrl)
+			tc.callMocks(codepipelineMocks{
+				cp: mockClient,
+			})
+			cp := CodePipeline{
+				client: mockClient,
+			}
+
+			// WHEN
+			actual, err := cp.StartExecution(mockPipelineName)
+
+			// THEN
+			if tc.expectedError != nil {
+				require.EqualError(t, err, tc.expectedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expectedOut, actual)
+			}
+		})
+	}
+}
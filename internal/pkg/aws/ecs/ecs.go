@@ -7,6 +7,7 @@ package ecs
 import (
 	"errors"
 	"fmt"
+	"sort"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws/awserr"
@@ -53,14 +54,23 @@ type ECS struct {
 
 // RunTaskInput holds the fields needed to run tasks.
 type RunTaskInput struct {
-	Cluster         string
-	Count           int
-	Subnets         []string
-	SecurityGroups  []string
-	TaskFamilyName  string
-	StartedBy       string
-	PlatformVersion string
-	EnableExec      bool
+	Cluster            string
+	Count              int
+	Subnets            []string
+	SecurityGroups     []string
+	AssignPublicIP     string // Defaults to ENABLED if empty.
+	TaskFamilyName     string
+	StartedBy          string
+	PlatformVersion    string
+	EnableExec         bool
+	ContainerOverrides []ContainerOverride
+}
+
+// ContainerOverride holds the overrides of a container in the task definition when running a task.
+type ContainerOverride struct {
+	Name    string
+	Command []string
+	EnvVars map[string]string
 }
 
 // ExecuteCommandInput holds the fields needed to execute commands in a running container.
@@ -191,6 +201,20 @@ func (e *ECS) RunningTasks(cluster string) ([]*Task, error) {
 	return e.listTasks(cluster, withRunningTasks())
 }
 
+// TasksStartedBy calls ECS API and returns both running and stopped ECS tasks within the same task definition family
+// that were started by the given caller.
+func (e *ECS) TasksStartedBy(cluster, family, startedBy string) ([]*Task, error) {
+	running, err := e.listTasks(cluster, withFamily(family), withStartedBy(startedBy), withRunningTasks())
+	if err != nil {
+		return nil, err
+	}
+	stopped, err := e.listTasks(cluster, withFamily(family), withStartedBy(startedBy), withStoppedTasks())
+	if err != nil {
+		return nil, err
+	}
+	return append(running, stopped...), nil
+}
+
 type listTasksOpts func(*ecs.ListTasksInput)
 
 func withService(svcName string) listTasksOpts {
@@ -205,6 +229,12 @@ func withFamily(family string) listTasksOpts {
 	}
 }
 
+func withStartedBy(startedBy string) listTasksOpts {
+	return func(in *ecs.ListTasksInput) {
+		in.StartedBy = aws.String(startedBy)
+	}
+}
+
 func withRunningTasks() listTasksOpts {
 	return func(in *ecs.ListTasksInput) {
 		in.DesiredStatus = aws.String(ecs.DesiredStatusRunning)
@@ -319,6 +349,10 @@ func (e *ECS) HasDefaultCluster() (bool, error) {
 // RunTask runs a number of tasks with the task definition and network configurations in a cluster, and returns after
 // the task(s) is running or fails to run, along with task ARNs if possible.
 func (e *ECS) RunTask(input RunTaskInput) ([]*Task, error) {
+	assignPublicIP := ecs.AssignPublicIpEnabled
+	if input.AssignPublicIP != "" {
+		assignPublicIP = input.AssignPublicIP
+	}
 	resp, err := e.client.RunTask(&ecs.RunTaskInput{
 		Cluster:        aws.String(input.Cluster),
 		Count:          aws.Int64(int64(input.Count)),
@@ -327,7 +361,7 @@ func (e *ECS) RunTask(input RunTaskInput) ([]*Task, error) {
 		TaskDefinition: aws.String(input.TaskFamilyName),
 		NetworkConfiguration: &ecs.NetworkConfiguration{
 			AwsvpcConfiguration: &ecs.AwsVpcConfiguration{
-				AssignPublicIp: aws.String(ecs.AssignPublicIpEnabled),
+				AssignPublicIp: aws.String(assignPublicIP),
 				Subnets:        aws.StringSlice(input.Subnets),
 				SecurityGroups: aws.StringSlice(input.SecurityGroups),
 			},
@@ -335,6 +369,7 @@ func (e *ECS) RunTask(input RunTaskInput) ([]*Task, error) {
 		EnableExecuteCommand: aws.Bool(input.EnableExec),
 		PlatformVersion:      aws.String(input.PlatformVersion),
 		PropagateTags:        aws.String(ecs.PropagateTagsTaskDefinition),
+		Overrides:            taskOverride(input.ContainerOverrides),
 	})
 	if err != nil {
 		return nil, fmt.Errorf("run task(s) %s: %w", input.TaskFamilyName, err)
@@ -447,3 +482,33 @@ func isRequestTimeoutErr(err error) bool {
 	}
 	return false
 }
+
+func taskOverride(containerOverrides []ContainerOverride) *ecs.TaskOverride {
+	if len(containerOverrides) == 0 {
+		return nil
+	}
+	overrides := make([]*ecs.ContainerOverride, len(containerOverrides))
+	for idx, override := range containerOverrides {
+		var envVars []*ecs.KeyValuePair
+		// Sort the environment variables so that the request is deterministic.
+		names := make([]string, 0, len(override.EnvVars))
+		for name := range override.EnvVars {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			envVars = append(envVars, &ecs.KeyValuePair{
+				Name:  aws.String(name),
+				Value: aws.String(override.EnvVars[name]),
+			})
+		}
+		overrides[idx] = &ecs.ContainerOverride{
+			Name:        aws.String(override.Name),
+			Command:     aws.StringSlice(override.Command),
+			Environment: envVars,
+		}
+	}
+	return &ecs.TaskOverride{
+		ContainerOverrides: overrides,
+	}
+}
@@ -643,6 +643,117 @@ func TestECS_StoppedServiceTasks(t *testing.T) {
 	}
 }
 
+func TestECS_TasksStartedBy(t *testing.T) {
+	testCases := map[string]struct {
+		mockECSClient func(m *mocks.Mockapi)
+
+		wantErr   error
+		wantTasks []*Task
+	}{
+		"errors if failed to list running tasks": {
+			mockECSClient: func(m *mocks.Mockapi) {
+				m.EXPECT().ListTasks(&ecs.ListTasksInput{
+					Cluster:       aws.String("mockCluster"),
+					Family:        aws.String("mockFamily"),
+					StartedBy:     aws.String("copilot-pre-hook"),
+					DesiredStatus: aws.String(ecs.DesiredStatusRunning),
+				}).Return(nil, errors.New("some error"))
+			},
+			wantErr: fmt.Errorf("list running tasks: some error"),
+		},
+		"errors if failed to list stopped tasks": {
+			mockECSClient: func(m *mocks.Mockapi) {
+				m.EXPECT().ListTasks(&ecs.ListTasksInput{
+					Cluster:       aws.String("mockCluster"),
+					Family:        aws.String("mockFamily"),
+					StartedBy:     aws.String("copilot-pre-hook"),
+					DesiredStatus: aws.String(ecs.DesiredStatusRunning),
+				}).Return(&ecs.ListTasksOutput{}, nil)
+				m.EXPECT().ListTasks(&ecs.ListTasksInput{
+					Cluster:       aws.String("mockCluster"),
+					Family:        aws.String("mockFamily"),
+					StartedBy:     aws.String("copilot-pre-hook"),
+					DesiredStatus: aws.String(ecs.DesiredStatusStopped),
+				}).Return(nil, errors.New("some error"))
+			},
+			wantErr: fmt.Errorf("list running tasks: some error"),
+		},
+		"success": {
+			mockECSClient: func(m *mocks.Mockapi) {
+				m.EXPECT().ListTasks(&ecs.ListTasksInput{
+					Cluster:       aws.String("mockCluster"),
+					Family:        aws.String("mockFamily"),
+					StartedBy:     aws.String("copilot-pre-hook"),
+					DesiredStatus: aws.String(ecs.DesiredStatusRunning),
+				}).Return(&ecs.ListTasksOutput{
+					TaskArns: aws.StringSlice([]string{"mockTaskArn1"}),
+				}, nil)
+				m.EXPECT().DescribeTasks(&ecs.DescribeTasksInput{
+					Cluster: aws.String("mockCluster"),
+					Tasks:   aws.StringSlice([]string{"mockTaskArn1"}),
+					Include: aws.StringSlice([]string{ecs.TaskFieldTags}),
+				}).Return(&ecs.DescribeTasksOutput{
+					Tasks: []*ecs.Task{
+						{
+							TaskArn: aws.String("mockTaskArn1"),
+						},
+					},
+				}, nil)
+				m.EXPECT().ListTasks(&ecs.ListTasksInput{
+					Cluster:       aws.String("mockCluster"),
+					Family:        aws.String("mockFamily"),
+					StartedBy:     aws.String("copilot-pre-hook"),
+					DesiredStatus: aws.String(ecs.DesiredStatusStopped),
+				}).Return(&ecs.ListTasksOutput{
+					TaskArns: aws.StringSlice([]string{"mockTaskArn2"}),
+				}, nil)
+				m.EXPECT().DescribeTasks(&ecs.DescribeTasksInput{
+					Cluster: aws.String("mockCluster"),
+					Tasks:   aws.StringSlice([]string{"mockTaskArn2"}),
+					Include: aws.StringSlice([]string{ecs.TaskFieldTags}),
+				}).Return(&ecs.DescribeTasksOutput{
+					Tasks: []*ecs.Task{
+						{
+							TaskArn: aws.String("mockTaskArn2"),
+						},
+					},
+				}, nil)
+			},
+			wantTasks: []*Task{
+				{
+					TaskArn: aws.String("mockTaskArn1"),
+				},
+				{
+					TaskArn: aws.String("mockTaskArn2"),
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockECSClient := mocks.NewMockapi(ctrl)
+			tc.mockECSClient(mockECSClient)
+
+			service := ECS{
+				client: mockECSClient,
+			}
+
+			gotTasks, gotErr := service.TasksStartedBy("mockCluster", "mockFamily", "copilot-pre-hook")
+
+			if tc.wantErr != nil {
+				require.EqualError(t, gotErr, tc.wantErr.Error())
+			} else {
+				require.NoError(t, gotErr)
+				require.Equal(t, tc.wantTasks, gotTasks)
+			}
+		})
+	}
+}
 func TestECS_StopTasks(t *testing.T) {
 	mockTasks := []string{"mockTask1", "mockTask2"}
 	mockError := errors.New("some error")
@@ -865,6 +976,9 @@ func TestECS_RunTask(t *testing.T) {
 		startedBy       string
 		platformVersion string
 		enableExec      bool
+
+		assignPublicIP     string
+		containerOverrides []ContainerOverride
 	}
 
 	runTaskInput := input{
@@ -940,6 +1054,76 @@ func TestECS_RunTask(t *testing.T) {
 				},
 			},
 		},
+		"run task with container overrides": {
+			input: input{
+				cluster:         "my-cluster",
+				count:           1,
+				subnets:         []string{"subnet-1"},
+				securityGroups:  []string{"sg-1"},
+				taskFamilyName:  "arn:aws:ecs:us-west-2:123456789:task-definition/my-app-prod-api:3",
+				startedBy:       "task",
+				platformVersion: "LATEST",
+				assignPublicIP:  ecs.AssignPublicIpDisabled,
+				containerOverrides: []ContainerOverride{
+					{
+						Name:    "api",
+						Command: []string{"rake", "db:migrate"},
+						EnvVars: map[string]string{
+							"RAILS_ENV": "production",
+							"DEBUG":     "false",
+						},
+					},
+				},
+			},
+			mockECSClient: func(m *mocks.Mockapi) {
+				m.EXPECT().RunTask(&ecs.RunTaskInput{
+					Cluster:        aws.String("my-cluster"),
+					Count:          aws.Int64(1),
+					LaunchType:     aws.String(ecs.LaunchTypeFargate),
+					StartedBy:      aws.String("task"),
+					TaskDefinition: aws.String("arn:aws:ecs:us-west-2:123456789:task-definition/my-app-prod-api:3"),
+					NetworkConfiguration: &ecs.NetworkConfiguration{
+						AwsvpcConfiguration: &ecs.AwsVpcConfiguration{
+							AssignPublicIp: aws.String(ecs.AssignPublicIpDisabled),
+							Subnets:        aws.StringSlice([]string{"subnet-1"}),
+							SecurityGroups: aws.StringSlice([]string{"sg-1"}),
+						},
+					},
+					EnableExecuteCommand: aws.Bool(false),
+					PlatformVersion:      aws.String("LATEST"),
+					PropagateTags:        aws.String(ecs.PropagateTagsTaskDefinition),
+					Overrides: &ecs.TaskOverride{
+						ContainerOverrides: []*ecs.ContainerOverride{
+							{
+								Name:    aws.String("api"),
+								Command: aws.StringSlice([]string{"rake", "db:migrate"}),
+								Environment: []*ecs.KeyValuePair{
+									{
+										Name:  aws.String("DEBUG"),
+										Value: aws.String("false"),
+									},
+									{
+										Name:  aws.String("RAILS_ENV"),
+										Value: aws.String("production"),
+									},
+								},
+							},
+						},
+					},
+				}).Return(&ecs.RunTaskOutput{
+					Tasks: ecsTasks[:1],
+				}, nil)
+				m.EXPECT().WaitUntilTasksRunning(gomock.Any()).Times(1)
+				m.EXPECT().DescribeTasks(gomock.Any()).Return(&ecs.DescribeTasksOutput{
+					Tasks: ecsTasks[:1],
+				}, nil)
+			},
+			wantedTasks: []*Task{
+				{
+					TaskArn: aws.String("task-1"),
+				},
+			},
+		},
 		"run task failed": {
 			input: runTaskInput,
 
@@ -1065,6 +1249,9 @@ func TestECS_RunTask(t *testing.T) {
 				StartedBy:       tc.startedBy,
 				PlatformVersion: tc.platformVersion,
 				EnableExec:      tc.enableExec,
+
+				AssignPublicIP:     tc.assignPublicIP,
+				ContainerOverrides: tc.containerOverrides,
 			})
 
 			if tc.wantedError != nil {
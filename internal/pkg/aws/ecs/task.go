@@ -260,6 +260,17 @@ func (t *TaskDefinition) EntryPoint(containerName string) ([]string, error) {
 	return nil, fmt.Errorf("container %s not found", containerName)
 }
 
+// IsEssential returns true if the container is marked as essential in the task definition.
+// Containers are essential unless specified otherwise.
+func (t *TaskDefinition) IsEssential(containerName string) (bool, error) {
+	for _, container := range t.ContainerDefinitions {
+		if aws.StringValue(container.Name) == containerName {
+			return container.Essential == nil || aws.BoolValue(container.Essential), nil
+		}
+	}
+	return false, fmt.Errorf("container %s not found", containerName)
+}
+
 // TaskID parses the task ARN and returns the task ID.
 // For example: arn:aws:ecs:us-west-2:123456789:task/my-project-test-Cluster-9F7Y0RLP60R7/4082490ee6c245e09d2145010aa1ba8d,
 // arn:aws:ecs:us-west-2:123456789:task/4082490ee6c245e09d2145010aa1ba8d
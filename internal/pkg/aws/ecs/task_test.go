@@ -507,6 +507,64 @@ func TestTaskDefinition_Image(t *testing.T) {
 	}
 }
 
+func TestTaskDefinition_IsEssential(t *testing.T) {
+	testCases := map[string]struct {
+		inContainers    []*ecs.ContainerDefinition
+		inContainerName string
+
+		wantedEssential bool
+		wantedError     error
+	}{
+		"containers are essential by default": {
+			inContainers: []*ecs.ContainerDefinition{
+				{
+					Name: aws.String("container-1"),
+				},
+			},
+			inContainerName: "container-1",
+			wantedEssential: true,
+		},
+		"should return false if the container is not essential": {
+			inContainers: []*ecs.ContainerDefinition{
+				{
+					Name: aws.String("container-1"),
+				},
+				{
+					Name:      aws.String("container-2"),
+					Essential: aws.Bool(false),
+				},
+			},
+			inContainerName: "container-2",
+			wantedEssential: false,
+		},
+		"container not found": {
+			inContainers: []*ecs.ContainerDefinition{
+				{
+					Name: aws.String("container-1"),
+				},
+			},
+			inContainerName: "container-3",
+			wantedError:     errors.New("container container-3 not found"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			taskDefinition := TaskDefinition{
+				ContainerDefinitions: tc.inContainers,
+			}
+
+			got, err := taskDefinition.IsEssential(tc.inContainerName)
+			if tc.wantedError != nil {
+				require.EqualError(t, tc.wantedError, err.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedEssential, got)
+			}
+		})
+	}
+}
+
 func TestTaskDefinition_Command(t *testing.T) {
 	testCases := map[string]struct {
 		inContainers    []*ecs.ContainerDefinition
@@ -5,11 +5,14 @@
 package secretsmanager
 
 import (
+	"errors"
 	"fmt"
+	"sort"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/secretsmanager"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 )
@@ -17,6 +20,12 @@ import (
 type api interface {
 	CreateSecret(*secretsmanager.CreateSecretInput) (*secretsmanager.CreateSecretOutput, error)
 	DeleteSecret(*secretsmanager.DeleteSecretInput) (*secretsmanager.DeleteSecretOutput, error)
+	PutSecretValue(*secretsmanager.PutSecretValueInput) (*secretsmanager.PutSecretValueOutput, error)
+	UpdateSecret(*secretsmanager.UpdateSecretInput) (*secretsmanager.UpdateSecretOutput, error)
+	RotateSecret(*secretsmanager.RotateSecretInput) (*secretsmanager.RotateSecretOutput, error)
+	ListSecrets(*secretsmanager.ListSecretsInput) (*secretsmanager.ListSecretsOutput, error)
+	DescribeSecret(*secretsmanager.DescribeSecretInput) (*secretsmanager.DescribeSecretOutput, error)
+	GetSecretValue(*secretsmanager.GetSecretValueInput) (*secretsmanager.GetSecretValueOutput, error)
 }
 
 // SecretsManager wraps the AWS SecretManager client.
@@ -40,6 +49,14 @@ func New() (*SecretsManager, error) {
 	}, nil
 }
 
+// NewWithSession returns a SecretsManager configured against the input session.
+func NewWithSession(s *session.Session) *SecretsManager {
+	return &SecretsManager{
+		secretsManager: secretsmanager.New(s),
+		sessionRegion:  aws.StringValue(s.Config.Region),
+	}
+}
+
 var secretTags = func() []*secretsmanager.Tag {
 	timestamp := time.Now().UTC().Format(time.UnixDate)
 	return []*secretsmanager.Tag{
@@ -76,6 +93,7 @@ func (s *SecretsManager) CreateSecret(secretName, secretString string) (string,
 }
 
 // DeleteSecret force removes the secret from SecretsManager.
+// ErrSecretNotFound is returned if the secret does not exist.
 func (s *SecretsManager) DeleteSecret(secretName string) error {
 	_, err := s.secretsManager.DeleteSecret(&secretsmanager.DeleteSecretInput{
 		SecretId:                   aws.String(secretName),
@@ -83,11 +101,227 @@ func (s *SecretsManager) DeleteSecret(secretName string) error {
 	})
 
 	if err != nil {
+		if isResourceNotFound(err) {
+			return &ErrSecretNotFound{secretName: secretName}
+		}
 		return fmt.Errorf("delete secret %s from secrets manager: %+v", secretName, err)
 	}
 	return nil
 }
 
+// PutSecretInput contains fields needed to create or update a secret.
+type PutSecretInput struct {
+	Name      string
+	Value     string
+	KMSKeyID  string // Optional. Defaults to the AWS managed key "aws/secretsmanager".
+	Overwrite bool
+	Tags      map[string]string
+}
+
+// PutSecretOutput holds the ARN of a secret, and whether the secret was created or overwritten.
+type PutSecretOutput struct {
+	ARN     string
+	Created bool
+}
+
+// PutSecret tries to create the secret, and overwrites its value if the secret exists and `Overwrite` is true.
+// ErrSecretAlreadyExists is returned if the secret exists and `Overwrite` is false.
+func (s *SecretsManager) PutSecret(in PutSecretInput) (*PutSecretOutput, error) {
+	input := &secretsmanager.CreateSecretInput{
+		Name:         aws.String(in.Name),
+		SecretString: aws.String(in.Value),
+		Tags:         convertTags(in.Tags),
+	}
+	if in.KMSKeyID != "" {
+		input.KmsKeyId = aws.String(in.KMSKeyID)
+	}
+	resp, err := s.secretsManager.CreateSecret(input)
+	if err == nil {
+		return &PutSecretOutput{
+			ARN:     aws.StringValue(resp.ARN),
+			Created: true,
+		}, nil
+	}
+	var aerr awserr.Error
+	if !errors.As(err, &aerr) || aerr.Code() != secretsmanager.ErrCodeResourceExistsException {
+		return nil, fmt.Errorf("create secret %s: %w", in.Name, err)
+	}
+	if !in.Overwrite {
+		return nil, &ErrSecretAlreadyExists{
+			secretName: in.Name,
+			parentErr:  err,
+		}
+	}
+	return s.overwriteSecret(in)
+}
+
+func (s *SecretsManager) overwriteSecret(in PutSecretInput) (*PutSecretOutput, error) {
+	if in.KMSKeyID != "" {
+		// The key only encrypts versions of the secret created after the update, so it has to be updated first.
+		if _, err := s.secretsManager.UpdateSecret(&secretsmanager.UpdateSecretInput{
+			SecretId: aws.String(in.Name),
+			KmsKeyId: aws.String(in.KMSKeyID),
+		}); err != nil {
+			return nil, fmt.Errorf("update kms key of secret %s: %w", in.Name, err)
+		}
+	}
+	resp, err := s.secretsManager.PutSecretValue(&secretsmanager.PutSecretValueInput{
+		SecretId:     aws.String(in.Name),
+		SecretString: aws.String(in.Value),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("put value of secret %s: %w", in.Name, err)
+	}
+	return &PutSecretOutput{
+		ARN: aws.StringValue(resp.ARN),
+	}, nil
+}
+
+// RotateSecretInput contains fields needed to configure the rotation of a secret.
+type RotateSecretInput struct {
+	SecretID               string
+	LambdaARN              string
+	AutomaticallyAfterDays int
+}
+
+// RotateSecret configures the secret to be rotated by a Lambda function on a schedule, and starts a first rotation.
+func (s *SecretsManager) RotateSecret(in RotateSecretInput) error {
+	_, err := s.secretsManager.RotateSecret(&secretsmanager.RotateSecretInput{
+		SecretId:          aws.String(in.SecretID),
+		RotationLambdaARN: aws.String(in.LambdaARN),
+		RotationRules: &secretsmanager.RotationRulesType{
+			AutomaticallyAfterDays: aws.Int64(int64(in.AutomaticallyAfterDays)),
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("rotate secret %s: %w", in.SecretID, err)
+	}
+	return nil
+}
+
+// SecretSummary holds the metadata of a secret, without its value.
+type SecretSummary struct {
+	Name         string    `json:"name"`
+	ARN          string    `json:"arn"`
+	LastModified time.Time `json:"lastModified"`
+}
+
+// Secret holds the value and the metadata of a secret.
+type Secret struct {
+	SecretSummary
+	Value string `json:"value,omitempty"` // Empty if the secret was not decrypted.
+}
+
+// ListSecrets returns the summaries of the secrets that have all the given tags, sorted by name.
+func (s *SecretsManager) ListSecrets(tags map[string]string) ([]SecretSummary, error) {
+	// The filters match the keys and the values of the tags independently, so the pairs are checked on the results.
+	var filters []*secretsmanager.Filter
+	for _, tag := range convertTags(tags) {
+		filters = append(filters, &secretsmanager.Filter{
+			Key:    aws.String(secretsmanager.FilterNameStringTypeTagKey),
+			Values: []*string{tag.Key},
+		})
+	}
+
+	var secrets []SecretSummary
+	var nextToken *string
+	for {
+		out, err := s.secretsManager.ListSecrets(&secretsmanager.ListSecretsInput{
+			Filters:   filters,
+			NextToken: nextToken,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("list secrets: %w", err)
+		}
+		for _, secret := range out.SecretList {
+			if !hasTags(secret.Tags, tags) {
+				continue
+			}
+			secrets = append(secrets, SecretSummary{
+				Name:         aws.StringValue(secret.Name),
+				ARN:          aws.StringValue(secret.ARN),
+				LastModified: aws.TimeValue(secret.LastChangedDate),
+			})
+		}
+		nextToken = out.NextToken
+		if nextToken == nil {
+			break
+		}
+	}
+	sort.Slice(secrets, func(i, j int) bool {
+		return secrets[i].Name < secrets[j].Name
+	})
+	return secrets, nil
+}
+
+// GetSecret returns the secret with the given name. The value of the secret is only returned if decrypt is true.
+// ErrSecretNotFound is returned if the secret does not exist.
+func (s *SecretsManager) GetSecret(name string, decrypt bool) (*Secret, error) {
+	out, err := s.secretsManager.DescribeSecret(&secretsmanager.DescribeSecretInput{
+		SecretId: aws.String(name),
+	})
+	if err != nil {
+		if isResourceNotFound(err) {
+			return nil, &ErrSecretNotFound{secretName: name}
+		}
+		return nil, fmt.Errorf("describe secret %s: %w", name, err)
+	}
+	secret := &Secret{
+		SecretSummary: SecretSummary{
+			Name:         aws.StringValue(out.Name),
+			ARN:          aws.StringValue(out.ARN),
+			LastModified: aws.TimeValue(out.LastChangedDate),
+		},
+	}
+	if !decrypt {
+		return secret, nil
+	}
+	value, err := s.secretsManager.GetSecretValue(&secretsmanager.GetSecretValueInput{
+		SecretId: aws.String(name),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get value of secret %s: %w", name, err)
+	}
+	secret.Value = aws.StringValue(value.SecretString)
+	return secret, nil
+}
+
+func hasTags(tags []*secretsmanager.Tag, wanted map[string]string) bool {
+	values := make(map[string]string)
+	for _, tag := range tags {
+		values[aws.StringValue(tag.Key)] = aws.StringValue(tag.Value)
+	}
+	for k, v := range wanted {
+		if value, ok := values[k]; !ok || value != v {
+			return false
+		}
+	}
+	return true
+}
+
+func isResourceNotFound(err error) bool {
+	var aerr awserr.Error
+	return errors.As(err, &aerr) && aerr.Code() == secretsmanager.ErrCodeResourceNotFoundException
+}
+
+func convertTags(inTags map[string]string) []*secretsmanager.Tag {
+	// Sort the map so that the unit test won't be flaky.
+	keys := make([]string, 0, len(inTags))
+	for k := range inTags {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var tags []*secretsmanager.Tag
+	for _, key := range keys {
+		tags = append(tags, &secretsmanager.Tag{
+			Key:   aws.String(key),
+			Value: aws.String(inTags[key]),
+		})
+	}
+	return tags
+}
+
 // ErrSecretAlreadyExists occurs if a secret with the same name already exists.
 type ErrSecretAlreadyExists struct {
 	secretName string
@@ -97,3 +331,12 @@ type ErrSecretAlreadyExists struct {
 func (err *ErrSecretAlreadyExists) Error() string {
 	return fmt.Sprintf("secret %s already exists", err.secretName)
 }
+
+// ErrSecretNotFound occurs if a secret with the given name doesn't exist.
+type ErrSecretNotFound struct {
+	secretName string
+}
+
+func (err *ErrSecretNotFound) Error() string {
+	return fmt.Sprintf("secret %s not found", err.secretName)
+}
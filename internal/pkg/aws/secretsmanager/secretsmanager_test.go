@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
@@ -105,3 +106,402 @@ func TestSecretsManager_CreateSecret(t *testing.T) {
 		})
 	}
 }
+
+func TestSecretsManager_PutSecret(t *testing.T) {
+	const (
+		mockSecretName = "/copilot/myapp/test/secrets/db"
+		mockValue      = `{"password":"hunter2"}`
+		mockKeyID      = "arn:aws:kms:us-west-2:123456789012:key/mock"
+		mockARN        = "arn:aws:secretsmanager:us-west-2:123456789012:secret:/copilot/myapp/test/secrets/db-AbCdEf"
+	)
+	mockError := errors.New("some error")
+	mockExistsErr := awserr.New(secretsmanager.ErrCodeResourceExistsException, "", nil)
+	mockCreateInput := &secretsmanager.CreateSecretInput{
+		Name:         aws.String(mockSecretName),
+		SecretString: aws.String(mockValue),
+		KmsKeyId:     aws.String(mockKeyID),
+		Tags: []*secretsmanager.Tag{
+			{Key: aws.String("copilot-application"), Value: aws.String("myapp")},
+			{Key: aws.String("copilot-environment"), Value: aws.String("test")},
+		},
+	}
+
+	testCases := map[string]struct {
+		inOverwrite bool
+		setupMocks  func(m *mocks.Mockapi)
+
+		wantedOutput *PutSecretOutput
+		wantedError  error
+	}{
+		"creates the secret with the kms key and tags": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().CreateSecret(mockCreateInput).Return(&secretsmanager.CreateSecretOutput{ARN: aws.String(mockARN)}, nil)
+			},
+			wantedOutput: &PutSecretOutput{ARN: mockARN, Created: true},
+		},
+		"wraps error if fail to create the secret": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().CreateSecret(mockCreateInput).Return(nil, mockError)
+			},
+			wantedError: fmt.Errorf("create secret %s: %w", mockSecretName, mockError),
+		},
+		"returns ErrSecretAlreadyExists if the secret exists and should not be overwritten": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().CreateSecret(mockCreateInput).Return(nil, mockExistsErr)
+			},
+			wantedError: &ErrSecretAlreadyExists{
+				secretName: mockSecretName,
+				parentErr:  mockExistsErr,
+			},
+		},
+		"wraps error if fail to update the kms key of an existing secret": {
+			inOverwrite: true,
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().CreateSecret(mockCreateInput).Return(nil, mockExistsErr)
+				m.EXPECT().UpdateSecret(gomock.Any()).Return(nil, mockError)
+			},
+			wantedError: fmt.Errorf("update kms key of secret %s: %w", mockSecretName, mockError),
+		},
+		"overwrites the value of an existing secret": {
+			inOverwrite: true,
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().CreateSecret(mockCreateInput).Return(nil, mockExistsErr)
+				m.EXPECT().UpdateSecret(&secretsmanager.UpdateSecretInput{
+					SecretId: aws.String(mockSecretName),
+					KmsKeyId: aws.String(mockKeyID),
+				}).Return(&secretsmanager.UpdateSecretOutput{}, nil)
+				m.EXPECT().PutSecretValue(&secretsmanager.PutSecretValueInput{
+					SecretId:     aws.String(mockSecretName),
+					SecretString: aws.String(mockValue),
+				}).Return(&secretsmanager.PutSecretValueOutput{ARN: aws.String(mockARN)}, nil)
+			},
+			wantedOutput: &PutSecretOutput{ARN: mockARN},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockapi(ctrl)
+			tc.setupMocks(m)
+			sm := SecretsManager{
+				secretsManager: m,
+			}
+
+			// WHEN
+			out, err := sm.PutSecret(PutSecretInput{
+				Name:      mockSecretName,
+				Value:     mockValue,
+				KMSKeyID:  mockKeyID,
+				Overwrite: tc.inOverwrite,
+				Tags: map[string]string{
+					"copilot-environment": "test",
+					"copilot-application": "myapp",
+				},
+			})
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedOutput, out)
+		})
+	}
+}
+
+func TestSecretsManager_RotateSecret(t *testing.T) {
+	const (
+		mockSecretName = "/copilot/myapp/test/secrets/db"
+		mockLambdaARN  = "arn:aws:lambda:us-west-2:123456789012:function:rotate"
+	)
+	mockError := errors.New("some error")
+	testCases := map[string]struct {
+		mockErr     error
+		wantedError error
+	}{
+		"wraps error if fail to rotate the secret": {
+			mockErr:     mockError,
+			wantedError: fmt.Errorf("rotate secret %s: %w", mockSecretName, mockError),
+		},
+		"configures the rotation of the secret": {},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockapi(ctrl)
+			m.EXPECT().RotateSecret(&secretsmanager.RotateSecretInput{
+				SecretId:          aws.String(mockSecretName),
+				RotationLambdaARN: aws.String(mockLambdaARN),
+				RotationRules: &secretsmanager.RotationRulesType{
+					AutomaticallyAfterDays: aws.Int64(30),
+				},
+			}).Return(&secretsmanager.RotateSecretOutput{}, tc.mockErr)
+			sm := SecretsManager{
+				secretsManager: m,
+			}
+
+			// WHEN
+			err := sm.RotateSecret(RotateSecretInput{
+				SecretID:               mockSecretName,
+				LambdaARN:              mockLambdaARN,
+				AutomaticallyAfterDays: 30,
+			})
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestSecretsManager_ListSecrets(t *testing.T) {
+	mockTime := time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC)
+	mockTags := map[string]string{
+		"copilot-application": "myapp",
+		"copilot-environment": "test",
+	}
+	wantedInput := &secretsmanager.ListSecretsInput{
+		Filters: []*secretsmanager.Filter{
+			{
+				Key:    aws.String("tag-key"),
+				Values: aws.StringSlice([]string{"copilot-application"}),
+			},
+			{
+				Key:    aws.String("tag-key"),
+				Values: aws.StringSlice([]string{"copilot-environment"}),
+			},
+		},
+	}
+	testCases := map[string]struct {
+		setupMocks func(m *mocks.Mockapi)
+
+		wanted      []SecretSummary
+		wantedError error
+	}{
+		"wraps error if fail to list secrets": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().ListSecrets(wantedInput).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("list secrets: some error"),
+		},
+		"returns the sorted secrets with all the tags across pages": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().ListSecrets(wantedInput).Return(&secretsmanager.ListSecretsOutput{
+					SecretList: []*secretsmanager.SecretListEntry{
+						{
+							Name:            aws.String("/copilot/myapp/test/secrets/db"),
+							ARN:             aws.String("arn:aws:secretsmanager:us-west-2:123456789012:secret:/copilot/myapp/test/secrets/db-abc"),
+							LastChangedDate: aws.Time(mockTime),
+							Tags: []*secretsmanager.Tag{
+								{Key: aws.String("copilot-application"), Value: aws.String("myapp")},
+								{Key: aws.String("copilot-environment"), Value: aws.String("test")},
+							},
+						},
+						{
+							Name: aws.String("/copilot/myapp/prod/secrets/db"),
+							Tags: []*secretsmanager.Tag{
+								{Key: aws.String("copilot-application"), Value: aws.String("myapp")},
+								{Key: aws.String("copilot-environment"), Value: aws.String("prod")},
+							},
+						},
+					},
+					NextToken: aws.String("token"),
+				}, nil)
+				m.EXPECT().ListSecrets(&secretsmanager.ListSecretsInput{
+					Filters:   wantedInput.Filters,
+					NextToken: aws.String("token"),
+				}).Return(&secretsmanager.ListSecretsOutput{
+					SecretList: []*secretsmanager.SecretListEntry{
+						{
+							Name:            aws.String("/copilot/myapp/test/secrets/api_key"),
+							ARN:             aws.String("arn:aws:secretsmanager:us-west-2:123456789012:secret:/copilot/myapp/test/secrets/api_key-def"),
+							LastChangedDate: aws.Time(mockTime),
+							Tags: []*secretsmanager.Tag{
+								{Key: aws.String("copilot-application"), Value: aws.String("myapp")},
+								{Key: aws.String("copilot-environment"), Value: aws.String("test")},
+							},
+						},
+					},
+				}, nil)
+			},
+			wanted: []SecretSummary{
+				{
+					Name:         "/copilot/myapp/test/secrets/api_key",
+					ARN:          "arn:aws:secretsmanager:us-west-2:123456789012:secret:/copilot/myapp/test/secrets/api_key-def",
+					LastModified: mockTime,
+				},
+				{
+					Name:         "/copilot/myapp/test/secrets/db",
+					ARN:          "arn:aws:secretsmanager:us-west-2:123456789012:secret:/copilot/myapp/test/secrets/db-abc",
+					LastModified: mockTime,
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockapi(ctrl)
+			tc.setupMocks(m)
+			sm := SecretsManager{
+				secretsManager: m,
+			}
+
+			// WHEN
+			secrets, err := sm.ListSecrets(mockTags)
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, secrets)
+		})
+	}
+}
+
+func TestSecretsManager_GetSecret(t *testing.T) {
+	const (
+		mockSecretName = "/copilot/myapp/test/secrets/db"
+		mockSecretARN  = "arn:aws:secretsmanager:us-west-2:123456789012:secret:/copilot/myapp/test/secrets/db-abc"
+	)
+	mockTime := time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC)
+	mockDescribeOutput := &secretsmanager.DescribeSecretOutput{
+		Name:            aws.String(mockSecretName),
+		ARN:             aws.String(mockSecretARN),
+		LastChangedDate: aws.Time(mockTime),
+	}
+	testCases := map[string]struct {
+		decrypt    bool
+		setupMocks func(m *mocks.Mockapi)
+
+		wanted      *Secret
+		wantedError error
+	}{
+		"returns ErrSecretNotFound if the secret does not exist": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeSecret(gomock.Any()).Return(nil, awserr.New(secretsmanager.ErrCodeResourceNotFoundException, "not found", nil))
+			},
+			wantedError: &ErrSecretNotFound{secretName: mockSecretName},
+		},
+		"wraps error if fail to get the value of the secret": {
+			decrypt: true,
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeSecret(gomock.Any()).Return(mockDescribeOutput, nil)
+				m.EXPECT().GetSecretValue(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: fmt.Errorf("get value of secret %s: some error", mockSecretName),
+		},
+		"does not get the value of the secret unless decrypted": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeSecret(&secretsmanager.DescribeSecretInput{
+					SecretId: aws.String(mockSecretName),
+				}).Return(mockDescribeOutput, nil)
+			},
+			wanted: &Secret{
+				SecretSummary: SecretSummary{
+					Name:         mockSecretName,
+					ARN:          mockSecretARN,
+					LastModified: mockTime,
+				},
+			},
+		},
+		"returns the value of the secret if decrypted": {
+			decrypt: true,
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeSecret(gomock.Any()).Return(mockDescribeOutput, nil)
+				m.EXPECT().GetSecretValue(&secretsmanager.GetSecretValueInput{
+					SecretId: aws.String(mockSecretName),
+				}).Return(&secretsmanager.GetSecretValueOutput{
+					SecretString: aws.String("hunter2"),
+				}, nil)
+			},
+			wanted: &Secret{
+				SecretSummary: SecretSummary{
+					Name:         mockSecretName,
+					ARN:          mockSecretARN,
+					LastModified: mockTime,
+				},
+				Value: "hunter2",
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockapi(ctrl)
+			tc.setupMocks(m)
+			sm := SecretsManager{
+				secretsManager: m,
+			}
+
+			// WHEN
+			secret, err := sm.GetSecret(mockSecretName, tc.decrypt)
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, secret)
+		})
+	}
+}
+
+func TestSecretsManager_DeleteSecret(t *testing.T) {
+	const mockSecretName = "/copilot/myapp/test/secrets/db"
+	testCases := map[string]struct {
+		mockErr     error
+		wantedError error
+	}{
+		"returns ErrSecretNotFound if the secret does not exist": {
+			mockErr:     awserr.New(secretsmanager.ErrCodeResourceNotFoundException, "not found", nil),
+			wantedError: &ErrSecretNotFound{secretName: mockSecretName},
+		},
+		"deletes the secret without recovery": {},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockapi(ctrl)
+			m.EXPECT().DeleteSecret(&secretsmanager.DeleteSecretInput{
+				SecretId:                   aws.String(mockSecretName),
+				ForceDeleteWithoutRecovery: aws.Bool(true),
+			}).Return(&secretsmanager.DeleteSecretOutput{}, tc.mockErr)
+			sm := SecretsManager{
+				secretsManager: m,
+			}
+
+			// WHEN
+			err := sm.DeleteSecret(mockSecretName)
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -13,3 +13,27 @@ type ErrParameterAlreadyExists struct {
 func (e *ErrParameterAlreadyExists) Error() string {
 	return fmt.Sprintf("parameter %s already exists", e.name)
 }
+
+// ErrParameterNotFound occurs when the parameter with name does not exist.
+type ErrParameterNotFound struct {
+	name string
+}
+
+func (e *ErrParameterNotFound) Error() string {
+	return fmt.Sprintf("parameter %s not found", e.name)
+}
+
+// ErrStartSession occurs when ssm:StartSession fails.
+type ErrStartSession struct {
+	target string
+	err    error
+}
+
+func (e *ErrStartSession) Error() string {
+	return fmt.Sprintf("start session with target %s: %v", e.target, e.err)
+}
+
+// Unwrap returns the underlying error.
+func (e *ErrStartSession) Unwrap() error {
+	return e.err
+}
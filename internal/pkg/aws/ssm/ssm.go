@@ -8,28 +8,53 @@ import (
 	"errors"
 	"fmt"
 	"sort"
+	"strconv"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws/awserr"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/exec"
+)
+
+const (
+	portForwardingDocument         = "AWS-StartPortForwardingSession"
+	portForwardingToRemoteDocument = "AWS-StartPortForwardingSessionToRemoteHost"
+	fmtECSPortForwardingTarget     = "ecs:%s_%s_%s" // ecs:<cluster name>_<task ID>_<container runtime ID>
+	portForwardingRemotePortParam  = "portNumber"
+	portForwardingLocalPortParam   = "localPortNumber"
+	portForwardingRemoteHostParam  = "host"
 )
 
 type api interface {
 	PutParameter(input *ssm.PutParameterInput) (*ssm.PutParameterOutput, error)
 	AddTagsToResource(input *ssm.AddTagsToResourceInput) (*ssm.AddTagsToResourceOutput, error)
+	DescribeParameters(input *ssm.DescribeParametersInput) (*ssm.DescribeParametersOutput, error)
+	GetParameter(input *ssm.GetParameterInput) (*ssm.GetParameterOutput, error)
+	DeleteParameter(input *ssm.DeleteParameterInput) (*ssm.DeleteParameterOutput, error)
+	StartSession(input *ssm.StartSessionInput) (*ssm.StartSessionOutput, error)
+	TerminateSession(input *ssm.TerminateSessionInput) (*ssm.TerminateSessionOutput, error)
+}
+
+type portForwardingSessionStarter interface {
+	StartPortForwardingSession(ssmSess *ssm.StartSessionOutput, in *ssm.StartSessionInput) error
 }
 
 // SSM wraps an AWS SSM client.
 type SSM struct {
-	client api
+	client         api
+	newSessStarter func() portForwardingSessionStarter
 }
 
 // New returns a SSM service configured against the input session.
 func New(s *session.Session) *SSM {
 	return &SSM{
 		client: ssm.New(s),
+		newSessStarter: func() portForwardingSessionStarter {
+			return exec.NewSSMPluginCommand(s)
+		},
 	}
 }
 
@@ -115,6 +140,110 @@ func (s *SSM) overwriteSecret(in PutSecretInput) (*PutSecretOutput, error) {
 	return (*PutSecretOutput)(output), nil
 }
 
+// SecretSummary holds the metadata of a secret, without its value.
+type SecretSummary struct {
+	Name         string    `json:"name"`
+	Version      int64     `json:"version"`
+	LastModified time.Time `json:"lastModified"`
+}
+
+// Secret holds the value and the metadata of a secret.
+type Secret struct {
+	SecretSummary
+	ARN   string `json:"arn"`
+	Value string `json:"value,omitempty"` // Empty if the secret was not decrypted.
+}
+
+// ListSecrets returns the summaries of the SecureString parameters that have all the given tags, sorted by name.
+func (s *SSM) ListSecrets(tags map[string]string) ([]SecretSummary, error) {
+	filters := []*ssm.ParameterStringFilter{
+		{
+			Key:    aws.String("Type"),
+			Values: aws.StringSlice([]string{ssm.ParameterTypeSecureString}),
+		},
+	}
+	for _, tag := range convertTags(tags) {
+		filters = append(filters, &ssm.ParameterStringFilter{
+			Key:    aws.String(fmt.Sprintf("tag:%s", aws.StringValue(tag.Key))),
+			Values: []*string{tag.Value},
+		})
+	}
+
+	var secrets []SecretSummary
+	var nextToken *string
+	for {
+		out, err := s.client.DescribeParameters(&ssm.DescribeParametersInput{
+			ParameterFilters: filters,
+			NextToken:        nextToken,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("describe parameters: %w", err)
+		}
+		for _, param := range out.Parameters {
+			secrets = append(secrets, SecretSummary{
+				Name:         aws.StringValue(param.Name),
+				Version:      aws.Int64Value(param.Version),
+				LastModified: aws.TimeValue(param.LastModifiedDate),
+			})
+		}
+		nextToken = out.NextToken
+		if nextToken == nil {
+			break
+		}
+	}
+	sort.Slice(secrets, func(i, j int) bool {
+		return secrets[i].Name < secrets[j].Name
+	})
+	return secrets, nil
+}
+
+// GetSecret returns the secret with the given name. The value of the secret is only returned if decrypt is true.
+// ErrParameterNotFound is returned if the secret does not exist.
+func (s *SSM) GetSecret(name string, decrypt bool) (*Secret, error) {
+	out, err := s.client.GetParameter(&ssm.GetParameterInput{
+		Name:           aws.String(name),
+		WithDecryption: aws.Bool(decrypt),
+	})
+	if err != nil {
+		if isParameterNotFound(err) {
+			return nil, &ErrParameterNotFound{name: name}
+		}
+		return nil, fmt.Errorf("get parameter %s: %w", name, err)
+	}
+	secret := &Secret{
+		SecretSummary: SecretSummary{
+			Name:         aws.StringValue(out.Parameter.Name),
+			Version:      aws.Int64Value(out.Parameter.Version),
+			LastModified: aws.TimeValue(out.Parameter.LastModifiedDate),
+		},
+		ARN: aws.StringValue(out.Parameter.ARN),
+	}
+	if decrypt {
+		secret.Value = aws.StringValue(out.Parameter.Value)
+	}
+	return secret, nil
+}
+
+// DeleteSecret deletes the secret with the given name.
+// ErrParameterNotFound is returned if the secret does not exist.
+func (s *SSM) DeleteSecret(name string) error {
+	_, err := s.client.DeleteParameter(&ssm.DeleteParameterInput{
+		Name: aws.String(name),
+	})
+	if err != nil {
+		if isParameterNotFound(err) {
+			return &ErrParameterNotFound{name: name}
+		}
+		return fmt.Errorf("delete parameter %s: %w", name, err)
+	}
+	return nil
+}
+
+func isParameterNotFound(err error) bool {
+	var awsErr awserr.Error
+	return errors.As(err, &awsErr) && awsErr.Code() == ssm.ErrCodeParameterNotFound
+}
+
 func convertTags(inTags map[string]string) []*ssm.Tag {
 	// Sort the map so that the unit test won't be flaky.
 	keys := make([]string, 0, len(inTags))
@@ -132,3 +261,47 @@ func convertTags(inTags map[string]string) []*ssm.Tag {
 	}
 	return tags
 }
+
+// PortForwardingSessionInput holds the fields needed to forward a local port to a container of a running ECS task.
+type PortForwardingSessionInput struct {
+	Cluster   string // Name of the cluster.
+	TaskID    string
+	RuntimeID string // Runtime ID of the container.
+
+	LocalPort  int
+	RemotePort int
+	RemoteHost string // Optional. A host reachable from the task. If empty, the traffic is forwarded to the task itself.
+}
+
+// StartPortForwardingSession starts a port forwarding session to the container using the ssm plugin.
+// The call blocks until the session is terminated.
+func (s *SSM) StartPortForwardingSession(in PortForwardingSessionInput) error {
+	input := &ssm.StartSessionInput{
+		Target:       aws.String(fmt.Sprintf(fmtECSPortForwardingTarget, in.Cluster, in.TaskID, in.RuntimeID)),
+		DocumentName: aws.String(portForwardingDocument),
+		Parameters: map[string][]*string{
+			portForwardingRemotePortParam: aws.StringSlice([]string{strconv.Itoa(in.RemotePort)}),
+			portForwardingLocalPortParam:  aws.StringSlice([]string{strconv.Itoa(in.LocalPort)}),
+		},
+	}
+	if in.RemoteHost != "" {
+		input.DocumentName = aws.String(portForwardingToRemoteDocument)
+		input.Parameters[portForwardingRemoteHostParam] = aws.StringSlice([]string{in.RemoteHost})
+	}
+	out, err := s.client.StartSession(input)
+	if err != nil {
+		return &ErrStartSession{
+			target: aws.StringValue(input.Target),
+			err:    err,
+		}
+	}
+	sessID := aws.StringValue(out.SessionId)
+	if err := s.newSessStarter().StartPortForwardingSession(out, input); err != nil {
+		// Best-effort clean up the session so that it does not linger until it times out.
+		_, _ = s.client.TerminateSession(&ssm.TerminateSessionInput{
+			SessionId: out.SessionId,
+		})
+		return fmt.Errorf("start session %s using ssm plugin: %w", sessID, err)
+	}
+	return nil
+}
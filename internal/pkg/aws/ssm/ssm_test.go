@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws/awserr"
 
@@ -361,3 +362,337 @@ func TestSSM_PutSecret(t *testing.T) {
 		})
 	}
 }
+
+func TestSSM_ListSecrets(t *testing.T) {
+	mockTime := time.Date(2021, 4, 1, 12, 0, 0, 0, time.UTC)
+	mockFilters := []*ssm.ParameterStringFilter{
+		{
+			Key:    aws.String("Type"),
+			Values: aws.StringSlice([]string{"SecureString"}),
+		},
+		{
+			Key:    aws.String("tag:copilot-application"),
+			Values: aws.StringSlice([]string{"myapp"}),
+		},
+		{
+			Key:    aws.String("tag:copilot-environment"),
+			Values: aws.StringSlice([]string{"myenv"}),
+		},
+	}
+	testCases := map[string]struct {
+		mockClient func(*mocks.Mockapi)
+
+		wantedSecrets []SecretSummary
+		wantedError   error
+	}{
+		"wraps the error if fail to describe parameters": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeParameters(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("describe parameters: some error"),
+		},
+		"returns the secrets of every page sorted by name": {
+			mockClient: func(m *mocks.Mockapi) {
+				gomock.InOrder(
+					m.EXPECT().DescribeParameters(&ssm.DescribeParametersInput{
+						ParameterFilters: mockFilters,
+					}).Return(&ssm.DescribeParametersOutput{
+						Parameters: []*ssm.ParameterMetadata{
+							{
+								Name:             aws.String("/copilot/myapp/myenv/secrets/redis-password"),
+								Version:          aws.Int64(2),
+								LastModifiedDate: aws.Time(mockTime),
+							},
+						},
+						NextToken: aws.String("next"),
+					}, nil),
+					m.EXPECT().DescribeParameters(&ssm.DescribeParametersInput{
+						ParameterFilters: mockFilters,
+						NextToken:        aws.String("next"),
+					}).Return(&ssm.DescribeParametersOutput{
+						Parameters: []*ssm.ParameterMetadata{
+							{
+								Name:             aws.String("/copilot/myapp/myenv/secrets/db-password"),
+								Version:          aws.Int64(1),
+								LastModifiedDate: aws.Time(mockTime),
+							},
+						},
+					}, nil),
+				)
+			},
+			wantedSecrets: []SecretSummary{
+				{
+					Name:         "/copilot/myapp/myenv/secrets/db-password",
+					Version:      1,
+					LastModified: mockTime,
+				},
+				{
+					Name:         "/copilot/myapp/myenv/secrets/redis-password",
+					Version:      2,
+					LastModified: mockTime,
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSSMClient := mocks.NewMockapi(ctrl)
+			tc.mockClient(mockSSMClient)
+			client := SSM{
+				client: mockSSMClient,
+			}
+
+			got, err := client.ListSecrets(map[string]string{
+				deploy.AppTagKey: "myapp",
+				deploy.EnvTagKey: "myenv",
+			})
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedSecrets, got)
+			}
+		})
+	}
+}
+
+func TestSSM_GetSecret(t *testing.T) {
+	const mockName = "/copilot/myapp/myenv/secrets/db-password"
+	mockTime := time.Date(2021, 4, 1, 12, 0, 0, 0, time.UTC)
+	mockParameter := &ssm.Parameter{
+		ARN:              aws.String("arn:aws:ssm:us-west-2:123456789012:parameter/copilot/myapp/myenv/secrets/db-password"),
+		Name:             aws.String(mockName),
+		Value:            aws.String("hunter2"),
+		Version:          aws.Int64(3),
+		LastModifiedDate: aws.Time(mockTime),
+	}
+	testCases := map[string]struct {
+		inDecrypt  bool
+		mockClient func(*mocks.Mockapi)
+
+		wantedSecret *Secret
+		wantedError  error
+	}{
+		"returns ErrParameterNotFound if the secret does not exist": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().GetParameter(gomock.Any()).Return(nil, awserr.New(ssm.ErrCodeParameterNotFound, "not found", nil))
+			},
+			wantedError: &ErrParameterNotFound{name: mockName},
+		},
+		"wraps other errors": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().GetParameter(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: fmt.Errorf("get parameter %s: some error", mockName),
+		},
+		"does not return the value if the secret is not decrypted": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().GetParameter(&ssm.GetParameterInput{
+					Name:           aws.String(mockName),
+					WithDecryption: aws.Bool(false),
+				}).Return(&ssm.GetParameterOutput{Parameter: mockParameter}, nil)
+			},
+			wantedSecret: &Secret{
+				SecretSummary: SecretSummary{
+					Name:         mockName,
+					Version:      3,
+					LastModified: mockTime,
+				},
+				ARN: "arn:aws:ssm:us-west-2:123456789012:parameter/copilot/myapp/myenv/secrets/db-password",
+			},
+		},
+		"returns the decrypted value": {
+			inDecrypt: true,
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().GetParameter(&ssm.GetParameterInput{
+					Name:           aws.String(mockName),
+					WithDecryption: aws.Bool(true),
+				}).Return(&ssm.GetParameterOutput{Parameter: mockParameter}, nil)
+			},
+			wantedSecret: &Secret{
+				SecretSummary: SecretSummary{
+					Name:         mockName,
+					Version:      3,
+					LastModified: mockTime,
+				},
+				ARN:   "arn:aws:ssm:us-west-2:123456789012:parameter/copilot/myapp/myenv/secrets/db-password",
+				Value: "hunter2",
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSSMClient := mocks.NewMockapi(ctrl)
+			tc.mockClient(mockSSMClient)
+			client := SSM{
+				client: mockSSMClient,
+			}
+
+			got, err := client.GetSecret(mockName, tc.inDecrypt)
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedSecret, got)
+			}
+		})
+	}
+}
+
+func TestSSM_DeleteSecret(t *testing.T) {
+	const mockName = "/copilot/myapp/myenv/secrets/db-password"
+	testCases := map[string]struct {
+		mockClient func(*mocks.Mockapi)
+
+		wantedError error
+	}{
+		"returns ErrParameterNotFound if the secret does not exist": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DeleteParameter(gomock.Any()).Return(nil, awserr.New(ssm.ErrCodeParameterNotFound, "not found", nil))
+			},
+			wantedError: &ErrParameterNotFound{name: mockName},
+		},
+		"wraps other errors": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DeleteParameter(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: fmt.Errorf("delete parameter %s: some error", mockName),
+		},
+		"deletes the secret": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DeleteParameter(&ssm.DeleteParameterInput{
+					Name: aws.String(mockName),
+				}).Return(&ssm.DeleteParameterOutput{}, nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSSMClient := mocks.NewMockapi(ctrl)
+			tc.mockClient(mockSSMClient)
+			client := SSM{
+				client: mockSSMClient,
+			}
+
+			err := client.DeleteSecret(mockName)
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestSSM_StartPortForwardingSession(t *testing.T) {
+	mockSession := &ssm.StartSessionOutput{
+		SessionId: aws.String("mockSessionID"),
+	}
+	testCases := map[string]struct {
+		inRemoteHost string
+
+		mockClient      func(m *mocks.Mockapi)
+		mockSessStarter func(m *mocks.MockportForwardingSessionStarter)
+
+		wantedError error
+	}{
+		"fail to start session": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().StartSession(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			mockSessStarter: func(m *mocks.MockportForwardingSessionStarter) {},
+			wantedError:     errors.New("start session with target ecs:my-cluster_task-1_runtime-1: some error"),
+		},
+		"terminate the session if the plugin fails": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().StartSession(gomock.Any()).Return(mockSession, nil)
+				m.EXPECT().TerminateSession(&ssm.TerminateSessionInput{
+					SessionId: aws.String("mockSessionID"),
+				}).Return(nil, nil)
+			},
+			mockSessStarter: func(m *mocks.MockportForwardingSessionStarter) {
+				m.EXPECT().StartPortForwardingSession(mockSession, gomock.Any()).Return(errors.New("some error"))
+			},
+			wantedError: errors.New("start session mockSessionID using ssm plugin: some error"),
+		},
+		"forward to a port of the task": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().StartSession(&ssm.StartSessionInput{
+					Target:       aws.String("ecs:my-cluster_task-1_runtime-1"),
+					DocumentName: aws.String("AWS-StartPortForwardingSession"),
+					Parameters: map[string][]*string{
+						"portNumber":      aws.StringSlice([]string{"80"}),
+						"localPortNumber": aws.StringSlice([]string{"8080"}),
+					},
+				}).Return(mockSession, nil)
+			},
+			mockSessStarter: func(m *mocks.MockportForwardingSessionStarter) {
+				m.EXPECT().StartPortForwardingSession(mockSession, gomock.Any()).Return(nil)
+			},
+		},
+		"forward to a remote host reachable from the task": {
+			inRemoteHost: "db.cluster-abc.us-west-2.rds.amazonaws.com",
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().StartSession(&ssm.StartSessionInput{
+					Target:       aws.String("ecs:my-cluster_task-1_runtime-1"),
+					DocumentName: aws.String("AWS-StartPortForwardingSessionToRemoteHost"),
+					Parameters: map[string][]*string{
+						"portNumber":      aws.StringSlice([]string{"80"}),
+						"localPortNumber": aws.StringSlice([]string{"8080"}),
+						"host":            aws.StringSlice([]string{"db.cluster-abc.us-west-2.rds.amazonaws.com"}),
+					},
+				}).Return(mockSession, nil)
+			},
+			mockSessStarter: func(m *mocks.MockportForwardingSessionStarter) {
+				m.EXPECT().StartPortForwardingSession(mockSession, gomock.Any()).Return(nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockClient := mocks.NewMockapi(ctrl)
+			mockSessStarter := mocks.NewMockportForwardingSessionStarter(ctrl)
+			tc.mockClient(mockClient)
+			tc.mockSessStarter(mockSessStarter)
+
+			client := SSM{
+				client: mockClient,
+				newSessStarter: func() portForwardingSessionStarter {
+					return mockSessStarter
+				},
+			}
+			err := client.StartPortForwardingSession(PortForwardingSessionInput{
+				Cluster:    "my-cluster",
+				TaskID:     "task-1",
+				RuntimeID:  "runtime-1",
+				LocalPort:  8080,
+				RemotePort: 80,
+				RemoteHost: tc.inRemoteHost,
+			})
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
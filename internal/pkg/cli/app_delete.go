@@ -51,7 +51,7 @@ type deleteAppOpts struct {
 	spinner progress
 
 	store                store
-	ws                   wsFileDeleter
+	ws                   wsAppDeleter
 	sessProvider         sessionProvider
 	cfn                  deployer
 	prompt               prompter
@@ -60,7 +60,7 @@ type deleteAppOpts struct {
 	jobDeleteExecutor    func(jobName string) (executor, error)
 	envDeleteExecutor    func(envName string) (executeAsker, error)
 	taskDeleteExecutor   func(envName, taskName string) (executor, error)
-	deletePipelineRunner func() (cmd, error)
+	deletePipelineRunner func(name string) (cmd, error)
 }
 
 func newDeleteAppOpts(vars deleteAppVars) (*deleteAppOpts, error) {
@@ -136,9 +136,10 @@ func newDeleteAppOpts(vars deleteAppVars) (*deleteAppOpts, error) {
 			}
 			return opts, nil
 		},
-		deletePipelineRunner: func() (cmd, error) {
+		deletePipelineRunner: func(name string) (cmd, error) {
 			opts, err := newDeletePipelineOpts(deletePipelineVars{
 				appName:            vars.name,
+				name:               name,
 				skipConfirmation:   true,
 				shouldDeleteSecret: true,
 			})
@@ -198,9 +199,9 @@ func (o *deleteAppOpts) Execute() error {
 		return err
 	}
 
-	// deletePipeline must happen before deleteAppResources and deleteWs, since the pipeline delete command relies
+	// deletePipelines must happen before deleteAppResources and deleteWs, since the pipeline delete command relies
 	// on the application stackset as well as the workspace directory to still exist.
-	if err := o.deletePipeline(); err != nil {
+	if err := o.deletePipelines(); err != nil {
 		if !errors.Is(err, workspace.ErrNoPipelineInWorkspace) {
 			return err
 		}
@@ -320,12 +321,21 @@ func (o *deleteAppOpts) emptyS3Bucket() error {
 	return nil
 }
 
-func (o *deleteAppOpts) deletePipeline() error {
-	cmd, err := o.deletePipelineRunner()
+func (o *deleteAppOpts) deletePipelines() error {
+	pipelines, err := o.ws.ListPipelines()
 	if err != nil {
 		return err
 	}
-	return run(cmd)
+	for _, pipeline := range pipelines {
+		cmd, err := o.deletePipelineRunner(pipeline.Name)
+		if err != nil {
+			return err
+		}
+		if err := run(cmd); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 func (o *deleteAppOpts) deleteAppResources() error {
@@ -129,7 +129,7 @@ func TestDeleteAppOpts_Ask(t *testing.T) {
 type deleteAppMocks struct {
 	spinner         *mocks.Mockprogress
 	store           *mocks.Mockstore
-	ws              *mocks.MockwsFileDeleter
+	ws              *mocks.MockwsAppDeleter
 	sessProvider    *sessions.Provider
 	deployer        *mocks.Mockdeployer
 	svcDeleter      *mocks.Mockexecutor
@@ -209,7 +209,20 @@ func TestDeleteAppOpts_Execute(t *testing.T) {
 					mocks.bucketEmptier.EXPECT().EmptyBucket(mockResources[0].S3Bucket).Return(nil),
 					mocks.spinner.EXPECT().Stop(log.Ssuccess(deleteAppCleanResourcesStopMsg)),
 
-					// delete pipeline
+					// delete pipelines
+					mocks.ws.EXPECT().ListPipelines().Return([]workspace.PipelineManifest{
+						{
+							Name: "pipeline-badgoose-legacy",
+							Path: "/copilot/pipeline.yml",
+						},
+						{
+							Name: "release",
+							Path: "/copilot/pipelines/release/manifest.yml",
+						},
+					}, nil),
+					mocks.pipelineDeleter.EXPECT().Validate().Return(nil),
+					mocks.pipelineDeleter.EXPECT().Ask().Return(nil),
+					mocks.pipelineDeleter.EXPECT().Execute().Return(nil),
 					mocks.pipelineDeleter.EXPECT().Validate().Return(nil),
 					mocks.pipelineDeleter.EXPECT().Ask().Return(nil),
 					mocks.pipelineDeleter.EXPECT().Execute().Return(nil),
@@ -261,10 +274,8 @@ func TestDeleteAppOpts_Execute(t *testing.T) {
 					mocks.bucketEmptier.EXPECT().EmptyBucket(mockResources[0].S3Bucket).Return(nil),
 					mocks.spinner.EXPECT().Stop(log.Ssuccess(deleteAppCleanResourcesStopMsg)),
 
-					// delete pipeline
-					mocks.pipelineDeleter.EXPECT().Validate().Return(nil),
-					mocks.pipelineDeleter.EXPECT().Ask().Return(nil),
-					mocks.pipelineDeleter.EXPECT().Execute().Return(nil),
+					// delete pipelines
+					mocks.ws.EXPECT().ListPipelines().Return(nil, workspace.ErrNoPipelineInWorkspace),
 
 					// deleteAppResources
 					mocks.spinner.EXPECT().Start(deleteAppResourcesStartMsg),
@@ -294,7 +305,7 @@ func TestDeleteAppOpts_Execute(t *testing.T) {
 
 			mockSpinner := mocks.NewMockprogress(ctrl)
 			mockStore := mocks.NewMockstore(ctrl)
-			mockWorkspace := mocks.NewMockwsFileDeleter(ctrl)
+			mockWorkspace := mocks.NewMockwsAppDeleter(ctrl)
 			mockSession := sessions.NewProvider()
 			mockDeployer := mocks.NewMockdeployer(ctrl)
 
@@ -325,7 +336,7 @@ func TestDeleteAppOpts_Execute(t *testing.T) {
 			}
 
 			mockPipelineDeleteCmd := mocks.NewMockcmd(ctrl)
-			mockRunnerProvider := func() (cmd, error) {
+			mockRunnerProvider := func(name string) (cmd, error) {
 				return mockPipelineDeleteCmd, nil
 			}
 
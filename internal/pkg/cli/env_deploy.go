@@ -0,0 +1,291 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/aws/copilot-cli/internal/pkg/addon"
+	"github.com/aws/copilot-cli/internal/pkg/aws/s3"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/template"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/spf13/cobra"
+	"golang.org/x/mod/semver"
+)
+
+const (
+	envDeployEnvPrompt = "Which environment do you want to deploy your storage to?"
+	envDeployEnvHelp   = `Deploys the storage addons under copilot/environments/addons/
+with the environment so that they can be shared by its workloads.`
+
+	envAddonsTemplateName = "environments"
+
+	fmtEnvDeployStart    = "Deploying resources for environment %s."
+	fmtEnvDeployFailed   = "Failed to deploy resources for environment %s.\n"
+	fmtEnvDeployComplete = "Deployed resources for environment %s.\n"
+)
+
+// deployEnvVars holds flag values.
+type deployEnvVars struct {
+	appName string // Required. Name of the application.
+	name    string // Required. Name of the environment.
+}
+
+// deployEnvOpts represents the env deploy command and holds the necessary data
+// and clients to execute the command.
+type deployEnvOpts struct {
+	deployEnvVars
+
+	store    store
+	sel      appEnvSelector
+	addons   templater
+	prog     progress
+	appCFN   appResourcesGetter
+	uploader customResourcesUploader
+
+	// Constructors for clients that can be initialized only at runtime.
+	// These functions are overridden in tests to provide mocks.
+	newEnvVersionGetter func(app, env string) (versionGetter, error)
+	newAddonsDeployer   func(conf *config.Environment) (envAddonsDeployer, error)
+	newS3               func(region string) (uploader, error)
+}
+
+func newDeployEnvOpts(vars deployEnvVars) (*deployEnvOpts, error) {
+	store, err := config.NewStore()
+	if err != nil {
+		return nil, fmt.Errorf("connect to config store: %v", err)
+	}
+	defaultSession, err := sessions.NewProvider().Default()
+	if err != nil {
+		return nil, err
+	}
+	addons, err := addon.NewEnvironment()
+	if err != nil {
+		return nil, fmt.Errorf("new environment addons: %w", err)
+	}
+	return &deployEnvOpts{
+		deployEnvVars: vars,
+
+		store:    store,
+		sel:      selector.NewSelect(prompt.New(), store),
+		addons:   addons,
+		prog:     termprogress.NewSpinner(log.DiagnosticWriter),
+		uploader: template.New(),
+		appCFN:   cloudformation.New(defaultSession),
+
+		newEnvVersionGetter: func(app, env string) (versionGetter, error) {
+			d, err := describe.NewEnvDescriber(describe.NewEnvDescriberConfig{
+				App:         app,
+				Env:         env,
+				ConfigStore: store,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("new env describer for environment %s in app %s: %v", env, app, err)
+			}
+			return d, nil
+		},
+		newAddonsDeployer: func(conf *config.Environment) (envAddonsDeployer, error) {
+			sess, err := sessions.NewProvider().FromRole(conf.ManagerRoleARN, conf.Region)
+			if err != nil {
+				return nil, fmt.Errorf("create session from role %s and region %s: %v", conf.ManagerRoleARN, conf.Region, err)
+			}
+			return cloudformation.New(sess), nil
+		},
+		newS3: func(region string) (uploader, error) {
+			sess, err := sessions.NewProvider().DefaultWithRegion(region)
+			if err != nil {
+				return nil, fmt.Errorf("create session with region %s: %v", region, err)
+			}
+			return s3.New(sess), nil
+		},
+	}, nil
+}
+
+// Validate returns an error if the values passed by flags are invalid.
+func (o *deployEnvOpts) Validate() error {
+	if o.appName == "" {
+		return errNoAppInWorkspace
+	}
+	if o.name == "" {
+		return nil
+	}
+	if _, err := o.store.GetEnvironment(o.appName, o.name); err != nil {
+		var errEnvDoesNotExist *config.ErrNoSuchEnvironment
+		if errors.As(err, &errEnvDoesNotExist) {
+			return err
+		}
+		return fmt.Errorf("get environment %s configuration from application %s: %v", o.name, o.appName, err)
+	}
+	return nil
+}
+
+// Ask prompts for any required flags that are not set by the user.
+func (o *deployEnvOpts) Ask() error {
+	if o.name != "" {
+		return nil
+	}
+	env, err := o.sel.Environment(envDeployEnvPrompt, envDeployEnvHelp, o.appName)
+	if err != nil {
+		return fmt.Errorf("select environment: %w", err)
+	}
+	o.name = env
+	return nil
+}
+
+// Execute uploads the environment addons template and deploys it as a nested stack of the environment stack.
+// If there are no environment addons in the workspace, then any previously deployed addons stack is removed.
+func (o *deployEnvOpts) Execute() error {
+	env, err := o.store.GetEnvironment(o.appName, o.name)
+	if err != nil {
+		return fmt.Errorf("get environment %s in application %s: %w", o.name, o.appName, err)
+	}
+	if err := o.validateEnvVersion(); err != nil {
+		return err
+	}
+	app, err := o.store.GetApplication(o.appName)
+	if err != nil {
+		return fmt.Errorf("get application %s: %w", o.appName, err)
+	}
+	resources, err := o.appCFN.GetAppResourcesByRegion(app, env.Region)
+	if err != nil {
+		return fmt.Errorf("get app resources: %w", err)
+	}
+	s3Client, err := o.newS3(env.Region)
+	if err != nil {
+		return err
+	}
+	urls, err := o.uploader.UploadEnvironmentCustomResources(s3.CompressAndUploadFunc(func(key string, objects ...s3.NamedBinary) (string, error) {
+		return s3Client.ZipAndUpload(resources.S3Bucket, key, objects...)
+	}))
+	if err != nil {
+		return fmt.Errorf("upload custom resources to bucket %s: %w", resources.S3Bucket, err)
+	}
+	addonsURL, err := o.pushAddonsTemplate(s3Client, resources.S3Bucket)
+	if err != nil {
+		return err
+	}
+	return o.deploy(env, urls, addonsURL)
+}
+
+// RecommendActions returns follow-up actions the user can take after successfully executing the command.
+func (o *deployEnvOpts) RecommendActions() error {
+	logRecommendedActions([]string{
+		fmt.Sprintf("Add the storage names to the %s field of your workload manifests to access them.", color.HighlightCode("storage.env_addons")),
+		fmt.Sprintf("Run %s to redeploy your workloads.", color.HighlightCode("copilot deploy")),
+	})
+	return nil
+}
+
+// validateEnvVersion returns an error if the environment is not on the latest template version.
+// Deploying the addons redeploys the environment stack with the latest template, so the environment
+// must be upgraded explicitly first instead of being silently bumped to a new version.
+func (o *deployEnvOpts) validateEnvVersion() error {
+	getter, err := o.newEnvVersionGetter(o.appName, o.name)
+	if err != nil {
+		return err
+	}
+	version, err := getter.Version()
+	if err != nil {
+		return fmt.Errorf("get template version of environment %s in app %s: %v", o.name, o.appName, err)
+	}
+	diff := semver.Compare(version, deploy.LatestEnvTemplateVersion)
+	if diff < 0 {
+		return fmt.Errorf("environment %s is on version %s instead of the latest version %s: run %s first",
+			o.name, version, deploy.LatestEnvTemplateVersion, color.HighlightCode(fmt.Sprintf("copilot env upgrade --name %s", o.name)))
+	}
+	if diff > 0 {
+		return fmt.Errorf("environment %s is on version %s which is newer than %s: are you using the latest version of AWS Copilot?",
+			o.name, version, deploy.LatestEnvTemplateVersion)
+	}
+	return nil
+}
+
+func (o *deployEnvOpts) pushAddonsTemplate(s3Client uploader, bucket string) (string, error) {
+	tpl, err := o.addons.Template()
+	if err != nil {
+		var notFoundErr *addon.ErrAddonsNotFound
+		if errors.As(err, &notFoundErr) {
+			// There are no environment addons, the url is empty so that the nested stack gets removed.
+			return "", nil
+		}
+		return "", fmt.Errorf("retrieve environment addons template: %w", err)
+	}
+	url, err := s3Client.Upload(bucket, fmt.Sprintf(deploy.AddonsCfnTemplateNameFormat, fmt.Sprintf("%s-%s", envAddonsTemplateName, o.name)), strings.NewReader(tpl))
+	if err != nil {
+		return "", fmt.Errorf("put environment addons artifact to bucket %s: %w", bucket, err)
+	}
+	return url, nil
+}
+
+func (o *deployEnvOpts) deploy(env *config.Environment, customResourcesURLs map[string]string, addonsURL string) (err error) {
+	o.prog.Start(fmt.Sprintf(fmtEnvDeployStart, color.HighlightUserInput(env.Name)))
+	defer func() {
+		if err != nil {
+			o.prog.Stop(log.Serrorf(fmtEnvDeployFailed, color.HighlightUserInput(env.Name)))
+			return
+		}
+		o.prog.Stop(log.Ssuccessf(fmtEnvDeployComplete, color.HighlightUserInput(env.Name)))
+	}()
+	deployer, err := o.newAddonsDeployer(env)
+	if err != nil {
+		return err
+	}
+	var importedVPC *config.ImportVPC
+	var adjustedVPC *config.AdjustVPC
+	if env.CustomConfig != nil {
+		importedVPC = env.CustomConfig.ImportVPC
+		adjustedVPC = env.CustomConfig.VPCConfig
+	}
+	if err := deployer.DeployEnvironmentAddons(&deploy.CreateEnvironmentInput{
+		Version: deploy.LatestEnvTemplateVersion,
+		App: deploy.AppInformation{
+			Name: env.App,
+		},
+		Name:                env.Name,
+		CustomResourcesURLs: customResourcesURLs,
+		ImportVPCConfig:     importedVPC,
+		AdjustVPCConfig:     adjustedVPC,
+		CFNServiceRoleARN:   env.ExecutionRoleARN,
+		AddonsTemplateURL:   addonsURL,
+	}); err != nil {
+		return fmt.Errorf("deploy addons of environment %s: %w", env.Name, err)
+	}
+	return nil
+}
+
+// buildEnvDeployCmd builds the command to deploy the storage addons shared by the workloads of an environment.
+func buildEnvDeployCmd() *cobra.Command {
+	vars := deployEnvVars{}
+	cmd := &cobra.Command{
+		Use:   "deploy",
+		Short: "Deploys the storage addons shared in an environment.",
+		Long: `Deploys the storage addons shared in an environment.
+Storage created with "copilot storage init --lifecycle environment" lives under copilot/environments/addons/
+and is deployed along with the environment stack.`,
+		Example: `
+  Deploys the shared storage of the "test" environment.
+  /code $ copilot env deploy --name test`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newDeployEnvOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", envFlagDescription)
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	return cmd
+}
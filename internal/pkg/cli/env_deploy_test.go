@@ -0,0 +1,307 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/addon"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeployEnvOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inAppName string
+		inName    string
+		mockStore func(m *mocks.Mockstore)
+
+		wantedErr error
+	}{
+		"no app in workspace": {
+			mockStore: func(m *mocks.Mockstore) {},
+
+			wantedErr: errNoAppInWorkspace,
+		},
+		"should not error if no environment is provided": {
+			inAppName: "phonetool",
+			mockStore: func(m *mocks.Mockstore) {},
+		},
+		"should return a config.ErrNoSuchEnvironment if the environment is not found": {
+			inAppName: "phonetool",
+			inName:    "test",
+			mockStore: func(m *mocks.Mockstore) {
+				m.EXPECT().GetEnvironment("phonetool", "test").Return(nil, &config.ErrNoSuchEnvironment{
+					ApplicationName: "phonetool",
+					EnvironmentName: "test",
+				})
+			},
+
+			wantedErr: &config.ErrNoSuchEnvironment{
+				ApplicationName: "phonetool",
+				EnvironmentName: "test",
+			},
+		},
+		"should wrap an unexpected config failure": {
+			inAppName: "phonetool",
+			inName:    "test",
+			mockStore: func(m *mocks.Mockstore) {
+				m.EXPECT().GetEnvironment("phonetool", "test").Return(nil, errors.New("some error"))
+			},
+
+			wantedErr: errors.New("get environment test configuration from application phonetool: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockStore := mocks.NewMockstore(ctrl)
+			tc.mockStore(mockStore)
+			opts := &deployEnvOpts{
+				deployEnvVars: deployEnvVars{
+					appName: tc.inAppName,
+					name:    tc.inName,
+				},
+				store: mockStore,
+			}
+
+			// WHEN
+			err := opts.Validate()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestDeployEnvOpts_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		inName  string
+		mockSel func(m *mocks.MockappEnvSelector)
+
+		wantedName string
+		wantedErr  error
+	}{
+		"should not prompt if the environment is provided": {
+			inName:  "test",
+			mockSel: func(m *mocks.MockappEnvSelector) {},
+
+			wantedName: "test",
+		},
+		"should prompt for the environment": {
+			mockSel: func(m *mocks.MockappEnvSelector) {
+				m.EXPECT().Environment(envDeployEnvPrompt, envDeployEnvHelp, "phonetool").Return("prod", nil)
+			},
+
+			wantedName: "prod",
+		},
+		"should wrap the selection error": {
+			mockSel: func(m *mocks.MockappEnvSelector) {
+				m.EXPECT().Environment(gomock.Any(), gomock.Any(), gomock.Any()).Return("", errors.New("some error"))
+			},
+
+			wantedErr: errors.New("select environment: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockSel := mocks.NewMockappEnvSelector(ctrl)
+			tc.mockSel(mockSel)
+			opts := &deployEnvOpts{
+				deployEnvVars: deployEnvVars{
+					appName: "phonetool",
+					name:    tc.inName,
+				},
+				sel: mockSel,
+			}
+
+			// WHEN
+			err := opts.Ask()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedName, opts.name)
+			}
+		})
+	}
+}
+
+type deployEnvMocks struct {
+	store         *mocks.Mockstore
+	versionGetter *mocks.MockversionGetter
+	appCFN        *mocks.MockappResourcesGetter
+	uploader      *mocks.MockcustomResourcesUploader
+	s3            *mocks.Mockuploader
+	addons        *mocks.Mocktemplater
+	deployer      *mocks.MockenvAddonsDeployer
+	prog          *mocks.Mockprogress
+}
+
+func TestDeployEnvOpts_Execute(t *testing.T) {
+	mockEnv := &config.Environment{
+		App:              "phonetool",
+		Name:             "test",
+		Region:           "us-west-2",
+		ExecutionRoleARN: "execARN",
+	}
+	mockApp := &config.Application{Name: "phonetool"}
+	testCases := map[string]struct {
+		setupMocks func(m deployEnvMocks)
+		wantedErr  error
+	}{
+		"should return an error if the environment is on a legacy version": {
+			setupMocks: func(m deployEnvMocks) {
+				m.store.EXPECT().GetEnvironment("phonetool", "test").Return(mockEnv, nil)
+				m.versionGetter.EXPECT().Version().Return(deploy.LegacyEnvTemplateVersion, nil)
+			},
+			wantedErr: errors.New("environment test is on version v0.0.0 instead of the latest version " + deploy.LatestEnvTemplateVersion + ": run `copilot env upgrade --name test` first"),
+		},
+		"should return an error if the environment is not on the latest version": {
+			setupMocks: func(m deployEnvMocks) {
+				m.store.EXPECT().GetEnvironment("phonetool", "test").Return(mockEnv, nil)
+				m.versionGetter.EXPECT().Version().Return("v1.7.0", nil)
+			},
+			wantedErr: errors.New("environment test is on version v1.7.0 instead of the latest version " + deploy.LatestEnvTemplateVersion + ": run `copilot env upgrade --name test` first"),
+		},
+		"should return an error if the environment is on a newer version than the CLI": {
+			setupMocks: func(m deployEnvMocks) {
+				m.store.EXPECT().GetEnvironment("phonetool", "test").Return(mockEnv, nil)
+				m.versionGetter.EXPECT().Version().Return("v99.0.0", nil)
+			},
+			wantedErr: errors.New("environment test is on version v99.0.0 which is newer than " + deploy.LatestEnvTemplateVersion + ": are you using the latest version of AWS Copilot?"),
+		},
+		"should wrap the error if the addons template cannot be read": {
+			setupMocks: func(m deployEnvMocks) {
+				m.store.EXPECT().GetEnvironment("phonetool", "test").Return(mockEnv, nil)
+				m.versionGetter.EXPECT().Version().Return(deploy.LatestEnvTemplateVersion, nil)
+				m.store.EXPECT().GetApplication("phonetool").Return(mockApp, nil)
+				m.appCFN.EXPECT().GetAppResourcesByRegion(mockApp, "us-west-2").Return(&stack.AppRegionalResources{S3Bucket: "mockBucket"}, nil)
+				m.uploader.EXPECT().UploadEnvironmentCustomResources(gomock.Any()).Return(nil, nil)
+				m.addons.EXPECT().Template().Return("", errors.New("some error"))
+			},
+			wantedErr: errors.New("retrieve environment addons template: some error"),
+		},
+		"should remove the addons stack if there are no environment addons": {
+			setupMocks: func(m deployEnvMocks) {
+				m.store.EXPECT().GetEnvironment("phonetool", "test").Return(mockEnv, nil)
+				m.versionGetter.EXPECT().Version().Return(deploy.LatestEnvTemplateVersion, nil)
+				m.store.EXPECT().GetApplication("phonetool").Return(mockApp, nil)
+				m.appCFN.EXPECT().GetAppResourcesByRegion(mockApp, "us-west-2").Return(&stack.AppRegionalResources{S3Bucket: "mockBucket"}, nil)
+				m.uploader.EXPECT().UploadEnvironmentCustomResources(gomock.Any()).Return(map[string]string{"mockCustomResource": "mockURL"}, nil)
+				m.addons.EXPECT().Template().Return("", &addon.ErrAddonsNotFound{})
+				m.prog.EXPECT().Start(gomock.Any())
+				m.deployer.EXPECT().DeployEnvironmentAddons(&deploy.CreateEnvironmentInput{
+					Version: deploy.LatestEnvTemplateVersion,
+					App: deploy.AppInformation{
+						Name: "phonetool",
+					},
+					Name:                "test",
+					CustomResourcesURLs: map[string]string{"mockCustomResource": "mockURL"},
+					CFNServiceRoleARN:   "execARN",
+				}).Return(nil)
+				m.prog.EXPECT().Stop(gomock.Any())
+			},
+		},
+		"should upload the addons template and deploy it with the environment": {
+			setupMocks: func(m deployEnvMocks) {
+				m.store.EXPECT().GetEnvironment("phonetool", "test").Return(mockEnv, nil)
+				m.versionGetter.EXPECT().Version().Return(deploy.LatestEnvTemplateVersion, nil)
+				m.store.EXPECT().GetApplication("phonetool").Return(mockApp, nil)
+				m.appCFN.EXPECT().GetAppResourcesByRegion(mockApp, "us-west-2").Return(&stack.AppRegionalResources{S3Bucket: "mockBucket"}, nil)
+				m.uploader.EXPECT().UploadEnvironmentCustomResources(gomock.Any()).Return(nil, nil)
+				m.addons.EXPECT().Template().Return("template", nil)
+				m.s3.EXPECT().Upload("mockBucket", "environments-test.addons.stack.yml", gomock.Any()).Return("mockAddonsURL", nil)
+				m.prog.EXPECT().Start(gomock.Any())
+				m.deployer.EXPECT().DeployEnvironmentAddons(gomock.Any()).DoAndReturn(func(in *deploy.CreateEnvironmentInput) error {
+					require.Equal(t, "mockAddonsURL", in.AddonsTemplateURL)
+					return nil
+				})
+				m.prog.EXPECT().Stop(gomock.Any())
+			},
+		},
+		"should wrap the deployment error": {
+			setupMocks: func(m deployEnvMocks) {
+				m.store.EXPECT().GetEnvironment("phonetool", "test").Return(mockEnv, nil)
+				m.versionGetter.EXPECT().Version().Return(deploy.LatestEnvTemplateVersion, nil)
+				m.store.EXPECT().GetApplication("phonetool").Return(mockApp, nil)
+				m.appCFN.EXPECT().GetAppResourcesByRegion(mockApp, "us-west-2").Return(&stack.AppRegionalResources{S3Bucket: "mockBucket"}, nil)
+				m.uploader.EXPECT().UploadEnvironmentCustomResources(gomock.Any()).Return(nil, nil)
+				m.addons.EXPECT().Template().Return("template", nil)
+				m.s3.EXPECT().Upload(gomock.Any(), gomock.Any(), gomock.Any()).Return("mockAddonsURL", nil)
+				m.prog.EXPECT().Start(gomock.Any())
+				m.deployer.EXPECT().DeployEnvironmentAddons(gomock.Any()).Return(errors.New("some error"))
+				m.prog.EXPECT().Stop(gomock.Any())
+			},
+			wantedErr: errors.New("deploy addons of environment test: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := deployEnvMocks{
+				store:         mocks.NewMockstore(ctrl),
+				versionGetter: mocks.NewMockversionGetter(ctrl),
+				appCFN:        mocks.NewMockappResourcesGetter(ctrl),
+				uploader:      mocks.NewMockcustomResourcesUploader(ctrl),
+				s3:            mocks.NewMockuploader(ctrl),
+				addons:        mocks.NewMocktemplater(ctrl),
+				deployer:      mocks.NewMockenvAddonsDeployer(ctrl),
+				prog:          mocks.NewMockprogress(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := &deployEnvOpts{
+				deployEnvVars: deployEnvVars{
+					appName: "phonetool",
+					name:    "test",
+				},
+				store:    m.store,
+				addons:   m.addons,
+				prog:     m.prog,
+				appCFN:   m.appCFN,
+				uploader: m.uploader,
+				newEnvVersionGetter: func(app, env string) (versionGetter, error) {
+					return m.versionGetter, nil
+				},
+				newAddonsDeployer: func(conf *config.Environment) (envAddonsDeployer, error) {
+					return m.deployer, nil
+				},
+				newS3: func(region string) (uploader, error) {
+					return m.s3, nil
+				},
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -51,19 +51,41 @@ const (
 	localFlag             = "local"
 	deleteSecretFlag      = "delete-secret"
 	svcPortFlag           = "port"
+	stageFlag             = "stage"
+	commentFlag           = "comment"
 
 	noSubscriptionFlag  = "no-subscribe"
 	subscribeTopicsFlag = "subscribe-topics"
 
-	storageTypeFlag              = "storage-type"
-	storagePartitionKeyFlag      = "partition-key"
-	storageSortKeyFlag           = "sort-key"
-	storageNoSortFlag            = "no-sort"
-	storageLSIConfigFlag         = "lsi"
-	storageNoLSIFlag             = "no-lsi"
-	storageRDSEngineFlag         = "engine"
-	storageRDSInitialDBFlag      = "initial-db"
-	storageRDSParameterGroupFlag = "parameter-group"
+	storageTypeFlag                   = "storage-type"
+	storageLifecycleFlag              = "lifecycle"
+	storagePartitionKeyFlag           = "partition-key"
+	storageSortKeyFlag                = "sort-key"
+	storageNoSortFlag                 = "no-sort"
+	storageLSIConfigFlag              = "lsi"
+	storageNoLSIFlag                  = "no-lsi"
+	storageGSIConfigFlag              = "gsi"
+	storageTTLFlag                    = "ttl"
+	storageStreamFlag                 = "stream"
+	storageBillingModeFlag            = "billing-mode"
+	storageS3VersioningFlag           = "versioning"
+	storageS3ExpirationDaysFlag       = "expiration-days"
+	storageS3TransitionFlag           = "transition"
+	storageS3CORSOriginFlag           = "cors-origin"
+	storageS3KMSEncryptionFlag        = "kms-encryption"
+	storageS3CloudFrontFlag           = "cloudfront"
+	storageRDSEngineFlag              = "engine"
+	storageRDSInitialDBFlag           = "initial-db"
+	storageRDSParameterGroupFlag      = "parameter-group"
+	storageRDSClusterTypeFlag         = "cluster-type"
+	storageRDSInstanceClassFlag       = "instance-class"
+	storageRDSReadersFlag             = "readers"
+	storageRDSBackupRetentionFlag     = "backup-retention"
+	storageRDSDeletionProtectionFlag  = "deletion-protection"
+	storageRDSSnapshotFlag            = "snapshot-identifier"
+	storageRedisNodeTypeFlag          = "node-type"
+	storageOpenSearchInstanceTypeFlag = "instance-type"
+	storageSQSFIFOFlag                = "fifo"
 
 	taskGroupNameFlag   = "task-group-name"
 	countFlag           = "count"
@@ -83,6 +105,9 @@ const (
 	generateCommandFlag = "generate-cmd"
 	osFlag              = "platform-os"
 	archFlag            = "platform-arch"
+	followExitCodeFlag  = "follow-exit-code"
+	logFileFlag         = "log-file"
+	fromSvcFlag         = "from-svc"
 
 	vpcIDFlag          = "import-vpc-id"
 	publicSubnetsFlag  = "import-public-subnets"
@@ -107,9 +132,20 @@ const (
 	taskIDFlag    = "task-id"
 	containerFlag = "container"
 
+	localPortFlag  = "local"
+	remotePortFlag = "remote"
+	remoteHostFlag = "remote-host"
+
 	valuesFlag        = "values"
 	overwriteFlag     = "overwrite"
 	inputFilePathFlag = "cli-input-yaml"
+	decryptFlag       = "decrypt"
+
+	secretStoreFlag     = "store"
+	kmsKeysFlag         = "kms-keys"
+	rotationLambdasFlag = "rotation-lambdas"
+	rotationDaysFlag    = "rotation-days"
+	jsonKeyFlag         = "json-key"
 
 	includeStateMachineLogsFlag = "include-state-machine"
 )
@@ -177,6 +213,22 @@ Mutually exclusive with the --%s flag.`, inputFilePathFlag)
 	secretInputFilePathFlagDescription = fmt.Sprintf(`Optional. A YAML file in which the secret values are specified.
 Mutually exclusive with the -%s ,--%s and --%s flags.`, nameFlagShort, nameFlag, valuesFlag)
 
+	secretStoreFlagDescription = fmt.Sprintf(`Optional. Where to store the secret, either %q (SSM Parameter Store) or %q (Secrets Manager).`,
+		secretStoreSSM, secretStoreSecretsManager)
+	secretKMSKeysFlagDescription = fmt.Sprintf(`Optional. KMS keys used to encrypt the secret in each environment. Specified as <environment>=<key ID or ARN> separated by commas.
+Defaults to the AWS managed key. Can only be used with --%s %s.`, secretStoreFlag, secretStoreSecretsManager)
+	secretRotationLambdasFlagDescription = fmt.Sprintf(`Optional. Lambda functions that rotate the secret in each environment. Specified as <environment>=<function ARN> separated by commas.
+The function must be in the account and region of the environment. Can only be used with --%s %s.`, secretStoreFlag, secretStoreSecretsManager)
+	secretRotationDaysFlagDescription = fmt.Sprintf(`Optional. Number of days between rotations of the secret. (default %d)
+Must be specified along with --%s.`, defaultSecretRotationDays, rotationLambdasFlag)
+	secretJSONKeyFlagDescription = fmt.Sprintf(`Optional. Key of the JSON value of the secret to inject into your containers.
+The value of the secret must be a JSON object containing the key. Can only be used with --%s %s.`, secretStoreFlag, secretStoreSecretsManager)
+
+	secretLsEnvFlagDescription     = "Optional. Only list the secrets of this environment."
+	secretShowNameFlagDescription  = "Name of the secret."
+	secretDecryptFlagDescription   = "Optional. Show the decrypted value of the secret instead of masking it."
+	secretDeleteEnvFlagDescription = "Optional. Only delete the secret from this environment. Defaults to all environments."
+
 	repoURLFlagDescription = fmt.Sprintf(`The repository URL to trigger your pipeline.
 Supported providers are: %s`, strings.Join(manifest.PipelineProviders, ", "))
 )
@@ -203,8 +255,10 @@ Allows you to categorize resources.`
 
 	limitFlagDescription = `Optional. The maximum number of log events returned. Default is 10
 unless any time filtering flags are set.`
-	followFlagDescription = "Optional. Specifies if the logs should be streamed."
-	sinceFlagDescription  = `Optional. Only return logs newer than a relative duration like 5s, 2m, or 3h.
+	followFlagDescription         = "Optional. Specifies if the logs should be streamed."
+	followPipelineFlagDescription = `Optional. Stream the progress of the latest execution until it finishes.
+Exits with a non-zero code if the execution did not succeed.`
+	sinceFlagDescription = `Optional. Only return logs newer than a relative duration like 5s, 2m, or 3h.
 Defaults to all logs. Only one of start-time / since may be used.`
 	startTimeFlagDescription = `Optional. Only return logs after a specific date (RFC3339).
 Defaults to all logs. Only one of start-time / since may be used.`
@@ -227,13 +281,19 @@ Defaults to all logs. Only one of end-time / follow may be used.`
 	localJobFlagDescription          = "Only show jobs in the workspace."
 	deleteSecretFlagDescription      = "Deletes AWS Secrets Manager secret associated with a pipeline source repository."
 	svcPortFlagDescription           = "The port on which your service listens."
+	approvalStageFlagDescription     = "Optional. Name of the stage or environment with a pending approval."
+	approvalCommentFlagDescription   = "Optional. Comment recorded with the approval or rejection."
+	retryStageFlagDescription        = "Optional. Name of the stage or environment with failed actions to retry."
+	historyLimitFlagDescription      = "Optional. The maximum number of pipeline executions returned."
 
 	noSubscriptionFlagDescription  = "Optional. Turn off selection for adding subscriptions for worker services."
 	subscribeTopicsFlagDescription = `Optional. SNS Topics to subscribe to from other services in your application.
 Must be of format '<svcName>:<topicName>'`
 
-	storageFlagDescription             = "Name of the storage resource to create."
-	storageWorkloadFlagDescription     = "Name of the service or job to associate with storage."
+	storageFlagDescription          = "Name of the storage resource to create."
+	storageWorkloadFlagDescription  = "Name of the service or job to associate with storage."
+	storageLifecycleFlagDescription = `Optional. Whether the storage is deployed with a workload or with an environment.
+Must be either "workload" or "environment".`
 	storagePartitionKeyFlagDescription = `Partition key for the DDB table.
 Must be of the format '<keyName>:<dataType>'.`
 	storageSortKeyFlagDescription = `Optional. Sort key for the DDB table.
@@ -242,10 +302,42 @@ Must be of the format '<keyName>:<dataType>'.`
 	storageNoLSIFlagDescription     = `Optional. Don't ask about configuring alternate sort keys.`
 	storageLSIConfigFlagDescription = `Optional. Attribute to use as an alternate sort key. May be specified up to 5 times.
 Must be of the format '<keyName>:<dataType>'.`
-	storageRDSEngineFlagDescription = `The database engine used in the cluster.
+	storageGSIConfigFlagDescription = `Optional. Keys of a global secondary index. May be specified up to 20 times.
+Must be of the format '<keyName>:<dataType>' or '<keyName>:<dataType>,<sortKeyName>:<dataType>'.`
+	storageTTLFlagDescription    = "Optional. Attribute holding the expiration time of items in the DDB table."
+	storageStreamFlagDescription = `Optional. Enables a stream of the item changes in the DDB table.
+Must be one of "keys-only", "new-image", "old-image" or "new-and-old-images".`
+	storageBillingModeFlagDescription = `Optional. Billing mode of the DDB table.
+Must be either "on-demand" or "provisioned". Provisioned capacity autoscales.`
+	storageS3VersioningFlagDescription     = "Optional. Keep multiple versions of the objects in the S3 bucket."
+	storageS3ExpirationDaysFlagDescription = "Optional. The number of days after which objects in the S3 bucket are deleted."
+	storageS3TransitionFlagDescription     = `Optional. Move objects of the S3 bucket to another storage class after a number of days.
+May be specified multiple times. Must be of the format '<storageClass>:<days>', e.g. "GLACIER:90".`
+	storageS3CORSOriginFlagDescription = `Optional. An origin allowed to make cross-origin requests to the S3 bucket.
+May be specified multiple times. Must be "*" or start with "http://" or "https://".`
+	storageS3KMSEncryptionFlagDescription = "Optional. Encrypt the objects of the S3 bucket with a customer managed KMS key."
+	storageS3CloudFrontFlagDescription    = "Optional. Serve the objects of the S3 bucket through a CloudFront distribution."
+	storageRDSEngineFlagDescription       = `The database engine used in the cluster.
 Must be either "MySQL" or "PostgreSQL".`
 	storageRDSInitialDBFlagDescription      = "The initial database to create in the cluster."
 	storageRDSParameterGroupFlagDescription = "Optional. The name of the parameter group to associate with the cluster."
+	storageRDSClusterTypeFlagDescription    = `Optional. The capacity type of the cluster.
+Must be one of "serverless", "serverless-v2" or "provisioned".`
+	storageRDSInstanceClassFlagDescription = `The instance class of the writer and reader instances of a provisioned cluster.
+Must be an RDS instance class such as "db.r6g.large".`
+	storageRDSReadersFlagDescription = `Optional. The number of reader instances of a "serverless-v2" or "provisioned" cluster.
+Must be between 0 and 15.`
+	storageRDSBackupRetentionFlagDescription    = "Optional. The number of days between 1 and 35 to keep automated backups of the cluster."
+	storageRDSDeletionProtectionFlagDescription = "Optional. Prevent the cluster from being deleted."
+	storageRDSSnapshotFlagDescription           = "Optional. The identifier or ARN of the DB cluster snapshot to restore the cluster from."
+	storageRedisNodeTypeFlagDescription         = `The compute and memory capacity of the nodes in the Redis cluster.
+Must be an ElastiCache node type such as "cache.t3.micro".`
+	storageOpenSearchInstanceTypeFlagDescription = `The instance type of the data nodes in the OpenSearch domain.
+Must be an OpenSearch instance type such as "t3.small.search".`
+	storageSQSFIFOFlagDescription  = "Optional. Create a FIFO queue that preserves the order of messages and delivers them exactly once."
+	storageLsEnvFlagDescription    = "Optional. Only list the storage deployed in this environment."
+	storageShowNameFlagDescription = "Name of the storage resource."
+	storageShowEnvFlagDescription  = "Optional. Only show the storage deployed in this environment."
 
 	countFlagDescription         = "Optional. The number of tasks to set up."
 	cpuFlagDescription           = "Optional. The number of CPU units to reserve for each task."
@@ -264,6 +356,13 @@ Tasks with the same group name share the same set of resources.
 To use it for an ECS service, specify --generate-cmd <cluster name>/<service name>.
 Alternatively, if the service or job is created with Copilot, specify --generate-cmd <application>/<environment>/<service or job name>.
 Cannot be specified with any other flags.`
+	followExitCodeFlagDescription = `Optional. Stream the logs until all tasks stop, then show each container's exit code.
+Exits with a non-zero code if any essential container failed.`
+	logFileFlagDescription = `Optional. Also write the streamed logs to a file.
+Must be specified with --follow or --follow-exit-code.`
+	fromSvcFlagDescription = `Optional. Name of a deployed service whose task definition, roles, secrets,
+subnets and security groups are used to run the task. Must be specified with --env.
+Only --command and --env-vars can override the service's container.`
 
 	vpcIDFlagDescription          = "Optional. Use an existing VPC ID."
 	publicSubnetsFlagDescription  = "Optional. Use existing public subnet IDs."
@@ -300,5 +399,12 @@ are also accepted.`
 	execCommandFlagDescription = `Optional. The command that is passed to a running container.`
 	containerFlagDescription   = "Optional. The specific container you want to exec in. By default the first essential container will be used."
 
+	portForwardTaskIDFlagDescription    = "Optional. ID of the task you want to forward traffic to."
+	portForwardContainerFlagDescription = "Optional. The specific container you want to forward traffic through. By default the first essential container will be used."
+	localPortFlagDescription            = "Optional. The port on your machine to listen on. Defaults to the remote port."
+	remotePortFlagDescription           = "The port to forward traffic to on the task, or on the remote host if --remote-host is specified."
+	remoteHostFlagDescription           = `Optional. A host reachable from the task to forward traffic to.
+For example, the endpoint of a database in the environment's VPC.`
+
 	secretOverwriteFlagDescription = "Optional. Whether to overwrite an existing secret."
 )
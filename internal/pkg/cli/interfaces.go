@@ -21,6 +21,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
 	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/aws/s3"
+	"github.com/aws/copilot-cli/internal/pkg/aws/secretsmanager"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
@@ -232,6 +233,11 @@ type wsFileDeleter interface {
 	DeleteWorkspaceFile() error
 }
 
+type wsAppDeleter interface {
+	wsFileDeleter
+	wsPipelineLister
+}
+
 type manifestReader interface {
 	ReadWorkloadManifest(name string) (workspace.WorkloadManifest, error)
 }
@@ -241,12 +247,25 @@ type workspacePathGetter interface {
 }
 
 type wsPipelineManifestReader interface {
-	ReadPipelineManifest() ([]byte, error)
+	ReadPipelineManifest(path string) ([]byte, error)
+}
+
+type wsPipelineLister interface {
+	ListPipelines() ([]workspace.PipelineManifest, error)
+}
+
+type wsCopilotDirReader interface {
+	ReadCopilotDir() ([]workspace.File, error)
 }
 
 type wsPipelineWriter interface {
-	WritePipelineBuildspec(marshaler encoding.BinaryMarshaler) (string, error)
-	WritePipelineManifest(marshaler encoding.BinaryMarshaler) (string, error)
+	WritePipelineBuildspec(marshaler encoding.BinaryMarshaler, name string) (string, error)
+	WritePipelineManifest(marshaler encoding.BinaryMarshaler, name string) (string, error)
+}
+
+type wsPipelineIniter interface {
+	wsPipelineWriter
+	wsPipelineLister
 }
 
 type serviceLister interface {
@@ -292,7 +311,11 @@ type wsWlDirReader interface {
 
 type wsPipelineReader interface {
 	wsPipelineManifestReader
+	wsPipelineLister
 	wlLister
+	manifestReader
+	workspacePathGetter
+	wsCopilotDirReader
 }
 
 type wsAppManager interface {
@@ -302,10 +325,23 @@ type wsAppManager interface {
 
 type wsAddonManager interface {
 	WriteAddon(f encoding.BinaryMarshaler, svc, name string) (string, error)
+	WriteEnvironmentAddon(f encoding.BinaryMarshaler, name string) (string, error)
 	manifestReader
 	wlLister
 }
 
+type wsStorageReader interface {
+	ListWorkloads() ([]string, error)
+	ReadAddonsDir(wlName string) ([]string, error)
+	ReadAddon(wlName, fname string) ([]byte, error)
+	ReadEnvironmentAddonsDir() ([]string, error)
+	ReadEnvironmentAddon(fname string) ([]byte, error)
+}
+
+type stackResourcesLister interface {
+	StackResources(name string) ([]*awscloudformation.StackResource, error)
+}
+
 type uploader interface {
 	Upload(bucket, key string, data io.Reader) (string, error)
 	ZipAndUpload(bucket, key string, files ...s3.NamedBinary) (string, error)
@@ -352,6 +388,7 @@ type pipelineDeployer interface {
 	PipelineExists(env *deploy.CreatePipelineInput) (bool, error)
 	DeletePipeline(pipelineName string) error
 	AddPipelineResourcesToApp(app *config.Application, region string) error
+	AddPipelineStagesToApp(app *config.Application, stages []deploy.PipelineStage) error
 	appResourcesGetter
 	// TODO: Add StreamPipelineCreation method
 }
@@ -419,8 +456,13 @@ type versionGetter interface {
 	Version() (string, error)
 }
 
+type envParamsGetter interface {
+	Params() (map[string]string, error)
+}
+
 type endpointGetter interface {
 	ServiceDiscoveryEndpoint() (string, error)
+	AddonsOutputs() ([]string, error)
 }
 
 type envTemplater interface {
@@ -436,6 +478,10 @@ type legacyEnvUpgrader interface {
 	envTemplater
 }
 
+type envAddonsDeployer interface {
+	DeployEnvironmentAddons(in *deploy.CreateEnvironmentInput) error
+}
+
 type envTemplateUpgrader interface {
 	envUpgrader
 	legacyEnvUpgrader
@@ -451,6 +497,25 @@ type pipelineGetter interface {
 	GetPipelinesByTags(tags map[string]string) ([]*codepipeline.Pipeline, error)
 }
 
+type pipelineExecutionGetter interface {
+	LatestPipelineExecution(pipelineName string) (*codepipeline.PipelineExecution, error)
+	PipelineExecution(pipelineName, executionID string) (*codepipeline.PipelineExecution, error)
+}
+
+type pipelineApprover interface {
+	PendingApprovals(pipelineName string) ([]codepipeline.PendingApproval, error)
+	PutApprovalResult(pipelineName string, approval codepipeline.PendingApproval, approved bool, comment string) error
+}
+
+type pipelineRetrier interface {
+	GetPipelineState(pipelineName string) (*codepipeline.PipelineState, error)
+	RetryFailedActions(pipelineName, stageName string) (string, error)
+}
+
+type pipelineReleaser interface {
+	StartExecution(pipelineName string) (string, error)
+}
+
 type executor interface {
 	Execute() error
 }
@@ -563,6 +628,10 @@ type ecsCommandExecutor interface {
 	ExecuteCommand(in awsecs.ExecuteCommandInput) error
 }
 
+type ssmPortForwarder interface {
+	StartPortForwardingSession(in ssm.PortForwardingSessionInput) error
+}
+
 type ssmPluginManager interface {
 	ValidateBinary() error
 	InstallLatestBinary() error
@@ -609,6 +678,11 @@ type publicIPGetter interface {
 	PublicIP(ENI string) (string, error)
 }
 
+type tasksDescriber interface {
+	DescribeTasks(cluster string, taskARNs []string) ([]*awsecs.Task, error)
+	TaskDefinition(taskDefName string) (*awsecs.TaskDefinition, error)
+}
+
 type cliStringer interface {
 	CLIString() string
 }
@@ -617,6 +691,34 @@ type secretPutter interface {
 	PutSecret(in ssm.PutSecretInput) (*ssm.PutSecretOutput, error)
 }
 
+type secretsManagerSecretPutter interface {
+	PutSecret(in secretsmanager.PutSecretInput) (*secretsmanager.PutSecretOutput, error)
+	RotateSecret(in secretsmanager.RotateSecretInput) error
+}
+
+type secretLister interface {
+	ListSecrets(tags map[string]string) ([]ssm.SecretSummary, error)
+}
+
+type secretGetter interface {
+	secretLister
+	GetSecret(name string, decrypt bool) (*ssm.Secret, error)
+}
+
+type secretsManagerSecretLister interface {
+	ListSecrets(tags map[string]string) ([]secretsmanager.SecretSummary, error)
+}
+
+type secretsManagerSecretGetter interface {
+	secretsManagerSecretLister
+	GetSecret(name string, decrypt bool) (*secretsmanager.Secret, error)
+}
+
+type wsWorkloadManifestReader interface {
+	wlLister
+	manifestReader
+}
+
 type servicePauser interface {
 	PauseService(svcARN string) error
 }
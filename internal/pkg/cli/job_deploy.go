@@ -11,6 +11,7 @@ import (
 	"path/filepath"
 	"strings"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/endpoints"
 	"github.com/aws/copilot-cli/internal/pkg/docker/dockerengine"
 
@@ -57,6 +58,7 @@ type deployJobOpts struct {
 	s3                 uploader
 	envUpgradeCmd      actionCommand
 	endpointGetter     endpointGetter
+	envParams          envParamsGetter
 
 	spinner progress
 	sel     wsSelector
@@ -280,7 +282,7 @@ func (o *deployJobOpts) configureClients() error {
 
 	// CF client against env account profile AND target environment region
 	o.jobCFN = cloudformation.New(envSession)
-	o.endpointGetter, err = describe.NewEnvDescriber(describe.NewEnvDescriberConfig{
+	envDescriber, err := describe.NewEnvDescriber(describe.NewEnvDescriberConfig{
 		App:         o.appName,
 		Env:         o.envName,
 		ConfigStore: o.store,
@@ -288,6 +290,8 @@ func (o *deployJobOpts) configureClients() error {
 	if err != nil {
 		return fmt.Errorf("initiate environment describer: %w", err)
 	}
+	o.endpointGetter = envDescriber
+	o.envParams = envDescriber
 
 	addonsSvc, err := addon.New(o.name)
 	if err != nil {
@@ -347,6 +351,13 @@ func (o *deployJobOpts) dfBuildArgs(job interface{}) (*dockerengine.BuildArgumen
 }
 
 func (o *deployJobOpts) deployJob() error {
+	mft, err := o.manifest()
+	if err != nil {
+		return err
+	}
+	if err := validateEFSOptionsOwner(o.envParams, o.name, o.envName, mft); err != nil {
+		return err
+	}
 	conf, err := o.stackConfiguration()
 	if err != nil {
 		return err
@@ -385,6 +396,14 @@ func (o *deployJobOpts) runtimeConfig() (*stack.RuntimeConfig, error) {
 	if err != nil {
 		return nil, err
 	}
+	mft, err := o.manifest()
+	if err != nil {
+		return nil, err
+	}
+	envAddonsOutputs, err := envAddonsOutputs(o.endpointGetter, mft)
+	if err != nil {
+		return nil, err
+	}
 	if !o.buildRequired {
 		return &stack.RuntimeConfig{
 			AddonsTemplateURL:        o.addonsURL,
@@ -392,6 +411,7 @@ func (o *deployJobOpts) runtimeConfig() (*stack.RuntimeConfig, error) {
 			ServiceDiscoveryEndpoint: endpoint,
 			AccountID:                o.targetEnvironment.AccountID,
 			Region:                   o.targetEnvironment.Region,
+			EnvAddonsOutputs:         envAddonsOutputs,
 		}, nil
 	}
 	if err := o.retrieveAppResourcesForEnvRegion(); err != nil {
@@ -416,6 +436,7 @@ func (o *deployJobOpts) runtimeConfig() (*stack.RuntimeConfig, error) {
 		ServiceDiscoveryEndpoint: endpoint,
 		AccountID:                o.targetEnvironment.AccountID,
 		Region:                   o.targetEnvironment.Region,
+		EnvAddonsOutputs:         envAddonsOutputs,
 	}, nil
 }
 
@@ -442,10 +463,78 @@ func (o *deployJobOpts) manifest() (interface{}, error) {
 	if err := envMft.Validate(); err != nil {
 		return nil, fmt.Errorf("validate manifest against environment %s: %s", o.envName, err)
 	}
+	if err := validateSharedEFSWorkloads(o.store, o.appName, envMft); err != nil {
+		return nil, err
+	}
+	if err := o.validateJobChain(envMft); err != nil {
+		return nil, err
+	}
 	o.appliedManifest = envMft // cache the results.
 	return envMft, nil
 }
 
+// validateJobChain returns an error if following "on.after" across the jobs in the workspace leads back to a job
+// that was already visited, since those jobs would trigger each other forever.
+func (o *deployJobOpts) validateJobChain(mft interface{}) error {
+	job, ok := mft.(*manifest.ScheduledJob)
+	if !ok || job.On.After == nil {
+		return nil
+	}
+	names, err := o.ws.ListJobs()
+	if err != nil {
+		return fmt.Errorf("list jobs in the workspace: %w", err)
+	}
+	inWorkspace := make(map[string]bool)
+	for _, name := range names {
+		inWorkspace[name] = true
+	}
+	chain := []string{o.name}
+	visited := map[string]bool{o.name: true}
+	next := aws.StringValue(job.On.After)
+	// Jobs outside of the workspace can't be inspected, so the walk stops there.
+	for inWorkspace[next] {
+		chain = append(chain, next)
+		if visited[next] {
+			return fmt.Errorf(`jobs trigger each other in a loop through "on.after": %s`, strings.Join(chain, " -> "))
+		}
+		visited[next] = true
+		after, err := o.jobRunsAfter(next)
+		if err != nil {
+			return err
+		}
+		if after == "" {
+			return nil
+		}
+		next = after
+	}
+	return nil
+}
+
+// jobRunsAfter returns the name of the job in "on.after" of the job's manifest for the target environment.
+func (o *deployJobOpts) jobRunsAfter(name string) (string, error) {
+	raw, err := o.ws.ReadWorkloadManifest(name)
+	if err != nil {
+		return "", fmt.Errorf("read job %s manifest: %w", name, err)
+	}
+	interpolated, err := o.newInterpolator(o.appName, o.envName).Interpolate(string(raw))
+	if err != nil {
+		return "", fmt.Errorf("interpolate environment variables for %s manifest: %w", name, err)
+	}
+	mft, err := o.unmarshal([]byte(interpolated))
+	if err != nil {
+		return "", fmt.Errorf("unmarshal job %s manifest: %w", name, err)
+	}
+	envMft, err := mft.ApplyEnv(o.envName)
+	if err != nil {
+		return "", fmt.Errorf("apply environment %s override to job %s: %w", o.envName, name, err)
+	}
+	job, ok := envMft.(*manifest.ScheduledJob)
+	if !ok {
+		return "", nil
+	}
+	return aws.StringValue(job.On.After), nil
+}
+
 // RecommendActions returns follow-up actions the user can take after successfully executing the command.
 func (o *deployJobOpts) RecommendActions() error {
 	return nil
@@ -9,6 +9,7 @@ import (
 	"path/filepath"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/copilot-cli/internal/pkg/addon"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
 	"github.com/aws/copilot-cli/internal/pkg/docker/dockerengine"
@@ -374,6 +375,100 @@ on:
 	}
 }
 
+func TestJobDeployOpts_validateJobChain(t *testing.T) {
+	jobAfter := func(name, after string) string {
+		return fmt.Sprintf(`name: %s
+type: 'Scheduled Job'
+image:
+  location: foo/bar
+on:
+  after: %s`, name, after)
+	}
+	jobOnSchedule := `name: extract
+type: 'Scheduled Job'
+image:
+  location: foo/bar
+on:
+  schedule: "@daily"`
+
+	testCases := map[string]struct {
+		inAfter    *string
+		setupMocks func(m deployJobMocks)
+
+		wantedError error
+	}{
+		"no op if the job doesn't run after another job": {
+			setupMocks: func(m deployJobMocks) {},
+		},
+		"no op if the previous job is not in the workspace": {
+			inAfter: aws.String("extract"),
+			setupMocks: func(m deployJobMocks) {
+				m.mockWs.EXPECT().ListJobs().Return([]string{"load"}, nil)
+			},
+		},
+		"error if jobs can't be listed": {
+			inAfter: aws.String("extract"),
+			setupMocks: func(m deployJobMocks) {
+				m.mockWs.EXPECT().ListJobs().Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("list jobs in the workspace: some error"),
+		},
+		"success if the chain ends with a scheduled job": {
+			inAfter: aws.String("transform"),
+			setupMocks: func(m deployJobMocks) {
+				m.mockWs.EXPECT().ListJobs().Return([]string{"extract", "transform", "load"}, nil)
+				m.mockWs.EXPECT().ReadWorkloadManifest("transform").Return([]byte(jobAfter("transform", "extract")), nil)
+				m.mockInterpolator.EXPECT().Interpolate(jobAfter("transform", "extract")).Return(jobAfter("transform", "extract"), nil)
+				m.mockWs.EXPECT().ReadWorkloadManifest("extract").Return([]byte(jobOnSchedule), nil)
+				m.mockInterpolator.EXPECT().Interpolate(jobOnSchedule).Return(jobOnSchedule, nil)
+			},
+		},
+		"error if the jobs run after each other": {
+			inAfter: aws.String("transform"),
+			setupMocks: func(m deployJobMocks) {
+				m.mockWs.EXPECT().ListJobs().Return([]string{"transform", "load"}, nil)
+				m.mockWs.EXPECT().ReadWorkloadManifest("transform").Return([]byte(jobAfter("transform", "load")), nil)
+				m.mockInterpolator.EXPECT().Interpolate(jobAfter("transform", "load")).Return(jobAfter("transform", "load"), nil)
+			},
+			wantedError: errors.New(`jobs trigger each other in a loop through "on.after": load -> transform -> load`),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := deployJobMocks{
+				mockWs:           mocks.NewMockwsJobDirReader(ctrl),
+				mockInterpolator: mocks.NewMockinterpolator(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := deployJobOpts{
+				deployWkldVars: deployWkldVars{
+					name:    "load",
+					envName: "test",
+				},
+				unmarshal: manifest.UnmarshalWorkload,
+				ws:        m.mockWs,
+				newInterpolator: func(app, env string) interpolator {
+					return m.mockInterpolator
+				},
+			}
+			mft := &manifest.ScheduledJob{}
+			mft.On.After = tc.inAfter
+
+			err := opts.validateJobChain(mft)
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestJobDeployOpts_pushToS3Bucket(t *testing.T) {
 	const (
 		mockJobName         = "mockJob"
@@ -16,6 +16,7 @@ import (
 	ec2 "github.com/aws/copilot-cli/internal/pkg/aws/ec2"
 	ecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
 	s3 "github.com/aws/copilot-cli/internal/pkg/aws/s3"
+	secretsmanager "github.com/aws/copilot-cli/internal/pkg/aws/secretsmanager"
 	ssm "github.com/aws/copilot-cli/internal/pkg/aws/ssm"
 	config "github.com/aws/copilot-cli/internal/pkg/config"
 	deploy "github.com/aws/copilot-cli/internal/pkg/deploy"
@@ -2152,6 +2153,58 @@ func (mr *MockwsFileDeleterMockRecorder) DeleteWorkspaceFile() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWorkspaceFile", reflect.TypeOf((*MockwsFileDeleter)(nil).DeleteWorkspaceFile))
 }
 
+// MockwsAppDeleter is a mock of wsAppDeleter interface.
+type MockwsAppDeleter struct {
+	ctrl     *gomock.Controller
+	recorder *MockwsAppDeleterMockRecorder
+}
+
+// MockwsAppDeleterMockRecorder is the mock recorder for MockwsAppDeleter.
+type MockwsAppDeleterMockRecorder struct {
+	mock *MockwsAppDeleter
+}
+
+// NewMockwsAppDeleter creates a new mock instance.
+func NewMockwsAppDeleter(ctrl *gomock.Controller) *MockwsAppDeleter {
+	mock := &MockwsAppDeleter{ctrl: ctrl}
+	mock.recorder = &MockwsAppDeleterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockwsAppDeleter) EXPECT() *MockwsAppDeleterMockRecorder {
+	return m.recorder
+}
+
+// DeleteWorkspaceFile mocks base method.
+func (m *MockwsAppDeleter) DeleteWorkspaceFile() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWorkspaceFile")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteWorkspaceFile indicates an expected call of DeleteWorkspaceFile.
+func (mr *MockwsAppDeleterMockRecorder) DeleteWorkspaceFile() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWorkspaceFile", reflect.TypeOf((*MockwsAppDeleter)(nil).DeleteWorkspaceFile))
+}
+
+// ListPipelines mocks base method.
+func (m *MockwsAppDeleter) ListPipelines() ([]workspace.PipelineManifest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPipelines")
+	ret0, _ := ret[0].([]workspace.PipelineManifest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPipelines indicates an expected call of ListPipelines.
+func (mr *MockwsAppDeleterMockRecorder) ListPipelines() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPipelines", reflect.TypeOf((*MockwsAppDeleter)(nil).ListPipelines))
+}
+
 // MockmanifestReader is a mock of manifestReader interface.
 type MockmanifestReader struct {
 	ctrl     *gomock.Controller
@@ -2252,18 +2305,94 @@ func (m *MockwsPipelineManifestReader) EXPECT() *MockwsPipelineManifestReaderMoc
 }
 
 // ReadPipelineManifest mocks base method.
-func (m *MockwsPipelineManifestReader) ReadPipelineManifest() ([]byte, error) {
+func (m *MockwsPipelineManifestReader) ReadPipelineManifest(path string) ([]byte, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ReadPipelineManifest")
+	ret := m.ctrl.Call(m, "ReadPipelineManifest", path)
 	ret0, _ := ret[0].([]byte)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ReadPipelineManifest indicates an expected call of ReadPipelineManifest.
-func (mr *MockwsPipelineManifestReaderMockRecorder) ReadPipelineManifest() *gomock.Call {
+func (mr *MockwsPipelineManifestReaderMockRecorder) ReadPipelineManifest(path interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadPipelineManifest", reflect.TypeOf((*MockwsPipelineManifestReader)(nil).ReadPipelineManifest), path)
+}
+
+// MockwsPipelineLister is a mock of wsPipelineLister interface.
+type MockwsPipelineLister struct {
+	ctrl     *gomock.Controller
+	recorder *MockwsPipelineListerMockRecorder
+}
+
+// MockwsPipelineListerMockRecorder is the mock recorder for MockwsPipelineLister.
+type MockwsPipelineListerMockRecorder struct {
+	mock *MockwsPipelineLister
+}
+
+// NewMockwsPipelineLister creates a new mock instance.
+func NewMockwsPipelineLister(ctrl *gomock.Controller) *MockwsPipelineLister {
+	mock := &MockwsPipelineLister{ctrl: ctrl}
+	mock.recorder = &MockwsPipelineListerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockwsPipelineLister) EXPECT() *MockwsPipelineListerMockRecorder {
+	return m.recorder
+}
+
+// ListPipelines mocks base method.
+func (m *MockwsPipelineLister) ListPipelines() ([]workspace.PipelineManifest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPipelines")
+	ret0, _ := ret[0].([]workspace.PipelineManifest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPipelines indicates an expected call of ListPipelines.
+func (mr *MockwsPipelineListerMockRecorder) ListPipelines() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPipelines", reflect.TypeOf((*MockwsPipelineLister)(nil).ListPipelines))
+}
+
+// MockwsCopilotDirReader is a mock of wsCopilotDirReader interface.
+type MockwsCopilotDirReader struct {
+	ctrl     *gomock.Controller
+	recorder *MockwsCopilotDirReaderMockRecorder
+}
+
+// MockwsCopilotDirReaderMockRecorder is the mock recorder for MockwsCopilotDirReader.
+type MockwsCopilotDirReaderMockRecorder struct {
+	mock *MockwsCopilotDirReader
+}
+
+// NewMockwsCopilotDirReader creates a new mock instance.
+func NewMockwsCopilotDirReader(ctrl *gomock.Controller) *MockwsCopilotDirReader {
+	mock := &MockwsCopilotDirReader{ctrl: ctrl}
+	mock.recorder = &MockwsCopilotDirReaderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockwsCopilotDirReader) EXPECT() *MockwsCopilotDirReaderMockRecorder {
+	return m.recorder
+}
+
+// ReadCopilotDir mocks base method.
+func (m *MockwsCopilotDirReader) ReadCopilotDir() ([]workspace.File, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadCopilotDir")
+	ret0, _ := ret[0].([]workspace.File)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadCopilotDir indicates an expected call of ReadCopilotDir.
+func (mr *MockwsCopilotDirReaderMockRecorder) ReadCopilotDir() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadPipelineManifest", reflect.TypeOf((*MockwsPipelineManifestReader)(nil).ReadPipelineManifest))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadCopilotDir", reflect.TypeOf((*MockwsCopilotDirReader)(nil).ReadCopilotDir))
 }
 
 // MockwsPipelineWriter is a mock of wsPipelineWriter interface.
@@ -2290,33 +2419,101 @@ func (m *MockwsPipelineWriter) EXPECT() *MockwsPipelineWriterMockRecorder {
 }
 
 // WritePipelineBuildspec mocks base method.
-func (m *MockwsPipelineWriter) WritePipelineBuildspec(marshaler encoding.BinaryMarshaler) (string, error) {
+func (m *MockwsPipelineWriter) WritePipelineBuildspec(marshaler encoding.BinaryMarshaler, name string) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "WritePipelineBuildspec", marshaler)
+	ret := m.ctrl.Call(m, "WritePipelineBuildspec", marshaler, name)
 	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // WritePipelineBuildspec indicates an expected call of WritePipelineBuildspec.
-func (mr *MockwsPipelineWriterMockRecorder) WritePipelineBuildspec(marshaler interface{}) *gomock.Call {
+func (mr *MockwsPipelineWriterMockRecorder) WritePipelineBuildspec(marshaler, name interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WritePipelineBuildspec", reflect.TypeOf((*MockwsPipelineWriter)(nil).WritePipelineBuildspec), marshaler)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WritePipelineBuildspec", reflect.TypeOf((*MockwsPipelineWriter)(nil).WritePipelineBuildspec), marshaler, name)
 }
 
 // WritePipelineManifest mocks base method.
-func (m *MockwsPipelineWriter) WritePipelineManifest(marshaler encoding.BinaryMarshaler) (string, error) {
+func (m *MockwsPipelineWriter) WritePipelineManifest(marshaler encoding.BinaryMarshaler, name string) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "WritePipelineManifest", marshaler)
+	ret := m.ctrl.Call(m, "WritePipelineManifest", marshaler, name)
 	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // WritePipelineManifest indicates an expected call of WritePipelineManifest.
-func (mr *MockwsPipelineWriterMockRecorder) WritePipelineManifest(marshaler interface{}) *gomock.Call {
+func (mr *MockwsPipelineWriterMockRecorder) WritePipelineManifest(marshaler, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WritePipelineManifest", reflect.TypeOf((*MockwsPipelineWriter)(nil).WritePipelineManifest), marshaler, name)
+}
+
+// MockwsPipelineIniter is a mock of wsPipelineIniter interface.
+type MockwsPipelineIniter struct {
+	ctrl     *gomock.Controller
+	recorder *MockwsPipelineIniterMockRecorder
+}
+
+// MockwsPipelineIniterMockRecorder is the mock recorder for MockwsPipelineIniter.
+type MockwsPipelineIniterMockRecorder struct {
+	mock *MockwsPipelineIniter
+}
+
+// NewMockwsPipelineIniter creates a new mock instance.
+func NewMockwsPipelineIniter(ctrl *gomock.Controller) *MockwsPipelineIniter {
+	mock := &MockwsPipelineIniter{ctrl: ctrl}
+	mock.recorder = &MockwsPipelineIniterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockwsPipelineIniter) EXPECT() *MockwsPipelineIniterMockRecorder {
+	return m.recorder
+}
+
+// ListPipelines mocks base method.
+func (m *MockwsPipelineIniter) ListPipelines() ([]workspace.PipelineManifest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPipelines")
+	ret0, _ := ret[0].([]workspace.PipelineManifest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPipelines indicates an expected call of ListPipelines.
+func (mr *MockwsPipelineIniterMockRecorder) ListPipelines() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WritePipelineManifest", reflect.TypeOf((*MockwsPipelineWriter)(nil).WritePipelineManifest), marshaler)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPipelines", reflect.TypeOf((*MockwsPipelineIniter)(nil).ListPipelines))
+}
+
+// WritePipelineBuildspec mocks base method.
+func (m *MockwsPipelineIniter) WritePipelineBuildspec(marshaler encoding.BinaryMarshaler, name string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WritePipelineBuildspec", marshaler, name)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WritePipelineBuildspec indicates an expected call of WritePipelineBuildspec.
+func (mr *MockwsPipelineIniterMockRecorder) WritePipelineBuildspec(marshaler, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WritePipelineBuildspec", reflect.TypeOf((*MockwsPipelineIniter)(nil).WritePipelineBuildspec), marshaler, name)
+}
+
+// WritePipelineManifest mocks base method.
+func (m *MockwsPipelineIniter) WritePipelineManifest(marshaler encoding.BinaryMarshaler, name string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WritePipelineManifest", marshaler, name)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WritePipelineManifest indicates an expected call of WritePipelineManifest.
+func (mr *MockwsPipelineIniterMockRecorder) WritePipelineManifest(marshaler, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WritePipelineManifest", reflect.TypeOf((*MockwsPipelineIniter)(nil).WritePipelineManifest), marshaler, name)
 }
 
 // MockserviceLister is a mock of serviceLister interface.
@@ -2826,6 +3023,21 @@ func (m *MockwsPipelineReader) EXPECT() *MockwsPipelineReaderMockRecorder {
 	return m.recorder
 }
 
+// ListPipelines mocks base method.
+func (m *MockwsPipelineReader) ListPipelines() ([]workspace.PipelineManifest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPipelines")
+	ret0, _ := ret[0].([]workspace.PipelineManifest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPipelines indicates an expected call of ListPipelines.
+func (mr *MockwsPipelineReaderMockRecorder) ListPipelines() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPipelines", reflect.TypeOf((*MockwsPipelineReader)(nil).ListPipelines))
+}
+
 // ListWorkloads mocks base method.
 func (m *MockwsPipelineReader) ListWorkloads() ([]string, error) {
 	m.ctrl.T.Helper()
@@ -2841,19 +3053,64 @@ func (mr *MockwsPipelineReaderMockRecorder) ListWorkloads() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWorkloads", reflect.TypeOf((*MockwsPipelineReader)(nil).ListWorkloads))
 }
 
+// Path mocks base method.
+func (m *MockwsPipelineReader) Path() (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Path")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Path indicates an expected call of Path.
+func (mr *MockwsPipelineReaderMockRecorder) Path() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Path", reflect.TypeOf((*MockwsPipelineReader)(nil).Path))
+}
+
+// ReadCopilotDir mocks base method.
+func (m *MockwsPipelineReader) ReadCopilotDir() ([]workspace.File, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadCopilotDir")
+	ret0, _ := ret[0].([]workspace.File)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadCopilotDir indicates an expected call of ReadCopilotDir.
+func (mr *MockwsPipelineReaderMockRecorder) ReadCopilotDir() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadCopilotDir", reflect.TypeOf((*MockwsPipelineReader)(nil).ReadCopilotDir))
+}
+
 // ReadPipelineManifest mocks base method.
-func (m *MockwsPipelineReader) ReadPipelineManifest() ([]byte, error) {
+func (m *MockwsPipelineReader) ReadPipelineManifest(path string) ([]byte, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ReadPipelineManifest")
+	ret := m.ctrl.Call(m, "ReadPipelineManifest", path)
 	ret0, _ := ret[0].([]byte)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ReadPipelineManifest indicates an expected call of ReadPipelineManifest.
-func (mr *MockwsPipelineReaderMockRecorder) ReadPipelineManifest() *gomock.Call {
+func (mr *MockwsPipelineReaderMockRecorder) ReadPipelineManifest(path interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadPipelineManifest", reflect.TypeOf((*MockwsPipelineReader)(nil).ReadPipelineManifest))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadPipelineManifest", reflect.TypeOf((*MockwsPipelineReader)(nil).ReadPipelineManifest), path)
+}
+
+// ReadWorkloadManifest mocks base method.
+func (m *MockwsPipelineReader) ReadWorkloadManifest(name string) (workspace.WorkloadManifest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadWorkloadManifest", name)
+	ret0, _ := ret[0].(workspace.WorkloadManifest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadWorkloadManifest indicates an expected call of ReadWorkloadManifest.
+func (mr *MockwsPipelineReaderMockRecorder) ReadWorkloadManifest(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadWorkloadManifest", reflect.TypeOf((*MockwsPipelineReader)(nil).ReadWorkloadManifest), name)
 }
 
 // MockwsAppManager is a mock of wsAppManager interface.
@@ -2976,6 +3233,157 @@ func (mr *MockwsAddonManagerMockRecorder) WriteAddon(f, svc, name interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteAddon", reflect.TypeOf((*MockwsAddonManager)(nil).WriteAddon), f, svc, name)
 }
 
+// WriteEnvironmentAddon mocks base method.
+func (m *MockwsAddonManager) WriteEnvironmentAddon(f encoding.BinaryMarshaler, name string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WriteEnvironmentAddon", f, name)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WriteEnvironmentAddon indicates an expected call of WriteEnvironmentAddon.
+func (mr *MockwsAddonManagerMockRecorder) WriteEnvironmentAddon(f, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteEnvironmentAddon", reflect.TypeOf((*MockwsAddonManager)(nil).WriteEnvironmentAddon), f, name)
+}
+
+// MockwsStorageReader is a mock of wsStorageReader interface.
+type MockwsStorageReader struct {
+	ctrl     *gomock.Controller
+	recorder *MockwsStorageReaderMockRecorder
+}
+
+// MockwsStorageReaderMockRecorder is the mock recorder for MockwsStorageReader.
+type MockwsStorageReaderMockRecorder struct {
+	mock *MockwsStorageReader
+}
+
+// NewMockwsStorageReader creates a new mock instance.
+func NewMockwsStorageReader(ctrl *gomock.Controller) *MockwsStorageReader {
+	mock := &MockwsStorageReader{ctrl: ctrl}
+	mock.recorder = &MockwsStorageReaderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockwsStorageReader) EXPECT() *MockwsStorageReaderMockRecorder {
+	return m.recorder
+}
+
+// ListWorkloads mocks base method.
+func (m *MockwsStorageReader) ListWorkloads() ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWorkloads")
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWorkloads indicates an expected call of ListWorkloads.
+func (mr *MockwsStorageReaderMockRecorder) ListWorkloads() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWorkloads", reflect.TypeOf((*MockwsStorageReader)(nil).ListWorkloads))
+}
+
+// ReadAddon mocks base method.
+func (m *MockwsStorageReader) ReadAddon(wlName, fname string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadAddon", wlName, fname)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadAddon indicates an expected call of ReadAddon.
+func (mr *MockwsStorageReaderMockRecorder) ReadAddon(wlName, fname interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadAddon", reflect.TypeOf((*MockwsStorageReader)(nil).ReadAddon), wlName, fname)
+}
+
+// ReadAddonsDir mocks base method.
+func (m *MockwsStorageReader) ReadAddonsDir(wlName string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadAddonsDir", wlName)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadAddonsDir indicates an expected call of ReadAddonsDir.
+func (mr *MockwsStorageReaderMockRecorder) ReadAddonsDir(wlName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadAddonsDir", reflect.TypeOf((*MockwsStorageReader)(nil).ReadAddonsDir), wlName)
+}
+
+// ReadEnvironmentAddon mocks base method.
+func (m *MockwsStorageReader) ReadEnvironmentAddon(fname string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadEnvironmentAddon", fname)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadEnvironmentAddon indicates an expected call of ReadEnvironmentAddon.
+func (mr *MockwsStorageReaderMockRecorder) ReadEnvironmentAddon(fname interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadEnvironmentAddon", reflect.TypeOf((*MockwsStorageReader)(nil).ReadEnvironmentAddon), fname)
+}
+
+// ReadEnvironmentAddonsDir mocks base method.
+func (m *MockwsStorageReader) ReadEnvironmentAddonsDir() ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadEnvironmentAddonsDir")
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadEnvironmentAddonsDir indicates an expected call of ReadEnvironmentAddonsDir.
+func (mr *MockwsStorageReaderMockRecorder) ReadEnvironmentAddonsDir() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadEnvironmentAddonsDir", reflect.TypeOf((*MockwsStorageReader)(nil).ReadEnvironmentAddonsDir))
+}
+
+// MockstackResourcesLister is a mock of stackResourcesLister interface.
+type MockstackResourcesLister struct {
+	ctrl     *gomock.Controller
+	recorder *MockstackResourcesListerMockRecorder
+}
+
+// MockstackResourcesListerMockRecorder is the mock recorder for MockstackResourcesLister.
+type MockstackResourcesListerMockRecorder struct {
+	mock *MockstackResourcesLister
+}
+
+// NewMockstackResourcesLister creates a new mock instance.
+func NewMockstackResourcesLister(ctrl *gomock.Controller) *MockstackResourcesLister {
+	mock := &MockstackResourcesLister{ctrl: ctrl}
+	mock.recorder = &MockstackResourcesListerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockstackResourcesLister) EXPECT() *MockstackResourcesListerMockRecorder {
+	return m.recorder
+}
+
+// StackResources mocks base method.
+func (m *MockstackResourcesLister) StackResources(name string) ([]*cloudformation.StackResource, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StackResources", name)
+	ret0, _ := ret[0].([]*cloudformation.StackResource)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StackResources indicates an expected call of StackResources.
+func (mr *MockstackResourcesListerMockRecorder) StackResources(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StackResources", reflect.TypeOf((*MockstackResourcesLister)(nil).StackResources), name)
+}
+
 // Mockuploader is a mock of uploader interface.
 type Mockuploader struct {
 	ctrl     *gomock.Controller
@@ -3419,6 +3827,20 @@ func (mr *MockpipelineDeployerMockRecorder) AddPipelineResourcesToApp(app, regio
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddPipelineResourcesToApp", reflect.TypeOf((*MockpipelineDeployer)(nil).AddPipelineResourcesToApp), app, region)
 }
 
+// AddPipelineStagesToApp mocks base method.
+func (m *MockpipelineDeployer) AddPipelineStagesToApp(app *config.Application, stages []deploy.PipelineStage) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddPipelineStagesToApp", app, stages)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddPipelineStagesToApp indicates an expected call of AddPipelineStagesToApp.
+func (mr *MockpipelineDeployerMockRecorder) AddPipelineStagesToApp(app, stages interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddPipelineStagesToApp", reflect.TypeOf((*MockpipelineDeployer)(nil).AddPipelineStagesToApp), app, stages)
+}
+
 // CreatePipeline mocks base method.
 func (m *MockpipelineDeployer) CreatePipeline(env *deploy.CreatePipelineInput, bucketName string) error {
 	m.ctrl.T.Helper()
@@ -3901,6 +4323,20 @@ func (mr *MockdeployerMockRecorder) AddPipelineResourcesToApp(app, region interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddPipelineResourcesToApp", reflect.TypeOf((*Mockdeployer)(nil).AddPipelineResourcesToApp), app, region)
 }
 
+// AddPipelineStagesToApp mocks base method.
+func (m *Mockdeployer) AddPipelineStagesToApp(app *config.Application, stages []deploy.PipelineStage) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddPipelineStagesToApp", app, stages)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddPipelineStagesToApp indicates an expected call of AddPipelineStagesToApp.
+func (mr *MockdeployerMockRecorder) AddPipelineStagesToApp(app, stages interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddPipelineStagesToApp", reflect.TypeOf((*Mockdeployer)(nil).AddPipelineStagesToApp), app, stages)
+}
+
 // AddServiceToApp mocks base method.
 func (m *Mockdeployer) AddServiceToApp(app *config.Application, svcName string) error {
 	m.ctrl.T.Helper()
@@ -4373,6 +4809,44 @@ func (mr *MockversionGetterMockRecorder) Version() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Version", reflect.TypeOf((*MockversionGetter)(nil).Version))
 }
 
+// MockenvParamsGetter is a mock of envParamsGetter interface.
+type MockenvParamsGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockenvParamsGetterMockRecorder
+}
+
+// MockenvParamsGetterMockRecorder is the mock recorder for MockenvParamsGetter.
+type MockenvParamsGetterMockRecorder struct {
+	mock *MockenvParamsGetter
+}
+
+// NewMockenvParamsGetter creates a new mock instance.
+func NewMockenvParamsGetter(ctrl *gomock.Controller) *MockenvParamsGetter {
+	mock := &MockenvParamsGetter{ctrl: ctrl}
+	mock.recorder = &MockenvParamsGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockenvParamsGetter) EXPECT() *MockenvParamsGetterMockRecorder {
+	return m.recorder
+}
+
+// Params mocks base method.
+func (m *MockenvParamsGetter) Params() (map[string]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Params")
+	ret0, _ := ret[0].(map[string]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Params indicates an expected call of Params.
+func (mr *MockenvParamsGetterMockRecorder) Params() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Params", reflect.TypeOf((*MockenvParamsGetter)(nil).Params))
+}
+
 // MockendpointGetter is a mock of endpointGetter interface.
 type MockendpointGetter struct {
 	ctrl     *gomock.Controller
@@ -4396,6 +4870,21 @@ func (m *MockendpointGetter) EXPECT() *MockendpointGetterMockRecorder {
 	return m.recorder
 }
 
+// AddonsOutputs mocks base method.
+func (m *MockendpointGetter) AddonsOutputs() ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddonsOutputs")
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddonsOutputs indicates an expected call of AddonsOutputs.
+func (mr *MockendpointGetterMockRecorder) AddonsOutputs() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddonsOutputs", reflect.TypeOf((*MockendpointGetter)(nil).AddonsOutputs))
+}
+
 // ServiceDiscoveryEndpoint mocks base method.
 func (m *MockendpointGetter) ServiceDiscoveryEndpoint() (string, error) {
 	m.ctrl.T.Helper()
@@ -4543,6 +5032,43 @@ func (mr *MocklegacyEnvUpgraderMockRecorder) UpgradeLegacyEnvironment(in interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpgradeLegacyEnvironment", reflect.TypeOf((*MocklegacyEnvUpgrader)(nil).UpgradeLegacyEnvironment), varargs...)
 }
 
+// MockenvAddonsDeployer is a mock of envAddonsDeployer interface.
+type MockenvAddonsDeployer struct {
+	ctrl     *gomock.Controller
+	recorder *MockenvAddonsDeployerMockRecorder
+}
+
+// MockenvAddonsDeployerMockRecorder is the mock recorder for MockenvAddonsDeployer.
+type MockenvAddonsDeployerMockRecorder struct {
+	mock *MockenvAddonsDeployer
+}
+
+// NewMockenvAddonsDeployer creates a new mock instance.
+func NewMockenvAddonsDeployer(ctrl *gomock.Controller) *MockenvAddonsDeployer {
+	mock := &MockenvAddonsDeployer{ctrl: ctrl}
+	mock.recorder = &MockenvAddonsDeployerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockenvAddonsDeployer) EXPECT() *MockenvAddonsDeployerMockRecorder {
+	return m.recorder
+}
+
+// DeployEnvironmentAddons mocks base method.
+func (m *MockenvAddonsDeployer) DeployEnvironmentAddons(in *deploy.CreateEnvironmentInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeployEnvironmentAddons", in)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeployEnvironmentAddons indicates an expected call of DeployEnvironmentAddons.
+func (mr *MockenvAddonsDeployerMockRecorder) DeployEnvironmentAddons(in interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployEnvironmentAddons", reflect.TypeOf((*MockenvAddonsDeployer)(nil).DeployEnvironmentAddons), in)
+}
+
 // MockenvTemplateUpgrader is a mock of envTemplateUpgrader interface.
 type MockenvTemplateUpgrader struct {
 	ctrl     *gomock.Controller
@@ -4654,69 +5180,265 @@ func (mr *MockappUpgraderMockRecorder) UpgradeApplication(in interface{}) *gomoc
 // MockpipelineGetter is a mock of pipelineGetter interface.
 type MockpipelineGetter struct {
 	ctrl     *gomock.Controller
-	recorder *MockpipelineGetterMockRecorder
+	recorder *MockpipelineGetterMockRecorder
+}
+
+// MockpipelineGetterMockRecorder is the mock recorder for MockpipelineGetter.
+type MockpipelineGetterMockRecorder struct {
+	mock *MockpipelineGetter
+}
+
+// NewMockpipelineGetter creates a new mock instance.
+func NewMockpipelineGetter(ctrl *gomock.Controller) *MockpipelineGetter {
+	mock := &MockpipelineGetter{ctrl: ctrl}
+	mock.recorder = &MockpipelineGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockpipelineGetter) EXPECT() *MockpipelineGetterMockRecorder {
+	return m.recorder
+}
+
+// GetPipeline mocks base method.
+func (m *MockpipelineGetter) GetPipeline(pipelineName string) (*codepipeline.Pipeline, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPipeline", pipelineName)
+	ret0, _ := ret[0].(*codepipeline.Pipeline)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPipeline indicates an expected call of GetPipeline.
+func (mr *MockpipelineGetterMockRecorder) GetPipeline(pipelineName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPipeline", reflect.TypeOf((*MockpipelineGetter)(nil).GetPipeline), pipelineName)
+}
+
+// GetPipelinesByTags mocks base method.
+func (m *MockpipelineGetter) GetPipelinesByTags(tags map[string]string) ([]*codepipeline.Pipeline, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPipelinesByTags", tags)
+	ret0, _ := ret[0].([]*codepipeline.Pipeline)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPipelinesByTags indicates an expected call of GetPipelinesByTags.
+func (mr *MockpipelineGetterMockRecorder) GetPipelinesByTags(tags interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPipelinesByTags", reflect.TypeOf((*MockpipelineGetter)(nil).GetPipelinesByTags), tags)
+}
+
+// ListPipelineNamesByTags mocks base method.
+func (m *MockpipelineGetter) ListPipelineNamesByTags(tags map[string]string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPipelineNamesByTags", tags)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPipelineNamesByTags indicates an expected call of ListPipelineNamesByTags.
+func (mr *MockpipelineGetterMockRecorder) ListPipelineNamesByTags(tags interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPipelineNamesByTags", reflect.TypeOf((*MockpipelineGetter)(nil).ListPipelineNamesByTags), tags)
+}
+
+// MockpipelineExecutionGetter is a mock of pipelineExecutionGetter interface.
+type MockpipelineExecutionGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockpipelineExecutionGetterMockRecorder
+}
+
+// MockpipelineExecutionGetterMockRecorder is the mock recorder for MockpipelineExecutionGetter.
+type MockpipelineExecutionGetterMockRecorder struct {
+	mock *MockpipelineExecutionGetter
+}
+
+// NewMockpipelineExecutionGetter creates a new mock instance.
+func NewMockpipelineExecutionGetter(ctrl *gomock.Controller) *MockpipelineExecutionGetter {
+	mock := &MockpipelineExecutionGetter{ctrl: ctrl}
+	mock.recorder = &MockpipelineExecutionGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockpipelineExecutionGetter) EXPECT() *MockpipelineExecutionGetterMockRecorder {
+	return m.recorder
+}
+
+// LatestPipelineExecution mocks base method.
+func (m *MockpipelineExecutionGetter) LatestPipelineExecution(pipelineName string) (*codepipeline.PipelineExecution, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LatestPipelineExecution", pipelineName)
+	ret0, _ := ret[0].(*codepipeline.PipelineExecution)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LatestPipelineExecution indicates an expected call of LatestPipelineExecution.
+func (mr *MockpipelineExecutionGetterMockRecorder) LatestPipelineExecution(pipelineName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LatestPipelineExecution", reflect.TypeOf((*MockpipelineExecutionGetter)(nil).LatestPipelineExecution), pipelineName)
+}
+
+// PipelineExecution mocks base method.
+func (m *MockpipelineExecutionGetter) PipelineExecution(pipelineName, executionID string) (*codepipeline.PipelineExecution, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PipelineExecution", pipelineName, executionID)
+	ret0, _ := ret[0].(*codepipeline.PipelineExecution)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PipelineExecution indicates an expected call of PipelineExecution.
+func (mr *MockpipelineExecutionGetterMockRecorder) PipelineExecution(pipelineName, executionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PipelineExecution", reflect.TypeOf((*MockpipelineExecutionGetter)(nil).PipelineExecution), pipelineName, executionID)
+}
+
+// MockpipelineApprover is a mock of pipelineApprover interface.
+type MockpipelineApprover struct {
+	ctrl     *gomock.Controller
+	recorder *MockpipelineApproverMockRecorder
+}
+
+// MockpipelineApproverMockRecorder is the mock recorder for MockpipelineApprover.
+type MockpipelineApproverMockRecorder struct {
+	mock *MockpipelineApprover
+}
+
+// NewMockpipelineApprover creates a new mock instance.
+func NewMockpipelineApprover(ctrl *gomock.Controller) *MockpipelineApprover {
+	mock := &MockpipelineApprover{ctrl: ctrl}
+	mock.recorder = &MockpipelineApproverMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockpipelineApprover) EXPECT() *MockpipelineApproverMockRecorder {
+	return m.recorder
+}
+
+// PendingApprovals mocks base method.
+func (m *MockpipelineApprover) PendingApprovals(pipelineName string) ([]codepipeline.PendingApproval, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PendingApprovals", pipelineName)
+	ret0, _ := ret[0].([]codepipeline.PendingApproval)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PendingApprovals indicates an expected call of PendingApprovals.
+func (mr *MockpipelineApproverMockRecorder) PendingApprovals(pipelineName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PendingApprovals", reflect.TypeOf((*MockpipelineApprover)(nil).PendingApprovals), pipelineName)
+}
+
+// PutApprovalResult mocks base method.
+func (m *MockpipelineApprover) PutApprovalResult(pipelineName string, approval codepipeline.PendingApproval, approved bool, comment string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutApprovalResult", pipelineName, approval, approved, comment)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PutApprovalResult indicates an expected call of PutApprovalResult.
+func (mr *MockpipelineApproverMockRecorder) PutApprovalResult(pipelineName, approval, approved, comment interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutApprovalResult", reflect.TypeOf((*MockpipelineApprover)(nil).PutApprovalResult), pipelineName, approval, approved, comment)
+}
+
+// MockpipelineRetrier is a mock of pipelineRetrier interface.
+type MockpipelineRetrier struct {
+	ctrl     *gomock.Controller
+	recorder *MockpipelineRetrierMockRecorder
 }
 
-// MockpipelineGetterMockRecorder is the mock recorder for MockpipelineGetter.
-type MockpipelineGetterMockRecorder struct {
-	mock *MockpipelineGetter
+// MockpipelineRetrierMockRecorder is the mock recorder for MockpipelineRetrier.
+type MockpipelineRetrierMockRecorder struct {
+	mock *MockpipelineRetrier
 }
 
-// NewMockpipelineGetter creates a new mock instance.
-func NewMockpipelineGetter(ctrl *gomock.Controller) *MockpipelineGetter {
-	mock := &MockpipelineGetter{ctrl: ctrl}
-	mock.recorder = &MockpipelineGetterMockRecorder{mock}
+// NewMockpipelineRetrier creates a new mock instance.
+func NewMockpipelineRetrier(ctrl *gomock.Controller) *MockpipelineRetrier {
+	mock := &MockpipelineRetrier{ctrl: ctrl}
+	mock.recorder = &MockpipelineRetrierMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockpipelineGetter) EXPECT() *MockpipelineGetterMockRecorder {
+func (m *MockpipelineRetrier) EXPECT() *MockpipelineRetrierMockRecorder {
 	return m.recorder
 }
 
-// GetPipeline mocks base method.
-func (m *MockpipelineGetter) GetPipeline(pipelineName string) (*codepipeline.Pipeline, error) {
+// GetPipelineState mocks base method.
+func (m *MockpipelineRetrier) GetPipelineState(pipelineName string) (*codepipeline.PipelineState, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetPipeline", pipelineName)
-	ret0, _ := ret[0].(*codepipeline.Pipeline)
+	ret := m.ctrl.Call(m, "GetPipelineState", pipelineName)
+	ret0, _ := ret[0].(*codepipeline.PipelineState)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetPipeline indicates an expected call of GetPipeline.
-func (mr *MockpipelineGetterMockRecorder) GetPipeline(pipelineName interface{}) *gomock.Call {
+// GetPipelineState indicates an expected call of GetPipelineState.
+func (mr *MockpipelineRetrierMockRecorder) GetPipelineState(pipelineName interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPipeline", reflect.TypeOf((*MockpipelineGetter)(nil).GetPipeline), pipelineName)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPipelineState", reflect.TypeOf((*MockpipelineRetrier)(nil).GetPipelineState), pipelineName)
 }
 
-// GetPipelinesByTags mocks base method.
-func (m *MockpipelineGetter) GetPipelinesByTags(tags map[string]string) ([]*codepipeline.Pipeline, error) {
+// RetryFailedActions mocks base method.
+func (m *MockpipelineRetrier) RetryFailedActions(pipelineName, stageName string) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetPipelinesByTags", tags)
-	ret0, _ := ret[0].([]*codepipeline.Pipeline)
+	ret := m.ctrl.Call(m, "RetryFailedActions", pipelineName, stageName)
+	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetPipelinesByTags indicates an expected call of GetPipelinesByTags.
-func (mr *MockpipelineGetterMockRecorder) GetPipelinesByTags(tags interface{}) *gomock.Call {
+// RetryFailedActions indicates an expected call of RetryFailedActions.
+func (mr *MockpipelineRetrierMockRecorder) RetryFailedActions(pipelineName, stageName interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPipelinesByTags", reflect.TypeOf((*MockpipelineGetter)(nil).GetPipelinesByTags), tags)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetryFailedActions", reflect.TypeOf((*MockpipelineRetrier)(nil).RetryFailedActions), pipelineName, stageName)
 }
 
-// ListPipelineNamesByTags mocks base method.
-func (m *MockpipelineGetter) ListPipelineNamesByTags(tags map[string]string) ([]string, error) {
+// MockpipelineReleaser is a mock of pipelineReleaser interface.
+type MockpipelineReleaser struct {
+	ctrl     *gomock.Controller
+	recorder *MockpipelineReleaserMockRecorder
+}
+
+// MockpipelineReleaserMockRecorder is the mock recorder for MockpipelineReleaser.
+type MockpipelineReleaserMockRecorder struct {
+	mock *MockpipelineReleaser
+}
+
+// NewMockpipelineReleaser creates a new mock instance.
+func NewMockpipelineReleaser(ctrl *gomock.Controller) *MockpipelineReleaser {
+	mock := &MockpipelineReleaser{ctrl: ctrl}
+	mock.recorder = &MockpipelineReleaserMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockpipelineReleaser) EXPECT() *MockpipelineReleaserMockRecorder {
+	return m.recorder
+}
+
+// StartExecution mocks base method.
+func (m *MockpipelineReleaser) StartExecution(pipelineName string) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListPipelineNamesByTags", tags)
-	ret0, _ := ret[0].([]string)
+	ret := m.ctrl.Call(m, "StartExecution", pipelineName)
+	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListPipelineNamesByTags indicates an expected call of ListPipelineNamesByTags.
-func (mr *MockpipelineGetterMockRecorder) ListPipelineNamesByTags(tags interface{}) *gomock.Call {
+// StartExecution indicates an expected call of StartExecution.
+func (mr *MockpipelineReleaserMockRecorder) StartExecution(pipelineName interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPipelineNamesByTags", reflect.TypeOf((*MockpipelineGetter)(nil).ListPipelineNamesByTags), tags)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartExecution", reflect.TypeOf((*MockpipelineReleaser)(nil).StartExecution), pipelineName)
 }
 
 // Mockexecutor is a mock of executor interface.
@@ -5926,6 +6648,43 @@ func (mr *MockecsCommandExecutorMockRecorder) ExecuteCommand(in interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecuteCommand", reflect.TypeOf((*MockecsCommandExecutor)(nil).ExecuteCommand), in)
 }
 
+// MockssmPortForwarder is a mock of ssmPortForwarder interface.
+type MockssmPortForwarder struct {
+	ctrl     *gomock.Controller
+	recorder *MockssmPortForwarderMockRecorder
+}
+
+// MockssmPortForwarderMockRecorder is the mock recorder for MockssmPortForwarder.
+type MockssmPortForwarderMockRecorder struct {
+	mock *MockssmPortForwarder
+}
+
+// NewMockssmPortForwarder creates a new mock instance.
+func NewMockssmPortForwarder(ctrl *gomock.Controller) *MockssmPortForwarder {
+	mock := &MockssmPortForwarder{ctrl: ctrl}
+	mock.recorder = &MockssmPortForwarderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockssmPortForwarder) EXPECT() *MockssmPortForwarderMockRecorder {
+	return m.recorder
+}
+
+// StartPortForwardingSession mocks base method.
+func (m *MockssmPortForwarder) StartPortForwardingSession(in ssm.PortForwardingSessionInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartPortForwardingSession", in)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StartPortForwardingSession indicates an expected call of StartPortForwardingSession.
+func (mr *MockssmPortForwarderMockRecorder) StartPortForwardingSession(in interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartPortForwardingSession", reflect.TypeOf((*MockssmPortForwarder)(nil).StartPortForwardingSession), in)
+}
+
 // MockssmPluginManager is a mock of ssmPluginManager interface.
 type MockssmPluginManager struct {
 	ctrl     *gomock.Controller
@@ -6393,6 +7152,59 @@ func (mr *MockpublicIPGetterMockRecorder) PublicIP(ENI interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublicIP", reflect.TypeOf((*MockpublicIPGetter)(nil).PublicIP), ENI)
 }
 
+// MocktasksDescriber is a mock of tasksDescriber interface.
+type MocktasksDescriber struct {
+	ctrl     *gomock.Controller
+	recorder *MocktasksDescriberMockRecorder
+}
+
+// MocktasksDescriberMockRecorder is the mock recorder for MocktasksDescriber.
+type MocktasksDescriberMockRecorder struct {
+	mock *MocktasksDescriber
+}
+
+// NewMocktasksDescriber creates a new mock instance.
+func NewMocktasksDescriber(ctrl *gomock.Controller) *MocktasksDescriber {
+	mock := &MocktasksDescriber{ctrl: ctrl}
+	mock.recorder = &MocktasksDescriberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocktasksDescriber) EXPECT() *MocktasksDescriberMockRecorder {
+	return m.recorder
+}
+
+// DescribeTasks mocks base method.
+func (m *MocktasksDescriber) DescribeTasks(cluster string, taskARNs []string) ([]*ecs.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeTasks", cluster, taskARNs)
+	ret0, _ := ret[0].([]*ecs.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeTasks indicates an expected call of DescribeTasks.
+func (mr *MocktasksDescriberMockRecorder) DescribeTasks(cluster, taskARNs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeTasks", reflect.TypeOf((*MocktasksDescriber)(nil).DescribeTasks), cluster, taskARNs)
+}
+
+// TaskDefinition mocks base method.
+func (m *MocktasksDescriber) TaskDefinition(taskDefName string) (*ecs.TaskDefinition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TaskDefinition", taskDefName)
+	ret0, _ := ret[0].(*ecs.TaskDefinition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TaskDefinition indicates an expected call of TaskDefinition.
+func (mr *MocktasksDescriberMockRecorder) TaskDefinition(taskDefName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TaskDefinition", reflect.TypeOf((*MocktasksDescriber)(nil).TaskDefinition), taskDefName)
+}
+
 // MockcliStringer is a mock of cliStringer interface.
 type MockcliStringer struct {
 	ctrl     *gomock.Controller
@@ -6468,6 +7280,293 @@ func (mr *MocksecretPutterMockRecorder) PutSecret(in interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutSecret", reflect.TypeOf((*MocksecretPutter)(nil).PutSecret), in)
 }
 
+// MocksecretsManagerSecretPutter is a mock of secretsManagerSecretPutter interface.
+type MocksecretsManagerSecretPutter struct {
+	ctrl     *gomock.Controller
+	recorder *MocksecretsManagerSecretPutterMockRecorder
+}
+
+// MocksecretsManagerSecretPutterMockRecorder is the mock recorder for MocksecretsManagerSecretPutter.
+type MocksecretsManagerSecretPutterMockRecorder struct {
+	mock *MocksecretsManagerSecretPutter
+}
+
+// NewMocksecretsManagerSecretPutter creates a new mock instance.
+func NewMocksecretsManagerSecretPutter(ctrl *gomock.Controller) *MocksecretsManagerSecretPutter {
+	mock := &MocksecretsManagerSecretPutter{ctrl: ctrl}
+	mock.recorder = &MocksecretsManagerSecretPutterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocksecretsManagerSecretPutter) EXPECT() *MocksecretsManagerSecretPutterMockRecorder {
+	return m.recorder
+}
+
+// PutSecret mocks base method.
+func (m *MocksecretsManagerSecretPutter) PutSecret(in secretsmanager.PutSecretInput) (*secretsmanager.PutSecretOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutSecret", in)
+	ret0, _ := ret[0].(*secretsmanager.PutSecretOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutSecret indicates an expected call of PutSecret.
+func (mr *MocksecretsManagerSecretPutterMockRecorder) PutSecret(in interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutSecret", reflect.TypeOf((*MocksecretsManagerSecretPutter)(nil).PutSecret), in)
+}
+
+// RotateSecret mocks base method.
+func (m *MocksecretsManagerSecretPutter) RotateSecret(in secretsmanager.RotateSecretInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RotateSecret", in)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RotateSecret indicates an expected call of RotateSecret.
+func (mr *MocksecretsManagerSecretPutterMockRecorder) RotateSecret(in interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RotateSecret", reflect.TypeOf((*MocksecretsManagerSecretPutter)(nil).RotateSecret), in)
+}
+
+// MocksecretLister is a mock of secretLister interface.
+type MocksecretLister struct {
+	ctrl     *gomock.Controller
+	recorder *MocksecretListerMockRecorder
+}
+
+// MocksecretListerMockRecorder is the mock recorder for MocksecretLister.
+type MocksecretListerMockRecorder struct {
+	mock *MocksecretLister
+}
+
+// NewMocksecretLister creates a new mock instance.
+func NewMocksecretLister(ctrl *gomock.Controller) *MocksecretLister {
+	mock := &MocksecretLister{ctrl: ctrl}
+	mock.recorder = &MocksecretListerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocksecretLister) EXPECT() *MocksecretListerMockRecorder {
+	return m.recorder
+}
+
+// ListSecrets mocks base method.
+func (m *MocksecretLister) ListSecrets(tags map[string]string) ([]ssm.SecretSummary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSecrets", tags)
+	ret0, _ := ret[0].([]ssm.SecretSummary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSecrets indicates an expected call of ListSecrets.
+func (mr *MocksecretListerMockRecorder) ListSecrets(tags interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSecrets", reflect.TypeOf((*MocksecretLister)(nil).ListSecrets), tags)
+}
+
+// MocksecretGetter is a mock of secretGetter interface.
+type MocksecretGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MocksecretGetterMockRecorder
+}
+
+// MocksecretGetterMockRecorder is the mock recorder for MocksecretGetter.
+type MocksecretGetterMockRecorder struct {
+	mock *MocksecretGetter
+}
+
+// NewMocksecretGetter creates a new mock instance.
+func NewMocksecretGetter(ctrl *gomock.Controller) *MocksecretGetter {
+	mock := &MocksecretGetter{ctrl: ctrl}
+	mock.recorder = &MocksecretGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocksecretGetter) EXPECT() *MocksecretGetterMockRecorder {
+	return m.recorder
+}
+
+// GetSecret mocks base method.
+func (m *MocksecretGetter) GetSecret(name string, decrypt bool) (*ssm.Secret, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSecret", name, decrypt)
+	ret0, _ := ret[0].(*ssm.Secret)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSecret indicates an expected call of GetSecret.
+func (mr *MocksecretGetterMockRecorder) GetSecret(name, decrypt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSecret", reflect.TypeOf((*MocksecretGetter)(nil).GetSecret), name, decrypt)
+}
+
+// ListSecrets mocks base method.
+func (m *MocksecretGetter) ListSecrets(tags map[string]string) ([]ssm.SecretSummary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSecrets", tags)
+	ret0, _ := ret[0].([]ssm.SecretSummary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSecrets indicates an expected call of ListSecrets.
+func (mr *MocksecretGetterMockRecorder) ListSecrets(tags interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSecrets", reflect.TypeOf((*MocksecretGetter)(nil).ListSecrets), tags)
+}
+
+// MocksecretsManagerSecretLister is a mock of secretsManagerSecretLister interface.
+type MocksecretsManagerSecretLister struct {
+	ctrl     *gomock.Controller
+	recorder *MocksecretsManagerSecretListerMockRecorder
+}
+
+// MocksecretsManagerSecretListerMockRecorder is the mock recorder for MocksecretsManagerSecretLister.
+type MocksecretsManagerSecretListerMockRecorder struct {
+	mock *MocksecretsManagerSecretLister
+}
+
+// NewMocksecretsManagerSecretLister creates a new mock instance.
+func NewMocksecretsManagerSecretLister(ctrl *gomock.Controller) *MocksecretsManagerSecretLister {
+	mock := &MocksecretsManagerSecretLister{ctrl: ctrl}
+	mock.recorder = &MocksecretsManagerSecretListerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocksecretsManagerSecretLister) EXPECT() *MocksecretsManagerSecretListerMockRecorder {
+	return m.recorder
+}
+
+// ListSecrets mocks base method.
+func (m *MocksecretsManagerSecretLister) ListSecrets(tags map[string]string) ([]secretsmanager.SecretSummary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSecrets", tags)
+	ret0, _ := ret[0].([]secretsmanager.SecretSummary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSecrets indicates an expected call of ListSecrets.
+func (mr *MocksecretsManagerSecretListerMockRecorder) ListSecrets(tags interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSecrets", reflect.TypeOf((*MocksecretsManagerSecretLister)(nil).ListSecrets), tags)
+}
+
+// MocksecretsManagerSecretGetter is a mock of secretsManagerSecretGetter interface.
+type MocksecretsManagerSecretGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MocksecretsManagerSecretGetterMockRecorder
+}
+
+// MocksecretsManagerSecretGetterMockRecorder is the mock recorder for MocksecretsManagerSecretGetter.
+type MocksecretsManagerSecretGetterMockRecorder struct {
+	mock *MocksecretsManagerSecretGetter
+}
+
+// NewMocksecretsManagerSecretGetter creates a new mock instance.
+func NewMocksecretsManagerSecretGetter(ctrl *gomock.Controller) *MocksecretsManagerSecretGetter {
+	mock := &MocksecretsManagerSecretGetter{ctrl: ctrl}
+	mock.recorder = &MocksecretsManagerSecretGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocksecretsManagerSecretGetter) EXPECT() *MocksecretsManagerSecretGetterMockRecorder {
+	return m.recorder
+}
+
+// GetSecret mocks base method.
+func (m *MocksecretsManagerSecretGetter) GetSecret(name string, decrypt bool) (*secretsmanager.Secret, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSecret", name, decrypt)
+	ret0, _ := ret[0].(*secretsmanager.Secret)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSecret indicates an expected call of GetSecret.
+func (mr *MocksecretsManagerSecretGetterMockRecorder) GetSecret(name, decrypt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSecret", reflect.TypeOf((*MocksecretsManagerSecretGetter)(nil).GetSecret), name, decrypt)
+}
+
+// ListSecrets mocks base method.
+func (m *MocksecretsManagerSecretGetter) ListSecrets(tags map[string]string) ([]secretsmanager.SecretSummary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSecrets", tags)
+	ret0, _ := ret[0].([]secretsmanager.SecretSummary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSecrets indicates an expected call of ListSecrets.
+func (mr *MocksecretsManagerSecretGetterMockRecorder) ListSecrets(tags interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSecrets", reflect.TypeOf((*MocksecretsManagerSecretGetter)(nil).ListSecrets), tags)
+}
+
+// MockwsWorkloadManifestReader is a mock of wsWorkloadManifestReader interface.
+type MockwsWorkloadManifestReader struct {
+	ctrl     *gomock.Controller
+	recorder *MockwsWorkloadManifestReaderMockRecorder
+}
+
+// MockwsWorkloadManifestReaderMockRecorder is the mock recorder for MockwsWorkloadManifestReader.
+type MockwsWorkloadManifestReaderMockRecorder struct {
+	mock *MockwsWorkloadManifestReader
+}
+
+// NewMockwsWorkloadManifestReader creates a new mock instance.
+func NewMockwsWorkloadManifestReader(ctrl *gomock.Controller) *MockwsWorkloadManifestReader {
+	mock := &MockwsWorkloadManifestReader{ctrl: ctrl}
+	mock.recorder = &MockwsWorkloadManifestReaderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockwsWorkloadManifestReader) EXPECT() *MockwsWorkloadManifestReaderMockRecorder {
+	return m.recorder
+}
+
+// ListWorkloads mocks base method.
+func (m *MockwsWorkloadManifestReader) ListWorkloads() ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWorkloads")
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWorkloads indicates an expected call of ListWorkloads.
+func (mr *MockwsWorkloadManifestReaderMockRecorder) ListWorkloads() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWorkloads", reflect.TypeOf((*MockwsWorkloadManifestReader)(nil).ListWorkloads))
+}
+
+// ReadWorkloadManifest mocks base method.
+func (m *MockwsWorkloadManifestReader) ReadWorkloadManifest(name string) (workspace.WorkloadManifest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadWorkloadManifest", name)
+	ret0, _ := ret[0].(workspace.WorkloadManifest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadWorkloadManifest indicates an expected call of ReadWorkloadManifest.
+func (mr *MockwsWorkloadManifestReaderMockRecorder) ReadWorkloadManifest(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadWorkloadManifest", reflect.TypeOf((*MockwsWorkloadManifestReader)(nil).ReadWorkloadManifest), name)
+}
+
 // MockservicePauser is a mock of servicePauser interface.
 type MockservicePauser struct {
 	ctrl     *gomock.Controller
@@ -4,11 +4,23 @@
 package cli
 
 import (
+	"errors"
+	"fmt"
+
 	"github.com/aws/copilot-cli/cmd/copilot/template"
 	"github.com/aws/copilot-cli/internal/pkg/cli/group"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
 	"github.com/spf13/cobra"
 )
 
+const (
+	pipelineSelectLocalPipelineHelpPrompt = "A pipeline defined in your workspace under copilot/pipelines/ or copilot/pipeline.yml."
+)
+
 // BuildPipelineCmd is the top level command for pipelines
 func BuildPipelineCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -25,6 +37,11 @@ Continuous delivery pipelines to release services.`,
 	cmd.AddCommand(buildPipelineShowCmd())
 	cmd.AddCommand(buildPipelineStatusCmd())
 	cmd.AddCommand(buildPipelineListCmd())
+	cmd.AddCommand(buildPipelineApproveCmd())
+	cmd.AddCommand(buildPipelineRejectCmd())
+	cmd.AddCommand(buildPipelineHistoryCmd())
+	cmd.AddCommand(buildPipelineRetryCmd())
+	cmd.AddCommand(buildPipelineReleaseCmd())
 
 	cmd.SetUsageTemplate(template.Usage)
 	cmd.Annotations = map[string]string{
@@ -33,3 +50,72 @@ Continuous delivery pipelines to release services.`,
 
 	return cmd
 }
+
+// selectLocalPipeline returns the pipeline manifest in the workspace with the given name.
+// If the name is empty and the workspace contains a single pipeline, that pipeline is returned.
+// Otherwise, the user is prompted to select one of the pipelines in the workspace.
+func selectLocalPipeline(ws wsPipelineLister, sel prompter, name, msg string) (*workspace.PipelineManifest, error) {
+	pipelines, err := ws.ListPipelines()
+	if err != nil {
+		return nil, err
+	}
+	if name != "" {
+		for _, pipeline := range pipelines {
+			if pipeline.Name == name {
+				return &pipeline, nil
+			}
+		}
+		return nil, fmt.Errorf("pipeline %s not found in the workspace", name)
+	}
+	if len(pipelines) == 1 {
+		log.Infof("Found pipeline: %s\n", color.HighlightUserInput(pipelines[0].Name))
+		return &pipelines[0], nil
+	}
+
+	var names []string
+	for _, pipeline := range pipelines {
+		names = append(names, pipeline.Name)
+	}
+	selected, err := sel.SelectOne(msg, pipelineSelectLocalPipelineHelpPrompt, names, prompt.WithFinalMessage("Pipeline:"))
+	if err != nil {
+		return nil, fmt.Errorf("select pipeline: %w", err)
+	}
+	for _, pipeline := range pipelines {
+		if pipeline.Name == selected {
+			return &pipeline, nil
+		}
+	}
+	return nil, fmt.Errorf("pipeline %s not found in the workspace", selected)
+}
+
+// selectPipelineName returns the name of a pipeline of the application.
+// Pipelines defined in the workspace are preferred, if there are none the user selects one of the deployed pipelines of the application.
+func selectPipelineName(ws wsPipelineLister, pipelineSvc pipelineGetter, sel prompter, appName, msg, help string) (string, error) {
+	pipeline, err := selectLocalPipeline(ws, sel, "", msg)
+	if err == nil {
+		return pipeline.Name, nil
+	}
+	if !errors.Is(err, workspace.ErrNoPipelineInWorkspace) {
+		return "", err
+	}
+
+	log.Infof("No pipeline manifest in workspace for application %s, looking for deployed pipelines.\n", color.HighlightUserInput(appName))
+	names, err := pipelineSvc.ListPipelineNamesByTags(map[string]string{
+		deploy.AppTagKey: appName,
+	})
+	if err != nil {
+		return "", fmt.Errorf("list pipelines: %w", err)
+	}
+	switch len(names) {
+	case 0:
+		return "", fmt.Errorf("no pipelines found for application %s", color.HighlightUserInput(appName))
+	case 1:
+		log.Infof("Found pipeline: %s\n", color.HighlightUserInput(names[0]))
+		return names[0], nil
+	}
+	name, err := sel.SelectOne(msg, help, names, prompt.WithFinalMessage("Pipeline:"))
+	if err != nil {
+		return "", fmt.Errorf("select pipeline for application %s: %w", appName, err)
+	}
+	return name, nil
+}
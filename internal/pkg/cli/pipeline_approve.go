@@ -0,0 +1,247 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/spf13/cobra"
+)
+
+const (
+	pipelineApprovalAppNamePrompt          = "Which application's pipeline has a pending approval?"
+	pipelineApprovalAppNameHelpPrompt      = "An application is a collection of related services."
+	fmtPipelineApprovalPipelineNamePrompt  = "Which pipeline of %s has a pending approval?"
+	pipelineApprovalPipelineNameHelpPrompt = "The pipeline with a manual approval action waiting for a response."
+	fmtPipelineApprovalStagePrompt         = "Which stage of %s would you like to %s?"
+	pipelineApprovalStageHelpPrompt        = "The stages of the pipeline with a manual approval action waiting for a response."
+
+	// Stages of a pipeline that deploy to an environment are named after the environment with this prefix.
+	pipelineDeployStagePrefix = "DeployTo-"
+)
+
+type pipelineApprovalVars struct {
+	appName      string
+	pipelineName string
+	stage        string
+	comment      string
+}
+
+type pipelineApprovalOpts struct {
+	pipelineApprovalVars
+	approve bool // True if the pending approval is approved, false if it's rejected.
+
+	ws          wsPipelineLister
+	store       store
+	pipelineSvc pipelineGetter
+	approver    pipelineApprover
+	sel         appSelector
+	prompt      prompter
+
+	// Cached variables.
+	approval *codepipeline.PendingApproval
+}
+
+func newPipelineApprovalOpts(vars pipelineApprovalVars, approve bool) (*pipelineApprovalOpts, error) {
+	store, err := config.NewStore()
+	if err != nil {
+		return nil, fmt.Errorf("new config store client: %w", err)
+	}
+
+	ws, err := workspace.New()
+	if err != nil {
+		return nil, fmt.Errorf("new workspace client: %w", err)
+	}
+
+	defaultSession, err := sessions.NewProvider().Default()
+	if err != nil {
+		return nil, fmt.Errorf("default session: %w", err)
+	}
+
+	prompter := prompt.New()
+	cp := codepipeline.New(defaultSession)
+	return &pipelineApprovalOpts{
+		pipelineApprovalVars: vars,
+		approve:              approve,
+		ws:                   ws,
+		store:                store,
+		pipelineSvc:          cp,
+		approver:             cp,
+		sel:                  selector.NewSelect(prompter, store),
+		prompt:               prompter,
+	}, nil
+}
+
+// Validate returns an error if the values provided by the user are invalid.
+func (o *pipelineApprovalOpts) Validate() error {
+	if o.appName != "" {
+		if _, err := o.store.GetApplication(o.appName); err != nil {
+			return err
+		}
+	}
+	if o.pipelineName != "" {
+		if _, err := o.pipelineSvc.GetPipeline(o.pipelineName); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Ask prompts for fields that are required but not passed in, and selects the pending approval to respond to.
+func (o *pipelineApprovalOpts) Ask() error {
+	if err := o.askAppName(); err != nil {
+		return err
+	}
+	if err := o.askPipelineName(); err != nil {
+		return err
+	}
+	return o.askStage()
+}
+
+// Execute approves or rejects the pending approval of the pipeline stage.
+func (o *pipelineApprovalOpts) Execute() error {
+	if err := o.approver.PutApprovalResult(o.pipelineName, *o.approval, o.approve, o.comment); err != nil {
+		return err
+	}
+	verb := "Rejected"
+	if o.approve {
+		verb = "Approved"
+	}
+	log.Successf("%s %s in stage %s of pipeline %s.\n", verb, o.approval.ActionName,
+		color.HighlightUserInput(o.approval.StageName), color.HighlightUserInput(o.pipelineName))
+	return nil
+}
+
+func (o *pipelineApprovalOpts) askAppName() error {
+	if o.appName != "" {
+		return nil
+	}
+	name, err := o.sel.Application(pipelineApprovalAppNamePrompt, pipelineApprovalAppNameHelpPrompt)
+	if err != nil {
+		return fmt.Errorf("select application: %w", err)
+	}
+	o.appName = name
+	return nil
+}
+
+func (o *pipelineApprovalOpts) askPipelineName() error {
+	if o.pipelineName != "" {
+		return nil
+	}
+	name, err := selectPipelineName(o.ws, o.pipelineSvc, o.prompt, o.appName,
+		fmt.Sprintf(fmtPipelineApprovalPipelineNamePrompt, color.HighlightUserInput(o.appName)), pipelineApprovalPipelineNameHelpPrompt)
+	if err != nil {
+		return err
+	}
+	o.pipelineName = name
+	return nil
+}
+
+// askStage selects the pending approval of the pipeline in the stage passed in,
+// or prompts for the stage if there are several pending approvals.
+// The stage can either be the name of the pipeline stage or the environment it deploys to.
+func (o *pipelineApprovalOpts) askStage() error {
+	approvals, err := o.approver.PendingApprovals(o.pipelineName)
+	if err != nil {
+		return err
+	}
+	if o.stage != "" {
+		for i, approval := range approvals {
+			if approval.StageName == o.stage || approval.StageName == pipelineDeployStagePrefix+o.stage {
+				o.approval = &approvals[i]
+				return nil
+			}
+		}
+		return fmt.Errorf("no pending approval in stage %s of pipeline %s", o.stage, o.pipelineName)
+	}
+	switch len(approvals) {
+	case 0:
+		return fmt.Errorf("no pending approval in pipeline %s", o.pipelineName)
+	case 1:
+		log.Infof("Found pending approval in stage: %s\n", color.HighlightUserInput(approvals[0].StageName))
+		o.approval = &approvals[0]
+		return nil
+	}
+	var stages []string
+	for _, approval := range approvals {
+		stages = append(stages, approval.StageName)
+	}
+	action := "reject"
+	if o.approve {
+		action = "approve"
+	}
+	stage, err := o.prompt.SelectOne(fmt.Sprintf(fmtPipelineApprovalStagePrompt, color.HighlightUserInput(o.pipelineName), action),
+		pipelineApprovalStageHelpPrompt, stages, prompt.WithFinalMessage("Stage:"))
+	if err != nil {
+		return fmt.Errorf("select stage of pipeline %s: %w", o.pipelineName, err)
+	}
+	for i, approval := range approvals {
+		if approval.StageName == stage {
+			o.approval = &approvals[i]
+		}
+	}
+	return nil
+}
+
+// buildPipelineApproveCmd builds the command for approving a pending manual approval of a pipeline.
+func buildPipelineApproveCmd() *cobra.Command {
+	vars := pipelineApprovalVars{}
+	cmd := &cobra.Command{
+		Use:   "approve",
+		Short: "Approves a pending manual approval of a pipeline.",
+		Long:  "Approves the manual approval action of a pipeline stage that is waiting for a response.",
+
+		Example: `
+Approves the promotion of the pipeline "pipeline-myapp-myrepo" to the "prod" environment.
+/code $ copilot pipeline approve -n pipeline-myapp-myrepo --stage prod --comment "Verified in staging."`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newPipelineApprovalOpts(vars, true)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	addPipelineApprovalFlags(cmd, &vars)
+	return cmd
+}
+
+// buildPipelineRejectCmd builds the command for rejecting a pending manual approval of a pipeline.
+func buildPipelineRejectCmd() *cobra.Command {
+	vars := pipelineApprovalVars{}
+	cmd := &cobra.Command{
+		Use:   "reject",
+		Short: "Rejects a pending manual approval of a pipeline.",
+		Long: `Rejects the manual approval action of a pipeline stage that is waiting for a response.
+The stage fails and the pipeline execution stops.`,
+
+		Example: `
+Rejects the promotion of the pipeline "pipeline-myapp-myrepo" to the "prod" environment.
+/code $ copilot pipeline reject -n pipeline-myapp-myrepo --stage prod --comment "Latency regressed in staging."`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newPipelineApprovalOpts(vars, false)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	addPipelineApprovalFlags(cmd, &vars)
+	return cmd
+}
+
+func addPipelineApprovalFlags(cmd *cobra.Command, vars *pipelineApprovalVars) {
+	cmd.Flags().StringVarP(&vars.pipelineName, nameFlag, nameFlagShort, "", pipelineFlagDescription)
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVar(&vars.stage, stageFlag, "", approvalStageFlagDescription)
+	cmd.Flags().StringVar(&vars.comment, commentFlag, "", approvalCommentFlagDescription)
+}
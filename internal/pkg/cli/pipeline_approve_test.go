@@ -0,0 +1,307 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type pipelineApprovalMocks struct {
+	store       *mocks.Mockstore
+	ws          *mocks.MockwsPipelineLister
+	prompt      *mocks.Mockprompter
+	pipelineSvc *mocks.MockpipelineGetter
+	approver    *mocks.MockpipelineApprover
+	sel         *mocks.MockappSelector
+}
+
+func TestPipelineApprovalOpts_Validate(t *testing.T) {
+	const (
+		mockAppName      = "dinder"
+		mockPipelineName = "pipeline-dinder-badgoose-repo"
+	)
+	mockError := errors.New("mock error")
+	testCases := map[string]struct {
+		inAppName      string
+		inPipelineName string
+		setupMocks     func(m pipelineApprovalMocks)
+
+		wantedError error
+	}{
+		"errors if app name is invalid": {
+			inAppName: mockAppName,
+			setupMocks: func(m pipelineApprovalMocks) {
+				m.store.EXPECT().GetApplication(mockAppName).Return(nil, mockError)
+			},
+			wantedError: mockError,
+		},
+		"errors if pipeline name is invalid": {
+			inAppName:      mockAppName,
+			inPipelineName: mockPipelineName,
+			setupMocks: func(m pipelineApprovalMocks) {
+				m.store.EXPECT().GetApplication(mockAppName).Return(&config.Application{Name: mockAppName}, nil)
+				m.pipelineSvc.EXPECT().GetPipeline(mockPipelineName).Return(nil, mockError)
+			},
+			wantedError: mockError,
+		},
+		"success": {
+			inAppName:      mockAppName,
+			inPipelineName: mockPipelineName,
+			setupMocks: func(m pipelineApprovalMocks) {
+				m.store.EXPECT().GetApplication(mockAppName).Return(&config.Application{Name: mockAppName}, nil)
+				m.pipelineSvc.EXPECT().GetPipeline(mockPipelineName).Return(nil, nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := pipelineApprovalMocks{
+				store:       mocks.NewMockstore(ctrl),
+				pipelineSvc: mocks.NewMockpipelineGetter(ctrl),
+			}
+			tc.setupMocks(m)
+
+			opts := &pipelineApprovalOpts{
+				pipelineApprovalVars: pipelineApprovalVars{
+					appName:      tc.inAppName,
+					pipelineName: tc.inPipelineName,
+				},
+				store:       m.store,
+				pipelineSvc: m.pipelineSvc,
+			}
+
+			// WHEN
+			err := opts.Validate()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestPipelineApprovalOpts_Ask(t *testing.T) {
+	const (
+		mockAppName      = "dinder"
+		mockPipelineName = "pipeline-dinder-badgoose-repo"
+	)
+	mockError := errors.New("mock error")
+	testApproval := codepipeline.PendingApproval{
+		StageName:  "DeployTo-test",
+		ActionName: "ApprovePromotionTo-test",
+		Token:      "test-token",
+	}
+	prodApproval := codepipeline.PendingApproval{
+		StageName:  "DeployTo-prod",
+		ActionName: "ApprovePromotionTo-prod",
+		Token:      "prod-token",
+	}
+	testCases := map[string]struct {
+		inAppName      string
+		inPipelineName string
+		inStage        string
+		setupMocks     func(m pipelineApprovalMocks)
+
+		wantedAppName      string
+		wantedPipelineName string
+		wantedApproval     *codepipeline.PendingApproval
+		wantedError        error
+	}{
+		"selects the app, the pipeline in the workspace and the only pending approval": {
+			setupMocks: func(m pipelineApprovalMocks) {
+				m.sel.EXPECT().Application(pipelineApprovalAppNamePrompt, pipelineApprovalAppNameHelpPrompt).Return(mockAppName, nil)
+				m.ws.EXPECT().ListPipelines().Return([]workspace.PipelineManifest{
+					{
+						Name: mockPipelineName,
+						Path: "/copilot/pipelines/badgoose/manifest.yml",
+					},
+				}, nil)
+				m.approver.EXPECT().PendingApprovals(mockPipelineName).Return([]codepipeline.PendingApproval{prodApproval}, nil)
+			},
+			wantedAppName:      mockAppName,
+			wantedPipelineName: mockPipelineName,
+			wantedApproval:     &prodApproval,
+		},
+		"looks for deployed pipelines if there are none in the workspace": {
+			inAppName: mockAppName,
+			setupMocks: func(m pipelineApprovalMocks) {
+				m.ws.EXPECT().ListPipelines().Return(nil, workspace.ErrNoPipelineInWorkspace)
+				m.pipelineSvc.EXPECT().ListPipelineNamesByTags(map[string]string{"copilot-application": mockAppName}).Return([]string{mockPipelineName}, nil)
+				m.approver.EXPECT().PendingApprovals(mockPipelineName).Return([]codepipeline.PendingApproval{prodApproval}, nil)
+			},
+			wantedAppName:      mockAppName,
+			wantedPipelineName: mockPipelineName,
+			wantedApproval:     &prodApproval,
+		},
+		"errors if fail to list pending approvals": {
+			inAppName:      mockAppName,
+			inPipelineName: mockPipelineName,
+			setupMocks: func(m pipelineApprovalMocks) {
+				m.approver.EXPECT().PendingApprovals(mockPipelineName).Return(nil, mockError)
+			},
+			wantedError: mockError,
+		},
+		"errors if there are no pending approvals": {
+			inAppName:      mockAppName,
+			inPipelineName: mockPipelineName,
+			setupMocks: func(m pipelineApprovalMocks) {
+				m.approver.EXPECT().PendingApprovals(mockPipelineName).Return(nil, nil)
+			},
+			wantedError: fmt.Errorf("no pending approval in pipeline %s", mockPipelineName),
+		},
+		"selects the pending approval of the stage named after the environment": {
+			inAppName:      mockAppName,
+			inPipelineName: mockPipelineName,
+			inStage:        "prod",
+			setupMocks: func(m pipelineApprovalMocks) {
+				m.approver.EXPECT().PendingApprovals(mockPipelineName).Return([]codepipeline.PendingApproval{testApproval, prodApproval}, nil)
+			},
+			wantedAppName:      mockAppName,
+			wantedPipelineName: mockPipelineName,
+			wantedApproval:     &prodApproval,
+		},
+		"errors if the stage has no pending approval": {
+			inAppName:      mockAppName,
+			inPipelineName: mockPipelineName,
+			inStage:        "prod",
+			setupMocks: func(m pipelineApprovalMocks) {
+				m.approver.EXPECT().PendingApprovals(mockPipelineName).Return([]codepipeline.PendingApproval{testApproval}, nil)
+			},
+			wantedError: fmt.Errorf("no pending approval in stage prod of pipeline %s", mockPipelineName),
+		},
+		"prompts for the stage if there are several pending approvals": {
+			inAppName:      mockAppName,
+			inPipelineName: mockPipelineName,
+			setupMocks: func(m pipelineApprovalMocks) {
+				m.approver.EXPECT().PendingApprovals(mockPipelineName).Return([]codepipeline.PendingApproval{testApproval, prodApproval}, nil)
+				m.prompt.EXPECT().SelectOne(gomock.Any(), pipelineApprovalStageHelpPrompt, []string{"DeployTo-test", "DeployTo-prod"}, gomock.Any()).Return("DeployTo-test", nil)
+			},
+			wantedAppName:      mockAppName,
+			wantedPipelineName: mockPipelineName,
+			wantedApproval:     &testApproval,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := pipelineApprovalMocks{
+				ws:          mocks.NewMockwsPipelineLister(ctrl),
+				prompt:      mocks.NewMockprompter(ctrl),
+				pipelineSvc: mocks.NewMockpipelineGetter(ctrl),
+				approver:    mocks.NewMockpipelineApprover(ctrl),
+				sel:         mocks.NewMockappSelector(ctrl),
+			}
+			tc.setupMocks(m)
+
+			opts := &pipelineApprovalOpts{
+				pipelineApprovalVars: pipelineApprovalVars{
+					appName:      tc.inAppName,
+					pipelineName: tc.inPipelineName,
+					stage:        tc.inStage,
+				},
+				approve:     true,
+				ws:          m.ws,
+				prompt:      m.prompt,
+				pipelineSvc: m.pipelineSvc,
+				approver:    m.approver,
+				sel:         m.sel,
+			}
+
+			// WHEN
+			err := opts.Ask()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedAppName, opts.appName)
+				require.Equal(t, tc.wantedPipelineName, opts.pipelineName)
+				require.Equal(t, tc.wantedApproval, opts.approval)
+			}
+		})
+	}
+}
+
+func TestPipelineApprovalOpts_Execute(t *testing.T) {
+	const mockPipelineName = "pipeline-dinder-badgoose-repo"
+	mockApproval := codepipeline.PendingApproval{
+		StageName:  "DeployTo-prod",
+		ActionName: "ApprovePromotionTo-prod",
+		Token:      "prod-token",
+	}
+	testCases := map[string]struct {
+		approve    bool
+		setupMocks func(m pipelineApprovalMocks)
+
+		wantedError error
+	}{
+		"errors if fail to put the approval result": {
+			approve: true,
+			setupMocks: func(m pipelineApprovalMocks) {
+				m.approver.EXPECT().PutApprovalResult(mockPipelineName, mockApproval, true, "lgtm").Return(errors.New("some error"))
+			},
+			wantedError: errors.New("some error"),
+		},
+		"rejects the pending approval": {
+			setupMocks: func(m pipelineApprovalMocks) {
+				m.approver.EXPECT().PutApprovalResult(mockPipelineName, mockApproval, false, "lgtm").Return(nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := pipelineApprovalMocks{
+				approver: mocks.NewMockpipelineApprover(ctrl),
+			}
+			tc.setupMocks(m)
+
+			opts := &pipelineApprovalOpts{
+				pipelineApprovalVars: pipelineApprovalVars{
+					pipelineName: mockPipelineName,
+					comment:      "lgtm",
+				},
+				approve:  tc.approve,
+				approver: m.approver,
+				approval: &mockApproval,
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -24,6 +24,7 @@ const (
 	pipelineDeleteConfirmHelp         = "This will delete the deployment pipeline for the services in the workspace."
 	pipelineSecretDeleteConfirmPrompt = "Are you sure you want to delete the source secret %s associated with pipeline %s?"
 	pipelineDeleteSecretConfirmHelp   = "This will delete the token associated with the source of your pipeline."
+	pipelineDeleteSelectPrompt        = "Which pipeline would you like to delete?"
 
 	fmtDeletePipelineStart    = "Deleting pipeline %s from application %s."
 	fmtDeletePipelineFailed   = "Failed to delete pipeline %s from application %s: %v.\n"
@@ -36,6 +37,7 @@ var (
 
 type deletePipelineVars struct {
 	appName            string
+	name               string
 	skipConfirmation   bool
 	shouldDeleteSecret bool
 }
@@ -87,16 +89,19 @@ func (o *deletePipelineOpts) Validate() error {
 	if o.appName == "" {
 		return errNoAppInWorkspace
 	}
-
-	if err := o.readPipelineManifest(); err != nil {
-		return err
-	}
-
 	return nil
 }
 
 // Ask prompts for fields that are required but not passed in.
 func (o *deletePipelineOpts) Ask() error {
+	pipeline, err := selectLocalPipeline(o.ws, o.prompt, o.name, pipelineDeleteSelectPrompt)
+	if err != nil {
+		return err
+	}
+	if err := o.readPipelineManifest(pipeline.Path); err != nil {
+		return err
+	}
+
 	if o.skipConfirmation {
 		return nil
 	}
@@ -130,12 +135,9 @@ func (o *deletePipelineOpts) Execute() error {
 	return nil
 }
 
-func (o *deletePipelineOpts) readPipelineManifest() error {
-	data, err := o.ws.ReadPipelineManifest()
+func (o *deletePipelineOpts) readPipelineManifest(path string) error {
+	data, err := o.ws.ReadPipelineManifest(path)
 	if err != nil {
-		if err == workspace.ErrNoPipelineInWorkspace {
-			return err
-		}
 		return fmt.Errorf("read pipeline manifest: %w", err)
 	}
 
@@ -202,10 +204,13 @@ func buildPipelineDeleteCmd() *cobra.Command {
 	vars := deletePipelineVars{}
 	cmd := &cobra.Command{
 		Use:   "delete",
-		Short: "Deletes the pipeline associated with your workspace.",
+		Short: "Deletes a pipeline associated with your workspace.",
 		Example: `
   Delete the pipeline associated with your workspace.
-  /code $ copilot pipeline delete`,
+  /code $ copilot pipeline delete
+
+  Delete the pipeline named "release" in your workspace.
+  /code $ copilot pipeline delete --name release`,
 
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newDeletePipelineOpts(vars)
@@ -216,6 +221,7 @@ func buildPipelineDeleteCmd() *cobra.Command {
 		}),
 	}
 	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", pipelineFlagDescription)
 	cmd.Flags().BoolVar(&vars.skipConfirmation, yesFlag, false, yesFlagDescription)
 	cmd.Flags().BoolVar(&vars.shouldDeleteSecret, deleteSecretFlag, false, deleteSecretFlagDescription)
 	return cmd
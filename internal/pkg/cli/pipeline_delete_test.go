@@ -31,51 +31,17 @@ type deletePipelineMocks struct {
 }
 
 func TestDeletePipelineOpts_Validate(t *testing.T) {
-	pipelineData := `
-name: pipeline-badgoose-honker-repo
-version: 1
-
-source:
-  provider: GitHub
-  properties:
-    repository: badgoose/repo
-    access_token_secret: "github-token-badgoose-repo"
-    branch: main
-
-stages:
-    -
-      name: test
-    -
-      name: prod
-`
-
 	testCases := map[string]struct {
 		inAppName string
-		callMocks func(m deletePipelineMocks)
 
 		wantedError error
 	}{
 		"happy path": {
-			inAppName: testAppName,
-			callMocks: func(m deletePipelineMocks) {
-				m.ws.EXPECT().ReadPipelineManifest().Return([]byte(pipelineData), nil)
-			},
+			inAppName:   testAppName,
 			wantedError: nil,
 		},
-
-		"pipeline manifest does not exist": {
-			inAppName: testAppName,
-			callMocks: func(m deletePipelineMocks) {
-				m.ws.EXPECT().ReadPipelineManifest().Return(nil, workspace.ErrNoPipelineInWorkspace)
-			},
-
-			wantedError: workspace.ErrNoPipelineInWorkspace,
-		},
-
 		"application does not exist": {
-			inAppName: "",
-			callMocks: func(m deletePipelineMocks) {},
-
+			inAppName:   "",
 			wantedError: errNoAppInWorkspace,
 		},
 	}
@@ -83,21 +49,10 @@ stages:
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
 			// GIVEN
-			ctrl := gomock.NewController(t)
-			defer ctrl.Finish()
-
-			mockWorkspace := mocks.NewMockwsPipelineReader(ctrl)
-			mocks := deletePipelineMocks{
-				ws: mockWorkspace,
-			}
-
-			tc.callMocks(mocks)
-
 			opts := &deletePipelineOpts{
 				deletePipelineVars: deletePipelineVars{
 					appName: tc.inAppName,
 				},
-				ws: mockWorkspace,
 			}
 
 			// WHEN
@@ -106,43 +61,112 @@ stages:
 			// THEN
 			if tc.wantedError != nil {
 				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
 			}
 		})
 	}
 }
 
 func TestDeletePipelineOpts_Ask(t *testing.T) {
+	pipelineData := `
+name: honkpipes
+version: 1
+
+source:
+  provider: GitHub
+  properties:
+    repository: badgoose/repo
+    access_token_secret: "github-token-badgoose-repo"
+    branch: main
+
+stages:
+    -
+      name: test
+    -
+      name: prod
+`
+	mockPipelines := []workspace.PipelineManifest{
+		{
+			Name: testPipelineName,
+			Path: "/copilot/pipelines/honkpipes/manifest.yml",
+		},
+		{
+			Name: "release",
+			Path: "/copilot/pipelines/release/manifest.yml",
+		},
+	}
 	testCases := map[string]struct {
 		skipConfirmation bool
 		inAppName        string
-		inPipelineName   string
+		inName           string
 
 		callMocks func(m deletePipelineMocks)
 
-		wantedError error
+		wantedPipelineName   string
+		wantedPipelineSecret string
+		wantedError          error
 	}{
+		"pipeline manifest does not exist": {
+			inAppName: testAppName,
+			callMocks: func(m deletePipelineMocks) {
+				m.ws.EXPECT().ListPipelines().Return(nil, workspace.ErrNoPipelineInWorkspace)
+			},
+
+			wantedError: workspace.ErrNoPipelineInWorkspace,
+		},
+		"returns error if the pipeline manifest cannot be read": {
+			skipConfirmation: true,
+			inAppName:        testAppName,
+			inName:           testPipelineName,
+			callMocks: func(m deletePipelineMocks) {
+				m.ws.EXPECT().ListPipelines().Return(mockPipelines, nil)
+				m.ws.EXPECT().ReadPipelineManifest(mockPipelines[0].Path).Return(nil, errors.New("some error"))
+			},
+
+			wantedError: errors.New("read pipeline manifest: some error"),
+		},
 		"skips confirmation works": {
 			skipConfirmation: true,
 			inAppName:        testAppName,
-			inPipelineName:   testPipelineName,
+			inName:           testPipelineName,
 
-			callMocks: func(m deletePipelineMocks) {},
+			callMocks: func(m deletePipelineMocks) {
+				m.ws.EXPECT().ListPipelines().Return(mockPipelines, nil)
+				m.ws.EXPECT().ReadPipelineManifest(mockPipelines[0].Path).Return([]byte(pipelineData), nil)
+			},
 
-			wantedError: nil,
+			wantedPipelineName:   testPipelineName,
+			wantedPipelineSecret: "github-token-badgoose-repo",
 		},
-
-		"delete confirmation works": {
+		"prompts for the pipeline and confirms the deletion": {
 			skipConfirmation: false,
 			inAppName:        testAppName,
-			inPipelineName:   testPipelineName,
 			callMocks: func(m deletePipelineMocks) {
+				m.ws.EXPECT().ListPipelines().Return(mockPipelines, nil)
+				m.prompt.EXPECT().SelectOne(pipelineDeleteSelectPrompt, gomock.Any(), []string{testPipelineName, "release"}, gomock.Any()).Return(testPipelineName, nil)
+				m.ws.EXPECT().ReadPipelineManifest(mockPipelines[0].Path).Return([]byte(pipelineData), nil)
 				m.prompt.EXPECT().Confirm(
 					fmt.Sprintf(pipelineDeleteConfirmPrompt, testPipelineName, testAppName),
 					pipelineDeleteConfirmHelp,
 					gomock.Any(),
 				).Times(1).Return(true, nil)
 			},
-			wantedError: nil,
+
+			wantedPipelineName:   testPipelineName,
+			wantedPipelineSecret: "github-token-badgoose-repo",
+		},
+		"returns error if the deletion is cancelled": {
+			skipConfirmation: false,
+			inAppName:        testAppName,
+			inName:           testPipelineName,
+			callMocks: func(m deletePipelineMocks) {
+				m.ws.EXPECT().ListPipelines().Return(mockPipelines, nil)
+				m.ws.EXPECT().ReadPipelineManifest(mockPipelines[0].Path).Return([]byte(pipelineData), nil)
+				m.prompt.EXPECT().Confirm(gomock.Any(), gomock.Any(), gomock.Any()).Return(false, nil)
+			},
+
+			wantedError: errPipelineDeleteCancelled,
 		},
 	}
 
@@ -153,9 +177,11 @@ func TestDeletePipelineOpts_Ask(t *testing.T) {
 			defer ctrl.Finish()
 
 			mockPrompt := mocks.NewMockprompter(ctrl)
+			mockWorkspace := mocks.NewMockwsPipelineReader(ctrl)
 
 			mocks := deletePipelineMocks{
 				prompt: mockPrompt,
+				ws:     mockWorkspace,
 			}
 
 			tc.callMocks(mocks)
@@ -164,9 +190,10 @@ func TestDeletePipelineOpts_Ask(t *testing.T) {
 				deletePipelineVars: deletePipelineVars{
 					skipConfirmation: tc.skipConfirmation,
 					appName:          tc.inAppName,
+					name:             tc.inName,
 				},
-				PipelineName: tc.inPipelineName,
-				prompt:       mockPrompt,
+				prompt: mockPrompt,
+				ws:     mockWorkspace,
 			}
 
 			// WHEN
@@ -175,6 +202,10 @@ func TestDeletePipelineOpts_Ask(t *testing.T) {
 			// THEN
 			if tc.wantedError != nil {
 				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedPipelineName, opts.PipelineName)
+				require.Equal(t, tc.wantedPipelineSecret, opts.PipelineSecret)
 			}
 		})
 	}
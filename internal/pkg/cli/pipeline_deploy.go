@@ -6,17 +6,23 @@ package cli
 import (
 	"errors"
 	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
 
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	cs "github.com/aws/copilot-cli/internal/pkg/aws/codestar"
+	"github.com/aws/copilot-cli/internal/pkg/aws/s3"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	deploycfn "github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/template"
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
 	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/version"
 	"github.com/aws/copilot-cli/internal/pkg/workspace"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -30,6 +36,10 @@ const (
 	fmtPipelineDeployResourcesFailed   = "Failed to add pipeline resources to your application: %s\n"
 	fmtPipelineDeployResourcesComplete = "Successfully added pipeline resources to your application: %s\n"
 
+	fmtPipelineDeployRegionsStart    = "Adding regional resources for the pipeline stages to your application: %s"
+	fmtPipelineDeployRegionsFailed   = "Failed to add regional resources for the pipeline stages to your application: %s\n"
+	fmtPipelineDeployRegionsComplete = "Successfully added regional resources for the pipeline stages to your application: %s\n"
+
 	fmtPipelineDeployStart    = "Creating a new pipeline: %s"
 	fmtPipelineDeployFailed   = "Failed to create a new pipeline: %s.\n"
 	fmtPipelineDeployComplete = "Successfully created a new pipeline: %s\n"
@@ -39,12 +49,17 @@ const (
 	fmtPipelineDeployProposalComplete = "Successfully deployed pipeline: %s\n"
 
 	fmtPipelineDeployExistPrompt = "Are you sure you want to redeploy an existing pipeline: %s?"
+
+	pipelineDeploySelectPrompt = "Which pipeline would you like to deploy?"
+
+	fmtPipelineWorkspaceKey = "manual/pipelines/%s/workspace.zip"
 )
 
 const connectionsURL = "https://console.aws.amazon.com/codesuite/settings/connections"
 
 type deployPipelineVars struct {
 	appName          string
+	name             string
 	skipConfirmation bool
 }
 
@@ -59,9 +74,13 @@ type deployPipelineOpts struct {
 	envStore         environmentStore
 	ws               wsPipelineReader
 	codestar         codestar
+	parser           template.Parser
+	uploader         uploader
 
+	pipeline                     *workspace.PipelineManifest
 	pipelineName                 string
 	shouldPromptUpdateConnection bool
+	uploadedWorkspace            bool
 }
 
 func newDeployPipelineOpts(vars deployPipelineVars) (*deployPipelineOpts, error) {
@@ -95,6 +114,8 @@ func newDeployPipelineOpts(vars deployPipelineVars) (*deployPipelineOpts, error)
 		prog:               termprogress.NewSpinner(log.DiagnosticWriter),
 		prompt:             prompt.New(),
 		codestar:           cs.New(defaultSession),
+		parser:             template.New(),
+		uploader:           s3.New(defaultSession),
 	}, nil
 }
 
@@ -103,6 +124,16 @@ func (o *deployPipelineOpts) Validate() error {
 	return nil
 }
 
+// Ask prompts for the pipeline to deploy if there are multiple pipelines in the workspace.
+func (o *deployPipelineOpts) Ask() error {
+	pipeline, err := selectLocalPipeline(o.ws, o.prompt, o.name, pipelineDeploySelectPrompt)
+	if err != nil {
+		return err
+	}
+	o.pipeline = pipeline
+	return nil
+}
+
 // Execute creates a new pipeline or updates the current pipeline if it already exists.
 func (o *deployPipelineOpts) Execute() error {
 	// bootstrap pipeline resources
@@ -115,7 +146,7 @@ func (o *deployPipelineOpts) Execute() error {
 	o.prog.Stop(log.Ssuccessf(fmtPipelineDeployResourcesComplete, color.HighlightUserInput(o.appName)))
 
 	// read pipeline manifest
-	data, err := o.ws.ReadPipelineManifest()
+	data, err := o.ws.ReadPipelineManifest(o.pipeline.Path)
 	if err != nil {
 		return fmt.Errorf("read pipeline manifest: %w", err)
 	}
@@ -143,6 +174,15 @@ func (o *deployPipelineOpts) Execute() error {
 		return fmt.Errorf("read source from manifest: %w", err)
 	}
 	o.shouldPromptUpdateConnection = bool
+	if src, ok := source.(*deploy.ECRSource); ok {
+		envs := make([]string, len(pipeline.Stages))
+		for i, stage := range pipeline.Stages {
+			envs[i] = stage.Name
+		}
+		if err := o.uploadWorkspace(src, envs); err != nil {
+			return err
+		}
+	}
 
 	// convert environments to deployment stages
 	stages, err := o.convertStages(pipeline.Stages)
@@ -150,17 +190,41 @@ func (o *deployPipelineOpts) Execute() error {
 		return fmt.Errorf("convert environments to deployment stage: %w", err)
 	}
 
+	// Environments can be added to the application after the pipeline was created,
+	// so make sure that the application has resources in every region that the stages deploy to.
+	o.prog.Start(fmt.Sprintf(fmtPipelineDeployRegionsStart, color.HighlightUserInput(o.appName)))
+	if err := o.pipelineDeployer.AddPipelineStagesToApp(o.app, stages); err != nil {
+		o.prog.Stop(log.Serrorf(fmtPipelineDeployRegionsFailed, color.HighlightUserInput(o.appName)))
+		return fmt.Errorf("add regional resources of pipeline stages to application %s: %w", o.appName, err)
+	}
+	o.prog.Stop(log.Ssuccessf(fmtPipelineDeployRegionsComplete, color.HighlightUserInput(o.appName)))
+
 	// get cross-regional resources
-	artifactBuckets, err := o.getArtifactBuckets()
+	artifactBuckets, err := o.getArtifactBuckets(stages)
 	if err != nil {
 		return fmt.Errorf("get cross-regional resources: %w", err)
 	}
 
+	build := deploy.PipelineBuildFromManifest(pipeline.Build)
+	buildspecPath, err := o.buildspecPath()
+	if err != nil {
+		return err
+	}
+	build.BuildspecPath = buildspecPath
+	// The buildspec of a pipeline with an image source must copy the pushed image instead of building it.
+	if _, ok := source.(*deploy.ECRSource); ok || pipeline.Build.RendersBuildspec() {
+		buildspec, err := o.buildspec(pipeline.Build, stages, artifactBuckets)
+		if err != nil {
+			return err
+		}
+		build.Buildspec = buildspec
+	}
+
 	deployPipelineInput := &deploy.CreatePipelineInput{
 		AppName:         o.appName,
 		Name:            pipeline.Name,
 		Source:          source,
-		Build:           deploy.PipelineBuildFromManifest(pipeline.Build),
+		Build:           build,
 		Stages:          stages,
 		ArtifactBuckets: artifactBuckets,
 		AdditionalTags:  o.app.Tags,
@@ -173,6 +237,81 @@ func (o *deployPipelineOpts) Execute() error {
 	return nil
 }
 
+// uploadWorkspace zips the copilot directory of the workspace and uploads it to the artifact bucket,
+// since the image source of the pipeline does not hold the manifests to deploy the image with.
+// The pipeline keeps deploying with this copy of the workspace until the next "copilot pipeline deploy".
+func (o *deployPipelineOpts) uploadWorkspace(source *deploy.ECRSource, envs []string) error {
+	workloads, err := o.ws.ListWorkloads()
+	if err != nil {
+		return fmt.Errorf("get workload names from workspace: %w", err)
+	}
+	if !contains(source.Workload, workloads) {
+		return fmt.Errorf("workload %s of the ECR source is not a workload in the workspace", source.Workload)
+	}
+	for _, workload := range workloads {
+		if workload == source.Workload {
+			continue
+		}
+		if err := o.validateNoImageBuild(workload, envs); err != nil {
+			return err
+		}
+	}
+	bucket, err := o.getBucketName()
+	if err != nil {
+		return fmt.Errorf("get bucket name: %w", err)
+	}
+	files, err := o.ws.ReadCopilotDir()
+	if err != nil {
+		return fmt.Errorf("read copilot directory: %w", err)
+	}
+	objects := make([]s3.NamedBinary, len(files))
+	for i, file := range files {
+		objects[i] = file
+	}
+	key := fmt.Sprintf(fmtPipelineWorkspaceKey, o.pipelineName)
+	if _, err := o.uploader.ZipAndUpload(bucket, key, objects...); err != nil {
+		return fmt.Errorf("upload workspace to s3 bucket %s: %w", bucket, err)
+	}
+	source.WorkspaceBucket = bucket
+	source.WorkspaceKey = key
+	o.uploadedWorkspace = true
+	return nil
+}
+
+// validateNoImageBuild returns an error if the workload builds its image from a Dockerfile in any of the environments,
+// since the zip of the copilot directory that a pipeline with an image source deploys from doesn't hold build contexts.
+func (o *deployPipelineOpts) validateNoImageBuild(workload string, envs []string) error {
+	type buildRequirer interface {
+		BuildRequired() (bool, error)
+	}
+	raw, err := o.ws.ReadWorkloadManifest(workload)
+	if err != nil {
+		return fmt.Errorf("read manifest of workload %s: %w", workload, err)
+	}
+	mft, err := manifest.UnmarshalWorkload(raw)
+	if err != nil {
+		return fmt.Errorf("unmarshal manifest of workload %s: %w", workload, err)
+	}
+	for _, env := range envs {
+		envMft, err := mft.ApplyEnv(env)
+		if err != nil {
+			return fmt.Errorf("apply environment %s override to workload %s: %w", env, workload, err)
+		}
+		wl, ok := envMft.(buildRequirer)
+		if !ok {
+			continue
+		}
+		required, err := wl.BuildRequired()
+		if err != nil {
+			return fmt.Errorf("check if workload %s requires building from local Dockerfile: %w", workload, err)
+		}
+		if required {
+			return fmt.Errorf(`workload %s builds its image from a Dockerfile in environment %s, which a pipeline with an ECR source can't build: set "image.location" in its manifest instead`, workload, env)
+		}
+	}
+	return nil
+}
+
 func (o *deployPipelineOpts) convertStages(manifestStages []manifest.PipelineStage) ([]deploy.PipelineStage, error) {
 	var stages []deploy.PipelineStage
 	workloads, err := o.ws.ListWorkloads()
@@ -185,6 +324,11 @@ func (o *deployPipelineOpts) convertStages(manifestStages []manifest.PipelineSta
 		if err != nil {
 			return nil, fmt.Errorf("get environment %s in application %s: %w", stage.Name, o.appName, err)
 		}
+		for name := range stage.Deployments {
+			if !contains(name, workloads) {
+				return nil, fmt.Errorf("deployment %s in stage %s is not a workload in the workspace", name, stage.Name)
+			}
+		}
 
 		pipelineStage := deploy.PipelineStage{
 			LocalWorkloads: workloads,
@@ -193,8 +337,11 @@ func (o *deployPipelineOpts) convertStages(manifestStages []manifest.PipelineSta
 				Region:    env.Region,
 				AccountID: env.AccountID,
 			},
-			RequiresApproval: stage.RequiresApproval,
-			TestCommands:     stage.TestCommands,
+			RequiresApproval:      stage.RequiresApproval,
+			ApprovalNotifications: stage.ApprovalNotifications,
+			TestCommands:          stage.TestCommands,
+			Deployments:           stage.Deployments,
+			PostDeployments:       o.postDeployments(stage),
 		}
 		stages = append(stages, pipelineStage)
 	}
@@ -202,19 +349,162 @@ func (o *deployPipelineOpts) convertStages(manifestStages []manifest.PipelineSta
 	return stages, nil
 }
 
-func (o *deployPipelineOpts) getArtifactBuckets() ([]deploy.ArtifactBucket, error) {
+// postDeployments returns the checks run after the workloads of the stage are deployed, sorted by name.
+// A check configured with an image runs a one-off task in the environment of the stage with "copilot task run".
+func (o *deployPipelineOpts) postDeployments(stage manifest.PipelineStage) []deploy.PostDeployment {
+	var names []string
+	for name := range stage.PostDeployments {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var checks []deploy.PostDeployment
+	for _, name := range names {
+		check := stage.PostDeployments[name]
+		if len(check.Commands) > 0 {
+			checks = append(checks, deploy.PostDeployment{
+				Name:     name,
+				Commands: check.Commands,
+			})
+			continue
+		}
+		taskRun := []string{"./copilot-linux", "task", "run",
+			"--" + appFlag, o.appName,
+			"--" + envFlag, stage.Name,
+			"--" + taskGroupNameFlag, name,
+			"--" + imageFlag, check.Image,
+		}
+		if check.Command != "" {
+			taskRun = append(taskRun, "--"+commandFlag, check.Command)
+		}
+		if len(check.Variables) > 0 {
+			var vars []string
+			for k, v := range check.Variables {
+				vars = append(vars, fmt.Sprintf("%s=%s", k, v))
+			}
+			sort.Strings(vars)
+			taskRun = append(taskRun, "--"+envVarsFlag, strings.Join(vars, ","))
+		}
+		taskRun = append(taskRun, "--"+followExitCodeFlag)
+		checks = append(checks, deploy.PostDeployment{
+			Name: name,
+			Commands: []string{
+				fmt.Sprintf("wget -q %s/copilot-linux-%s -O ./copilot-linux", binaryS3BucketPath, version.Version),
+				"chmod +x ./copilot-linux",
+				shellJoin(taskRun),
+			},
+		})
+	}
+	return checks
+}
+
+// shellJoin quotes each argument that contains characters special to the shell and joins them with spaces.
+func shellJoin(args []string) string {
+	quoted := make([]string, len(args))
+	for i, arg := range args {
+		if arg != "" && !strings.ContainsAny(arg, " \t\n'\"\\$`!&|;<>()*?[]{}#~") {
+			quoted[i] = arg
+			continue
+		}
+		quoted[i] = "'" + strings.ReplaceAll(arg, "'", `'\''`) + "'"
+	}
+	return strings.Join(quoted, " ")
+}
+
+// buildspecPath returns the path of the pipeline's buildspec relative to the root of the workspace.
+func (o *deployPipelineOpts) buildspecPath() (string, error) {
+	path, err := o.relPathToWorkspace(o.pipeline.BuildspecPath())
+	if err != nil {
+		return "", fmt.Errorf("get relative path of the buildspec for pipeline %s: %w", o.pipeline.Name, err)
+	}
+	return path, nil
+}
+
+// buildspec renders the pipeline's buildspec from the build section of the manifest and the steps owned by Copilot.
+func (o *deployPipelineOpts) buildspec(mfBuild *manifest.Build, stages []deploy.PipelineStage, buckets []deploy.ArtifactBucket) (string, error) {
+	manifestPath, err := o.relPathToWorkspace(o.pipeline.Path)
+	if err != nil {
+		return "", fmt.Errorf("get relative path of the manifest for pipeline %s: %w", o.pipeline.Name, err)
+	}
+	var artifactBuckets []artifactBucket
+	for _, bucket := range buckets {
+		region, err := bucket.Region()
+		if err != nil {
+			return "", err
+		}
+		var envNames []string
+		for _, stage := range stages {
+			if stage.AssociatedEnvironment == nil {
+				continue
+			}
+			if stage.Region == region {
+				envNames = append(envNames, stage.Name)
+			}
+		}
+		artifactBuckets = append(artifactBuckets, artifactBucket{
+			BucketName:   bucket.BucketName,
+			Region:       region,
+			Environments: envNames,
+		})
+	}
+	var commands, cachePaths []string
+	if mfBuild != nil {
+		commands = mfBuild.Commands
+		if mfBuild.Cache != nil && mfBuild.Cache.S3 != nil {
+			cachePaths = mfBuild.Cache.S3.Paths
+		}
+	}
+	content, err := o.parser.Parse(buildspecTemplatePath, buildspecConfig{
+		BinaryS3BucketPath: binaryS3BucketPath,
+		Version:            version.Version,
+		ManifestPath:       manifestPath,
+		ArtifactBuckets:    artifactBuckets,
+		Commands:           commands,
+		CachePaths:         cachePaths,
+	})
+	if err != nil {
+		return "", fmt.Errorf("render buildspec for pipeline %s: %w", o.pipeline.Name, err)
+	}
+	return content.String(), nil
+}
+
+func (o *deployPipelineOpts) relPathToWorkspace(path string) (string, error) {
+	wsPath, err := o.ws.Path()
+	if err != nil {
+		return "", fmt.Errorf("get workspace path: %w", err)
+	}
+	rel, err := filepath.Rel(wsPath, path)
+	if err != nil {
+		return "", err
+	}
+	return filepath.ToSlash(rel), nil
+}
+
+// getArtifactBuckets returns the artifact buckets of the application in every region,
+// and errors if there is no bucket in a region that one of the stages deploys to.
+func (o *deployPipelineOpts) getArtifactBuckets(stages []deploy.PipelineStage) ([]deploy.ArtifactBucket, error) {
 	regionalResources, err := o.pipelineDeployer.GetRegionalAppResources(o.app)
 	if err != nil {
 		return nil, err
 	}
 
 	var buckets []deploy.ArtifactBucket
+	regions := make(map[string]bool)
 	for _, resource := range regionalResources {
 		bucket := deploy.ArtifactBucket{
 			BucketName: resource.S3Bucket,
 			KeyArn:     resource.KMSKeyARN,
 		}
 		buckets = append(buckets, bucket)
+		regions[resource.Region] = true
+	}
+	for _, stage := range stages {
+		if stage.AssociatedEnvironment == nil {
+			continue
+		}
+		if !regions[stage.Region] {
+			return nil, fmt.Errorf("no artifact bucket in region %s for stage %s", stage.Region, stage.Name)
+		}
 	}
 
 	return buckets, nil
@@ -300,10 +590,15 @@ func (o *deployPipelineOpts) deployPipeline(in *deploy.CreatePipelineInput) erro
 
 // RecommendedActions returns follow-up actions the user can take after successfully executing the command.
 func (o *deployPipelineOpts) RecommendedActions() []string {
-	return []string{
+	actions := []string{
 		fmt.Sprintf("Run %s to see the state of your pipeline.", color.HighlightCode("copilot pipeline status")),
 		fmt.Sprintf("Run %s for info about your pipeline.", color.HighlightCode("copilot pipeline show")),
 	}
+	if o.uploadedWorkspace {
+		actions = append(actions, fmt.Sprintf("Your pipeline deploys with the copy of the %s directory uploaded by this command. Run %s again after you update your manifests.",
+			color.HighlightResource("copilot"), color.HighlightCode("copilot pipeline deploy")))
+	}
+	return actions
 }
 
 // BuildPipelineDeployCmd build the command for deploying a new pipeline or updating an existing pipeline.
@@ -317,6 +612,9 @@ func buildPipelineDeployCmd() *cobra.Command {
 		Example: `
   Deploys a pipeline for the services and jobs in your workspace.
   /code $ copilot pipeline deploy
+
+  Deploys the pipeline named "release" in your workspace.
+  /code $ copilot pipeline deploy --name release
 `,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newDeployPipelineOpts(vars)
@@ -326,6 +624,9 @@ func buildPipelineDeployCmd() *cobra.Command {
 			if err := opts.Validate(); err != nil {
 				return err
 			}
+			if err := opts.Ask(); err != nil {
+				return err
+			}
 			if err := opts.Execute(); err != nil {
 				return err
 			}
@@ -338,6 +639,7 @@ func buildPipelineDeployCmd() *cobra.Command {
 		}),
 	}
 	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", pipelineFlagDescription)
 	cmd.Flags().BoolVar(&vars.skipConfirmation, yesFlag, false, yesFlagDescription)
 	return cmd
 }
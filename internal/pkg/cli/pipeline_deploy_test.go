@@ -4,6 +4,7 @@
 package cli
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"testing"
@@ -13,9 +14,14 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/template"
+	templatemocks "github.com/aws/copilot-cli/internal/pkg/template/mocks"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/version"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
 )
 
 type deployPipelineMocks struct {
@@ -142,6 +148,126 @@ func TestDeployPipelineOpts_convertStages(t *testing.T) {
 			},
 			expectedError: nil,
 		},
+		"converts stages with deployments": {
+			stages: []manifest.PipelineStage{
+				{
+					Name: "test",
+					Deployments: manifest.Deployments{
+						"backend": nil,
+						"frontend": &manifest.Deployment{
+							DependsOn: []string{"backend"},
+						},
+					},
+				},
+			},
+			inAppName: "badgoose",
+			callMocks: func(m deployPipelineMocks) {
+				mockEnv := &config.Environment{
+					Name:      "test",
+					App:       "badgoose",
+					Region:    "us-west-2",
+					AccountID: "123456789012",
+				}
+				gomock.InOrder(
+					m.ws.EXPECT().ListWorkloads().Return([]string{"frontend", "backend"}, nil).Times(1),
+					m.envStore.EXPECT().GetEnvironment("badgoose", "test").Return(mockEnv, nil).Times(1),
+				)
+			},
+
+			expectedStages: []deploy.PipelineStage{
+				{
+					AssociatedEnvironment: &deploy.AssociatedEnvironment{
+						Name:      "test",
+						Region:    "us-west-2",
+						AccountID: "123456789012",
+					},
+					LocalWorkloads: []string{"frontend", "backend"},
+					Deployments: manifest.Deployments{
+						"backend": nil,
+						"frontend": &manifest.Deployment{
+							DependsOn: []string{"backend"},
+						},
+					},
+				},
+			},
+		},
+		"converts stages with post deployments": {
+			stages: []manifest.PipelineStage{
+				{
+					Name: "test",
+					PostDeployments: manifest.PostDeployments{
+						"smoke": &manifest.PostDeployment{
+							Commands: []string{"make smoke"},
+						},
+						"integration": &manifest.PostDeployment{
+							Image:   "public.ecr.aws/badgoose/tests:latest",
+							Command: "./run.sh --suite integration",
+							Variables: map[string]string{
+								"TARGET": "https://test.badgoose.com",
+								"DEBUG":  "true",
+							},
+						},
+					},
+				},
+			},
+			inAppName: "badgoose",
+			callMocks: func(m deployPipelineMocks) {
+				mockEnv := &config.Environment{
+					Name:      "test",
+					App:       "badgoose",
+					Region:    "us-west-2",
+					AccountID: "123456789012",
+				}
+				gomock.InOrder(
+					m.ws.EXPECT().ListWorkloads().Return([]string{"frontend"}, nil).Times(1),
+					m.envStore.EXPECT().GetEnvironment("badgoose", "test").Return(mockEnv, nil).Times(1),
+				)
+			},
+
+			expectedStages: []deploy.PipelineStage{
+				{
+					AssociatedEnvironment: &deploy.AssociatedEnvironment{
+						Name:      "test",
+						Region:    "us-west-2",
+						AccountID: "123456789012",
+					},
+					LocalWorkloads: []string{"frontend"},
+					PostDeployments: []deploy.PostDeployment{
+						{
+							Name: "integration",
+							Commands: []string{
+								fmt.Sprintf("wget -q %s/copilot-linux-%s -O ./copilot-linux", binaryS3BucketPath, version.Version),
+								"chmod +x ./copilot-linux",
+								"./copilot-linux task run --app badgoose --env test --task-group-name integration --image public.ecr.aws/badgoose/tests:latest --command './run.sh --suite integration' --env-vars DEBUG=true,TARGET=https://test.badgoose.com --follow-exit-code",
+							},
+						},
+						{
+							Name:     "smoke",
+							Commands: []string{"make smoke"},
+						},
+					},
+				},
+			},
+		},
+		"errors if a deployment is not a workload in the workspace": {
+			stages: []manifest.PipelineStage{
+				{
+					Name: "test",
+					Deployments: manifest.Deployments{
+						"migration": nil,
+					},
+				},
+			},
+			inAppName: "badgoose",
+			callMocks: func(m deployPipelineMocks) {
+				gomock.InOrder(
+					m.ws.EXPECT().ListWorkloads().Return([]string{"frontend", "backend"}, nil).Times(1),
+					m.envStore.EXPECT().GetEnvironment("badgoose", "test").Return(&config.Environment{}, nil).Times(1),
+				)
+			},
+
+			expectedError: errors.New("deployment migration in stage test is not a workload in the workspace"),
+		},
 	}
 
 	for name, tc := range testCases {
@@ -172,7 +298,7 @@ func TestDeployPipelineOpts_convertStages(t *testing.T) {
 
 			// THEN
 			if tc.expectedError != nil {
-				require.Equal(t, tc.expectedError, err)
+				require.EqualError(t, err, tc.expectedError.Error())
 			} else {
 				require.NoError(t, err)
 				require.ElementsMatch(t, tc.expectedStages, actualStages)
@@ -181,8 +307,278 @@ func TestDeployPipelineOpts_convertStages(t *testing.T) {
 	}
 }
 
+func TestDeployPipelineOpts_Ask(t *testing.T) {
+	mockPipelines := []workspace.PipelineManifest{
+		{
+			Name: "pipeline-badgoose-legacy",
+			Path: "/ws/copilot/pipeline.yml",
+		},
+		{
+			Name: "release",
+			Path: "/ws/copilot/pipelines/release/manifest.yml",
+		},
+	}
+	testCases := map[string]struct {
+		inName    string
+		callMocks func(m deployPipelineMocks)
+
+		wantedPipeline *workspace.PipelineManifest
+		wantedError    error
+	}{
+		"returns error if there are no pipelines in the workspace": {
+			callMocks: func(m deployPipelineMocks) {
+				m.ws.EXPECT().ListPipelines().Return(nil, workspace.ErrNoPipelineInWorkspace)
+			},
+			wantedError: workspace.ErrNoPipelineInWorkspace,
+		},
+		"returns error if the pipeline passed by flag is not in the workspace": {
+			inName: "main",
+			callMocks: func(m deployPipelineMocks) {
+				m.ws.EXPECT().ListPipelines().Return(mockPipelines, nil)
+			},
+			wantedError: errors.New("pipeline main not found in the workspace"),
+		},
+		"selects the pipeline passed by flag": {
+			inName: "release",
+			callMocks: func(m deployPipelineMocks) {
+				m.ws.EXPECT().ListPipelines().Return(mockPipelines, nil)
+				m.prompt.EXPECT().SelectOne(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			wantedPipeline: &mockPipelines[1],
+		},
+		"uses the only pipeline in the workspace without prompting": {
+			callMocks: func(m deployPipelineMocks) {
+				m.ws.EXPECT().ListPipelines().Return(mockPipelines[:1], nil)
+				m.prompt.EXPECT().SelectOne(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			wantedPipeline: &mockPipelines[0],
+		},
+		"prompts for the pipeline if there are multiple pipelines in the workspace": {
+			callMocks: func(m deployPipelineMocks) {
+				m.ws.EXPECT().ListPipelines().Return(mockPipelines, nil)
+				m.prompt.EXPECT().SelectOne(pipelineDeploySelectPrompt, gomock.Any(), []string{"pipeline-badgoose-legacy", "release"}, gomock.Any()).Return("release", nil)
+			},
+			wantedPipeline: &mockPipelines[1],
+		},
+		"returns error if the prompt fails": {
+			callMocks: func(m deployPipelineMocks) {
+				m.ws.EXPECT().ListPipelines().Return(mockPipelines, nil)
+				m.prompt.EXPECT().SelectOne(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return("", errors.New("some error"))
+			},
+			wantedError: errors.New("select pipeline: some error"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := deployPipelineMocks{
+				prompt: mocks.NewMockprompter(ctrl),
+				ws:     mocks.NewMockwsPipelineReader(ctrl),
+			}
+			tc.callMocks(m)
+
+			opts := &deployPipelineOpts{
+				deployPipelineVars: deployPipelineVars{
+					name: tc.inName,
+				},
+				ws:     m.ws,
+				prompt: m.prompt,
+			}
+
+			// WHEN
+			err := opts.Ask()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedPipeline, opts.pipeline)
+			}
+		})
+	}
+}
+
+func TestDeployPipelineOpts_buildspecPath(t *testing.T) {
+	testCases := map[string]struct {
+		inPipeline *workspace.PipelineManifest
+
+		wantedPath string
+	}{
+		"legacy pipeline": {
+			inPipeline: &workspace.PipelineManifest{
+				Name: "pipeline-badgoose-legacy",
+				Path: "/ws/copilot/pipeline.yml",
+			},
+			wantedPath: "copilot/buildspec.yml",
+		},
+		"pipeline under the pipelines directory": {
+			inPipeline: &workspace.PipelineManifest{
+				Name: "release",
+				Path: "/ws/copilot/pipelines/release/manifest.yml",
+			},
+			wantedPath: "copilot/pipelines/release/buildspec.yml",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockWorkspace := mocks.NewMockwsPipelineReader(ctrl)
+			mockWorkspace.EXPECT().Path().Return("/ws", nil)
+			opts := &deployPipelineOpts{
+				ws:       mockWorkspace,
+				pipeline: tc.inPipeline,
+			}
+
+			// WHEN
+			path, err := opts.buildspecPath()
+
+			// THEN
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedPath, path)
+		})
+	}
+}
+
+func TestDeployPipelineOpts_buildspec(t *testing.T) {
+	mockBuild := &manifest.Build{
+		Commands: []string{"make test"},
+		Cache: &manifest.BuildCache{
+			S3: &manifest.BuildS3Cache{
+				Location: "my-bucket/cache",
+				Paths:    []string{"/root/.cache/go-build/**/*"},
+			},
+		},
+	}
+	mockStages := []deploy.PipelineStage{
+		{
+			AssociatedEnvironment: &deploy.AssociatedEnvironment{
+				Name:   "test",
+				Region: "us-west-2",
+			},
+		},
+		{
+			AssociatedEnvironment: &deploy.AssociatedEnvironment{
+				Name:   "prod",
+				Region: "us-east-1",
+			},
+		},
+	}
+	mockBuckets := []deploy.ArtifactBucket{
+		{
+			BucketName: "badgoose-bucket",
+			KeyArn:     "arn:aws:kms:us-west-2:123456789012:key/1234",
+		},
+	}
+	testCases := map[string]struct {
+		mockParser func(m *templatemocks.MockParser)
+
+		wantedBuildspec string
+		wantedError     error
+	}{
+		"renders the buildspec with the build commands and cache paths": {
+			mockParser: func(m *templatemocks.MockParser) {
+				m.EXPECT().Parse(buildspecTemplatePath, gomock.Any()).DoAndReturn(func(_ string, data interface{}, _ ...template.ParseOption) (*template.Content, error) {
+					config := data.(buildspecConfig)
+					require.Equal(t, "copilot/pipelines/release/manifest.yml", config.ManifestPath)
+					require.Equal(t, []string{"make test"}, config.Commands)
+					require.Equal(t, []string{"/root/.cache/go-build/**/*"}, config.CachePaths)
+					require.Equal(t, []artifactBucket{
+						{
+							BucketName:   "badgoose-bucket",
+							Region:       "us-west-2",
+							Environments: []string{"test"},
+						},
+					}, config.ArtifactBuckets)
+					return &template.Content{Buffer: bytes.NewBufferString("version: 0.2")}, nil
+				})
+			},
+			wantedBuildspec: "version: 0.2",
+		},
+		"returns wrapped error if the buildspec cannot be rendered": {
+			mockParser: func(m *templatemocks.MockParser) {
+				m.EXPECT().Parse(buildspecTemplatePath, gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("render buildspec for pipeline release: some error"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockWorkspace := mocks.NewMockwsPipelineReader(ctrl)
+			mockWorkspace.EXPECT().Path().Return("/ws", nil)
+			mockParser := templatemocks.NewMockParser(ctrl)
+			tc.mockParser(mockParser)
+			opts := &deployPipelineOpts{
+				ws:     mockWorkspace,
+				parser: mockParser,
+				pipeline: &workspace.PipelineManifest{
+					Name: "release",
+					Path: "/ws/copilot/pipelines/release/manifest.yml",
+				},
+			}
+
+			// WHEN
+			buildspec, err := opts.buildspec(mockBuild, mockStages, mockBuckets)
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedBuildspec, buildspec)
+			}
+		})
+	}
+}
+
+func TestDeployPipelineOpts_buildspecCommands(t *testing.T) {
+	// GIVEN
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockWorkspace := mocks.NewMockwsPipelineReader(ctrl)
+	mockWorkspace.EXPECT().Path().Return("/ws", nil)
+	opts := &deployPipelineOpts{
+		ws:     mockWorkspace,
+		parser: template.New(),
+		pipeline: &workspace.PipelineManifest{
+			Name: "release",
+			Path: "/ws/copilot/pipelines/release/manifest.yml",
+		},
+	}
+	commands := []string{
+		`echo "key: value"`,
+		"make test # run the unit tests",
+	}
+
+	// WHEN
+	buildspec, err := opts.buildspec(&manifest.Build{Commands: commands}, nil, nil)
+
+	// THEN
+	require.NoError(t, err)
+	var rendered struct {
+		Phases struct {
+			Build struct {
+				Commands []string `yaml:"commands"`
+			} `yaml:"build"`
+		} `yaml:"phases"`
+	}
+	require.NoError(t, yaml.Unmarshal([]byte(buildspec), &rendered))
+	require.Equal(t, commands, rendered.Phases.Build.Commands)
+}
+
 func TestDeployPipelineOpts_getArtifactBuckets(t *testing.T) {
 	testCases := map[string]struct {
+		inStages     []deploy.PipelineStage
 		mockDeployer func(m *mocks.MockpipelineDeployer)
 
 		expectedOut []deploy.ArtifactBucket
@@ -195,6 +591,7 @@ func TestDeployPipelineOpts_getArtifactBuckets(t *testing.T) {
 					{
 						S3Bucket:  "someBucket",
 						KMSKeyARN: "someKey",
+						Region:    "us-west-2",
 					},
 				}
 				m.EXPECT().GetRegionalAppResources(gomock.Any()).Return(mockResources, nil)
@@ -206,6 +603,46 @@ func TestDeployPipelineOpts_getArtifactBuckets(t *testing.T) {
 				},
 			},
 		},
+		"errors if a stage deploys to a region without a bucket": {
+			inStages: []deploy.PipelineStage{
+				{
+					AssociatedEnvironment: &deploy.AssociatedEnvironment{Name: "prod", Region: "eu-west-1"},
+				},
+			},
+			mockDeployer: func(m *mocks.MockpipelineDeployer) {
+				m.EXPECT().GetRegionalAppResources(gomock.Any()).Return([]*stack.AppRegionalResources{
+					{
+						S3Bucket:  "someBucket",
+						KMSKeyARN: "someKey",
+						Region:    "us-west-2",
+					},
+				}, nil)
+			},
+			expectedError: errors.New("no artifact bucket in region eu-west-1 for stage prod"),
+		},
+		"skips stages without an environment": {
+			inStages: []deploy.PipelineStage{
+				{},
+				{
+					AssociatedEnvironment: &deploy.AssociatedEnvironment{Name: "test", Region: "us-west-2"},
+				},
+			},
+			mockDeployer: func(m *mocks.MockpipelineDeployer) {
+				m.EXPECT().GetRegionalAppResources(gomock.Any()).Return([]*stack.AppRegionalResources{
+					{
+						S3Bucket:  "someBucket",
+						KMSKeyARN: "someKey",
+						Region:    "us-west-2",
+					},
+				}, nil)
+			},
+			expectedOut: []deploy.ArtifactBucket{
+				{
+					BucketName: "someBucket",
+					KeyArn:     "someKey",
+				},
+			},
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -221,11 +658,11 @@ func TestDeployPipelineOpts_getArtifactBuckets(t *testing.T) {
 			}
 
 			// WHEN
-			actual, err := opts.getArtifactBuckets()
+			actual, err := opts.getArtifactBuckets(tc.inStages)
 
 			// THEN
 			if tc.expectedError != nil {
-				require.Equal(t, tc.expectedError, err)
+				require.EqualError(t, err, tc.expectedError.Error())
 			} else {
 				require.NoError(t, err)
 				require.ElementsMatch(t, tc.expectedOut, actual)
@@ -234,6 +671,140 @@ func TestDeployPipelineOpts_getArtifactBuckets(t *testing.T) {
 	}
 }
 
+func TestDeployPipelineOpts_uploadWorkspace(t *testing.T) {
+	const (
+		appName      = "badgoose"
+		region       = "us-west-2"
+		pipelineName = "release"
+		bucketName   = "badgoose-bucket"
+	)
+	app := config.Application{
+		Name: appName,
+	}
+	files := []workspace.File{
+		{Path: "copilot/.workspace", Data: []byte("application: badgoose")},
+		{Path: "copilot/api/manifest.yml", Data: []byte("name: api")},
+	}
+	workerWithImage := []byte(`name: worker
+type: Backend Service
+image:
+  location: nginx`)
+	workerWithBuild := []byte(`name: worker
+type: Backend Service
+image:
+  location: nginx
+environments:
+  test:
+    image:
+      build: worker/Dockerfile`)
+	testCases := map[string]struct {
+		callMocks func(m deployPipelineMocks, uploader *mocks.Mockuploader)
+
+		expectedSource *deploy.ECRSource
+		expectedError  error
+	}{
+		"wraps error if fail to list workloads": {
+			callMocks: func(m deployPipelineMocks, _ *mocks.Mockuploader) {
+				m.ws.EXPECT().ListWorkloads().Return(nil, errors.New("some error"))
+			},
+			expectedError: errors.New("get workload names from workspace: some error"),
+		},
+		"error if the workload of the source is not in the workspace": {
+			callMocks: func(m deployPipelineMocks, _ *mocks.Mockuploader) {
+				m.ws.EXPECT().ListWorkloads().Return([]string{"frontend"}, nil)
+			},
+			expectedError: errors.New("workload api of the ECR source is not a workload in the workspace"),
+		},
+		"wraps error if fail to read the manifest of another workload": {
+			callMocks: func(m deployPipelineMocks, _ *mocks.Mockuploader) {
+				m.ws.EXPECT().ListWorkloads().Return([]string{"api", "worker"}, nil)
+				m.ws.EXPECT().ReadWorkloadManifest("worker").Return(nil, errors.New("some error"))
+			},
+			expectedError: errors.New("read manifest of workload worker: some error"),
+		},
+		"error if another workload builds its image in an environment of the pipeline": {
+			callMocks: func(m deployPipelineMocks, _ *mocks.Mockuploader) {
+				m.ws.EXPECT().ListWorkloads().Return([]string{"api", "worker"}, nil)
+				m.ws.EXPECT().ReadWorkloadManifest("worker").Return(workspace.WorkloadManifest(workerWithBuild), nil)
+			},
+			expectedError: errors.New(`workload worker builds its image from a Dockerfile in environment test, which a pipeline with an ECR source can't build: set "image.location" in its manifest instead`),
+		},
+		"wraps error if fail to read the copilot directory": {
+			callMocks: func(m deployPipelineMocks, _ *mocks.Mockuploader) {
+				m.ws.EXPECT().ListWorkloads().Return([]string{"api"}, nil)
+				m.deployer.EXPECT().GetAppResourcesByRegion(&app, region).Return(&stack.AppRegionalResources{S3Bucket: bucketName}, nil)
+				m.ws.EXPECT().ReadCopilotDir().Return(nil, errors.New("some error"))
+			},
+			expectedError: errors.New("read copilot directory: some error"),
+		},
+		"wraps error if fail to upload the workspace": {
+			callMocks: func(m deployPipelineMocks, uploader *mocks.Mockuploader) {
+				m.ws.EXPECT().ListWorkloads().Return([]string{"api"}, nil)
+				m.deployer.EXPECT().GetAppResourcesByRegion(&app, region).Return(&stack.AppRegionalResources{S3Bucket: bucketName}, nil)
+				m.ws.EXPECT().ReadCopilotDir().Return(files, nil)
+				uploader.EXPECT().ZipAndUpload(bucketName, "manual/pipelines/release/workspace.zip", files[0], files[1]).Return("", errors.New("some error"))
+			},
+			expectedError: errors.New("upload workspace to s3 bucket badgoose-bucket: some error"),
+		},
+		"sets the location of the uploaded workspace": {
+			callMocks: func(m deployPipelineMocks, uploader *mocks.Mockuploader) {
+				m.ws.EXPECT().ListWorkloads().Return([]string{"api", "worker"}, nil)
+				m.ws.EXPECT().ReadWorkloadManifest("worker").Return(workspace.WorkloadManifest(workerWithImage), nil)
+				m.deployer.EXPECT().GetAppResourcesByRegion(&app, region).Return(&stack.AppRegionalResources{S3Bucket: bucketName}, nil)
+				m.ws.EXPECT().ReadCopilotDir().Return(files, nil)
+				uploader.EXPECT().ZipAndUpload(bucketName, "manual/pipelines/release/workspace.zip", files[0], files[1]).Return("https://url", nil)
+			},
+			expectedSource: &deploy.ECRSource{
+				ProviderName:    manifest.ECRProviderName,
+				RepositoryName:  "badgoose/api",
+				ImageTag:        "latest",
+				Workload:        "api",
+				WorkspaceBucket: bucketName,
+				WorkspaceKey:    "manual/pipelines/release/workspace.zip",
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := deployPipelineMocks{
+				deployer: mocks.NewMockpipelineDeployer(ctrl),
+				ws:       mocks.NewMockwsPipelineReader(ctrl),
+			}
+			uploader := mocks.NewMockuploader(ctrl)
+			tc.callMocks(m, uploader)
+			opts := &deployPipelineOpts{
+				app:              &app,
+				region:           region,
+				pipelineName:     pipelineName,
+				pipelineDeployer: m.deployer,
+				ws:               m.ws,
+				uploader:         uploader,
+			}
+			source := &deploy.ECRSource{
+				ProviderName:   manifest.ECRProviderName,
+				RepositoryName: "badgoose/api",
+				ImageTag:       "latest",
+				Workload:       "api",
+			}
+
+			// WHEN
+			err := opts.uploadWorkspace(source, []string{"test", "prod"})
+
+			// THEN
+			if tc.expectedError != nil {
+				require.EqualError(t, err, tc.expectedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expectedSource, source)
+			}
+		})
+	}
+}
+
 func TestDeployPipelineOpts_Execute(t *testing.T) {
 	const (
 		appName      = "badgoose"
@@ -274,6 +845,7 @@ stages:
 		{
 			S3Bucket:  "someBucket",
 			KMSKeyARN: "someKey",
+			Region:    region,
 		},
 	}
 
@@ -281,6 +853,11 @@ stages:
 		S3Bucket: "someOtherBucket",
 	}
 
+	mockPipeline := &workspace.PipelineManifest{
+		Name: pipelineName,
+		Path: "/ws/copilot/pipelines/pipepiper/manifest.yml",
+	}
+
 	mockEnv := &config.Environment{
 		Name:      "test",
 		App:       appName,
@@ -308,15 +885,21 @@ stages:
 					m.deployer.EXPECT().AddPipelineResourcesToApp(&app, region).Return(nil),
 					m.prog.EXPECT().Stop(log.Ssuccessf(fmtPipelineDeployResourcesComplete, appName)).Times(1),
 
-					m.ws.EXPECT().ReadPipelineManifest().Return([]byte(content), nil),
+					m.ws.EXPECT().ReadPipelineManifest(mockPipeline.Path).Return([]byte(content), nil),
 					m.ws.EXPECT().ListWorkloads().Return([]string{"frontend", "backend"}, nil).Times(1),
 
 					// convertStages
 					m.envStore.EXPECT().GetEnvironment(appName, "chicken").Return(mockEnv, nil).Times(1),
 					m.envStore.EXPECT().GetEnvironment(appName, "wings").Return(mockEnv, nil).Times(1),
 
+					// reconcile regional resources
+					m.prog.EXPECT().Start(fmt.Sprintf(fmtPipelineDeployRegionsStart, appName)).Times(1),
+					m.deployer.EXPECT().AddPipelineStagesToApp(&app, gomock.Any()).Return(nil),
+					m.prog.EXPECT().Stop(log.Ssuccessf(fmtPipelineDeployRegionsComplete, appName)).Times(1),
+
 					// getArtifactBuckets
 					m.deployer.EXPECT().GetRegionalAppResources(gomock.Any()).Return(mockResources, nil),
+					m.ws.EXPECT().Path().Return("/ws", nil),
 
 					// deployPipeline
 					m.deployer.EXPECT().PipelineExists(gomock.Any()).Return(false, nil),
@@ -338,15 +921,21 @@ stages:
 					m.deployer.EXPECT().AddPipelineResourcesToApp(&app, region).Return(nil),
 					m.prog.EXPECT().Stop(log.Ssuccessf(fmtPipelineDeployResourcesComplete, appName)).Times(1),
 
-					m.ws.EXPECT().ReadPipelineManifest().Return([]byte(content), nil),
+					m.ws.EXPECT().ReadPipelineManifest(mockPipeline.Path).Return([]byte(content), nil),
 					m.ws.EXPECT().ListWorkloads().Return([]string{"frontend", "backend"}, nil).Times(1),
 
 					// convertStages
 					m.envStore.EXPECT().GetEnvironment(appName, "chicken").Return(mockEnv, nil).Times(1),
 					m.envStore.EXPECT().GetEnvironment(appName, "wings").Return(mockEnv, nil).Times(1),
 
+					// reconcile regional resources
+					m.prog.EXPECT().Start(fmt.Sprintf(fmtPipelineDeployRegionsStart, appName)).Times(1),
+					m.deployer.EXPECT().AddPipelineStagesToApp(&app, gomock.Any()).Return(nil),
+					m.prog.EXPECT().Stop(log.Ssuccessf(fmtPipelineDeployRegionsComplete, appName)).Times(1),
+
 					// getArtifactBuckets
 					m.deployer.EXPECT().GetRegionalAppResources(gomock.Any()).Return(mockResources, nil),
+					m.ws.EXPECT().Path().Return("/ws", nil),
 
 					// deployPipeline
 					m.deployer.EXPECT().PipelineExists(gomock.Any()).Return(true, nil),
@@ -369,15 +958,21 @@ stages:
 					m.deployer.EXPECT().AddPipelineResourcesToApp(&app, region).Return(nil),
 					m.prog.EXPECT().Stop(log.Ssuccessf(fmtPipelineDeployResourcesComplete, appName)).Times(1),
 
-					m.ws.EXPECT().ReadPipelineManifest().Return([]byte(content), nil),
+					m.ws.EXPECT().ReadPipelineManifest(mockPipeline.Path).Return([]byte(content), nil),
 					m.ws.EXPECT().ListWorkloads().Return([]string{"frontend", "backend"}, nil).Times(1),
 
 					// convertStages
 					m.envStore.EXPECT().GetEnvironment(appName, "chicken").Return(mockEnv, nil).Times(1),
 					m.envStore.EXPECT().GetEnvironment(appName, "wings").Return(mockEnv, nil).Times(1),
 
+					// reconcile regional resources
+					m.prog.EXPECT().Start(fmt.Sprintf(fmtPipelineDeployRegionsStart, appName)).Times(1),
+					m.deployer.EXPECT().AddPipelineStagesToApp(&app, gomock.Any()).Return(nil),
+					m.prog.EXPECT().Stop(log.Ssuccessf(fmtPipelineDeployRegionsComplete, appName)).Times(1),
+
 					// getArtifactBuckets
 					m.deployer.EXPECT().GetRegionalAppResources(gomock.Any()).Return(mockResources, nil),
+					m.ws.EXPECT().Path().Return("/ws", nil),
 
 					// deployPipeline
 					m.deployer.EXPECT().PipelineExists(gomock.Any()).Return(true, nil),
@@ -397,15 +992,21 @@ stages:
 					m.deployer.EXPECT().AddPipelineResourcesToApp(&app, region).Return(nil),
 					m.prog.EXPECT().Stop(log.Ssuccessf(fmtPipelineDeployResourcesComplete, appName)).Times(1),
 
-					m.ws.EXPECT().ReadPipelineManifest().Return([]byte(content), nil),
+					m.ws.EXPECT().ReadPipelineManifest(mockPipeline.Path).Return([]byte(content), nil),
 					m.ws.EXPECT().ListWorkloads().Return([]string{"frontend", "backend"}, nil).Times(1),
 
 					// convertStages
 					m.envStore.EXPECT().GetEnvironment(appName, "chicken").Return(mockEnv, nil).Times(1),
 					m.envStore.EXPECT().GetEnvironment(appName, "wings").Return(mockEnv, nil).Times(1),
 
+					// reconcile regional resources
+					m.prog.EXPECT().Start(fmt.Sprintf(fmtPipelineDeployRegionsStart, appName)).Times(1),
+					m.deployer.EXPECT().AddPipelineStagesToApp(&app, gomock.Any()).Return(nil),
+					m.prog.EXPECT().Stop(log.Ssuccessf(fmtPipelineDeployRegionsComplete, appName)).Times(1),
+
 					// getArtifactBuckets
 					m.deployer.EXPECT().GetRegionalAppResources(gomock.Any()).Return(mockResources, nil),
+					m.ws.EXPECT().Path().Return("/ws", nil),
 
 					// deployPipeline
 					m.deployer.EXPECT().PipelineExists(gomock.Any()).Return(true, nil),
@@ -438,7 +1039,7 @@ stages:
 					m.deployer.EXPECT().AddPipelineResourcesToApp(&app, region).Return(nil),
 					m.prog.EXPECT().Stop(log.Ssuccessf(fmtPipelineDeployResourcesComplete, appName)).Times(1),
 
-					m.ws.EXPECT().ReadPipelineManifest().Return([]byte(content), errors.New("some error")),
+					m.ws.EXPECT().ReadPipelineManifest(mockPipeline.Path).Return([]byte(content), errors.New("some error")),
 				)
 			},
 			expectedError: fmt.Errorf("read pipeline manifest: some error"),
@@ -454,7 +1055,7 @@ stages:
 					m.deployer.EXPECT().AddPipelineResourcesToApp(&app, region).Return(nil),
 					m.prog.EXPECT().Stop(log.Ssuccessf(fmtPipelineDeployResourcesComplete, appName)).Times(1),
 
-					m.ws.EXPECT().ReadPipelineManifest().Return([]byte(content), nil),
+					m.ws.EXPECT().ReadPipelineManifest(mockPipeline.Path).Return([]byte(content), nil),
 				)
 			},
 			expectedError: fmt.Errorf("unmarshal pipeline manifest: pipeline.yml contains invalid schema version: 0"),
@@ -472,7 +1073,7 @@ version: 1
 					m.prog.EXPECT().Start(fmt.Sprintf(fmtPipelineDeployResourcesStart, appName)).Times(1),
 					m.deployer.EXPECT().AddPipelineResourcesToApp(&app, region).Return(nil),
 					m.prog.EXPECT().Stop(log.Ssuccessf(fmtPipelineDeployResourcesComplete, appName)).Times(1),
-					m.ws.EXPECT().ReadPipelineManifest().Return([]byte(content), nil),
+					m.ws.EXPECT().ReadPipelineManifest(mockPipeline.Path).Return([]byte(content), nil),
 				)
 			},
 			expectedError: fmt.Errorf("pipeline name '12345678101234567820123456783012345678401234567850123456786012345678701234567880123456789012345671001' must be shorter than 100 characters"),
@@ -497,7 +1098,7 @@ source:
 					m.prog.EXPECT().Start(fmt.Sprintf(fmtPipelineDeployResourcesStart, appName)).Times(1),
 					m.deployer.EXPECT().AddPipelineResourcesToApp(&app, region).Return(nil),
 					m.prog.EXPECT().Stop(log.Ssuccessf(fmtPipelineDeployResourcesComplete, appName)).Times(1),
-					m.ws.EXPECT().ReadPipelineManifest().Return([]byte(content), nil),
+					m.ws.EXPECT().ReadPipelineManifest(mockPipeline.Path).Return([]byte(content), nil),
 				)
 			},
 			expectedError: fmt.Errorf("read source from manifest: invalid repo source provider: NotGitHub"),
@@ -512,12 +1113,37 @@ source:
 					m.deployer.EXPECT().AddPipelineResourcesToApp(&app, region).Return(nil),
 					m.prog.EXPECT().Stop(log.Ssuccessf(fmtPipelineDeployResourcesComplete, appName)).Times(1),
 
-					m.ws.EXPECT().ReadPipelineManifest().Return([]byte(content), nil),
+					m.ws.EXPECT().ReadPipelineManifest(mockPipeline.Path).Return([]byte(content), nil),
 					m.ws.EXPECT().ListWorkloads().Return(nil, errors.New("some error")).Times(1),
 				)
 			},
 			expectedError: fmt.Errorf("convert environments to deployment stage: get workload names from workspace: some error"),
 		},
+		"returns an error if fails to add regional resources of the stages": {
+			inApp:     &app,
+			inRegion:  region,
+			inAppName: appName,
+			callMocks: func(m deployPipelineMocks) {
+				gomock.InOrder(
+					m.prog.EXPECT().Start(fmt.Sprintf(fmtPipelineDeployResourcesStart, appName)).Times(1),
+					m.deployer.EXPECT().AddPipelineResourcesToApp(&app, region).Return(nil),
+					m.prog.EXPECT().Stop(log.Ssuccessf(fmtPipelineDeployResourcesComplete, appName)).Times(1),
+
+					m.ws.EXPECT().ReadPipelineManifest(mockPipeline.Path).Return([]byte(content), nil),
+					m.ws.EXPECT().ListWorkloads().Return([]string{"frontend", "backend"}, nil).Times(1),
+
+					// convertStages
+					m.envStore.EXPECT().GetEnvironment(appName, "chicken").Return(mockEnv, nil).Times(1),
+					m.envStore.EXPECT().GetEnvironment(appName, "wings").Return(mockEnv, nil).Times(1),
+
+					// reconcile regional resources
+					m.prog.EXPECT().Start(fmt.Sprintf(fmtPipelineDeployRegionsStart, appName)).Times(1),
+					m.deployer.EXPECT().AddPipelineStagesToApp(&app, gomock.Any()).Return(errors.New("some error")),
+					m.prog.EXPECT().Stop(log.Serrorf(fmtPipelineDeployRegionsFailed, appName)).Times(1),
+				)
+			},
+			expectedError: fmt.Errorf("add regional resources of pipeline stages to application %s: some error", appName),
+		},
 		"returns an error if fails to get cross-regional resources": {
 			inApp:     &app,
 			inRegion:  region,
@@ -528,13 +1154,18 @@ source:
 					m.deployer.EXPECT().AddPipelineResourcesToApp(&app, region).Return(nil),
 					m.prog.EXPECT().Stop(log.Ssuccessf(fmtPipelineDeployResourcesComplete, appName)).Times(1),
 
-					m.ws.EXPECT().ReadPipelineManifest().Return([]byte(content), nil),
+					m.ws.EXPECT().ReadPipelineManifest(mockPipeline.Path).Return([]byte(content), nil),
 					m.ws.EXPECT().ListWorkloads().Return([]string{"frontend", "backend"}, nil).Times(1),
 
 					// convertStages
 					m.envStore.EXPECT().GetEnvironment(appName, "chicken").Return(mockEnv, nil).Times(1),
 					m.envStore.EXPECT().GetEnvironment(appName, "wings").Return(mockEnv, nil).Times(1),
 
+					// reconcile regional resources
+					m.prog.EXPECT().Start(fmt.Sprintf(fmtPipelineDeployRegionsStart, appName)).Times(1),
+					m.deployer.EXPECT().AddPipelineStagesToApp(&app, gomock.Any()).Return(nil),
+					m.prog.EXPECT().Stop(log.Ssuccessf(fmtPipelineDeployRegionsComplete, appName)).Times(1),
+
 					// getArtifactBuckets
 					m.deployer.EXPECT().GetRegionalAppResources(gomock.Any()).Return(mockResources, errors.New("some error")),
 				)
@@ -551,15 +1182,21 @@ source:
 					m.deployer.EXPECT().AddPipelineResourcesToApp(&app, region).Return(nil),
 					m.prog.EXPECT().Stop(log.Ssuccessf(fmtPipelineDeployResourcesComplete, appName)).Times(1),
 
-					m.ws.EXPECT().ReadPipelineManifest().Return([]byte(content), nil),
+					m.ws.EXPECT().ReadPipelineManifest(mockPipeline.Path).Return([]byte(content), nil),
 					m.ws.EXPECT().ListWorkloads().Return([]string{"frontend", "backend"}, nil).Times(1),
 
 					// convertStages
 					m.envStore.EXPECT().GetEnvironment(appName, "chicken").Return(mockEnv, nil).Times(1),
 					m.envStore.EXPECT().GetEnvironment(appName, "wings").Return(mockEnv, nil).Times(1),
 
+					// reconcile regional resources
+					m.prog.EXPECT().Start(fmt.Sprintf(fmtPipelineDeployRegionsStart, appName)).Times(1),
+					m.deployer.EXPECT().AddPipelineStagesToApp(&app, gomock.Any()).Return(nil),
+					m.prog.EXPECT().Stop(log.Ssuccessf(fmtPipelineDeployRegionsComplete, appName)).Times(1),
+
 					// getArtifactBuckets
 					m.deployer.EXPECT().GetRegionalAppResources(gomock.Any()).Return(mockResources, nil),
+					m.ws.EXPECT().Path().Return("/ws", nil),
 
 					// deployPipeline
 					m.deployer.EXPECT().PipelineExists(gomock.Any()).Return(false, errors.New("some error")),
@@ -577,15 +1214,21 @@ source:
 					m.deployer.EXPECT().AddPipelineResourcesToApp(&app, region).Return(nil),
 					m.prog.EXPECT().Stop(log.Ssuccessf(fmtPipelineDeployResourcesComplete, appName)).Times(1),
 
-					m.ws.EXPECT().ReadPipelineManifest().Return([]byte(content), nil),
+					m.ws.EXPECT().ReadPipelineManifest(mockPipeline.Path).Return([]byte(content), nil),
 					m.ws.EXPECT().ListWorkloads().Return([]string{"frontend", "backend"}, nil).Times(1),
 
 					// convertStages
 					m.envStore.EXPECT().GetEnvironment(appName, "chicken").Return(mockEnv, nil).Times(1),
 					m.envStore.EXPECT().GetEnvironment(appName, "wings").Return(mockEnv, nil).Times(1),
 
+					// reconcile regional resources
+					m.prog.EXPECT().Start(fmt.Sprintf(fmtPipelineDeployRegionsStart, appName)).Times(1),
+					m.deployer.EXPECT().AddPipelineStagesToApp(&app, gomock.Any()).Return(nil),
+					m.prog.EXPECT().Stop(log.Ssuccessf(fmtPipelineDeployRegionsComplete, appName)).Times(1),
+
 					// getArtifactBuckets
 					m.deployer.EXPECT().GetRegionalAppResources(gomock.Any()).Return(mockResources, nil),
+					m.ws.EXPECT().Path().Return("/ws", nil),
 
 					// deployPipeline
 					m.deployer.EXPECT().PipelineExists(gomock.Any()).Return(false, nil),
@@ -607,15 +1250,21 @@ source:
 					m.deployer.EXPECT().AddPipelineResourcesToApp(&app, region).Return(nil),
 					m.prog.EXPECT().Stop(log.Ssuccessf(fmtPipelineDeployResourcesComplete, appName)).Times(1),
 
-					m.ws.EXPECT().ReadPipelineManifest().Return([]byte(content), nil),
+					m.ws.EXPECT().ReadPipelineManifest(mockPipeline.Path).Return([]byte(content), nil),
 					m.ws.EXPECT().ListWorkloads().Return([]string{"frontend", "backend"}, nil).Times(1),
 
 					// convertStages
 					m.envStore.EXPECT().GetEnvironment(appName, "chicken").Return(mockEnv, nil).Times(1),
 					m.envStore.EXPECT().GetEnvironment(appName, "wings").Return(mockEnv, nil).Times(1),
 
+					// reconcile regional resources
+					m.prog.EXPECT().Start(fmt.Sprintf(fmtPipelineDeployRegionsStart, appName)).Times(1),
+					m.deployer.EXPECT().AddPipelineStagesToApp(&app, gomock.Any()).Return(nil),
+					m.prog.EXPECT().Stop(log.Ssuccessf(fmtPipelineDeployRegionsComplete, appName)).Times(1),
+
 					// getArtifactBuckets
 					m.deployer.EXPECT().GetRegionalAppResources(gomock.Any()).Return(mockResources, nil),
+					m.ws.EXPECT().Path().Return("/ws", nil),
 
 					// deployPipeline
 					m.deployer.EXPECT().PipelineExists(gomock.Any()).Return(true, nil),
@@ -664,15 +1313,21 @@ stages:
 					m.deployer.EXPECT().AddPipelineResourcesToApp(&app, region).Return(nil),
 					m.prog.EXPECT().Stop(log.Ssuccessf(fmtPipelineDeployResourcesComplete, appName)).Times(1),
 
-					m.ws.EXPECT().ReadPipelineManifest().Return([]byte(content), nil),
+					m.ws.EXPECT().ReadPipelineManifest(mockPipeline.Path).Return([]byte(content), nil),
 					m.ws.EXPECT().ListWorkloads().Return([]string{"frontend", "backend"}, nil).Times(1),
 
 					// convertStages
 					m.envStore.EXPECT().GetEnvironment(appName, "chicken").Return(mockEnv, nil).Times(1),
 					m.envStore.EXPECT().GetEnvironment(appName, "wings").Return(mockEnv, nil).Times(1),
 
+					// reconcile regional resources
+					m.prog.EXPECT().Start(fmt.Sprintf(fmtPipelineDeployRegionsStart, appName)).Times(1),
+					m.deployer.EXPECT().AddPipelineStagesToApp(&app, gomock.Any()).Return(nil),
+					m.prog.EXPECT().Stop(log.Ssuccessf(fmtPipelineDeployRegionsComplete, appName)).Times(1),
+
 					// getArtifactBuckets
 					m.deployer.EXPECT().GetRegionalAppResources(gomock.Any()).Return(mockResources, nil),
+					m.ws.EXPECT().Path().Return("/ws", nil),
 
 					// deployPipeline
 					m.deployer.EXPECT().PipelineExists(gomock.Any()).Return(true, nil),
@@ -720,6 +1375,7 @@ stages:
 				prog:             mockProgress,
 				prompt:           mockPrompt,
 
+				pipeline:     mockPipeline,
 				pipelineName: tc.inPipelineName,
 			}
 
@@ -0,0 +1,173 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/spf13/cobra"
+)
+
+const (
+	pipelineHistoryAppNamePrompt          = "Which application's pipeline history would you like to show?"
+	pipelineHistoryAppNameHelpPrompt      = "An application is a collection of related services."
+	fmtPipelineHistoryPipelineNamePrompt  = "Which pipeline of %s would you like to show the history of?"
+	pipelineHistoryPipelineNameHelpPrompt = "The most recent executions of the pipeline will be shown (e.g., status, commit, duration)."
+
+	defaultPipelineHistoryLimit = 10
+)
+
+type pipelineHistoryVars struct {
+	appName          string
+	pipelineName     string
+	limit            int
+	shouldOutputJSON bool
+}
+
+type pipelineHistoryOpts struct {
+	pipelineHistoryVars
+
+	w             io.Writer
+	ws            wsPipelineLister
+	store         store
+	pipelineSvc   pipelineGetter
+	describer     describer
+	initDescriber func() error
+	sel           appSelector
+	prompt        prompter
+}
+
+func newPipelineHistoryOpts(vars pipelineHistoryVars) (*pipelineHistoryOpts, error) {
+	store, err := config.NewStore()
+	if err != nil {
+		return nil, fmt.Errorf("new config store client: %w", err)
+	}
+
+	ws, err := workspace.New()
+	if err != nil {
+		return nil, fmt.Errorf("new workspace client: %w", err)
+	}
+
+	defaultSession, err := sessions.NewProvider().Default()
+	if err != nil {
+		return nil, fmt.Errorf("default session: %w", err)
+	}
+
+	prompter := prompt.New()
+	opts := &pipelineHistoryOpts{
+		pipelineHistoryVars: vars,
+		w:                   log.OutputWriter,
+		ws:                  ws,
+		store:               store,
+		pipelineSvc:         codepipeline.New(defaultSession),
+		sel:                 selector.NewSelect(prompter, store),
+		prompt:              prompter,
+	}
+	opts.initDescriber = func() error {
+		d, err := describe.NewPipelineHistoryDescriber(opts.pipelineName, opts.limit)
+		if err != nil {
+			return fmt.Errorf("new pipeline history describer: %w", err)
+		}
+		opts.describer = d
+		return nil
+	}
+	return opts, nil
+}
+
+// Validate returns an error if the values provided by the user are invalid.
+func (o *pipelineHistoryOpts) Validate() error {
+	if o.limit <= 0 {
+		return fmt.Errorf("--%s must be a positive integer", limitFlag)
+	}
+	if o.appName != "" {
+		if _, err := o.store.GetApplication(o.appName); err != nil {
+			return err
+		}
+	}
+	if o.pipelineName != "" {
+		if _, err := o.pipelineSvc.GetPipeline(o.pipelineName); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Ask prompts for fields that are required but not passed in.
+func (o *pipelineHistoryOpts) Ask() error {
+	if o.appName == "" {
+		name, err := o.sel.Application(pipelineHistoryAppNamePrompt, pipelineHistoryAppNameHelpPrompt)
+		if err != nil {
+			return fmt.Errorf("select application: %w", err)
+		}
+		o.appName = name
+	}
+	if o.pipelineName != "" {
+		return nil
+	}
+	name, err := selectPipelineName(o.ws, o.pipelineSvc, o.prompt, o.appName,
+		fmt.Sprintf(fmtPipelineHistoryPipelineNamePrompt, color.HighlightUserInput(o.appName)), pipelineHistoryPipelineNameHelpPrompt)
+	if err != nil {
+		return err
+	}
+	o.pipelineName = name
+	return nil
+}
+
+// Execute shows the most recent executions of the pipeline.
+func (o *pipelineHistoryOpts) Execute() error {
+	if err := o.initDescriber(); err != nil {
+		return err
+	}
+	history, err := o.describer.Describe()
+	if err != nil {
+		return fmt.Errorf("describe history of pipeline %s: %w", o.pipelineName, err)
+	}
+	if o.shouldOutputJSON {
+		data, err := history.JSONString()
+		if err != nil {
+			return err
+		}
+		fmt.Fprint(o.w, data)
+	} else {
+		fmt.Fprint(o.w, history.HumanString())
+	}
+	return nil
+}
+
+// buildPipelineHistoryCmd builds the command for showing the past executions of a pipeline.
+func buildPipelineHistoryCmd() *cobra.Command {
+	vars := pipelineHistoryVars{}
+	cmd := &cobra.Command{
+		Use:   "history",
+		Short: "Shows the past executions of a pipeline.",
+		Long: `Shows the most recent executions of a pipeline, including the commit that triggered them, their status and duration.
+Commit authors are only shown for CodeCommit sources. They are "unavailable" for GitHub, Bitbucket and CodeStar connection sources.`,
+
+		Example: `
+  Shows the last 20 executions of the pipeline "pipeline-myapp-myrepo".
+  /code $ copilot pipeline history -n pipeline-myapp-myrepo --limit 20`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newPipelineHistoryOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.pipelineName, nameFlag, nameFlagShort, "", pipelineFlagDescription)
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().IntVar(&vars.limit, limitFlag, defaultPipelineHistoryLimit, historyLimitFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
+	return cmd
+}
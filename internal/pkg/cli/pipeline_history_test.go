@@ -0,0 +1,287 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type pipelineHistoryMocks struct {
+	store       *mocks.Mockstore
+	ws          *mocks.MockwsPipelineLister
+	prompt      *mocks.Mockprompter
+	pipelineSvc *mocks.MockpipelineGetter
+	describer   *mocks.Mockdescriber
+	sel         *mocks.MockappSelector
+}
+
+func TestPipelineHistoryOpts_Validate(t *testing.T) {
+	const (
+		mockAppName      = "dinder"
+		mockPipelineName = "pipeline-dinder-badgoose-repo"
+	)
+	mockError := errors.New("mock error")
+	testCases := map[string]struct {
+		inAppName      string
+		inPipelineName string
+		inLimit        int
+		setupMocks     func(m pipelineHistoryMocks)
+
+		wantedError error
+	}{
+		"errors if limit is not positive": {
+			inLimit:     0,
+			setupMocks:  func(m pipelineHistoryMocks) {},
+			wantedError: errors.New("--limit must be a positive integer"),
+		},
+		"errors if app name is invalid": {
+			inAppName: mockAppName,
+			inLimit:   10,
+			setupMocks: func(m pipelineHistoryMocks) {
+				m.store.EXPECT().GetApplication(mockAppName).Return(nil, mockError)
+			},
+			wantedError: mockError,
+		},
+		"errors if pipeline name is invalid": {
+			inAppName:      mockAppName,
+			inPipelineName: mockPipelineName,
+			inLimit:        10,
+			setupMocks: func(m pipelineHistoryMocks) {
+				m.store.EXPECT().GetApplication(mockAppName).Return(&config.Application{Name: mockAppName}, nil)
+				m.pipelineSvc.EXPECT().GetPipeline(mockPipelineName).Return(nil, mockError)
+			},
+			wantedError: mockError,
+		},
+		"success": {
+			inAppName:      mockAppName,
+			inPipelineName: mockPipelineName,
+			inLimit:        10,
+			setupMocks: func(m pipelineHistoryMocks) {
+				m.store.EXPECT().GetApplication(mockAppName).Return(&config.Application{Name: mockAppName}, nil)
+				m.pipelineSvc.EXPECT().GetPipeline(mockPipelineName).Return(&codepipeline.Pipeline{}, nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := pipelineHistoryMocks{
+				store:       mocks.NewMockstore(ctrl),
+				pipelineSvc: mocks.NewMockpipelineGetter(ctrl),
+			}
+			tc.setupMocks(m)
+
+			opts := &pipelineHistoryOpts{
+				pipelineHistoryVars: pipelineHistoryVars{
+					appName:      tc.inAppName,
+					pipelineName: tc.inPipelineName,
+					limit:        tc.inLimit,
+				},
+				store:       m.store,
+				pipelineSvc: m.pipelineSvc,
+			}
+
+			// WHEN
+			err := opts.Validate()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestPipelineHistoryOpts_Ask(t *testing.T) {
+	const (
+		mockAppName      = "dinder"
+		mockPipelineName = "pipeline-dinder-badgoose-repo"
+	)
+	mockError := errors.New("mock error")
+	testCases := map[string]struct {
+		inAppName      string
+		inPipelineName string
+		setupMocks     func(m pipelineHistoryMocks)
+
+		wantedAppName      string
+		wantedPipelineName string
+		wantedError        error
+	}{
+		"errors if fail to select application": {
+			setupMocks: func(m pipelineHistoryMocks) {
+				m.sel.EXPECT().Application(pipelineHistoryAppNamePrompt, pipelineHistoryAppNameHelpPrompt).Return("", mockError)
+			},
+			wantedError: fmt.Errorf("select application: %w", mockError),
+		},
+		"selects the pipeline in the workspace": {
+			setupMocks: func(m pipelineHistoryMocks) {
+				m.sel.EXPECT().Application(pipelineHistoryAppNamePrompt, pipelineHistoryAppNameHelpPrompt).Return(mockAppName, nil)
+				m.ws.EXPECT().ListPipelines().Return([]workspace.PipelineManifest{
+					{
+						Name: mockPipelineName,
+						Path: "/copilot/pipelines/badgoose/manifest.yml",
+					},
+				}, nil)
+			},
+			wantedAppName:      mockAppName,
+			wantedPipelineName: mockPipelineName,
+		},
+		"prompts among deployed pipelines if there are none in the workspace": {
+			inAppName: mockAppName,
+			setupMocks: func(m pipelineHistoryMocks) {
+				m.ws.EXPECT().ListPipelines().Return(nil, workspace.ErrNoPipelineInWorkspace)
+				m.pipelineSvc.EXPECT().ListPipelineNamesByTags(map[string]string{"copilot-application": mockAppName}).Return([]string{mockPipelineName, "pipeline-dinder-other"}, nil)
+				m.prompt.EXPECT().SelectOne(gomock.Any(), pipelineHistoryPipelineNameHelpPrompt, []string{mockPipelineName, "pipeline-dinder-other"}, gomock.Any()).Return(mockPipelineName, nil)
+			},
+			wantedAppName:      mockAppName,
+			wantedPipelineName: mockPipelineName,
+		},
+		"errors if there are no deployed pipelines": {
+			inAppName: mockAppName,
+			setupMocks: func(m pipelineHistoryMocks) {
+				m.ws.EXPECT().ListPipelines().Return(nil, workspace.ErrNoPipelineInWorkspace)
+				m.pipelineSvc.EXPECT().ListPipelineNamesByTags(map[string]string{"copilot-application": mockAppName}).Return(nil, nil)
+			},
+			wantedError: fmt.Errorf("no pipelines found for application %s", mockAppName),
+		},
+		"skips prompting if the pipeline is passed in": {
+			inAppName:          mockAppName,
+			inPipelineName:     mockPipelineName,
+			setupMocks:         func(m pipelineHistoryMocks) {},
+			wantedAppName:      mockAppName,
+			wantedPipelineName: mockPipelineName,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := pipelineHistoryMocks{
+				ws:          mocks.NewMockwsPipelineLister(ctrl),
+				prompt:      mocks.NewMockprompter(ctrl),
+				pipelineSvc: mocks.NewMockpipelineGetter(ctrl),
+				sel:         mocks.NewMockappSelector(ctrl),
+			}
+			tc.setupMocks(m)
+
+			opts := &pipelineHistoryOpts{
+				pipelineHistoryVars: pipelineHistoryVars{
+					appName:      tc.inAppName,
+					pipelineName: tc.inPipelineName,
+				},
+				ws:          m.ws,
+				prompt:      m.prompt,
+				pipelineSvc: m.pipelineSvc,
+				sel:         m.sel,
+			}
+
+			// WHEN
+			err := opts.Ask()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedAppName, opts.appName)
+				require.Equal(t, tc.wantedPipelineName, opts.pipelineName)
+			}
+		})
+	}
+}
+
+func TestPipelineHistoryOpts_Execute(t *testing.T) {
+	const mockPipelineName = "pipeline-dinder-badgoose-repo"
+	mockHistory := &describe.PipelineHistory{
+		PipelineName: mockPipelineName,
+		Executions: []codepipeline.ExecutionSummary{
+			{
+				ID:     "a1b2c3d4",
+				Status: "Succeeded",
+			},
+		},
+	}
+	testCases := map[string]struct {
+		shouldOutputJSON bool
+		setupMocks       func(m pipelineHistoryMocks)
+
+		wantedContent string
+		wantedError   error
+	}{
+		"errors if fail to describe the history": {
+			setupMocks: func(m pipelineHistoryMocks) {
+				m.describer.EXPECT().Describe().Return(nil, errors.New("some error"))
+			},
+			wantedError: fmt.Errorf("describe history of pipeline %s: some error", mockPipelineName),
+		},
+		"prints the history in JSON format": {
+			shouldOutputJSON: true,
+			setupMocks: func(m pipelineHistoryMocks) {
+				m.describer.EXPECT().Describe().Return(mockHistory, nil)
+			},
+			wantedContent: `{"pipelineName":"pipeline-dinder-badgoose-repo","executions":[{"id":"a1b2c3d4","status":"Succeeded","startedAt":"0001-01-01T00:00:00Z","updatedAt":"0001-01-01T00:00:00Z","trigger":""}]}` + "\n",
+		},
+		"prints the history in human format": {
+			setupMocks: func(m pipelineHistoryMocks) {
+				m.describer.EXPECT().Describe().Return(mockHistory, nil)
+			},
+			wantedContent: mockHistory.HumanString(),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := pipelineHistoryMocks{
+				describer: mocks.NewMockdescriber(ctrl),
+			}
+			tc.setupMocks(m)
+			b := &bytes.Buffer{}
+
+			opts := &pipelineHistoryOpts{
+				pipelineHistoryVars: pipelineHistoryVars{
+					pipelineName:     mockPipelineName,
+					shouldOutputJSON: tc.shouldOutputJSON,
+				},
+				w:             b,
+				initDescriber: func() error { return nil },
+				describer:     m.describer,
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedContent, b.String())
+			}
+		})
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"bytes"
 	"errors"
 	"fmt"
+	"path/filepath"
 	"regexp"
 	"strings"
 
@@ -47,8 +48,9 @@ Please enter full repository URL, e.g. "https://github.com/myCompany/myRepo", or
 )
 
 const (
-	buildspecTemplatePath = "cicd/buildspec.yml"
-	fmtPipelineName       = "pipeline-%s-%s" // Ex: "pipeline-appName-repoName"
+	buildspecTemplatePath   = "cicd/buildspec.yml"
+	fmtPipelineName         = "pipeline-%s-%s"                    // Ex: "pipeline-appName-repoName"
+	fmtPipelineManifestPath = "copilot/pipelines/%s/manifest.yml" // Ex: "copilot/pipelines/pipeline-appName-repoName/manifest.yml"
 	// For a GitHub repository.
 	githubURL       = "github.com"
 	defaultGHBranch = deploy.DefaultPipelineBranch
@@ -72,6 +74,7 @@ var (
 
 type initPipelineVars struct {
 	appName           string
+	name              string
 	environments      []string
 	repoURL           string
 	repoBranch        string
@@ -81,7 +84,7 @@ type initPipelineVars struct {
 type initPipelineOpts struct {
 	initPipelineVars
 	// Interfaces to interact with dependencies.
-	workspace      wsPipelineWriter
+	workspace      wsPipelineIniter
 	secretsmanager secretsManager
 	parser         template.Parser
 	runner         runner
@@ -110,6 +113,16 @@ type artifactBucket struct {
 	Environments []string
 }
 
+// buildspecConfig holds the data to render the buildspec of a pipeline.
+type buildspecConfig struct {
+	BinaryS3BucketPath string
+	Version            string
+	ManifestPath       string
+	ArtifactBuckets    []artifactBucket
+	Commands           []string // Commands of the "build" section in the pipeline manifest.
+	CachePaths         []string // Paths to cache in the S3 cache location.
+}
+
 func newInitPipelineOpts(vars initPipelineVars) (*initPipelineOpts, error) {
 	ws, err := workspace.New()
 	if err != nil {
@@ -158,6 +171,15 @@ func (o *initPipelineOpts) Validate() error {
 		return err
 	}
 
+	if o.name != "" {
+		if err := validatePipelineName(o.name); err != nil {
+			return err
+		}
+		if err := o.validateDuplicatePipeline(); err != nil {
+			return err
+		}
+	}
+
 	if o.repoURL != "" {
 		if err := o.validateURL(o.repoURL); err != nil {
 			return err
@@ -184,6 +206,12 @@ func (o *initPipelineOpts) Ask() error {
 	if err := o.askRepository(); err != nil {
 		return err
 	}
+	if o.name == "" {
+		o.name = o.pipelineName()
+		if err := o.validateDuplicatePipeline(); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -195,7 +223,7 @@ func (o *initPipelineOpts) Execute() error {
 		}
 	}
 
-	// write pipeline.yml file, populate with:
+	// write the pipeline manifest file, populate with:
 	//   - git repo as source
 	//   - stage names (environments)
 	//   - enable/disable transition to prod envs
@@ -211,8 +239,8 @@ func (o *initPipelineOpts) Execute() error {
 // RequiredActions returns follow-up actions the user must take after successfully executing the command.
 func (o *initPipelineOpts) RequiredActions() []string {
 	return []string{
-		fmt.Sprintf("Commit and push the %s, %s, and %s files of your %s directory to your repository.", color.HighlightResource("buildspec.yml"), color.HighlightResource("pipeline.yml"), color.HighlightResource(".workspace"), color.HighlightResource("copilot")),
-		fmt.Sprintf("Run %s to create your pipeline.", color.HighlightCode("copilot pipeline deploy")),
+		fmt.Sprintf("Commit and push the %s directory and the %s file of your %s directory to your repository.", color.HighlightResource(fmt.Sprintf("pipelines/%s", o.name)), color.HighlightResource(".workspace"), color.HighlightResource("copilot")),
+		fmt.Sprintf("Run %s to create your pipeline.", color.HighlightCode(fmt.Sprintf("copilot pipeline deploy --name %s", o.name))),
 	}
 }
 
@@ -501,8 +529,6 @@ func (o *initPipelineOpts) storeGitHubAccessToken() error {
 }
 
 func (o *initPipelineOpts) createPipelineManifest() error {
-	pipelineName := o.pipelineName()
-
 	provider, err := o.pipelineProvider()
 	if err != nil {
 		return err
@@ -518,13 +544,13 @@ func (o *initPipelineOpts) createPipelineManifest() error {
 		stages = append(stages, stage)
 	}
 
-	manifest, err := manifest.NewPipelineManifest(pipelineName, provider, stages)
+	manifest, err := manifest.NewPipelineManifest(o.name, provider, stages)
 	if err != nil {
 		return fmt.Errorf("generate a pipeline manifest: %w", err)
 	}
 
 	var manifestExists bool
-	manifestPath, err := o.workspace.WritePipelineManifest(manifest)
+	manifestPath, err := o.workspace.WritePipelineManifest(manifest, o.name)
 	if err != nil {
 		e, ok := err.(*workspace.ErrFileExists)
 		if !ok {
@@ -543,7 +569,7 @@ func (o *initPipelineOpts) createPipelineManifest() error {
 	if manifestExists {
 		manifestMsgFmt = "Pipeline manifest file for %s already exists at %s, skipping writing it.\n"
 	}
-	log.Successf(manifestMsgFmt, color.HighlightUserInput(o.repoName), color.HighlightResource(manifestPath))
+	log.Successf(manifestMsgFmt, color.HighlightUserInput(o.name), color.HighlightResource(manifestPath))
 	log.Infof(`The manifest contains configurations for your CodePipeline resources, such as your pipeline stages and build steps.
 Update the file to add additional stages, change the branch to be tracked, or add test commands or manual approval actions.
 `)
@@ -555,19 +581,16 @@ func (o *initPipelineOpts) createBuildspec() error {
 	if err != nil {
 		return err
 	}
-	content, err := o.parser.Parse(buildspecTemplatePath, struct {
-		BinaryS3BucketPath string
-		Version            string
-		ArtifactBuckets    []artifactBucket
-	}{
+	content, err := o.parser.Parse(buildspecTemplatePath, buildspecConfig{
 		BinaryS3BucketPath: binaryS3BucketPath,
 		Version:            version.Version,
+		ManifestPath:       fmt.Sprintf(fmtPipelineManifestPath, o.name),
 		ArtifactBuckets:    artifactBuckets,
 	})
 	if err != nil {
 		return err
 	}
-	buildspecPath, err := o.workspace.WritePipelineBuildspec(content)
+	buildspecPath, err := o.workspace.WritePipelineBuildspec(content, o.name)
 	var buildspecExists bool
 	if err != nil {
 		e, ok := err.(*workspace.ErrFileExists)
@@ -587,8 +610,8 @@ func (o *initPipelineOpts) createBuildspec() error {
 	}
 	log.Successf(buildspecMsgFmt, color.HighlightResource(buildspecPath))
 	log.Infof(`The buildspec contains the commands to build and push your container images to your ECR repositories.
-Update the %s phase to unit test your services before pushing the images.
-`, color.HighlightResource("build"))
+Add %s to the pipeline manifest to unit test your services before pushing the images.
+`, color.HighlightCode("build.commands"))
 
 	return nil
 }
@@ -597,6 +620,29 @@ func (o *initPipelineOpts) secretName() string {
 	return fmt.Sprintf(fmtSecretName, o.appName, o.repoName)
 }
 
+// validateDuplicatePipeline returns an error if a pipeline manifest in the workspace already uses the pipeline's name
+// but lives outside of the pipeline's directory, since all pipeline commands reject duplicate names.
+func (o *initPipelineOpts) validateDuplicatePipeline() error {
+	pipelines, err := o.workspace.ListPipelines()
+	if err != nil {
+		if errors.Is(err, workspace.ErrNoPipelineInWorkspace) {
+			return nil
+		}
+		return fmt.Errorf("list pipelines in the workspace: %w", err)
+	}
+	for _, pipeline := range pipelines {
+		if pipeline.Name != o.name {
+			continue
+		}
+		// Running "pipeline init" again for the same pipeline keeps its existing manifest.
+		if strings.HasSuffix(pipeline.Path, filepath.FromSlash(fmt.Sprintf(fmtPipelineManifestPath, o.name))) {
+			continue
+		}
+		return fmt.Errorf("pipeline %s already exists in the workspace at %s", o.name, pipeline.Path)
+	}
+	return nil
+}
+
 func (o *initPipelineOpts) pipelineName() string {
 	name := fmt.Sprintf(fmtPipelineName, o.appName, o.repoName)
 	if len(name) <= 100 {
@@ -674,7 +720,12 @@ func buildPipelineInitCmd() *cobra.Command {
   Create a pipeline for the services in your workspace.
   /code $ copilot pipeline init \
   /code  --url https://github.com/gitHubUserName/myFrontendApp.git \
-  /code  --environments "stage,prod"`,
+  /code  --environments "stage,prod"
+
+  Create a separate pipeline that tracks the release branch.
+  /code $ copilot pipeline init --name release \
+  /code  --url https://github.com/gitHubUserName/myFrontendApp.git \
+  /code  --git-branch release --environments "prod"`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newInitPipelineOpts(vars)
 			if err != nil {
@@ -698,6 +749,7 @@ func buildPipelineInitCmd() *cobra.Command {
 		}),
 	}
 	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", pipelineFlagDescription)
 	cmd.Flags().StringVar(&vars.repoURL, githubURLFlag, "", githubURLFlagDescription)
 	_ = cmd.Flags().MarkHidden(githubURLFlag)
 	cmd.Flags().StringVarP(&vars.repoURL, repoURLFlag, repoURLFlagShort, "", repoURLFlagDescription)
@@ -7,6 +7,7 @@ import (
 	"bytes"
 	"errors"
 	"fmt"
+	"path/filepath"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -27,9 +28,11 @@ import (
 func TestInitPipelineOpts_Validate(t *testing.T) {
 	testCases := map[string]struct {
 		inAppName     string
+		inName        string
 		inrepoURL     string
 		inEnvs        []string
 		setupMocks    func(m *mocks.Mockstore)
+		mockWs        func(m *mocks.MockwsPipelineIniter)
 		expectedError error
 	}{
 		"empty app name": {
@@ -45,6 +48,59 @@ func TestInitPipelineOpts_Validate(t *testing.T) {
 
 			expectedError: fmt.Errorf("get application ghost-app: some error"),
 		},
+		"invalid pipeline name": {
+			inAppName: "my-app",
+			inName:    "1234",
+			setupMocks: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("my-app").Return(&config.Application{Name: "my-app"}, nil)
+			},
+
+			expectedError: fmt.Errorf("pipeline name 1234 is invalid: %w", errValueBadFormat),
+		},
+		"pipeline name already used by another manifest": {
+			inAppName: "my-app",
+			inName:    "pipeline-my-app-chaos",
+			setupMocks: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("my-app").Return(&config.Application{Name: "my-app"}, nil)
+			},
+			mockWs: func(m *mocks.MockwsPipelineIniter) {
+				m.EXPECT().ListPipelines().Return([]workspace.PipelineManifest{
+					{
+						Name: "pipeline-my-app-chaos",
+						Path: filepath.FromSlash("/ws/copilot/pipeline.yml"),
+					},
+				}, nil)
+			},
+
+			expectedError: fmt.Errorf("pipeline pipeline-my-app-chaos already exists in the workspace at %s", filepath.FromSlash("/ws/copilot/pipeline.yml")),
+		},
+		"error if pipelines can't be listed": {
+			inAppName: "my-app",
+			inName:    "pipeline-my-app-chaos",
+			setupMocks: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("my-app").Return(&config.Application{Name: "my-app"}, nil)
+			},
+			mockWs: func(m *mocks.MockwsPipelineIniter) {
+				m.EXPECT().ListPipelines().Return(nil, errors.New("some error"))
+			},
+
+			expectedError: fmt.Errorf("list pipelines in the workspace: %w", errors.New("some error")),
+		},
+		"success when re-initializing the same pipeline": {
+			inAppName: "my-app",
+			inName:    "pipeline-my-app-chaos",
+			setupMocks: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("my-app").Return(&config.Application{Name: "my-app"}, nil)
+			},
+			mockWs: func(m *mocks.MockwsPipelineIniter) {
+				m.EXPECT().ListPipelines().Return([]workspace.PipelineManifest{
+					{
+						Name: "pipeline-my-app-chaos",
+						Path: filepath.FromSlash("/ws/copilot/pipelines/pipeline-my-app-chaos/manifest.yml"),
+					},
+				}, nil)
+			},
+		},
 		"URL to unsupported repo provider": {
 			inAppName: "my-app",
 			inrepoURL: "unsupported.org/repositories/repoName",
@@ -114,16 +170,22 @@ func TestInitPipelineOpts_Validate(t *testing.T) {
 			defer ctrl.Finish()
 
 			mockStore := mocks.NewMockstore(ctrl)
+			mockWs := mocks.NewMockwsPipelineIniter(ctrl)
 
 			tc.setupMocks(mockStore)
+			if tc.mockWs != nil {
+				tc.mockWs(mockWs)
+			}
 
 			opts := &initPipelineOpts{
 				initPipelineVars: initPipelineVars{
 					appName:      tc.inAppName,
+					name:         tc.inName,
 					repoURL:      tc.inrepoURL,
 					environments: tc.inEnvs,
 				},
-				store: mockStore,
+				store:     mockStore,
+				workspace: mockWs,
 			}
 
 			// WHEN
@@ -499,6 +561,8 @@ func TestInitPipelineOpts_Ask(t *testing.T) {
 			mocksSessProvider := mocks.NewMocksessionProvider(ctrl)
 			mockSelector := mocks.NewMockpipelineSelector(ctrl)
 			mockStore := mocks.NewMockstore(ctrl)
+			mockWs := mocks.NewMockwsPipelineIniter(ctrl)
+			mockWs.EXPECT().ListPipelines().Return(nil, workspace.ErrNoPipelineInWorkspace).AnyTimes()
 
 			opts := &initPipelineOpts{
 				initPipelineVars: initPipelineVars{
@@ -507,6 +571,7 @@ func TestInitPipelineOpts_Ask(t *testing.T) {
 					repoURL:           tc.inRepoURL,
 					githubAccessToken: tc.inGitHubAccessToken,
 				},
+				workspace:    mockWs,
 				prompt:       mockPrompt,
 				runner:       mockRunner,
 				sessProvider: mocksSessProvider,
@@ -534,6 +599,7 @@ func TestInitPipelineOpts_Ask(t *testing.T) {
 				require.Equal(t, tc.expectedGitHubAccessToken, opts.githubAccessToken)
 				require.Equal(t, tc.expectedCodeCommitRegion, opts.ccRegion)
 				require.ElementsMatch(t, tc.expectedEnvironments, opts.environments)
+				require.Equal(t, fmt.Sprintf(fmtPipelineName, "my-app", tc.expectedRepoName), opts.name)
 			}
 		})
 	}
@@ -552,7 +618,7 @@ func TestInitPipelineOpts_Execute(t *testing.T) {
 		inAppName      string
 
 		mockSecretsManager          func(m *mocks.MocksecretsManager)
-		mockWsWriter                func(m *mocks.MockwsPipelineWriter)
+		mockWsWriter                func(m *mocks.MockwsPipelineIniter)
 		mockParser                  func(m *templatemocks.MockParser)
 		mockFileSystem              func(mockFS afero.Fs)
 		mockRegionalResourcesGetter func(m *mocks.MockappResourcesGetter)
@@ -576,9 +642,9 @@ func TestInitPipelineOpts_Execute(t *testing.T) {
 			mockSecretsManager: func(m *mocks.MocksecretsManager) {
 				m.EXPECT().CreateSecret("github-token-badgoose-goose", "hunter2").Return("some-arn", nil)
 			},
-			mockWsWriter: func(m *mocks.MockwsPipelineWriter) {
-				m.EXPECT().WritePipelineManifest(gomock.Any()).Return("/pipeline.yml", nil)
-				m.EXPECT().WritePipelineBuildspec(gomock.Any()).Return("/buildspec.yml", nil)
+			mockWsWriter: func(m *mocks.MockwsPipelineIniter) {
+				m.EXPECT().WritePipelineManifest(gomock.Any(), "my-pipeline").Return("/pipeline.yml", nil)
+				m.EXPECT().WritePipelineBuildspec(gomock.Any(), "my-pipeline").Return("/buildspec.yml", nil)
 			},
 			mockParser: func(m *templatemocks.MockParser) {
 				m.EXPECT().Parse(buildspecTemplatePath, gomock.Any()).Return(&template.Content{
@@ -615,9 +681,9 @@ func TestInitPipelineOpts_Execute(t *testing.T) {
 			inAppName:  "badgoose",
 
 			mockSecretsManager: func(m *mocks.MocksecretsManager) {},
-			mockWsWriter: func(m *mocks.MockwsPipelineWriter) {
-				m.EXPECT().WritePipelineManifest(gomock.Any()).Return("/pipeline.yml", nil)
-				m.EXPECT().WritePipelineBuildspec(gomock.Any()).Return("/buildspec.yml", nil)
+			mockWsWriter: func(m *mocks.MockwsPipelineIniter) {
+				m.EXPECT().WritePipelineManifest(gomock.Any(), "my-pipeline").Return("/pipeline.yml", nil)
+				m.EXPECT().WritePipelineBuildspec(gomock.Any(), "my-pipeline").Return("/buildspec.yml", nil)
 			},
 			mockParser: func(m *templatemocks.MockParser) {
 				m.EXPECT().Parse(buildspecTemplatePath, gomock.Any()).Return(&template.Content{
@@ -654,9 +720,9 @@ func TestInitPipelineOpts_Execute(t *testing.T) {
 			inAppName:  "badgoose",
 
 			mockSecretsManager: func(m *mocks.MocksecretsManager) {},
-			mockWsWriter: func(m *mocks.MockwsPipelineWriter) {
-				m.EXPECT().WritePipelineManifest(gomock.Any()).Return("/pipeline.yml", nil)
-				m.EXPECT().WritePipelineBuildspec(gomock.Any()).Return("/buildspec.yml", nil)
+			mockWsWriter: func(m *mocks.MockwsPipelineIniter) {
+				m.EXPECT().WritePipelineManifest(gomock.Any(), "my-pipeline").Return("/pipeline.yml", nil)
+				m.EXPECT().WritePipelineBuildspec(gomock.Any(), "my-pipeline").Return("/buildspec.yml", nil)
 			},
 			mockParser: func(m *templatemocks.MockParser) {
 				m.EXPECT().Parse(buildspecTemplatePath, gomock.Any()).Return(&template.Content{
@@ -693,9 +759,9 @@ func TestInitPipelineOpts_Execute(t *testing.T) {
 			inAppName:  "badgoose",
 
 			mockSecretsManager: func(m *mocks.MocksecretsManager) {},
-			mockWsWriter: func(m *mocks.MockwsPipelineWriter) {
-				m.EXPECT().WritePipelineManifest(gomock.Any()).Return("/pipeline.yml", nil)
-				m.EXPECT().WritePipelineBuildspec(gomock.Any()).Return("/buildspec.yml", nil)
+			mockWsWriter: func(m *mocks.MockwsPipelineIniter) {
+				m.EXPECT().WritePipelineManifest(gomock.Any(), "my-pipeline").Return("/pipeline.yml", nil)
+				m.EXPECT().WritePipelineBuildspec(gomock.Any(), "my-pipeline").Return("/buildspec.yml", nil)
 			},
 			mockParser: func(m *templatemocks.MockParser) {
 				m.EXPECT().Parse(buildspecTemplatePath, gomock.Any()).Return(&template.Content{
@@ -736,9 +802,9 @@ func TestInitPipelineOpts_Execute(t *testing.T) {
 				existsErr := &secretsmanager.ErrSecretAlreadyExists{}
 				m.EXPECT().CreateSecret("github-token-badgoose-goose", "hunter2").Return("", existsErr)
 			},
-			mockWsWriter: func(m *mocks.MockwsPipelineWriter) {
-				m.EXPECT().WritePipelineManifest(gomock.Any()).Return("/pipeline.yml", nil)
-				m.EXPECT().WritePipelineBuildspec(gomock.Any()).Return("/buildspec.yml", nil)
+			mockWsWriter: func(m *mocks.MockwsPipelineIniter) {
+				m.EXPECT().WritePipelineManifest(gomock.Any(), "my-pipeline").Return("/pipeline.yml", nil)
+				m.EXPECT().WritePipelineBuildspec(gomock.Any(), "my-pipeline").Return("/buildspec.yml", nil)
 			},
 			mockParser: func(m *templatemocks.MockParser) {
 				m.EXPECT().Parse(buildspecTemplatePath, gomock.Any()).Return(&template.Content{
@@ -779,8 +845,8 @@ func TestInitPipelineOpts_Execute(t *testing.T) {
 			mockSecretsManager: func(m *mocks.MocksecretsManager) {
 				m.EXPECT().CreateSecret("github-token-badgoose-goose", "hunter2").Return("some-arn", nil)
 			},
-			mockWsWriter: func(m *mocks.MockwsPipelineWriter) {
-				m.EXPECT().WritePipelineManifest(gomock.Any()).Return("", errors.New("some error"))
+			mockWsWriter: func(m *mocks.MockwsPipelineIniter) {
+				m.EXPECT().WritePipelineManifest(gomock.Any(), "my-pipeline").Return("", errors.New("some error"))
 			},
 			mockParser:                  func(m *templatemocks.MockParser) {},
 			mockStoreSvc:                func(m *mocks.Mockstore) {},
@@ -803,8 +869,8 @@ func TestInitPipelineOpts_Execute(t *testing.T) {
 			mockSecretsManager: func(m *mocks.MocksecretsManager) {
 				m.EXPECT().CreateSecret("github-token-badgoose-goose", "hunter2").Return("some-arn", nil)
 			},
-			mockWsWriter: func(m *mocks.MockwsPipelineWriter) {
-				m.EXPECT().WritePipelineManifest(gomock.Any()).Return("/pipeline.yml", nil)
+			mockWsWriter: func(m *mocks.MockwsPipelineIniter) {
+				m.EXPECT().WritePipelineManifest(gomock.Any(), "my-pipeline").Return("/pipeline.yml", nil)
 			},
 			mockParser: func(m *templatemocks.MockParser) {},
 			mockStoreSvc: func(m *mocks.Mockstore) {
@@ -829,8 +895,8 @@ func TestInitPipelineOpts_Execute(t *testing.T) {
 			mockSecretsManager: func(m *mocks.MocksecretsManager) {
 				m.EXPECT().CreateSecret("github-token-badgoose-goose", "hunter2").Return("some-arn", nil)
 			},
-			mockWsWriter: func(m *mocks.MockwsPipelineWriter) {
-				m.EXPECT().WritePipelineManifest(gomock.Any()).Return("/pipeline.yml", nil)
+			mockWsWriter: func(m *mocks.MockwsPipelineIniter) {
+				m.EXPECT().WritePipelineManifest(gomock.Any(), "my-pipeline").Return("/pipeline.yml", nil)
 			},
 			mockParser: func(m *templatemocks.MockParser) {},
 			mockStoreSvc: func(m *mocks.Mockstore) {
@@ -861,9 +927,9 @@ func TestInitPipelineOpts_Execute(t *testing.T) {
 			mockSecretsManager: func(m *mocks.MocksecretsManager) {
 				m.EXPECT().CreateSecret("github-token-badgoose-goose", "hunter2").Return("some-arn", nil)
 			},
-			mockWsWriter: func(m *mocks.MockwsPipelineWriter) {
-				m.EXPECT().WritePipelineManifest(gomock.Any()).Return("/pipeline.yml", nil)
-				m.EXPECT().WritePipelineBuildspec(gomock.Any()).Times(0)
+			mockWsWriter: func(m *mocks.MockwsPipelineIniter) {
+				m.EXPECT().WritePipelineManifest(gomock.Any(), "my-pipeline").Return("/pipeline.yml", nil)
+				m.EXPECT().WritePipelineBuildspec(gomock.Any(), "my-pipeline").Times(0)
 			},
 			mockParser: func(m *templatemocks.MockParser) {
 				m.EXPECT().Parse(buildspecTemplatePath, gomock.Any()).Return(nil, errors.New("some error"))
@@ -901,9 +967,9 @@ func TestInitPipelineOpts_Execute(t *testing.T) {
 			mockSecretsManager: func(m *mocks.MocksecretsManager) {
 				m.EXPECT().CreateSecret("github-token-badgoose-goose", "hunter2").Return("some-arn", nil)
 			},
-			mockWsWriter: func(m *mocks.MockwsPipelineWriter) {
-				m.EXPECT().WritePipelineManifest(gomock.Any()).Return("", manifestExistsErr)
-				m.EXPECT().WritePipelineBuildspec(gomock.Any()).Return("", buildspecExistsErr)
+			mockWsWriter: func(m *mocks.MockwsPipelineIniter) {
+				m.EXPECT().WritePipelineManifest(gomock.Any(), "my-pipeline").Return("", manifestExistsErr)
+				m.EXPECT().WritePipelineBuildspec(gomock.Any(), "my-pipeline").Return("", buildspecExistsErr)
 			},
 			mockParser: func(m *templatemocks.MockParser) {
 				m.EXPECT().Parse(buildspecTemplatePath, gomock.Any()).Return(&template.Content{
@@ -943,9 +1009,9 @@ func TestInitPipelineOpts_Execute(t *testing.T) {
 			mockSecretsManager: func(m *mocks.MocksecretsManager) {
 				m.EXPECT().CreateSecret("github-token-badgoose-goose", "hunter2").Return("some-arn", nil)
 			},
-			mockWsWriter: func(m *mocks.MockwsPipelineWriter) {
-				m.EXPECT().WritePipelineManifest(gomock.Any()).Return("/pipeline.yml", nil)
-				m.EXPECT().WritePipelineBuildspec(gomock.Any()).Return("", errors.New("some error"))
+			mockWsWriter: func(m *mocks.MockwsPipelineIniter) {
+				m.EXPECT().WritePipelineManifest(gomock.Any(), "my-pipeline").Return("/pipeline.yml", nil)
+				m.EXPECT().WritePipelineBuildspec(gomock.Any(), "my-pipeline").Return("", errors.New("some error"))
 			},
 			mockParser: func(m *templatemocks.MockParser) {
 				m.EXPECT().Parse(buildspecTemplatePath, gomock.Any()).Return(&template.Content{
@@ -978,7 +1044,7 @@ func TestInitPipelineOpts_Execute(t *testing.T) {
 			defer ctrl.Finish()
 
 			mockSecretsManager := mocks.NewMocksecretsManager(ctrl)
-			mockWriter := mocks.NewMockwsPipelineWriter(ctrl)
+			mockWriter := mocks.NewMockwsPipelineIniter(ctrl)
 			mockParser := templatemocks.NewMockParser(ctrl)
 			mockRegionalResourcesGetter := mocks.NewMockappResourcesGetter(ctrl)
 			mockstore := mocks.NewMockstore(ctrl)
@@ -994,6 +1060,7 @@ func TestInitPipelineOpts_Execute(t *testing.T) {
 				initPipelineVars: initPipelineVars{
 					githubAccessToken: tc.inGitHubToken,
 					appName:           tc.inAppName,
+					name:              "my-pipeline",
 				},
 
 				secretsmanager: mockSecretsManager,
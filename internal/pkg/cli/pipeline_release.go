@@ -0,0 +1,153 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/spf13/cobra"
+)
+
+const (
+	pipelineReleaseAppNamePrompt          = "Which application's pipeline would you like to release?"
+	pipelineReleaseAppNameHelpPrompt      = "An application is a collection of related services."
+	fmtPipelineReleasePipelineNamePrompt  = "Which pipeline of %s would you like to release?"
+	pipelineReleasePipelineNameHelpPrompt = "A new execution of the pipeline will start with the latest source revision."
+)
+
+type pipelineReleaseVars struct {
+	appName      string
+	pipelineName string
+}
+
+type pipelineReleaseOpts struct {
+	pipelineReleaseVars
+
+	ws          wsPipelineLister
+	store       store
+	pipelineSvc pipelineGetter
+	releaser    pipelineReleaser
+	sel         appSelector
+	prompt      prompter
+
+	// Cached variables.
+	executionID string // ID of the pipeline execution that is started.
+}
+
+func newPipelineReleaseOpts(vars pipelineReleaseVars) (*pipelineReleaseOpts, error) {
+	store, err := config.NewStore()
+	if err != nil {
+		return nil, fmt.Errorf("new config store client: %w", err)
+	}
+
+	ws, err := workspace.New()
+	if err != nil {
+		return nil, fmt.Errorf("new workspace client: %w", err)
+	}
+
+	defaultSession, err := sessions.NewProvider().Default()
+	if err != nil {
+		return nil, fmt.Errorf("default session: %w", err)
+	}
+
+	prompter := prompt.New()
+	cp := codepipeline.New(defaultSession)
+	return &pipelineReleaseOpts{
+		pipelineReleaseVars: vars,
+		ws:                  ws,
+		store:               store,
+		pipelineSvc:         cp,
+		releaser:            cp,
+		sel:                 selector.NewSelect(prompter, store),
+		prompt:              prompter,
+	}, nil
+}
+
+// Validate returns an error if the values provided by the user are invalid.
+func (o *pipelineReleaseOpts) Validate() error {
+	if o.appName != "" {
+		if _, err := o.store.GetApplication(o.appName); err != nil {
+			return err
+		}
+	}
+	if o.pipelineName != "" {
+		if _, err := o.pipelineSvc.GetPipeline(o.pipelineName); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Ask prompts for fields that are required but not passed in.
+func (o *pipelineReleaseOpts) Ask() error {
+	if o.appName == "" {
+		name, err := o.sel.Application(pipelineReleaseAppNamePrompt, pipelineReleaseAppNameHelpPrompt)
+		if err != nil {
+			return fmt.Errorf("select application: %w", err)
+		}
+		o.appName = name
+	}
+	if o.pipelineName != "" {
+		return nil
+	}
+	name, err := selectPipelineName(o.ws, o.pipelineSvc, o.prompt, o.appName,
+		fmt.Sprintf(fmtPipelineReleasePipelineNamePrompt, color.HighlightUserInput(o.appName)), pipelineReleasePipelineNameHelpPrompt)
+	if err != nil {
+		return err
+	}
+	o.pipelineName = name
+	return nil
+}
+
+// Execute starts a new execution of the pipeline.
+func (o *pipelineReleaseOpts) Execute() error {
+	id, err := o.releaser.StartExecution(o.pipelineName)
+	if err != nil {
+		return err
+	}
+	o.executionID = id
+	log.Successf("Started execution %s of pipeline %s.\n", o.executionID, color.HighlightUserInput(o.pipelineName))
+	return nil
+}
+
+// RecommendActions returns follow-up actions the user can take after successfully executing the command.
+func (o *pipelineReleaseOpts) RecommendActions() error {
+	logRecommendedActions([]string{
+		fmt.Sprintf("Run %s to follow the progress of the execution.",
+			color.HighlightCode(fmt.Sprintf("copilot pipeline status -n %s --follow", o.pipelineName))),
+	})
+	return nil
+}
+
+// buildPipelineReleaseCmd builds the command for manually starting a new execution of a pipeline.
+func buildPipelineReleaseCmd() *cobra.Command {
+	vars := pipelineReleaseVars{}
+	cmd := &cobra.Command{
+		Use:   "release",
+		Short: "Starts a new execution of a pipeline.",
+		Long:  "Manually starts a new execution of a pipeline with the latest revision of each source.",
+
+		Example: `
+  Releases the latest commit of the pipeline "pipeline-myapp-myrepo".
+  /code $ copilot pipeline release -n pipeline-myapp-myrepo`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newPipelineReleaseOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.pipelineName, nameFlag, nameFlagShort, "", pipelineFlagDescription)
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	return cmd
+}
@@ -0,0 +1,157 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type pipelineReleaseMocks struct {
+	ws          *mocks.MockwsPipelineLister
+	prompt      *mocks.Mockprompter
+	pipelineSvc *mocks.MockpipelineGetter
+	releaser    *mocks.MockpipelineReleaser
+	sel         *mocks.MockappSelector
+}
+
+func TestPipelineReleaseOpts_Ask(t *testing.T) {
+	const (
+		mockAppName      = "dinder"
+		mockPipelineName = "pipeline-dinder-badgoose-repo"
+	)
+	mockError := errors.New("mock error")
+	testCases := map[string]struct {
+		inAppName      string
+		inPipelineName string
+		setupMocks     func(m pipelineReleaseMocks)
+
+		wantedAppName      string
+		wantedPipelineName string
+		wantedError        error
+	}{
+		"errors if fail to select application": {
+			setupMocks: func(m pipelineReleaseMocks) {
+				m.sel.EXPECT().Application(pipelineReleaseAppNamePrompt, pipelineReleaseAppNameHelpPrompt).Return("", mockError)
+			},
+			wantedError: fmt.Errorf("select application: %w", mockError),
+		},
+		"selects the only deployed pipeline if there are none in the workspace": {
+			inAppName: mockAppName,
+			setupMocks: func(m pipelineReleaseMocks) {
+				m.ws.EXPECT().ListPipelines().Return(nil, workspace.ErrNoPipelineInWorkspace)
+				m.pipelineSvc.EXPECT().ListPipelineNamesByTags(map[string]string{"copilot-application": mockAppName}).Return([]string{mockPipelineName}, nil)
+			},
+			wantedAppName:      mockAppName,
+			wantedPipelineName: mockPipelineName,
+		},
+		"errors if fail to list deployed pipelines": {
+			inAppName: mockAppName,
+			setupMocks: func(m pipelineReleaseMocks) {
+				m.ws.EXPECT().ListPipelines().Return(nil, workspace.ErrNoPipelineInWorkspace)
+				m.pipelineSvc.EXPECT().ListPipelineNamesByTags(map[string]string{"copilot-application": mockAppName}).Return(nil, mockError)
+			},
+			wantedError: fmt.Errorf("list pipelines: %w", mockError),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := pipelineReleaseMocks{
+				ws:          mocks.NewMockwsPipelineLister(ctrl),
+				prompt:      mocks.NewMockprompter(ctrl),
+				pipelineSvc: mocks.NewMockpipelineGetter(ctrl),
+				sel:         mocks.NewMockappSelector(ctrl),
+			}
+			tc.setupMocks(m)
+
+			opts := &pipelineReleaseOpts{
+				pipelineReleaseVars: pipelineReleaseVars{
+					appName:      tc.inAppName,
+					pipelineName: tc.inPipelineName,
+				},
+				ws:          m.ws,
+				prompt:      m.prompt,
+				pipelineSvc: m.pipelineSvc,
+				sel:         m.sel,
+			}
+
+			// WHEN
+			err := opts.Ask()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedAppName, opts.appName)
+				require.Equal(t, tc.wantedPipelineName, opts.pipelineName)
+			}
+		})
+	}
+}
+
+func TestPipelineReleaseOpts_Execute(t *testing.T) {
+	const mockPipelineName = "pipeline-dinder-badgoose-repo"
+	testCases := map[string]struct {
+		setupMocks func(m pipelineReleaseMocks)
+
+		wantedExecutionID string
+		wantedError       error
+	}{
+		"errors if fail to start the execution": {
+			setupMocks: func(m pipelineReleaseMocks) {
+				m.releaser.EXPECT().StartExecution(mockPipelineName).Return("", errors.New("some error"))
+			},
+			wantedError: errors.New("some error"),
+		},
+		"starts a new execution": {
+			setupMocks: func(m pipelineReleaseMocks) {
+				m.releaser.EXPECT().StartExecution(mockPipelineName).Return("a1b2c3d4", nil)
+			},
+			wantedExecutionID: "a1b2c3d4",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := pipelineReleaseMocks{
+				releaser: mocks.NewMockpipelineReleaser(ctrl),
+			}
+			tc.setupMocks(m)
+
+			opts := &pipelineReleaseOpts{
+				pipelineReleaseVars: pipelineReleaseVars{
+					pipelineName: mockPipelineName,
+				},
+				releaser: m.releaser,
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedExecutionID, opts.executionID)
+			}
+		})
+	}
+}
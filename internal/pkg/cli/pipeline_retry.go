@@ -0,0 +1,200 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/spf13/cobra"
+)
+
+const (
+	pipelineRetryAppNamePrompt          = "Which application's pipeline would you like to retry?"
+	pipelineRetryAppNameHelpPrompt      = "An application is a collection of related services."
+	fmtPipelineRetryPipelineNamePrompt  = "Which pipeline of %s would you like to retry?"
+	pipelineRetryPipelineNameHelpPrompt = "The pipeline with a stage that failed."
+	fmtPipelineRetryStagePrompt         = "Which stage of %s would you like to retry?"
+	pipelineRetryStageHelpPrompt        = "The stages of the pipeline with failed actions."
+
+	pipelineStageStatusFailed = "Failed"
+)
+
+type pipelineRetryVars struct {
+	appName      string
+	pipelineName string
+	stage        string
+}
+
+type pipelineRetryOpts struct {
+	pipelineRetryVars
+
+	ws          wsPipelineLister
+	store       store
+	pipelineSvc pipelineGetter
+	retrier     pipelineRetrier
+	sel         appSelector
+	prompt      prompter
+
+	// Cached variables.
+	stageName   string // Name of the pipeline stage to retry.
+	executionID string // ID of the pipeline execution that is retried.
+}
+
+func newPipelineRetryOpts(vars pipelineRetryVars) (*pipelineRetryOpts, error) {
+	store, err := config.NewStore()
+	if err != nil {
+		return nil, fmt.Errorf("new config store client: %w", err)
+	}
+
+	ws, err := workspace.New()
+	if err != nil {
+		return nil, fmt.Errorf("new workspace client: %w", err)
+	}
+
+	defaultSession, err := sessions.NewProvider().Default()
+	if err != nil {
+		return nil, fmt.Errorf("default session: %w", err)
+	}
+
+	prompter := prompt.New()
+	cp := codepipeline.New(defaultSession)
+	return &pipelineRetryOpts{
+		pipelineRetryVars: vars,
+		ws:                ws,
+		store:             store,
+		pipelineSvc:       cp,
+		retrier:           cp,
+		sel:               selector.NewSelect(prompter, store),
+		prompt:            prompter,
+	}, nil
+}
+
+// Validate returns an error if the values provided by the user are invalid.
+func (o *pipelineRetryOpts) Validate() error {
+	if o.appName != "" {
+		if _, err := o.store.GetApplication(o.appName); err != nil {
+			return err
+		}
+	}
+	if o.pipelineName != "" {
+		if _, err := o.pipelineSvc.GetPipeline(o.pipelineName); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Ask prompts for fields that are required but not passed in, and selects the failed stage to retry.
+func (o *pipelineRetryOpts) Ask() error {
+	if o.appName == "" {
+		name, err := o.sel.Application(pipelineRetryAppNamePrompt, pipelineRetryAppNameHelpPrompt)
+		if err != nil {
+			return fmt.Errorf("select application: %w", err)
+		}
+		o.appName = name
+	}
+	if o.pipelineName == "" {
+		name, err := selectPipelineName(o.ws, o.pipelineSvc, o.prompt, o.appName,
+			fmt.Sprintf(fmtPipelineRetryPipelineNamePrompt, color.HighlightUserInput(o.appName)), pipelineRetryPipelineNameHelpPrompt)
+		if err != nil {
+			return err
+		}
+		o.pipelineName = name
+	}
+	return o.askStage()
+}
+
+// Execute retries the failed actions of the pipeline stage.
+func (o *pipelineRetryOpts) Execute() error {
+	id, err := o.retrier.RetryFailedActions(o.pipelineName, o.stageName)
+	if err != nil {
+		return err
+	}
+	o.executionID = id
+	log.Successf("Retrying the failed actions of stage %s in execution %s of pipeline %s.\n",
+		color.HighlightUserInput(o.stageName), o.executionID, color.HighlightUserInput(o.pipelineName))
+	return nil
+}
+
+// RecommendActions returns follow-up actions the user can take after successfully executing the command.
+func (o *pipelineRetryOpts) RecommendActions() error {
+	logRecommendedActions([]string{
+		fmt.Sprintf("Run %s to follow the progress of the execution.",
+			color.HighlightCode(fmt.Sprintf("copilot pipeline status -n %s --follow", o.pipelineName))),
+	})
+	return nil
+}
+
+// askStage selects the failed stage of the pipeline passed in, or prompts for the stage if several stages failed.
+// The stage can either be the name of the pipeline stage or the environment it deploys to.
+func (o *pipelineRetryOpts) askStage() error {
+	state, err := o.retrier.GetPipelineState(o.pipelineName)
+	if err != nil {
+		return fmt.Errorf("get state of pipeline %s: %w", o.pipelineName, err)
+	}
+	var failed []string
+	for _, stage := range state.StageStates {
+		if stage.AggregateStatus() == pipelineStageStatusFailed {
+			failed = append(failed, stage.StageName)
+		}
+	}
+	if o.stage != "" {
+		for _, name := range failed {
+			if name == o.stage || name == pipelineDeployStagePrefix+o.stage {
+				o.stageName = name
+				return nil
+			}
+		}
+		return fmt.Errorf("no failed actions in stage %s of pipeline %s", o.stage, o.pipelineName)
+	}
+	switch len(failed) {
+	case 0:
+		return fmt.Errorf("no failed stage in pipeline %s", o.pipelineName)
+	case 1:
+		log.Infof("Found failed stage: %s\n", color.HighlightUserInput(failed[0]))
+		o.stageName = failed[0]
+		return nil
+	}
+	stage, err := o.prompt.SelectOne(fmt.Sprintf(fmtPipelineRetryStagePrompt, color.HighlightUserInput(o.pipelineName)),
+		pipelineRetryStageHelpPrompt, failed, prompt.WithFinalMessage("Stage:"))
+	if err != nil {
+		return fmt.Errorf("select stage of pipeline %s: %w", o.pipelineName, err)
+	}
+	o.stageName = stage
+	return nil
+}
+
+// buildPipelineRetryCmd builds the command for retrying the failed actions of a pipeline stage.
+func buildPipelineRetryCmd() *cobra.Command {
+	vars := pipelineRetryVars{}
+	cmd := &cobra.Command{
+		Use:   "retry",
+		Short: "Retries the failed actions of a pipeline stage.",
+		Long: `Retries the failed actions of a pipeline stage.
+The stage is retried within the same execution, with the same source revision.`,
+
+		Example: `
+  Retries the failed deployment to the "prod" environment of the pipeline "pipeline-myapp-myrepo".
+  /code $ copilot pipeline retry -n pipeline-myapp-myrepo --stage prod`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newPipelineRetryOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.pipelineName, nameFlag, nameFlagShort, "", pipelineFlagDescription)
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVar(&vars.stage, stageFlag, "", retryStageFlagDescription)
+	return cmd
+}
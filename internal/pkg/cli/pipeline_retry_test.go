@@ -0,0 +1,295 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type pipelineRetryMocks struct {
+	store       *mocks.Mockstore
+	ws          *mocks.MockwsPipelineLister
+	prompt      *mocks.Mockprompter
+	pipelineSvc *mocks.MockpipelineGetter
+	retrier     *mocks.MockpipelineRetrier
+	sel         *mocks.MockappSelector
+}
+
+func TestPipelineRetryOpts_Validate(t *testing.T) {
+	const (
+		mockAppName      = "dinder"
+		mockPipelineName = "pipeline-dinder-badgoose-repo"
+	)
+	mockError := errors.New("mock error")
+	testCases := map[string]struct {
+		inAppName      string
+		inPipelineName string
+		setupMocks     func(m pipelineRetryMocks)
+
+		wantedError error
+	}{
+		"errors if app name is invalid": {
+			inAppName: mockAppName,
+			setupMocks: func(m pipelineRetryMocks) {
+				m.store.EXPECT().GetApplication(mockAppName).Return(nil, mockError)
+			},
+			wantedError: mockError,
+		},
+		"errors if pipeline name is invalid": {
+			inAppName:      mockAppName,
+			inPipelineName: mockPipelineName,
+			setupMocks: func(m pipelineRetryMocks) {
+				m.store.EXPECT().GetApplication(mockAppName).Return(&config.Application{Name: mockAppName}, nil)
+				m.pipelineSvc.EXPECT().GetPipeline(mockPipelineName).Return(nil, mockError)
+			},
+			wantedError: mockError,
+		},
+		"success": {
+			inAppName:      mockAppName,
+			inPipelineName: mockPipelineName,
+			setupMocks: func(m pipelineRetryMocks) {
+				m.store.EXPECT().GetApplication(mockAppName).Return(&config.Application{Name: mockAppName}, nil)
+				m.pipelineSvc.EXPECT().GetPipeline(mockPipelineName).Return(&codepipeline.Pipeline{}, nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := pipelineRetryMocks{
+				store:       mocks.NewMockstore(ctrl),
+				pipelineSvc: mocks.NewMockpipelineGetter(ctrl),
+			}
+			tc.setupMocks(m)
+
+			opts := &pipelineRetryOpts{
+				pipelineRetryVars: pipelineRetryVars{
+					appName:      tc.inAppName,
+					pipelineName: tc.inPipelineName,
+				},
+				store:       m.store,
+				pipelineSvc: m.pipelineSvc,
+			}
+
+			// WHEN
+			err := opts.Validate()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestPipelineRetryOpts_Ask(t *testing.T) {
+	const (
+		mockAppName      = "dinder"
+		mockPipelineName = "pipeline-dinder-badgoose-repo"
+	)
+	mockError := errors.New("mock error")
+	mockState := &codepipeline.PipelineState{
+		PipelineName: mockPipelineName,
+		StageStates: []*codepipeline.StageState{
+			{
+				StageName: "Source",
+				Actions:   []codepipeline.StageAction{{Name: "SourceCodeFor-dinder", Status: "Succeeded"}},
+			},
+			{
+				StageName: "DeployTo-test",
+				Actions:   []codepipeline.StageAction{{Name: "CreateOrUpdate-api-test", Status: "Failed"}},
+			},
+			{
+				StageName: "DeployTo-prod",
+				Actions:   []codepipeline.StageAction{{Name: "CreateOrUpdate-api-prod", Status: "Abandoned"}},
+			},
+		},
+	}
+	testCases := map[string]struct {
+		inAppName      string
+		inPipelineName string
+		inStage        string
+		setupMocks     func(m pipelineRetryMocks)
+
+		wantedPipelineName string
+		wantedStageName    string
+		wantedError        error
+	}{
+		"selects the pipeline in the workspace and its only failed stage": {
+			setupMocks: func(m pipelineRetryMocks) {
+				m.sel.EXPECT().Application(pipelineRetryAppNamePrompt, pipelineRetryAppNameHelpPrompt).Return(mockAppName, nil)
+				m.ws.EXPECT().ListPipelines().Return([]workspace.PipelineManifest{
+					{
+						Name: mockPipelineName,
+						Path: "/copilot/pipelines/badgoose/manifest.yml",
+					},
+				}, nil)
+				m.retrier.EXPECT().GetPipelineState(mockPipelineName).Return(&codepipeline.PipelineState{
+					StageStates: mockState.StageStates[:2],
+				}, nil)
+			},
+			wantedPipelineName: mockPipelineName,
+			wantedStageName:    "DeployTo-test",
+		},
+		"errors if fail to get the state of the pipeline": {
+			inAppName:      mockAppName,
+			inPipelineName: mockPipelineName,
+			setupMocks: func(m pipelineRetryMocks) {
+				m.retrier.EXPECT().GetPipelineState(mockPipelineName).Return(nil, mockError)
+			},
+			wantedError: fmt.Errorf("get state of pipeline %s: %w", mockPipelineName, mockError),
+		},
+		"errors if there are no failed stages": {
+			inAppName:      mockAppName,
+			inPipelineName: mockPipelineName,
+			setupMocks: func(m pipelineRetryMocks) {
+				m.retrier.EXPECT().GetPipelineState(mockPipelineName).Return(&codepipeline.PipelineState{
+					StageStates: mockState.StageStates[:1],
+				}, nil)
+			},
+			wantedError: fmt.Errorf("no failed stage in pipeline %s", mockPipelineName),
+		},
+		"selects the failed stage named after the environment": {
+			inAppName:      mockAppName,
+			inPipelineName: mockPipelineName,
+			inStage:        "prod",
+			setupMocks: func(m pipelineRetryMocks) {
+				m.retrier.EXPECT().GetPipelineState(mockPipelineName).Return(mockState, nil)
+			},
+			wantedPipelineName: mockPipelineName,
+			wantedStageName:    "DeployTo-prod",
+		},
+		"errors if the stage did not fail": {
+			inAppName:      mockAppName,
+			inPipelineName: mockPipelineName,
+			inStage:        "Source",
+			setupMocks: func(m pipelineRetryMocks) {
+				m.retrier.EXPECT().GetPipelineState(mockPipelineName).Return(mockState, nil)
+			},
+			wantedError: fmt.Errorf("no failed actions in stage Source of pipeline %s", mockPipelineName),
+		},
+		"prompts for the stage if several stages failed": {
+			inAppName:      mockAppName,
+			inPipelineName: mockPipelineName,
+			setupMocks: func(m pipelineRetryMocks) {
+				m.retrier.EXPECT().GetPipelineState(mockPipelineName).Return(mockState, nil)
+				m.prompt.EXPECT().SelectOne(gomock.Any(), pipelineRetryStageHelpPrompt, []string{"DeployTo-test", "DeployTo-prod"}, gomock.Any()).Return("DeployTo-prod", nil)
+			},
+			wantedPipelineName: mockPipelineName,
+			wantedStageName:    "DeployTo-prod",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := pipelineRetryMocks{
+				ws:          mocks.NewMockwsPipelineLister(ctrl),
+				prompt:      mocks.NewMockprompter(ctrl),
+				pipelineSvc: mocks.NewMockpipelineGetter(ctrl),
+				retrier:     mocks.NewMockpipelineRetrier(ctrl),
+				sel:         mocks.NewMockappSelector(ctrl),
+			}
+			tc.setupMocks(m)
+
+			opts := &pipelineRetryOpts{
+				pipelineRetryVars: pipelineRetryVars{
+					appName:      tc.inAppName,
+					pipelineName: tc.inPipelineName,
+					stage:        tc.inStage,
+				},
+				ws:          m.ws,
+				prompt:      m.prompt,
+				pipelineSvc: m.pipelineSvc,
+				retrier:     m.retrier,
+				sel:         m.sel,
+			}
+
+			// WHEN
+			err := opts.Ask()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedPipelineName, opts.pipelineName)
+				require.Equal(t, tc.wantedStageName, opts.stageName)
+			}
+		})
+	}
+}
+
+func TestPipelineRetryOpts_Execute(t *testing.T) {
+	const mockPipelineName = "pipeline-dinder-badgoose-repo"
+	testCases := map[string]struct {
+		setupMocks func(m pipelineRetryMocks)
+
+		wantedExecutionID string
+		wantedError       error
+	}{
+		"errors if fail to retry the stage": {
+			setupMocks: func(m pipelineRetryMocks) {
+				m.retrier.EXPECT().RetryFailedActions(mockPipelineName, "DeployTo-prod").Return("", errors.New("some error"))
+			},
+			wantedError: errors.New("some error"),
+		},
+		"retries the failed actions of the stage": {
+			setupMocks: func(m pipelineRetryMocks) {
+				m.retrier.EXPECT().RetryFailedActions(mockPipelineName, "DeployTo-prod").Return("a1b2c3d4", nil)
+			},
+			wantedExecutionID: "a1b2c3d4",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := pipelineRetryMocks{
+				retrier: mocks.NewMockpipelineRetrier(ctrl),
+			}
+			tc.setupMocks(m)
+
+			opts := &pipelineRetryOpts{
+				pipelineRetryVars: pipelineRetryVars{
+					pipelineName: mockPipelineName,
+				},
+				retrier:   m.retrier,
+				stageName: "DeployTo-prod",
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedExecutionID, opts.executionID)
+			}
+		})
+	}
+}
@@ -13,7 +13,6 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/describe"
-	"github.com/aws/copilot-cli/internal/pkg/manifest"
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
 	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
@@ -41,7 +40,7 @@ type showPipelineOpts struct {
 
 	// Interfaces to dependencies
 	w             io.Writer
-	ws            wsPipelineReader
+	ws            wsPipelineLister
 	store         applicationStore
 	pipelineSvc   pipelineGetter
 	describer     describer
@@ -187,16 +186,10 @@ func (o *showPipelineOpts) retrieveAllPipelines() ([]string, error) {
 }
 
 func (o *showPipelineOpts) getPipelineNameFromManifest() (string, error) {
-	data, err := o.ws.ReadPipelineManifest()
+	pipeline, err := selectLocalPipeline(o.ws, o.prompt, "", fmt.Sprintf(fmtPipelineShowPipelineNamePrompt, color.HighlightUserInput(o.appName)))
 	if err != nil {
 		return "", err
 	}
-
-	pipeline, err := manifest.UnmarshalPipeline(data)
-	if err != nil {
-		return "", fmt.Errorf("unmarshal pipeline manifest: %w", err)
-	}
-
 	return pipeline.Name, nil
 }
 
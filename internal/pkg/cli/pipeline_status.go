@@ -4,23 +4,28 @@
 package cli
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"io"
+	"os"
 
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatchlogs"
 	"github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/describe"
-	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/stream"
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
 	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
 	"github.com/aws/copilot-cli/internal/pkg/term/selector"
 	"github.com/aws/copilot-cli/internal/pkg/workspace"
 
 	"github.com/spf13/cobra"
+	"golang.org/x/sync/errgroup"
 )
 
 const (
@@ -30,23 +35,35 @@ const (
 	pipelineStatusPipelineNameHelpPrompt = "The details of a pipeline's status will be shown (e.g., stages, status, transition)."
 )
 
+// Pipeline execution statuses.
+const (
+	pipelineExecutionStatusSucceeded  = "Succeeded"
+	pipelineExecutionStatusFailed     = "Failed"
+	pipelineExecutionStatusStopped    = "Stopped"
+	pipelineExecutionStatusSuperseded = "Superseded"
+)
+
 type pipelineStatusVars struct {
 	appName          string
 	shouldOutputJSON bool
 	pipelineName     string
+	follow           bool
 }
 
 type pipelineStatusOpts struct {
 	pipelineStatusVars
 
 	w             io.Writer
-	ws            wsPipelineReader
+	ws            wsPipelineLister
 	store         store
 	pipelineSvc   pipelineGetter
+	executions    pipelineExecutionGetter
 	describer     describer
 	sel           appSelector
 	prompt        prompter
 	initDescriber func(opts *pipelineStatusOpts) error
+	// Renders the progress of the execution until it finishes.
+	renderExecution func(opts *pipelineStatusOpts, executionID string) error
 }
 
 func newPipelineStatusOpts(vars pipelineStatusVars) (*pipelineStatusOpts, error) {
@@ -66,12 +83,14 @@ func newPipelineStatusOpts(vars pipelineStatusVars) (*pipelineStatusOpts, error)
 	}
 
 	prompter := prompt.New()
+	cp := codepipeline.New(session)
 	return &pipelineStatusOpts{
 		w:                  log.OutputWriter,
 		pipelineStatusVars: vars,
 		ws:                 ws,
 		store:              store,
-		pipelineSvc:        codepipeline.New(session),
+		pipelineSvc:        cp,
+		executions:         cp,
 		sel:                selector.NewSelect(prompter, store),
 		prompt:             prompter,
 		initDescriber: func(o *pipelineStatusOpts) error {
@@ -82,11 +101,26 @@ func newPipelineStatusOpts(vars pipelineStatusVars) (*pipelineStatusOpts, error)
 			o.describer = d
 			return nil
 		},
+		renderExecution: func(o *pipelineStatusOpts, executionID string) error {
+			streamer := stream.NewPipelineExecutionStreamer(cp, cloudwatchlogs.New(session), o.pipelineName, executionID)
+			renderer := termprogress.ListeningPipelineExecutionRenderer(streamer, termprogress.RenderOptions{})
+			g, ctx := errgroup.WithContext(context.Background())
+			g.Go(func() error {
+				return stream.Stream(ctx, streamer)
+			})
+			g.Go(func() error {
+				return termprogress.Render(ctx, termprogress.NewTabbedFileWriter(os.Stderr), renderer)
+			})
+			return g.Wait()
+		},
 	}, nil
 }
 
 // Validate returns an error if the values provided by the user are invalid.
 func (o *pipelineStatusOpts) Validate() error {
+	if o.follow && o.shouldOutputJSON {
+		return fmt.Errorf("cannot specify both --%s and --%s", followFlag, jsonFlag)
+	}
 	if o.appName != "" {
 		if _, err := o.store.GetApplication(o.appName); err != nil {
 			return err
@@ -109,7 +143,12 @@ func (o *pipelineStatusOpts) Ask() error {
 }
 
 // Execute displays the status of the pipeline.
+// If --follow is set, it streams the progress of the latest execution instead and
+// returns an error with a non-zero exit code if the execution did not succeed.
 func (o *pipelineStatusOpts) Execute() error {
+	if o.follow {
+		return o.followLatestExecution()
+	}
 	err := o.initDescriber(o)
 	if err != nil {
 		return fmt.Errorf("describe status of pipeline: %w", err)
@@ -132,6 +171,29 @@ func (o *pipelineStatusOpts) Execute() error {
 	return nil
 }
 
+func (o *pipelineStatusOpts) followLatestExecution() error {
+	execution, err := o.executions.LatestPipelineExecution(o.pipelineName)
+	if err != nil {
+		return err
+	}
+	if err := o.renderExecution(o, execution.ID); err != nil {
+		return fmt.Errorf("follow execution %s of pipeline %s: %w", execution.ID, o.pipelineName, err)
+	}
+	execution, err = o.executions.PipelineExecution(o.pipelineName, execution.ID)
+	if err != nil {
+		return err
+	}
+	if execution.Status != pipelineExecutionStatusSucceeded {
+		return &errPipelineExecutionUnsuccessful{
+			pipeline:    o.pipelineName,
+			executionID: execution.ID,
+			status:      execution.Status,
+		}
+	}
+	log.Successf("Execution %s of pipeline %s succeeded.\n", execution.ID, color.HighlightUserInput(o.pipelineName))
+	return nil
+}
+
 func (o *pipelineStatusOpts) askAppName() error {
 	if o.appName != "" {
 		return nil
@@ -204,16 +266,10 @@ func (o *pipelineStatusOpts) retrieveAllPipelines() ([]string, error) {
 }
 
 func (o *pipelineStatusOpts) getPipelineNameFromManifest() (string, error) {
-	data, err := o.ws.ReadPipelineManifest()
+	pipeline, err := selectLocalPipeline(o.ws, o.prompt, "", fmt.Sprintf(fmtPipelineStatusPipelineNamePrompt, color.HighlightUserInput(o.appName)))
 	if err != nil {
 		return "", err
 	}
-
-	pipeline, err := manifest.UnmarshalPipeline(data)
-	if err != nil {
-		return "", fmt.Errorf("unmarshal pipeline manifest: %w", err)
-	}
-
 	return pipeline.Name, nil
 }
 
@@ -227,7 +283,9 @@ func buildPipelineStatusCmd() *cobra.Command {
 
 		Example: `
 Shows status of the pipeline "pipeline-myapp-myrepo".
-/code $ copilot pipeline status -n pipeline-myapp-myrepo`,
+/code $ copilot pipeline status -n pipeline-myapp-myrepo
+Follows the latest execution of the pipeline until it finishes.
+/code $ copilot pipeline status -n pipeline-myapp-myrepo --follow`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newPipelineStatusOpts(vars)
 			if err != nil {
@@ -239,6 +297,29 @@ Shows status of the pipeline "pipeline-myapp-myrepo".
 	cmd.Flags().StringVarP(&vars.pipelineName, nameFlag, nameFlagShort, "", pipelineFlagDescription)
 	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, "", appFlagDescription)
 	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
+	cmd.Flags().BoolVar(&vars.follow, followFlag, false, followPipelineFlagDescription)
 
 	return cmd
 }
+
+type errPipelineExecutionUnsuccessful struct {
+	pipeline    string
+	executionID string
+	status      string
+}
+
+func (e *errPipelineExecutionUnsuccessful) Error() string {
+	return fmt.Sprintf("execution %s of pipeline %s finished with status %s", e.executionID, e.pipeline, e.status)
+}
+
+// ExitCode returns 2 if the execution was stopped, 3 if it was superseded by a newer execution, and 1 otherwise.
+func (e *errPipelineExecutionUnsuccessful) ExitCode() int {
+	switch e.status {
+	case pipelineExecutionStatusStopped:
+		return 2
+	case pipelineExecutionStatusSuperseded:
+		return 3
+	default:
+		return 1
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
 	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
@@ -19,11 +20,12 @@ import (
 
 type pipelineStatusMocks struct {
 	store       *mocks.Mockstore
-	ws          *mocks.MockwsPipelineReader
+	ws          *mocks.MockwsPipelineLister
 	prompt      *mocks.Mockprompter
 	pipelineSvc *mocks.MockpipelineGetter
 	describer   *mocks.Mockdescriber
 	sel         *mocks.MockappSelector
+	executions  *mocks.MockpipelineExecutionGetter
 }
 
 func TestPipelineStatus_Validate(t *testing.T) {
@@ -35,10 +37,18 @@ func TestPipelineStatus_Validate(t *testing.T) {
 	testCases := map[string]struct {
 		testAppName      string
 		testPipelineName string
+		testFollow       bool
+		testJSON         bool
 		setupMocks       func(mocks pipelineStatusMocks)
 
 		expectedErr error
 	}{
+		"errors if both --follow and --json are specified": {
+			testFollow:  true,
+			testJSON:    true,
+			setupMocks:  func(mocks pipelineStatusMocks) {},
+			expectedErr: errors.New("cannot specify both --follow and --json"),
+		},
 		"errors if app name is invalid": {
 			testAppName: "bad-app-le",
 			setupMocks: func(mocks pipelineStatusMocks) {
@@ -94,8 +104,10 @@ func TestPipelineStatus_Validate(t *testing.T) {
 
 			opts := &pipelineStatusOpts{
 				pipelineStatusVars: pipelineStatusVars{
-					appName:      tc.testAppName,
-					pipelineName: tc.testPipelineName,
+					appName:          tc.testAppName,
+					pipelineName:     tc.testPipelineName,
+					follow:           tc.testFollow,
+					shouldOutputJSON: tc.testJSON,
 				},
 				store:       mockStoreReader,
 				pipelineSvc: mockPipelineStateGetter,
@@ -125,25 +137,6 @@ func TestPipelineStatus_Ask(t *testing.T) {
 	}
 	mockPipelines := []string{mockPipelineName, "pipeline-the-other-one"}
 	mockTestCommands := []string{"make test", "echo 'honk'"}
-	pipelineData := `
-name: pipeline-dinder-badgoose-repo
-version: 1
-
-source:
-  provider: GitHub
-  properties:
-    repository: badgoose/repo
-    access_token_secret: "github-token-badgoose-repo"
-    branch: main
-
-stages:
-    -
-      name: test
-	  test_commands: [make test, echo 'honk']
-    -
-      name: prod
-`
-
 	testCases := map[string]struct {
 		testAppName      string
 		testPipelineName string
@@ -159,7 +152,7 @@ stages:
 			testPipelineName: "",
 			setupMocks: func(mocks pipelineStatusMocks) {
 				gomock.InOrder(
-					mocks.ws.EXPECT().ReadPipelineManifest().Return(nil, workspace.ErrNoPipelineInWorkspace),
+					mocks.ws.EXPECT().ListPipelines().Return(nil, workspace.ErrNoPipelineInWorkspace),
 					mocks.pipelineSvc.EXPECT().ListPipelineNamesByTags(testTags).Return([]string{mockPipelineName}, nil),
 				)
 			},
@@ -167,11 +160,48 @@ stages:
 			expectedPipeline: mockPipelineName,
 			expectedErr:      nil,
 		},
-		"reads pipeline name and test commands from manifest": {
+		"reads pipeline name from manifest": {
+			testAppName: mockAppName,
+			setupMocks: func(mocks pipelineStatusMocks) {
+				gomock.InOrder(
+					mocks.ws.EXPECT().ListPipelines().Return([]workspace.PipelineManifest{
+						{
+							Name: mockPipelineName,
+							Path: "/copilot/pipelines/pipeline-dinder-badgoose-repo/manifest.yml",
+						},
+					}, nil),
+				)
+			},
+			expectedApp:      mockAppName,
+			expectedPipeline: mockPipelineName,
+			expectedErr:      nil,
+		},
+		"prompts for the pipeline if there are multiple pipelines in the workspace": {
+			testAppName: mockAppName,
+			setupMocks: func(mocks pipelineStatusMocks) {
+				gomock.InOrder(
+					mocks.ws.EXPECT().ListPipelines().Return([]workspace.PipelineManifest{
+						{
+							Name: mockPipelineName,
+							Path: "/copilot/pipelines/pipeline-dinder-badgoose-repo/manifest.yml",
+						},
+						{
+							Name: "pipeline-the-other-one",
+							Path: "/copilot/pipelines/pipeline-the-other-one/manifest.yml",
+						},
+					}, nil),
+					mocks.prompt.EXPECT().SelectOne(fmt.Sprintf(fmtPipelineStatusPipelineNamePrompt, mockAppName), gomock.Any(), mockPipelines, gomock.Any()).Return(mockPipelineName, nil),
+				)
+			},
+			expectedApp:      mockAppName,
+			expectedPipeline: mockPipelineName,
+			expectedErr:      nil,
+		},
+		"falls back to deployed pipelines if the manifest is malformed": {
 			testAppName: mockAppName,
 			setupMocks: func(mocks pipelineStatusMocks) {
 				gomock.InOrder(
-					mocks.ws.EXPECT().ReadPipelineManifest().Return([]byte(pipelineData), nil),
+					mocks.ws.EXPECT().ListPipelines().Return(nil, mockError),
 					mocks.pipelineSvc.EXPECT().ListPipelineNamesByTags(testTags).Return([]string{mockPipelineName}, nil),
 				)
 			},
@@ -184,7 +214,7 @@ stages:
 			testAppName: mockAppName,
 			setupMocks: func(mocks pipelineStatusMocks) {
 				gomock.InOrder(
-					mocks.ws.EXPECT().ReadPipelineManifest().Return(nil, workspace.ErrNoPipelineInWorkspace),
+					mocks.ws.EXPECT().ListPipelines().Return(nil, workspace.ErrNoPipelineInWorkspace),
 					mocks.pipelineSvc.EXPECT().ListPipelineNamesByTags(testTags).Return(mockPipelines, nil),
 					mocks.prompt.EXPECT().SelectOne(fmt.Sprintf(fmtPipelineStatusPipelineNamePrompt, color.HighlightUserInput(mockAppName)), pipelineStatusPipelineNameHelpPrompt, mockPipelines, gomock.Any()).Return(mockPipelineName, nil),
 				)
@@ -198,7 +228,7 @@ stages:
 			testPipelineName: "",
 			setupMocks: func(mocks pipelineStatusMocks) {
 				gomock.InOrder(
-					mocks.ws.EXPECT().ReadPipelineManifest().Return(nil, workspace.ErrNoPipelineInWorkspace),
+					mocks.ws.EXPECT().ListPipelines().Return(nil, workspace.ErrNoPipelineInWorkspace),
 					mocks.pipelineSvc.EXPECT().ListPipelineNamesByTags(testTags).Return([]string{}, nil),
 				)
 			},
@@ -220,7 +250,7 @@ stages:
 			testPipelineName: "",
 			setupMocks: func(mocks pipelineStatusMocks) {
 				gomock.InOrder(
-					mocks.ws.EXPECT().ReadPipelineManifest().Return(nil, workspace.ErrNoPipelineInWorkspace),
+					mocks.ws.EXPECT().ListPipelines().Return(nil, workspace.ErrNoPipelineInWorkspace),
 					mocks.pipelineSvc.EXPECT().ListPipelineNamesByTags(testTags).Return(nil, mockError),
 				)
 			},
@@ -230,7 +260,7 @@ stages:
 			testAppName: mockAppName,
 			setupMocks: func(mocks pipelineStatusMocks) {
 				gomock.InOrder(
-					mocks.ws.EXPECT().ReadPipelineManifest().Return(nil, workspace.ErrNoPipelineInWorkspace),
+					mocks.ws.EXPECT().ListPipelines().Return(nil, workspace.ErrNoPipelineInWorkspace),
 					mocks.pipelineSvc.EXPECT().ListPipelineNamesByTags(testTags).Return(mockPipelines, nil),
 					mocks.prompt.EXPECT().SelectOne(fmt.Sprintf(fmtPipelineStatusPipelineNamePrompt, color.HighlightUserInput(mockAppName)), pipelineStatusPipelineNameHelpPrompt, mockPipelines, gomock.Any()).Return("", mockError),
 				)
@@ -257,7 +287,7 @@ stages:
 			defer ctrl.Finish()
 
 			mockStore := mocks.NewMockstore(ctrl)
-			mockWS := mocks.NewMockwsPipelineReader(ctrl)
+			mockWS := mocks.NewMockwsPipelineLister(ctrl)
 			mockPrompt := mocks.NewMockprompter(ctrl)
 			mockPLSvc := mocks.NewMockpipelineGetter(ctrl)
 			mockSel := mocks.NewMockappSelector(ctrl)
@@ -310,12 +340,54 @@ func TestPipelineStatus_Execute(t *testing.T) {
 	}
 	testCases := map[string]struct {
 		shouldOutputJSON bool
+		follow           bool
 		pipelineName     string
 		setupMocks       func(m pipelineStatusMocks)
+		renderErr        error
 
-		expectedContent string
-		expectedError   error
+		expectedContent  string
+		expectedError    error
+		expectedExitCode int
 	}{
+		"errors if fail to get the latest execution to follow": {
+			pipelineName: mockPipelineName,
+			follow:       true,
+			setupMocks: func(m pipelineStatusMocks) {
+				m.executions.EXPECT().LatestPipelineExecution(mockPipelineName).Return(nil, mockError)
+			},
+			expectedError: mockError,
+		},
+		"errors if fail to render the execution": {
+			pipelineName: mockPipelineName,
+			follow:       true,
+			setupMocks: func(m pipelineStatusMocks) {
+				m.executions.EXPECT().LatestPipelineExecution(mockPipelineName).Return(&codepipeline.PipelineExecution{ID: "1234"}, nil)
+			},
+			renderErr:     mockError,
+			expectedError: fmt.Errorf("follow execution 1234 of pipeline %s: mock error", mockPipelineName),
+		},
+		"returns an error with a non-zero exit code if the followed execution was stopped": {
+			pipelineName: mockPipelineName,
+			follow:       true,
+			setupMocks: func(m pipelineStatusMocks) {
+				gomock.InOrder(
+					m.executions.EXPECT().LatestPipelineExecution(mockPipelineName).Return(&codepipeline.PipelineExecution{ID: "1234", Status: "InProgress"}, nil),
+					m.executions.EXPECT().PipelineExecution(mockPipelineName, "1234").Return(&codepipeline.PipelineExecution{ID: "1234", Status: "Stopped"}, nil),
+				)
+			},
+			expectedError:    fmt.Errorf("execution 1234 of pipeline %s finished with status Stopped", mockPipelineName),
+			expectedExitCode: 2,
+		},
+		"success if the followed execution succeeded": {
+			pipelineName: mockPipelineName,
+			follow:       true,
+			setupMocks: func(m pipelineStatusMocks) {
+				gomock.InOrder(
+					m.executions.EXPECT().LatestPipelineExecution(mockPipelineName).Return(&codepipeline.PipelineExecution{ID: "1234", Status: "InProgress"}, nil),
+					m.executions.EXPECT().PipelineExecution(mockPipelineName, "1234").Return(&codepipeline.PipelineExecution{ID: "1234", Status: "Succeeded"}, nil),
+				)
+			},
+		},
 		"errors if fail to return JSON output": {
 			pipelineName:     mockPipelineName,
 			shouldOutputJSON: true,
@@ -342,9 +414,11 @@ func TestPipelineStatus_Execute(t *testing.T) {
 
 			b := &bytes.Buffer{}
 			mockDescriber := mocks.NewMockdescriber(ctrl)
+			mockExecutions := mocks.NewMockpipelineExecutionGetter(ctrl)
 
 			mocks := pipelineStatusMocks{
-				describer: mockDescriber,
+				describer:  mockDescriber,
+				executions: mockExecutions,
 			}
 
 			tc.setupMocks(mocks)
@@ -353,10 +427,15 @@ func TestPipelineStatus_Execute(t *testing.T) {
 				pipelineStatusVars: pipelineStatusVars{
 					shouldOutputJSON: tc.shouldOutputJSON,
 					pipelineName:     tc.pipelineName,
+					follow:           tc.follow,
 				},
 				describer:     mockDescriber,
+				executions:    mockExecutions,
 				initDescriber: func(o *pipelineStatusOpts) error { return nil },
-				w:             b,
+				renderExecution: func(o *pipelineStatusOpts, executionID string) error {
+					return tc.renderErr
+				},
+				w: b,
 			}
 
 			// WHEN
@@ -365,6 +444,11 @@ func TestPipelineStatus_Execute(t *testing.T) {
 			// THEN
 			if tc.expectedError != nil {
 				require.EqualError(t, err, tc.expectedError.Error())
+				if tc.expectedExitCode != 0 {
+					var errUnsuccessful *errPipelineExecutionUnsuccessful
+					require.True(t, errors.As(err, &errUnsuccessful))
+					require.Equal(t, tc.expectedExitCode, errUnsuccessful.ExitCode())
+				}
 			} else {
 				require.NoError(t, err)
 				require.Equal(t, tc.expectedContent, b.String(), "expected output content to match")
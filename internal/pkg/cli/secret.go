@@ -4,9 +4,32 @@
 package cli
 
 import (
+	"fmt"
+	"sort"
+	"strings"
+
 	"github.com/aws/copilot-cli/cmd/copilot/template"
+	"github.com/aws/copilot-cli/internal/pkg/aws/secretsmanager"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ssm"
 	"github.com/aws/copilot-cli/internal/pkg/cli/group"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
 	"github.com/spf13/cobra"
+	"golang.org/x/mod/semver"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// Manifest field holding the secrets of a container.
+	manifestSecretsField = "secrets"
+	// Manifest field holding the name of a secret in Secrets Manager.
+	manifestSecretsManagerField = "secretsmanager"
+	// Variables substituted in manifests with the names of the application and environment.
+	manifestAppNameVar = "COPILOT_APPLICATION_NAME"
+	manifestEnvNameVar = "COPILOT_ENVIRONMENT_NAME"
 )
 
 // BuildSecretCmd is the top level command for secret.
@@ -18,6 +41,9 @@ Secrets are sensitive information that you need in your application.`,
 	}
 
 	cmd.AddCommand(buildSecretInitCmd())
+	cmd.AddCommand(buildSecretListCmd())
+	cmd.AddCommand(buildSecretShowCmd())
+	cmd.AddCommand(buildSecretDeleteCmd())
 
 	cmd.SetUsageTemplate(template.Usage)
 	cmd.Annotations = map[string]string{
@@ -25,3 +51,152 @@ Secrets are sensitive information that you need in your application.`,
 	}
 	return cmd
 }
+
+// newSSMForEnv returns an SSM client in the account and region of the environment using its manager role.
+func newSSMForEnv(env *config.Environment) (*ssm.SSM, error) {
+	sess, err := sessions.NewProvider().FromRole(env.ManagerRoleARN, env.Region)
+	if err != nil {
+		return nil, fmt.Errorf("create session from environment manager role %s in region %s: %w", env.ManagerRoleARN, env.Region, err)
+	}
+	return ssm.New(sess), nil
+}
+
+// newSecretsManagerForEnv returns a Secrets Manager client in the account and region of the environment using its manager role.
+func newSecretsManagerForEnv(env *config.Environment) (*secretsmanager.SecretsManager, error) {
+	sess, err := sessions.NewProvider().FromRole(env.ManagerRoleARN, env.Region)
+	if err != nil {
+		return nil, fmt.Errorf("create session from environment manager role %s in region %s: %w", env.ManagerRoleARN, env.Region, err)
+	}
+	return secretsmanager.NewWithSession(sess), nil
+}
+
+// newEnvVersionGetterForSecrets returns a function that creates describers of the template version of an environment.
+func newEnvVersionGetterForSecrets(store *config.Store) func(app, env string) (versionGetter, error) {
+	return func(app, env string) (versionGetter, error) {
+		d, err := describe.NewEnvDescriber(describe.NewEnvDescriberConfig{
+			App:         app,
+			Env:         env,
+			ConfigStore: store,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("new env describer for environment %s in app %s: %v", env, app, err)
+		}
+		return d, nil
+	}
+}
+
+// validateSecretsManagerEnvVersion returns an error if the environment was deployed with a template older than
+// deploy.SecretsManagerLeastEnvTemplateVersion, since its manager role isn't allowed to manage Secrets Manager secrets yet.
+func validateSecretsManagerEnvVersion(newGetter func(app, env string) (versionGetter, error), app, env string) error {
+	getter, err := newGetter(app, env)
+	if err != nil {
+		return err
+	}
+	version, err := getter.Version()
+	if err != nil {
+		return fmt.Errorf("get template version of environment %s in app %s: %v", env, app, err)
+	}
+	if semver.Compare(version, deploy.SecretsManagerLeastEnvTemplateVersion) < 0 {
+		return fmt.Errorf("environment %s is on version %s, which can't manage Secrets Manager secrets: run %s first",
+			env, version, color.HighlightCode(fmt.Sprintf("copilot env upgrade --app %s --name %s", app, env)))
+	}
+	return nil
+}
+
+// secretParameterPrefix returns the prefix of the names of the parameters holding the secrets of an environment.
+func secretParameterPrefix(app, env string) string {
+	return fmt.Sprintf(fmtSecretParameterName, app, env, "")
+}
+
+// workloadsReferencingSecrets returns the sorted names of the workloads in the workspace keyed by the secrets that their manifests reference.
+// A workload references a secret if the value of one of its "secrets" entries, in any container or environment override,
+// is the name of a parameter or Secrets Manager secret. The names are returned as written in the manifests, see secretReferencesInEnv
+// to resolve them for an environment.
+func workloadsReferencingSecrets(ws wsWorkloadManifestReader) (map[string][]string, error) {
+	workloads, err := ws.ListWorkloads()
+	if err != nil {
+		return nil, fmt.Errorf("list workloads in workspace: %w", err)
+	}
+	refs := make(map[string][]string)
+	for _, wl := range workloads {
+		raw, err := ws.ReadWorkloadManifest(wl)
+		if err != nil {
+			return nil, fmt.Errorf("read manifest of %s: %w", wl, err)
+		}
+		var mft interface{}
+		if err := yaml.Unmarshal(raw, &mft); err != nil {
+			return nil, fmt.Errorf("unmarshal manifest of %s: %w", wl, err)
+		}
+		names := make(map[string]bool)
+		collectSecretNames(mft, names)
+		for name := range names {
+			refs[name] = append(refs[name], wl)
+		}
+	}
+	for name := range refs {
+		sort.Strings(refs[name])
+	}
+	return refs, nil
+}
+
+// secretReferencesInEnv returns the sorted names of the workloads keyed by the full names of the secrets of the environment
+// that they reference, e.g. "/copilot/<app>/<env>/secrets/<name>".
+// References to the secrets of other applications or environments are dropped.
+func secretReferencesInEnv(refs map[string][]string, app, env string) map[string][]string {
+	prefix := secretParameterPrefix(app, env)
+	placeholders := strings.NewReplacer(
+		"${"+manifestAppNameVar+"}", app,
+		"${"+manifestEnvNameVar+"}", env,
+	)
+	workloads := make(map[string]map[string]bool)
+	for name, wls := range refs {
+		name = placeholders.Replace(name)
+		if !strings.HasPrefix(name, prefix) {
+			continue
+		}
+		if _, ok := workloads[name]; !ok {
+			workloads[name] = make(map[string]bool)
+		}
+		for _, wl := range wls {
+			workloads[name][wl] = true
+		}
+	}
+	envRefs := make(map[string][]string)
+	for name, wls := range workloads {
+		for wl := range wls {
+			envRefs[name] = append(envRefs[name], wl)
+		}
+		sort.Strings(envRefs[name])
+	}
+	return envRefs
+}
+
+// collectSecretNames walks a manifest node and adds the names of the parameters or Secrets Manager secrets referenced under any "secrets" field.
+func collectSecretNames(node interface{}, names map[string]bool) {
+	switch v := node.(type) {
+	case map[string]interface{}:
+		for key, value := range v {
+			if key != manifestSecretsField {
+				collectSecretNames(value, names)
+				continue
+			}
+			secrets, ok := value.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			for _, param := range secrets {
+				// A secret is either the name of a parameter, or a map with the name of a Secrets Manager secret.
+				if sm, ok := param.(map[string]interface{}); ok {
+					param = sm[manifestSecretsManagerField]
+				}
+				if s, ok := param.(string); ok {
+					names[s] = true
+				}
+			}
+		}
+	case []interface{}:
+		for _, item := range v {
+			collectSecretNames(item, names)
+		}
+	}
+}
@@ -0,0 +1,220 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/secretsmanager"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+)
+
+const (
+	secretDeleteAppNamePrompt     = "Which application does the secret belong to?"
+	secretDeleteAppNamePromptHelp = "An application is a collection of related services."
+	secretDeleteNamePrompt        = "What is the name of the secret you would like to delete?"
+
+	fmtSecretDeleteConfirmPrompt        = "Are you sure you want to delete secret %s from application %s?"
+	fmtSecretDeleteFromEnvConfirmPrompt = "Are you sure you want to delete secret %s from environment %s?"
+	secretDeleteConfirmHelp             = "This will remove the secret from every environment of the application. Workloads referencing it will fail to start."
+)
+
+var (
+	errSecretDeleteCancelled = errors.New("secret delete cancelled - no changes made")
+)
+
+type secretDeleteVars struct {
+	appName          string
+	envName          string
+	name             string
+	skipConfirmation bool
+}
+
+type secretDeleteOpts struct {
+	secretDeleteVars
+
+	store  store
+	prompt prompter
+	sel    appSelector
+
+	newSecretDeleter               func(env *config.Environment) (secretDeleter, error)
+	newSecretsManagerSecretDeleter func(env *config.Environment) (secretDeleter, error)
+	newEnvVersionGetter            func(app, env string) (versionGetter, error)
+}
+
+func newSecretDeleteOpts(vars secretDeleteVars) (*secretDeleteOpts, error) {
+	store, err := config.NewStore()
+	if err != nil {
+		return nil, fmt.Errorf("new config store: %w", err)
+	}
+	prompter := prompt.New()
+	return &secretDeleteOpts{
+		secretDeleteVars: vars,
+		store:            store,
+		prompt:           prompter,
+		sel:              selector.NewSelect(prompter, store),
+		newSecretDeleter: func(env *config.Environment) (secretDeleter, error) {
+			return newSSMForEnv(env)
+		},
+		newSecretsManagerSecretDeleter: func(env *config.Environment) (secretDeleter, error) {
+			return newSecretsManagerForEnv(env)
+		},
+		newEnvVersionGetter: newEnvVersionGetterForSecrets(store),
+	}, nil
+}
+
+// Validate returns an error if the values provided by the user are invalid.
+func (o *secretDeleteOpts) Validate() error {
+	if o.appName == "" {
+		return nil
+	}
+	if _, err := o.store.GetApplication(o.appName); err != nil {
+		return fmt.Errorf("get application %s: %w", o.appName, err)
+	}
+	if o.envName != "" {
+		if _, err := o.store.GetEnvironment(o.appName, o.envName); err != nil {
+			return fmt.Errorf("get environment %s in application %s: %w", o.envName, o.appName, err)
+		}
+	}
+	return nil
+}
+
+// Ask prompts for fields that are required but not passed in, and confirms the deletion.
+func (o *secretDeleteOpts) Ask() error {
+	if o.appName == "" {
+		app, err := o.sel.Application(secretDeleteAppNamePrompt, secretDeleteAppNamePromptHelp)
+		if err != nil {
+			return fmt.Errorf("select application: %w", err)
+		}
+		o.appName = app
+	}
+	if o.name == "" {
+		name, err := o.prompt.Get(secretDeleteNamePrompt, "", nil, prompt.WithFinalMessage("Secret name:"))
+		if err != nil {
+			return fmt.Errorf("prompt for secret name: %w", err)
+		}
+		o.name = name
+	}
+	if o.skipConfirmation {
+		return nil
+	}
+	deletePrompt := fmt.Sprintf(fmtSecretDeleteConfirmPrompt, o.name, o.appName)
+	if o.envName != "" {
+		deletePrompt = fmt.Sprintf(fmtSecretDeleteFromEnvConfirmPrompt, o.name, o.envName)
+	}
+	deleteConfirmed, err := o.prompt.Confirm(deletePrompt, secretDeleteConfirmHelp, prompt.WithConfirmFinalMessage())
+	if err != nil {
+		return fmt.Errorf("secret delete confirmation prompt: %w", err)
+	}
+	if !deleteConfirmed {
+		return errSecretDeleteCancelled
+	}
+	return nil
+}
+
+// Execute deletes the secret from the targeted environments, in both SSM and Secrets Manager.
+func (o *secretDeleteOpts) Execute() error {
+	envs, err := o.targetEnvs()
+	if err != nil {
+		return err
+	}
+	// Check every environment first so that the secret isn't deleted from only some of them.
+	for _, env := range envs {
+		if err := validateSecretsManagerEnvVersion(o.newEnvVersionGetter, o.appName, env.Name); err != nil {
+			return err
+		}
+	}
+	for _, env := range envs {
+		deleted, err := o.deleteSecret(env)
+		if err != nil {
+			return fmt.Errorf("delete secret %s from environment %s: %w", o.name, env.Name, err)
+		}
+		if !deleted {
+			log.Infof("Secret %s does not exist in environment %s.\n", o.name, env.Name)
+			continue
+		}
+		log.Successf("Deleted secret %s from environment %s.\n", o.name, env.Name)
+	}
+	return nil
+}
+
+// deleteSecret deletes the secret of the environment from both stores, and returns whether it existed in either of them.
+func (o *secretDeleteOpts) deleteSecret(env *config.Environment) (bool, error) {
+	name := fmt.Sprintf(fmtSecretParameterName, o.appName, env.Name, o.name)
+	deleter, err := o.newSecretDeleter(env)
+	if err != nil {
+		return false, err
+	}
+	deleted := true
+	if err := deleter.DeleteSecret(name); err != nil {
+		var errNotFound *ssm.ErrParameterNotFound
+		if !errors.As(err, &errNotFound) {
+			return false, err
+		}
+		deleted = false
+	}
+
+	smDeleter, err := o.newSecretsManagerSecretDeleter(env)
+	if err != nil {
+		return false, err
+	}
+	if err := smDeleter.DeleteSecret(name); err != nil {
+		var errNotFound *secretsmanager.ErrSecretNotFound
+		if !errors.As(err, &errNotFound) {
+			return false, err
+		}
+		return deleted, nil
+	}
+	return true, nil
+}
+
+func (o *secretDeleteOpts) targetEnvs() ([]*config.Environment, error) {
+	if o.envName != "" {
+		env, err := o.store.GetEnvironment(o.appName, o.envName)
+		if err != nil {
+			return nil, fmt.Errorf("get environment %s in application %s: %w", o.envName, o.appName, err)
+		}
+		return []*config.Environment{env}, nil
+	}
+	envs, err := o.store.ListEnvironments(o.appName)
+	if err != nil {
+		return nil, fmt.Errorf("list environments in application %s: %w", o.appName, err)
+	}
+	return envs, nil
+}
+
+// buildSecretDeleteCmd builds the command for deleting a secret.
+func buildSecretDeleteCmd() *cobra.Command {
+	vars := secretDeleteVars{}
+	cmd := &cobra.Command{
+		Use:   "delete",
+		Short: "Deletes a secret from an application.",
+		Long: `Deletes a secret from every environment of an application, or from a single environment.
+Workloads that reference the secret will fail to start once it is deleted.`,
+		Example: `
+  Deletes the "db_password" secret from all the environments.
+  /code $ copilot secret delete -n db_password
+  Deletes the secret only from the "test" environment without confirmation.
+  /code $ copilot secret delete -n db_password --env test --yes`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newSecretDeleteOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", secretDeleteEnvFlagDescription)
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", secretShowNameFlagDescription)
+	cmd.Flags().BoolVar(&vars.skipConfirmation, yesFlag, false, yesFlagDescription)
+	return cmd
+}
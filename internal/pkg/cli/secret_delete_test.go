@@ -0,0 +1,198 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/secretsmanager"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSecretDeleteOpts_Ask(t *testing.T) {
+	const mockApp = "myapp"
+	mockError := errors.New("some error")
+	testCases := map[string]struct {
+		inEnvName          string
+		inSkipConfirmation bool
+		setupMocks         func(m *mocks.Mockprompter)
+
+		wantedError error
+	}{
+		"skips confirmation": {
+			inSkipConfirmation: true,
+			setupMocks:         func(m *mocks.Mockprompter) {},
+		},
+		"confirms deletion from every environment": {
+			setupMocks: func(m *mocks.Mockprompter) {
+				m.EXPECT().Confirm("Are you sure you want to delete secret db_password from application myapp?", secretDeleteConfirmHelp, gomock.Any()).Return(true, nil)
+			},
+		},
+		"errors if deletion from an environment is cancelled": {
+			inEnvName: "test",
+			setupMocks: func(m *mocks.Mockprompter) {
+				m.EXPECT().Confirm("Are you sure you want to delete secret db_password from environment test?", secretDeleteConfirmHelp, gomock.Any()).Return(false, nil)
+			},
+			wantedError: errSecretDeleteCancelled,
+		},
+		"errors if fail to confirm": {
+			setupMocks: func(m *mocks.Mockprompter) {
+				m.EXPECT().Confirm(gomock.Any(), gomock.Any(), gomock.Any()).Return(false, mockError)
+			},
+			wantedError: fmt.Errorf("secret delete confirmation prompt: %w", mockError),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockprompter(ctrl)
+			tc.setupMocks(m)
+
+			opts := &secretDeleteOpts{
+				secretDeleteVars: secretDeleteVars{
+					appName:          mockApp,
+					envName:          tc.inEnvName,
+					name:             "db_password",
+					skipConfirmation: tc.inSkipConfirmation,
+				},
+				prompt: m,
+			}
+
+			// WHEN
+			err := opts.Ask()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestSecretDeleteOpts_Execute(t *testing.T) {
+	const mockApp = "myapp"
+	mockError := errors.New("some error")
+	testEnv := &config.Environment{App: mockApp, Name: "test"}
+	prodEnv := &config.Environment{App: mockApp, Name: "prod"}
+	testCases := map[string]struct {
+		inEnvName    string
+		inEnvVersion string
+		setupMocks   func(store *mocks.Mockstore, deleter, smDeleter *mocks.MocksecretDeleter)
+
+		wantedError error
+	}{
+		"errors if fail to get environment": {
+			inEnvName: "test",
+			setupMocks: func(store *mocks.Mockstore, _, _ *mocks.MocksecretDeleter) {
+				store.EXPECT().GetEnvironment(mockApp, "test").Return(nil, mockError)
+			},
+			wantedError: fmt.Errorf("get environment test in application myapp: %w", mockError),
+		},
+		"errors before deleting anything if an environment can't manage secrets manager secrets": {
+			inEnvVersion: "v1.10.0",
+			setupMocks: func(store *mocks.Mockstore, deleter, smDeleter *mocks.MocksecretDeleter) {
+				store.EXPECT().ListEnvironments(mockApp).Return([]*config.Environment{testEnv, prodEnv}, nil)
+				deleter.EXPECT().DeleteSecret(gomock.Any()).Times(0)
+				smDeleter.EXPECT().DeleteSecret(gomock.Any()).Times(0)
+			},
+			wantedError: errors.New("environment test is on version v1.10.0, which can't manage Secrets Manager secrets: run `copilot env upgrade --app myapp --name test` first"),
+		},
+		"deletes the secret from every environment and store it exists in": {
+			setupMocks: func(store *mocks.Mockstore, deleter, smDeleter *mocks.MocksecretDeleter) {
+				store.EXPECT().ListEnvironments(mockApp).Return([]*config.Environment{testEnv, prodEnv}, nil)
+				gomock.InOrder(
+					deleter.EXPECT().DeleteSecret("/copilot/myapp/test/secrets/db_password").Return(nil),
+					deleter.EXPECT().DeleteSecret("/copilot/myapp/prod/secrets/db_password").Return(&ssm.ErrParameterNotFound{}),
+				)
+				gomock.InOrder(
+					smDeleter.EXPECT().DeleteSecret("/copilot/myapp/test/secrets/db_password").Return(&secretsmanager.ErrSecretNotFound{}),
+					smDeleter.EXPECT().DeleteSecret("/copilot/myapp/prod/secrets/db_password").Return(nil),
+				)
+			},
+		},
+		"skips the environment if the secret does not exist in either store": {
+			inEnvName: "test",
+			setupMocks: func(store *mocks.Mockstore, deleter, smDeleter *mocks.MocksecretDeleter) {
+				store.EXPECT().GetEnvironment(mockApp, "test").Return(testEnv, nil)
+				deleter.EXPECT().DeleteSecret("/copilot/myapp/test/secrets/db_password").Return(&ssm.ErrParameterNotFound{})
+				smDeleter.EXPECT().DeleteSecret("/copilot/myapp/test/secrets/db_password").Return(&secretsmanager.ErrSecretNotFound{})
+			},
+		},
+		"errors if fail to delete secret": {
+			inEnvName: "test",
+			setupMocks: func(store *mocks.Mockstore, deleter, _ *mocks.MocksecretDeleter) {
+				store.EXPECT().GetEnvironment(mockApp, "test").Return(testEnv, nil)
+				deleter.EXPECT().DeleteSecret("/copilot/myapp/test/secrets/db_password").Return(mockError)
+			},
+			wantedError: fmt.Errorf("delete secret db_password from environment test: %w", mockError),
+		},
+		"errors if fail to delete secrets manager secret": {
+			inEnvName: "test",
+			setupMocks: func(store *mocks.Mockstore, deleter, smDeleter *mocks.MocksecretDeleter) {
+				store.EXPECT().GetEnvironment(mockApp, "test").Return(testEnv, nil)
+				deleter.EXPECT().DeleteSecret("/copilot/myapp/test/secrets/db_password").Return(nil)
+				smDeleter.EXPECT().DeleteSecret("/copilot/myapp/test/secrets/db_password").Return(mockError)
+			},
+			wantedError: fmt.Errorf("delete secret db_password from environment test: %w", mockError),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			store := mocks.NewMockstore(ctrl)
+			deleter := mocks.NewMocksecretDeleter(ctrl)
+			smDeleter := mocks.NewMocksecretDeleter(ctrl)
+			tc.setupMocks(store, deleter, smDeleter)
+			envVersionGetter := mocks.NewMockversionGetter(ctrl)
+			envVersion := tc.inEnvVersion
+			if envVersion == "" {
+				envVersion = deploy.LatestEnvTemplateVersion
+			}
+			envVersionGetter.EXPECT().Version().Return(envVersion, nil).AnyTimes()
+
+			opts := &secretDeleteOpts{
+				secretDeleteVars: secretDeleteVars{
+					appName: mockApp,
+					envName: tc.inEnvName,
+					name:    "db_password",
+				},
+				store: store,
+				newSecretDeleter: func(_ *config.Environment) (secretDeleter, error) {
+					return deleter, nil
+				},
+				newSecretsManagerSecretDeleter: func(_ *config.Environment) (secretDeleter, error) {
+					return smDeleter, nil
+				},
+				newEnvVersionGetter: func(_, _ string) (versionGetter, error) {
+					return envVersionGetter, nil
+				},
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
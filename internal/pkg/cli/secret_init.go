@@ -4,6 +4,7 @@
 package cli
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"sort"
@@ -14,10 +15,12 @@ import (
 	"gopkg.in/yaml.v3"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
 
 	"github.com/spf13/afero"
 	"github.com/spf13/cobra"
 
+	"github.com/aws/copilot-cli/internal/pkg/aws/secretsmanager"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 	"github.com/aws/copilot-cli/internal/pkg/aws/ssm"
 	"github.com/aws/copilot-cli/internal/pkg/config"
@@ -33,6 +36,16 @@ const (
 	fmtSecretParameterNameMftExample = "/copilot/${COPILOT_APPLICATION_NAME}/${COPILOT_ENVIRONMENT_NAME}/secrets/%s"
 )
 
+const (
+	secretStoreSSM            = "ssm"
+	secretStoreSecretsManager = "secretsmanager"
+
+	defaultSecretRotationDays = 30
+	maxSecretRotationDays     = 1000
+)
+
+var secretStores = []string{secretStoreSSM, secretStoreSecretsManager}
+
 const (
 	secretInitAppPrompt     = "Which application do you want to add the secret to?"
 	secretInitAppPromptHelp = "The secret can then be versioned by your existing environments inside the application."
@@ -51,6 +64,12 @@ type secretInitVars struct {
 	values        map[string]string
 	inputFilePath string
 	overwrite     bool
+
+	storeType       string
+	kmsKeys         map[string]string
+	rotationLambdas map[string]string
+	rotationDays    int
+	jsonKey         string
 }
 
 type secretInitOpts struct {
@@ -66,11 +85,13 @@ type secretInitOpts struct {
 
 	shouldShowOverwriteHint bool
 
-	envUpgradeCMDs map[string]actionCommand
-	secretPutters  map[string]secretPutter
+	envUpgradeCMDs        map[string]actionCommand
+	secretPutters         map[string]secretPutter
+	secretsManagerPutters map[string]secretsManagerSecretPutter
 
 	configureClientsForEnv func(envName string) error
 	readFile               func() ([]byte, error)
+	newEnvVersionGetter    func(app, env string) (versionGetter, error)
 }
 
 func newSecretInitOpts(vars secretInitVars) (*secretInitOpts, error) {
@@ -85,11 +106,14 @@ func newSecretInitOpts(vars secretInitVars) (*secretInitOpts, error) {
 		store:          store,
 		fs:             &afero.Afero{Fs: afero.NewOsFs()},
 
-		envUpgradeCMDs: make(map[string]actionCommand),
-		secretPutters:  make(map[string]secretPutter),
+		envUpgradeCMDs:        make(map[string]actionCommand),
+		secretPutters:         make(map[string]secretPutter),
+		secretsManagerPutters: make(map[string]secretsManagerSecretPutter),
 
 		prompter: prompter,
 		selector: selector.NewSelect(prompter, store),
+
+		newEnvVersionGetter: newEnvVersionGetterForSecrets(store),
 	}
 
 	opts.configureClientsForEnv = func(envName string) error {
@@ -111,6 +135,7 @@ func newSecretInitOpts(vars secretInitVars) (*secretInitOpts, error) {
 			return fmt.Errorf("create session from environment manager role %s in region %s: %w", env.ManagerRoleARN, env.Region, err)
 		}
 		opts.secretPutters[envName] = ssm.New(sess)
+		opts.secretsManagerPutters[envName] = secretsmanager.NewWithSession(sess)
 
 		return nil
 	}
@@ -141,6 +166,10 @@ func (o *secretInitOpts) Validate() error {
 		return errors.New("cannot specify `--cli-input-yaml` with `--values`")
 	}
 
+	if err := o.validateStore(); err != nil {
+		return err
+	}
+
 	if o.appName != "" {
 		_, err := o.store.GetApplication(o.appName)
 		if err != nil {
@@ -152,6 +181,17 @@ func (o *secretInitOpts) Validate() error {
 					return err
 				}
 			}
+			if err := o.validateEnvVersions(o.values); err != nil {
+				return err
+			}
+		}
+		for env := range o.kmsKeys {
+			if _, err := o.targetEnv(env); err != nil {
+				return err
+			}
+		}
+		if err := o.validateRotationLambdas(); err != nil {
+			return err
 		}
 	}
 
@@ -169,6 +209,70 @@ func (o *secretInitOpts) Validate() error {
 	return nil
 }
 
+func (o *secretInitOpts) validateStore() error {
+	switch o.storeType {
+	case secretStoreSSM:
+		if o.kmsKeys != nil || o.rotationLambdas != nil || o.rotationDays != 0 || o.jsonKey != "" {
+			return fmt.Errorf("`--%s`, `--%s`, `--%s` and `--%s` can only be used with `--%s %s`",
+				kmsKeysFlag, rotationLambdasFlag, rotationDaysFlag, jsonKeyFlag, secretStoreFlag, secretStoreSecretsManager)
+		}
+		return nil
+	case secretStoreSecretsManager:
+	default:
+		return fmt.Errorf("invalid secret store %s: must be one of %s", o.storeType, prettify(secretStores))
+	}
+	if o.rotationDays != 0 && o.rotationLambdas == nil {
+		return fmt.Errorf("`--%s` must be specified along with `--%s`", rotationDaysFlag, rotationLambdasFlag)
+	}
+	if o.rotationDays < 0 || o.rotationDays > maxSecretRotationDays {
+		return fmt.Errorf("`--%s` must be between 1 and %d", rotationDaysFlag, maxSecretRotationDays)
+	}
+	for env, lambda := range o.rotationLambdas {
+		if _, err := arn.Parse(lambda); err != nil {
+			return fmt.Errorf("parse rotation lambda ARN %s of environment %s: %w", lambda, env, err)
+		}
+	}
+	return nil
+}
+
+// validateEnvVersions returns an error if Secrets Manager secrets are stored in environments
+// whose manager role can't manage them yet.
+func (o *secretInitOpts) validateEnvVersions(values map[string]string) error {
+	if o.storeType != secretStoreSecretsManager {
+		return nil
+	}
+	envs := make([]string, 0, len(values))
+	for env := range values {
+		envs = append(envs, env)
+	}
+	sort.Strings(envs)
+	for _, env := range envs {
+		if err := validateSecretsManagerEnvVersion(o.newEnvVersionGetter, o.appName, env); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateRotationLambdas returns an error if a rotation lambda isn't in the account and region of its environment,
+// since Secrets Manager can only invoke functions of the same account and region as the secret.
+func (o *secretInitOpts) validateRotationLambdas() error {
+	for envName, lambda := range o.rotationLambdas {
+		env, err := o.targetEnv(envName)
+		if err != nil {
+			return err
+		}
+		parsed, err := arn.Parse(lambda)
+		if err != nil {
+			return fmt.Errorf("parse rotation lambda ARN %s of environment %s: %w", lambda, envName, err)
+		}
+		if parsed.Region != env.Region || parsed.AccountID != env.AccountID {
+			return fmt.Errorf("rotation lambda %s of environment %s must be in account %s and region %s", lambda, envName, env.AccountID, env.Region)
+		}
+	}
+	return nil
+}
+
 // Ask prompts the user for any required or important fields that are not provided.
 func (o *secretInitOpts) Ask() error {
 	if o.overwrite {
@@ -179,8 +283,14 @@ func (o *secretInitOpts) Ask() error {
 		return nil
 	}
 
-	if err := o.askForAppName(); err != nil {
-		return err
+	if o.appName == "" {
+		if err := o.askForAppName(); err != nil {
+			return err
+		}
+		// The environments of the rotation lambdas can only be validated once the application is known.
+		if err := o.validateRotationLambdas(); err != nil {
+			return err
+		}
 	}
 	if err := o.askForSecretName(); err != nil {
 		return err
@@ -200,6 +310,11 @@ func (o *secretInitOpts) Execute() error {
 		}
 
 		o.secretValues = secrets
+		for _, values := range secrets {
+			if err := o.validateEnvVersions(values); err != nil {
+				return err
+			}
+		}
 
 		if err := o.configureClientsAndUpgradeForEnvironments(secrets); err != nil {
 			return err
@@ -285,6 +400,9 @@ func (o *secretInitOpts) putSecret(secretName string, values map[string]string)
 }
 
 func (o *secretInitOpts) putSecretInEnv(secretName, envName, value string) error {
+	if o.storeType == secretStoreSecretsManager {
+		return o.putSecretsManagerSecretInEnv(secretName, envName, value)
+	}
 	name := fmt.Sprintf(fmtSecretParameterName, o.appName, envName, secretName)
 	in := ssm.PutSecretInput{
 		Name:      name,
@@ -317,6 +435,67 @@ func (o *secretInitOpts) putSecretInEnv(secretName, envName, value string) error
 	return nil
 }
 
+func (o *secretInitOpts) putSecretsManagerSecretInEnv(secretName, envName, value string) error {
+	name := fmt.Sprintf(fmtSecretParameterName, o.appName, envName, secretName)
+	if o.jsonKey != "" {
+		if err := validateSecretJSONKey(value, o.jsonKey); err != nil {
+			return err
+		}
+	}
+	out, err := o.secretsManagerPutters[envName].PutSecret(secretsmanager.PutSecretInput{
+		Name:      name,
+		Value:     value,
+		KMSKeyID:  o.kmsKeys[envName],
+		Overwrite: o.overwrite,
+		Tags: map[string]string{
+			deploy.AppTagKey: o.appName,
+			deploy.EnvTagKey: envName,
+		},
+	})
+	if err != nil {
+		var targetErr *secretsmanager.ErrSecretAlreadyExists
+		if errors.As(err, &targetErr) {
+			o.shouldShowOverwriteHint = true
+			log.Successf("Secret %s already exists in environment %s as %s. Did not overwrite. \n", color.HighlightUserInput(secretName), color.HighlightUserInput(envName), color.HighlightResource(name))
+			return nil
+		}
+		return err
+	}
+
+	if lambda, ok := o.rotationLambdas[envName]; ok {
+		days := o.rotationDays
+		if days == 0 {
+			days = defaultSecretRotationDays
+		}
+		if err := o.secretsManagerPutters[envName].RotateSecret(secretsmanager.RotateSecretInput{
+			SecretID:               out.ARN,
+			LambdaARN:              lambda,
+			AutomaticallyAfterDays: days,
+		}); err != nil {
+			return err
+		}
+	}
+
+	if !out.Created {
+		log.Successln(fmt.Sprintf("Secret %s already exists in environment %s. Overwritten.", name, color.HighlightUserInput(envName)))
+		return nil
+	}
+	log.Successln(fmt.Sprintf("Successfully put secret %s in environment %s as %s.", color.HighlightUserInput(secretName), color.HighlightUserInput(envName), color.HighlightResource(name)))
+	return nil
+}
+
+// validateSecretJSONKey returns an error if the value of a secret isn't a JSON object containing the key.
+func validateSecretJSONKey(value, key string) error {
+	var obj map[string]interface{}
+	if err := json.Unmarshal([]byte(value), &obj); err != nil {
+		return fmt.Errorf("value of the secret must be a JSON object with key %s", key)
+	}
+	if _, ok := obj[key]; !ok {
+		return fmt.Errorf("key %s not found in the JSON value of the secret", key)
+	}
+	return nil
+}
+
 func (o *secretInitOpts) parseSecretsInputFile() (map[string]map[string]string, error) {
 	raw, err := o.readFile()
 	if err != nil {
@@ -379,6 +558,14 @@ func (o *secretInitOpts) askForSecretValues() error {
 			color.HighlightCode("copilot secret init"))
 		return fmt.Errorf("no environment is found in app %s", o.appName)
 	}
+	if o.storeType == secretStoreSecretsManager {
+		// Fail before prompting for the values if an environment can't store them.
+		for _, env := range envs {
+			if err := validateSecretsManagerEnvVersion(o.newEnvVersionGetter, o.appName, env.Name); err != nil {
+				return err
+			}
+		}
+	}
 
 	values := make(map[string]string)
 	for _, env := range envs {
@@ -404,6 +591,12 @@ func (o *secretInitOpts) RecommendActions() error {
 	secretsManifestExample := "secrets:"
 	for secretName := range o.secretValues {
 		currSecret := fmt.Sprintf("%s: %s", secretName, fmt.Sprintf(fmtSecretParameterNameMftExample, secretName))
+		if o.storeType == secretStoreSecretsManager {
+			currSecret = fmt.Sprintf("%s:\n      secretsmanager: %s", secretName, fmt.Sprintf(fmtSecretParameterNameMftExample, secretName))
+			if o.jsonKey != "" {
+				currSecret = fmt.Sprintf("%s\n      key: %s", currSecret, o.jsonKey)
+			}
+		}
 		secretsManifestExample = fmt.Sprintf("%s\n%s", secretsManifestExample, fmt.Sprintf("    %s", currSecret))
 	}
 
@@ -466,14 +659,17 @@ func buildSecretInitCmd() *cobra.Command {
 	vars := secretInitVars{}
 	cmd := &cobra.Command{
 		Use:   "init",
-		Short: "Create or update secrets in SSM Parameter Store.",
+		Short: "Create or update secrets in SSM Parameter Store or Secrets Manager.",
 		Example: `
 Create a secret with prompts. 
 /code $ copilot secret init
 Create a secret named db-password in multiple environments.
 /code $ copilot secret init --name db-password
 Create secrets from input.yml. For the format of the YAML file, please see https://aws.github.io/copilot-cli/docs/commands/secret-init/.
-/code $ copilot secret init --cli-input-yaml input.yml`,
+/code $ copilot secret init --cli-input-yaml input.yml
+Create a JSON secret in Secrets Manager rotated every 7 days, and inject its "password" key.
+/code $ copilot secret init --name db --store secretsmanager --json-key password \
+  --rotation-lambdas test=arn:aws:lambda:us-west-2:123456789012:function:rotate-db --rotation-days 7`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newSecretInitOpts(vars)
 			if err != nil {
@@ -506,5 +702,10 @@ Create secrets from input.yml. For the format of the YAML file, please see https
 	cmd.Flags().StringToStringVar(&vars.values, valuesFlag, nil, secretValuesFlagDescription)
 	cmd.Flags().BoolVar(&vars.overwrite, overwriteFlag, false, secretOverwriteFlagDescription)
 	cmd.Flags().StringVar(&vars.inputFilePath, inputFilePathFlag, "", secretInputFilePathFlagDescription)
+	cmd.Flags().StringVar(&vars.storeType, secretStoreFlag, secretStoreSSM, secretStoreFlagDescription)
+	cmd.Flags().StringToStringVar(&vars.kmsKeys, kmsKeysFlag, nil, secretKMSKeysFlagDescription)
+	cmd.Flags().StringToStringVar(&vars.rotationLambdas, rotationLambdasFlag, nil, secretRotationLambdasFlagDescription)
+	cmd.Flags().IntVar(&vars.rotationDays, rotationDaysFlag, 0, secretRotationDaysFlagDescription)
+	cmd.Flags().StringVar(&vars.jsonKey, jsonKeyFlag, "", secretJSONKeyFlagDescription)
 	return cmd
 }
@@ -14,6 +14,7 @@ import (
 
 	"github.com/aws/copilot-cli/internal/pkg/config"
 
+	"github.com/aws/copilot-cli/internal/pkg/aws/secretsmanager"
 	"github.com/aws/copilot-cli/internal/pkg/aws/ssm"
 	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
 	"github.com/golang/mock/gomock"
@@ -23,8 +24,9 @@ import (
 )
 
 type secretInitMocks struct {
-	mockFS    afero.Fs
-	mockStore *mocks.Mockstore
+	mockFS      afero.Fs
+	mockStore   *mocks.Mockstore
+	mockVersion *mocks.MockversionGetter
 }
 
 func TestSecretInitOpts_Validate(t *testing.T) {
@@ -35,6 +37,12 @@ func TestSecretInitOpts_Validate(t *testing.T) {
 		inOverwrite     bool
 		inInputFilePath string
 
+		inStoreType       string
+		inKMSKeys         map[string]string
+		inRotationLambdas map[string]string
+		inRotationDays    int
+		inJSONKey         string
+
 		setupMocks func(m secretInitMocks)
 
 		wantedError error
@@ -109,6 +117,125 @@ func TestSecretInitOpts_Validate(t *testing.T) {
 			setupMocks:      func(m secretInitMocks) {},
 			wantedError:     errors.New("cannot specify `--cli-input-yaml` with `--values`"),
 		},
+		"error if the store is invalid": {
+			inStoreType: "vault",
+			setupMocks:  func(m secretInitMocks) {},
+			wantedError: errors.New(`invalid secret store vault: must be one of "ssm", "secretsmanager"`),
+		},
+		"error if secrets manager flags are used with ssm": {
+			inJSONKey:   "password",
+			setupMocks:  func(m secretInitMocks) {},
+			wantedError: errors.New("`--kms-keys`, `--rotation-lambdas`, `--rotation-days` and `--json-key` can only be used with `--store secretsmanager`"),
+		},
+		"error if rotation days are specified without a rotation lambda": {
+			inStoreType:    secretStoreSecretsManager,
+			inRotationDays: 7,
+			setupMocks:     func(m secretInitMocks) {},
+			wantedError:    errors.New("`--rotation-days` must be specified along with `--rotation-lambdas`"),
+		},
+		"error if rotation days are out of range": {
+			inStoreType: secretStoreSecretsManager,
+			inRotationLambdas: map[string]string{
+				"good_village": "arn:aws:lambda:us-west-2:123456789012:function:rotate",
+			},
+			inRotationDays: 1001,
+			setupMocks:     func(m secretInitMocks) {},
+			wantedError:    errors.New("`--rotation-days` must be between 1 and 1000"),
+		},
+		"error if the rotation lambda is not an ARN": {
+			inStoreType: secretStoreSecretsManager,
+			inRotationLambdas: map[string]string{
+				"good_village": "rotate",
+			},
+			setupMocks:  func(m secretInitMocks) {},
+			wantedError: errors.New("parse rotation lambda ARN rotate of environment good_village: arn: invalid prefix"),
+		},
+		"error if the rotation lambda is not in the region of its environment": {
+			inApp:       "dragon_slaying",
+			inStoreType: secretStoreSecretsManager,
+			inRotationLambdas: map[string]string{
+				"good_village": "arn:aws:lambda:us-east-1:123456789012:function:rotate",
+			},
+			setupMocks: func(m secretInitMocks) {
+				m.mockStore.EXPECT().GetApplication("dragon_slaying").Return(&config.Application{}, nil)
+				m.mockStore.EXPECT().GetEnvironment("dragon_slaying", "good_village").Return(&config.Environment{
+					Region:    "us-west-2",
+					AccountID: "123456789012",
+				}, nil)
+			},
+			wantedError: errors.New("rotation lambda arn:aws:lambda:us-east-1:123456789012:function:rotate of environment good_village must be in account 123456789012 and region us-west-2"),
+		},
+		"error if the rotation lambda is not in the account of its environment": {
+			inApp:       "dragon_slaying",
+			inStoreType: secretStoreSecretsManager,
+			inRotationLambdas: map[string]string{
+				"good_village": "arn:aws:lambda:us-west-2:210987654321:function:rotate",
+			},
+			setupMocks: func(m secretInitMocks) {
+				m.mockStore.EXPECT().GetApplication("dragon_slaying").Return(&config.Application{}, nil)
+				m.mockStore.EXPECT().GetEnvironment("dragon_slaying", "good_village").Return(&config.Environment{
+					Region:    "us-west-2",
+					AccountID: "123456789012",
+				}, nil)
+			},
+			wantedError: errors.New("rotation lambda arn:aws:lambda:us-west-2:210987654321:function:rotate of environment good_village must be in account 123456789012 and region us-west-2"),
+		},
+		"error if the environment of a kms key does not exist": {
+			inApp:       "dragon_slaying",
+			inStoreType: secretStoreSecretsManager,
+			inKMSKeys: map[string]string{
+				"cave": "arn:aws:kms:us-west-2:123456789012:key/mock",
+			},
+			setupMocks: func(m secretInitMocks) {
+				m.mockStore.EXPECT().GetApplication("dragon_slaying").Return(&config.Application{}, nil)
+				m.mockStore.EXPECT().GetEnvironment("dragon_slaying", "cave").Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("get environment cave in application dragon_slaying: some error"),
+		},
+		"error if an environment of the values can't manage secrets manager secrets": {
+			inApp:       "dragon_slaying",
+			inStoreType: secretStoreSecretsManager,
+			inValues: map[string]string{
+				"good_village": "hunter2",
+			},
+			setupMocks: func(m secretInitMocks) {
+				m.mockStore.EXPECT().GetApplication("dragon_slaying").Return(&config.Application{}, nil)
+				m.mockStore.EXPECT().GetEnvironment("dragon_slaying", "good_village").Return(&config.Environment{}, nil)
+				m.mockVersion.EXPECT().Version().Return("v1.10.0", nil)
+			},
+			wantedError: errors.New("environment good_village is on version v1.10.0, which can't manage Secrets Manager secrets: run `copilot env upgrade --app dragon_slaying --name good_village` first"),
+		},
+		"valid with secrets manager values in an upgraded environment": {
+			inApp:       "dragon_slaying",
+			inStoreType: secretStoreSecretsManager,
+			inValues: map[string]string{
+				"good_village": "hunter2",
+			},
+			setupMocks: func(m secretInitMocks) {
+				m.mockStore.EXPECT().GetApplication("dragon_slaying").Return(&config.Application{}, nil)
+				m.mockStore.EXPECT().GetEnvironment("dragon_slaying", "good_village").Return(&config.Environment{}, nil)
+				m.mockVersion.EXPECT().Version().Return(deploy.SecretsManagerLeastEnvTemplateVersion, nil)
+			},
+		},
+		"valid with secrets manager options": {
+			inApp:       "dragon_slaying",
+			inStoreType: secretStoreSecretsManager,
+			inRotationLambdas: map[string]string{
+				"good_village": "arn:aws:lambda:us-west-2:123456789012:function:rotate",
+			},
+			inRotationDays: 7,
+			inJSONKey:      "password",
+			inKMSKeys: map[string]string{
+				"good_village": "arn:aws:kms:us-west-2:123456789012:key/mock",
+			},
+			setupMocks: func(m secretInitMocks) {
+				m.mockStore.EXPECT().GetApplication("dragon_slaying").Return(&config.Application{}, nil)
+				m.mockStore.EXPECT().GetEnvironment("dragon_slaying", "good_village").Return(&config.Environment{
+					Region:    "us-west-2",
+					AccountID: "123456789012",
+				}, nil).Times(2)
+			},
+		},
 	}
 
 	for name, tc := range testCases {
@@ -118,21 +245,34 @@ func TestSecretInitOpts_Validate(t *testing.T) {
 
 			mockStore := mocks.NewMockstore(ctrl)
 
+			storeType := secretStoreSSM
+			if tc.inStoreType != "" {
+				storeType = tc.inStoreType
+			}
 			opts := secretInitOpts{
 				secretInitVars: secretInitVars{
-					appName:       tc.inApp,
-					name:          tc.inName,
-					values:        tc.inValues,
-					inputFilePath: tc.inInputFilePath,
-					overwrite:     tc.inOverwrite,
+					appName:         tc.inApp,
+					name:            tc.inName,
+					values:          tc.inValues,
+					inputFilePath:   tc.inInputFilePath,
+					overwrite:       tc.inOverwrite,
+					storeType:       storeType,
+					kmsKeys:         tc.inKMSKeys,
+					rotationLambdas: tc.inRotationLambdas,
+					rotationDays:    tc.inRotationDays,
+					jsonKey:         tc.inJSONKey,
 				},
 				fs:    &afero.Afero{Fs: afero.NewMemMapFs()},
 				store: mockStore,
 			}
 
 			m := secretInitMocks{
-				mockFS:    opts.fs,
-				mockStore: mockStore,
+				mockFS:      opts.fs,
+				mockStore:   mockStore,
+				mockVersion: mocks.NewMockversionGetter(ctrl),
+			}
+			opts.newEnvVersionGetter = func(_, _ string) (versionGetter, error) {
+				return m.mockVersion, nil
 			}
 			tc.setupMocks(m)
 
@@ -153,6 +293,7 @@ type secretInitAskMocks struct {
 	mockStore    *mocks.Mockstore
 	mockPrompter *mocks.Mockprompter
 	mockSelector *mocks.MockappSelector
+	mockVersion  *mocks.MockversionGetter
 }
 
 func TestSecretInitOpts_Ask(t *testing.T) {
@@ -171,9 +312,10 @@ func TestSecretInitOpts_Ask(t *testing.T) {
 		}
 	)
 	testCases := map[string]struct {
-		inAppName string
-		inName    string
-		inValues  map[string]string
+		inAppName   string
+		inName      string
+		inValues    map[string]string
+		inStoreType string
 
 		setupMocks func(m secretInitAskMocks)
 
@@ -258,6 +400,21 @@ func TestSecretInitOpts_Ask(t *testing.T) {
 			},
 			wantedVars: wantedVars,
 		},
+		"error before prompting for values if an environment can't manage secrets manager secrets": {
+			inAppName:   wantedApp,
+			inName:      wantedName,
+			inStoreType: secretStoreSecretsManager,
+			setupMocks: func(m secretInitAskMocks) {
+				m.mockStore.EXPECT().ListEnvironments("my-app").Return([]*config.Environment{
+					{
+						Name: "test",
+					},
+				}, nil)
+				m.mockVersion.EXPECT().Version().Return("v1.10.0", nil)
+				m.mockPrompter.EXPECT().GetSecret(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			wantedError: errors.New("environment test is on version v1.10.0, which can't manage Secrets Manager secrets: run `copilot env upgrade --app my-app --name test` first"),
+		},
 		"error listing environments": {
 			inAppName: wantedApp,
 			inName:    wantedName,
@@ -314,17 +471,22 @@ func TestSecretInitOpts_Ask(t *testing.T) {
 				mockPrompter: mocks.NewMockprompter(ctrl),
 				mockSelector: mocks.NewMockappSelector(ctrl),
 				mockStore:    mocks.NewMockstore(ctrl),
+				mockVersion:  mocks.NewMockversionGetter(ctrl),
 			}
 
 			opts := secretInitOpts{
 				secretInitVars: secretInitVars{
-					appName: tc.inAppName,
-					name:    tc.inName,
-					values:  tc.inValues,
+					appName:   tc.inAppName,
+					name:      tc.inName,
+					values:    tc.inValues,
+					storeType: tc.inStoreType,
 				},
 				prompter: m.mockPrompter,
 				store:    m.mockStore,
 				selector: m.mockSelector,
+				newEnvVersionGetter: func(_, _ string) (versionGetter, error) {
+					return m.mockVersion, nil
+				},
 			}
 
 			tc.setupMocks(m)
@@ -341,9 +503,10 @@ func TestSecretInitOpts_Ask(t *testing.T) {
 }
 
 type secretInitExecuteMocks struct {
-	mockStore        *mocks.Mockstore
-	mockSecretPutter *mocks.MocksecretPutter
-	mockEnvUpgrader  *mocks.MockactionCommand
+	mockStore                      *mocks.Mockstore
+	mockSecretPutter               *mocks.MocksecretPutter
+	mockSecretsManagerSecretPutter *mocks.MocksecretsManagerSecretPutter
+	mockEnvUpgrader                *mocks.MockactionCommand
 }
 
 func TestSecretInitOpts_Execute(t *testing.T) {
@@ -365,6 +528,11 @@ func TestSecretInitOpts_Execute(t *testing.T) {
 
 		inOverwrite bool
 
+		inStoreType       string
+		inKMSKeys         map[string]string
+		inRotationLambdas map[string]string
+		inJSONKey         string
+
 		mockInputFileContent []byte
 		setupMocks           func(m secretInitExecuteMocks)
 
@@ -557,6 +725,78 @@ db-host:
 				},
 			},
 		},
+		"creates secrets in secrets manager and configures their rotation": {
+			inAppName:   testApp,
+			inName:      "db",
+			inStoreType: secretStoreSecretsManager,
+			inRotationLambdas: map[string]string{
+				"test": "arn:aws:lambda:us-west-2:123456789012:function:rotate",
+			},
+			inJSONKey: "password",
+			inKMSKeys: map[string]string{
+				"test": "arn:aws:kms:us-west-2:123456789012:key/mock",
+			},
+			inValues: map[string]string{
+				"test": `{"username":"admin","password":"hunter2"}`,
+			},
+
+			setupMocks: func(m secretInitExecuteMocks) {
+				m.mockSecretsManagerSecretPutter.EXPECT().PutSecret(secretsmanager.PutSecretInput{
+					Name:     "/copilot/test-app/test/secrets/db",
+					Value:    `{"username":"admin","password":"hunter2"}`,
+					KMSKeyID: "arn:aws:kms:us-west-2:123456789012:key/mock",
+					Tags: map[string]string{
+						deploy.AppTagKey: "test-app",
+						deploy.EnvTagKey: "test",
+					},
+				}).Return(&secretsmanager.PutSecretOutput{
+					ARN:     "arn:aws:secretsmanager:us-west-2:123456789012:secret:/copilot/test-app/test/secrets/db-AbCdEf",
+					Created: true,
+				}, nil)
+				m.mockSecretsManagerSecretPutter.EXPECT().RotateSecret(secretsmanager.RotateSecretInput{
+					SecretID:               "arn:aws:secretsmanager:us-west-2:123456789012:secret:/copilot/test-app/test/secrets/db-AbCdEf",
+					LambdaARN:              "arn:aws:lambda:us-west-2:123456789012:function:rotate",
+					AutomaticallyAfterDays: defaultSecretRotationDays,
+				}).Return(nil)
+				m.mockEnvUpgrader.EXPECT().Execute().Return(nil)
+			},
+		},
+		"does not put a secrets manager secret whose value does not contain the json key": {
+			inAppName:   testApp,
+			inName:      "db",
+			inStoreType: secretStoreSecretsManager,
+			inJSONKey:   "password",
+			inValues: map[string]string{
+				"test": `{"username":"admin"}`,
+			},
+
+			setupMocks: func(m secretInitExecuteMocks) {
+				m.mockEnvUpgrader.EXPECT().Execute().Return(nil)
+			},
+			wantedError: &errSecretFailedInSomeEnvironments{
+				secretName: "db",
+				errorsForEnvironments: map[string]error{
+					"test": errors.New("key password not found in the JSON value of the secret"),
+				},
+			},
+		},
+		"does not overwrite an existing secrets manager secret": {
+			inAppName:   testApp,
+			inName:      "db",
+			inStoreType: secretStoreSecretsManager,
+			inRotationLambdas: map[string]string{
+				"test": "arn:aws:lambda:us-west-2:123456789012:function:rotate",
+			},
+			inValues: map[string]string{
+				"test": "hunter2",
+			},
+
+			setupMocks: func(m secretInitExecuteMocks) {
+				m.mockSecretsManagerSecretPutter.EXPECT().PutSecret(gomock.Any()).Return(nil, &secretsmanager.ErrSecretAlreadyExists{})
+				m.mockSecretsManagerSecretPutter.EXPECT().RotateSecret(gomock.Any()).Times(0)
+				m.mockEnvUpgrader.EXPECT().Execute().Return(nil)
+			},
+		},
 	}
 
 	for name, tc := range testCases {
@@ -565,24 +805,34 @@ db-host:
 			defer ctrl.Finish()
 
 			m := secretInitExecuteMocks{
-				mockStore:        mocks.NewMockstore(ctrl),
-				mockSecretPutter: mocks.NewMocksecretPutter(ctrl),
-				mockEnvUpgrader:  mocks.NewMockactionCommand(ctrl),
+				mockStore:                      mocks.NewMockstore(ctrl),
+				mockSecretPutter:               mocks.NewMocksecretPutter(ctrl),
+				mockSecretsManagerSecretPutter: mocks.NewMocksecretsManagerSecretPutter(ctrl),
+				mockEnvUpgrader:                mocks.NewMockactionCommand(ctrl),
 			}
 			tc.setupMocks(m)
 
+			storeType := secretStoreSSM
+			if tc.inStoreType != "" {
+				storeType = tc.inStoreType
+			}
 			opts := secretInitOpts{
 				secretInitVars: secretInitVars{
-					appName:       tc.inAppName,
-					name:          tc.inName,
-					values:        tc.inValues,
-					overwrite:     tc.inOverwrite,
-					inputFilePath: tc.inInputFilePath,
+					appName:         tc.inAppName,
+					name:            tc.inName,
+					values:          tc.inValues,
+					overwrite:       tc.inOverwrite,
+					inputFilePath:   tc.inInputFilePath,
+					storeType:       storeType,
+					kmsKeys:         tc.inKMSKeys,
+					rotationLambdas: tc.inRotationLambdas,
+					jsonKey:         tc.inJSONKey,
 				},
 				store: m.mockStore,
 
-				secretPutters:  make(map[string]secretPutter),
-				envUpgradeCMDs: make(map[string]actionCommand),
+				secretPutters:         make(map[string]secretPutter),
+				secretsManagerPutters: make(map[string]secretsManagerSecretPutter),
+				envUpgradeCMDs:        make(map[string]actionCommand),
 				readFile: func() ([]byte, error) {
 					return tc.mockInputFileContent, nil
 				},
@@ -590,6 +840,7 @@ db-host:
 
 			opts.configureClientsForEnv = func(envName string) error {
 				opts.secretPutters[envName] = m.mockSecretPutter
+				opts.secretsManagerPutters[envName] = m.mockSecretsManagerSecretPutter
 				opts.envUpgradeCMDs[envName] = m.mockEnvUpgrader
 				return nil
 			}
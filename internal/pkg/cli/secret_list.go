@@ -0,0 +1,286 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/dustin/go-humanize"
+	"github.com/spf13/cobra"
+
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+)
+
+const (
+	secretListAppNamePrompt     = "Which application's secrets would you like to list?"
+	secretListAppNamePromptHelp = "An application is a collection of related services."
+)
+
+const (
+	// Display settings of the secrets table.
+	secretListMinCellWidth     = 20  // minimum number of characters in a table's cell.
+	secretListTabWidth         = 4   // number of characters in between columns.
+	secretListCellPaddingWidth = 2   // number of padding characters added by default to a cell.
+	secretListPaddingChar      = ' ' // character in between columns.
+)
+
+type secretListVars struct {
+	appName          string
+	envName          string
+	shouldOutputJSON bool
+}
+
+type secretListOpts struct {
+	secretListVars
+
+	store store
+	ws    wsWorkloadManifestReader // Nil if the command is not run in a workspace.
+	sel   appSelector
+	w     io.Writer
+	now   func() time.Time
+
+	newSecretLister               func(env *config.Environment) (secretLister, error)
+	newSecretsManagerSecretLister func(env *config.Environment) (secretsManagerSecretLister, error)
+	newEnvVersionGetter           func(app, env string) (versionGetter, error)
+}
+
+// secretListing is a secret of an environment.
+type secretListing struct {
+	Name         string    `json:"name"`
+	Environment  string    `json:"environment"`
+	Store        string    `json:"store"`
+	Parameter    string    `json:"parameter"` // Name of the SSM parameter or of the Secrets Manager secret.
+	Version      int64     `json:"version"`   // Zero for Secrets Manager secrets.
+	LastModified time.Time `json:"lastModified"`
+	ReferencedBy []string  `json:"referencedBy"`
+}
+
+func newSecretListOpts(vars secretListVars) (*secretListOpts, error) {
+	store, err := config.NewStore()
+	if err != nil {
+		return nil, fmt.Errorf("new config store: %w", err)
+	}
+	opts := &secretListOpts{
+		secretListVars: vars,
+		store:          store,
+		sel:            selector.NewSelect(prompt.New(), store),
+		w:              os.Stdout,
+		now:            time.Now,
+		newSecretLister: func(env *config.Environment) (secretLister, error) {
+			return newSSMForEnv(env)
+		},
+		newSecretsManagerSecretLister: func(env *config.Environment) (secretsManagerSecretLister, error) {
+			return newSecretsManagerForEnv(env)
+		},
+		newEnvVersionGetter: newEnvVersionGetterForSecrets(store),
+	}
+	// The workloads that reference the secrets can only be found if the command is run in a workspace.
+	if ws, err := workspace.New(); err == nil {
+		opts.ws = ws
+	}
+	return opts, nil
+}
+
+// Validate returns an error if the values provided by the user are invalid.
+func (o *secretListOpts) Validate() error {
+	if o.appName == "" {
+		return nil
+	}
+	if _, err := o.store.GetApplication(o.appName); err != nil {
+		return fmt.Errorf("get application %s: %w", o.appName, err)
+	}
+	if o.envName != "" {
+		if _, err := o.store.GetEnvironment(o.appName, o.envName); err != nil {
+			return fmt.Errorf("get environment %s in application %s: %w", o.envName, o.appName, err)
+		}
+	}
+	return nil
+}
+
+// Ask prompts for fields that are required but not passed in.
+func (o *secretListOpts) Ask() error {
+	if o.appName != "" {
+		return nil
+	}
+	app, err := o.sel.Application(secretListAppNamePrompt, secretListAppNamePromptHelp)
+	if err != nil {
+		return fmt.Errorf("select application: %w", err)
+	}
+	o.appName = app
+	return nil
+}
+
+// Execute lists the secrets of the environments in the application.
+func (o *secretListOpts) Execute() error {
+	envs, err := o.targetEnvs()
+	if err != nil {
+		return err
+	}
+	for _, env := range envs {
+		if err := validateSecretsManagerEnvVersion(o.newEnvVersionGetter, o.appName, env.Name); err != nil {
+			return err
+		}
+	}
+	refs := make(map[string][]string)
+	if o.ws != nil {
+		if refs, err = workloadsReferencingSecrets(o.ws); err != nil {
+			return err
+		}
+	}
+
+	secrets := make([]secretListing, 0)
+	for _, env := range envs {
+		envSecrets, err := o.listSecrets(env, refs)
+		if err != nil {
+			return err
+		}
+		secrets = append(secrets, envSecrets...)
+	}
+
+	if o.shouldOutputJSON {
+		data, err := o.jsonOutput(secrets)
+		if err != nil {
+			return err
+		}
+		fmt.Fprint(o.w, data)
+		return nil
+	}
+	if len(secrets) == 0 {
+		log.Infof("No secrets found in application %s.\n", o.appName)
+		return nil
+	}
+	fmt.Fprint(o.w, o.humanOutput(secrets))
+	return nil
+}
+
+// listSecrets returns the secrets of the environment stored in SSM, followed by the ones stored in Secrets Manager.
+func (o *secretListOpts) listSecrets(env *config.Environment, refs map[string][]string) ([]secretListing, error) {
+	tags := map[string]string{
+		deploy.AppTagKey: o.appName,
+		deploy.EnvTagKey: env.Name,
+	}
+	prefix := secretParameterPrefix(o.appName, env.Name)
+	refs = secretReferencesInEnv(refs, o.appName, env.Name)
+	lister, err := o.newSecretLister(env)
+	if err != nil {
+		return nil, err
+	}
+	summaries, err := lister.ListSecrets(tags)
+	if err != nil {
+		return nil, fmt.Errorf("list secrets in environment %s: %w", env.Name, err)
+	}
+	var secrets []secretListing
+	for _, summary := range summaries {
+		name := strings.TrimPrefix(summary.Name, prefix)
+		secrets = append(secrets, secretListing{
+			Name:         name,
+			Environment:  env.Name,
+			Store:        secretStoreSSM,
+			Parameter:    summary.Name,
+			Version:      summary.Version,
+			LastModified: summary.LastModified,
+			ReferencedBy: refs[summary.Name],
+		})
+	}
+
+	smLister, err := o.newSecretsManagerSecretLister(env)
+	if err != nil {
+		return nil, err
+	}
+	smSummaries, err := smLister.ListSecrets(tags)
+	if err != nil {
+		return nil, fmt.Errorf("list secrets manager secrets in environment %s: %w", env.Name, err)
+	}
+	for _, summary := range smSummaries {
+		name := strings.TrimPrefix(summary.Name, prefix)
+		secrets = append(secrets, secretListing{
+			Name:         name,
+			Environment:  env.Name,
+			Store:        secretStoreSecretsManager,
+			Parameter:    summary.Name,
+			LastModified: summary.LastModified,
+			ReferencedBy: refs[summary.Name],
+		})
+	}
+	return secrets, nil
+}
+
+func (o *secretListOpts) targetEnvs() ([]*config.Environment, error) {
+	if o.envName != "" {
+		env, err := o.store.GetEnvironment(o.appName, o.envName)
+		if err != nil {
+			return nil, fmt.Errorf("get environment %s in application %s: %w", o.envName, o.appName, err)
+		}
+		return []*config.Environment{env}, nil
+	}
+	envs, err := o.store.ListEnvironments(o.appName)
+	if err != nil {
+		return nil, fmt.Errorf("list environments in application %s: %w", o.appName, err)
+	}
+	return envs, nil
+}
+
+func (o *secretListOpts) humanOutput(secrets []secretListing) string {
+	b := &strings.Builder{}
+	writer := tabwriter.NewWriter(b, secretListMinCellWidth, secretListTabWidth, secretListCellPaddingWidth, secretListPaddingChar, 0)
+	fmt.Fprintln(writer, "Name\tEnvironment\tStore\tLast Modified\tReferenced By")
+	fmt.Fprintln(writer, "----\t-----------\t-----\t-------------\t-------------")
+	for _, secret := range secrets {
+		referencedBy := "-"
+		if len(secret.ReferencedBy) > 0 {
+			referencedBy = strings.Join(secret.ReferencedBy, ", ")
+		}
+		fmt.Fprintf(writer, "%s\t%s\t%s\t%s\t%s\n", secret.Name, secret.Environment, secret.Store,
+			humanize.RelTime(secret.LastModified, o.now(), "ago", "from now"), referencedBy)
+	}
+	writer.Flush()
+	return b.String()
+}
+
+func (o *secretListOpts) jsonOutput(secrets []secretListing) (string, error) {
+	type serializedSecrets struct {
+		Secrets []secretListing `json:"secrets"`
+	}
+	b, err := json.Marshal(serializedSecrets{Secrets: secrets})
+	if err != nil {
+		return "", fmt.Errorf("marshal secrets: %w", err)
+	}
+	return fmt.Sprintf("%s\n", b), nil
+}
+
+// buildSecretListCmd builds the command for listing the secrets of an application.
+func buildSecretListCmd() *cobra.Command {
+	vars := secretListVars{}
+	cmd := &cobra.Command{
+		Use:   "ls",
+		Short: "Lists the secrets of an application.",
+		Long: `Lists the secrets of each environment in an application, stored in SSM Parameter Store or Secrets Manager,
+and the workloads in the workspace whose manifest references them.`,
+		Example: `
+  Lists the secrets of the "test" environment of the "myapp" application.
+  /code $ copilot secret ls -a myapp --env test`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newSecretListOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", secretLsEnvFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
+	return cmd
+}
@@ -0,0 +1,258 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/secretsmanager"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWorkloadsReferencingSecrets(t *testing.T) {
+	mockError := errors.New("some error")
+	testCases := map[string]struct {
+		setupMocks func(m *mocks.MockwsWorkloadManifestReader)
+
+		wantedRefs  map[string][]string
+		wantedError error
+	}{
+		"errors if fail to list workloads": {
+			setupMocks: func(m *mocks.MockwsWorkloadManifestReader) {
+				m.EXPECT().ListWorkloads().Return(nil, mockError)
+			},
+			wantedError: fmt.Errorf("list workloads in workspace: %w", mockError),
+		},
+		"errors if fail to read manifest": {
+			setupMocks: func(m *mocks.MockwsWorkloadManifestReader) {
+				m.EXPECT().ListWorkloads().Return([]string{"api"}, nil)
+				m.EXPECT().ReadWorkloadManifest("api").Return(nil, mockError)
+			},
+			wantedError: fmt.Errorf("read manifest of api: %w", mockError),
+		},
+		"collects secrets referenced by containers, sidecars and environment overrides": {
+			setupMocks: func(m *mocks.MockwsWorkloadManifestReader) {
+				m.EXPECT().ListWorkloads().Return([]string{"worker", "api"}, nil)
+				m.EXPECT().ReadWorkloadManifest("worker").Return([]byte(`name: worker
+secrets:
+  DB_PASSWORD: /copilot/myapp/test/secrets/db_password
+`), nil)
+				m.EXPECT().ReadWorkloadManifest("api").Return([]byte(`name: api
+secrets:
+  GITHUB_TOKEN: GH_TOKEN_SECRET
+sidecars:
+  nginx:
+    secrets:
+      DB_PASSWORD: /copilot/myapp/test/secrets/db_password
+environments:
+  prod:
+    secrets:
+      API_KEY: /copilot/myapp/prod/secrets/api_key
+      DB:
+        secretsmanager: /copilot/myapp/prod/secrets/db
+        key: password
+`), nil)
+			},
+			wantedRefs: map[string][]string{
+				"/copilot/myapp/test/secrets/db_password": {"api", "worker"},
+				"/copilot/myapp/prod/secrets/api_key":     {"api"},
+				"/copilot/myapp/prod/secrets/db":          {"api"},
+				"GH_TOKEN_SECRET":                         {"api"},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockwsWorkloadManifestReader(ctrl)
+			tc.setupMocks(m)
+
+			// WHEN
+			refs, err := workloadsReferencingSecrets(m)
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedRefs, refs)
+		})
+	}
+}
+
+func TestSecretReferencesInEnv(t *testing.T) {
+	refs := map[string][]string{
+		"/copilot/myapp/test/secrets/db":                                              {"worker"},
+		"/copilot/${COPILOT_APPLICATION_NAME}/${COPILOT_ENVIRONMENT_NAME}/secrets/db": {"api", "worker"},
+		"/copilot/myapp/prod/secrets/db":                                              {"billing"},
+		"/copilot/otherapp/test/secrets/db":                                           {"legacy"},
+		"GH_TOKEN_SECRET":                                                             {"api"},
+	}
+
+	require.Equal(t, map[string][]string{
+		"/copilot/myapp/test/secrets/db": {"api", "worker"},
+	}, secretReferencesInEnv(refs, "myapp", "test"))
+}
+
+func TestSecretListOpts_Execute(t *testing.T) {
+	const mockApp = "myapp"
+	mockError := errors.New("some error")
+	mockNow := time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC)
+	testEnv := &config.Environment{App: mockApp, Name: "test"}
+	prodEnv := &config.Environment{App: mockApp, Name: "prod"}
+	testCases := map[string]struct {
+		inEnvName     string
+		inEnvVersion  string
+		inOutputJSON  bool
+		setupMocks    func(store *mocks.Mockstore, ws *mocks.MockwsWorkloadManifestReader, lister *mocks.MocksecretLister, smLister *mocks.MocksecretsManagerSecretLister)
+		wantedContent string
+		wantedError   error
+	}{
+		"errors if fail to list environments": {
+			setupMocks: func(store *mocks.Mockstore, _ *mocks.MockwsWorkloadManifestReader, _ *mocks.MocksecretLister, _ *mocks.MocksecretsManagerSecretLister) {
+				store.EXPECT().ListEnvironments(mockApp).Return(nil, mockError)
+			},
+			wantedError: fmt.Errorf("list environments in application myapp: %w", mockError),
+		},
+		"errors if an environment can't manage secrets manager secrets": {
+			inEnvVersion: "v1.10.0",
+			setupMocks: func(store *mocks.Mockstore, _ *mocks.MockwsWorkloadManifestReader, _ *mocks.MocksecretLister, _ *mocks.MocksecretsManagerSecretLister) {
+				store.EXPECT().ListEnvironments(mockApp).Return([]*config.Environment{testEnv, prodEnv}, nil)
+			},
+			wantedError: errors.New("environment test is on version v1.10.0, which can't manage Secrets Manager secrets: run `copilot env upgrade --app myapp --name test` first"),
+		},
+		"errors if fail to list secrets": {
+			inEnvName: "test",
+			setupMocks: func(store *mocks.Mockstore, ws *mocks.MockwsWorkloadManifestReader, lister *mocks.MocksecretLister, _ *mocks.MocksecretsManagerSecretLister) {
+				store.EXPECT().GetEnvironment(mockApp, "test").Return(testEnv, nil)
+				ws.EXPECT().ListWorkloads().Return(nil, nil)
+				lister.EXPECT().ListSecrets(map[string]string{
+					"copilot-application": mockApp,
+					"copilot-environment": "test",
+				}).Return(nil, mockError)
+			},
+			wantedError: fmt.Errorf("list secrets in environment test: %w", mockError),
+		},
+		"errors if fail to list secrets manager secrets": {
+			inEnvName: "test",
+			setupMocks: func(store *mocks.Mockstore, ws *mocks.MockwsWorkloadManifestReader, lister *mocks.MocksecretLister, smLister *mocks.MocksecretsManagerSecretLister) {
+				store.EXPECT().GetEnvironment(mockApp, "test").Return(testEnv, nil)
+				ws.EXPECT().ListWorkloads().Return(nil, nil)
+				lister.EXPECT().ListSecrets(gomock.Any()).Return(nil, nil)
+				smLister.EXPECT().ListSecrets(map[string]string{
+					"copilot-application": mockApp,
+					"copilot-environment": "test",
+				}).Return(nil, mockError)
+			},
+			wantedError: fmt.Errorf("list secrets manager secrets in environment test: %w", mockError),
+		},
+		"writes the secrets of every environment in a table": {
+			setupMocks: func(store *mocks.Mockstore, ws *mocks.MockwsWorkloadManifestReader, lister *mocks.MocksecretLister, smLister *mocks.MocksecretsManagerSecretLister) {
+				store.EXPECT().ListEnvironments(mockApp).Return([]*config.Environment{testEnv, prodEnv}, nil)
+				ws.EXPECT().ListWorkloads().Return([]string{"api"}, nil)
+				ws.EXPECT().ReadWorkloadManifest("api").Return([]byte(`secrets:
+  DB_PASSWORD: /copilot/myapp/test/secrets/db_password
+  API_KEY: /copilot/otherapp/prod/secrets/api_key
+`), nil)
+				lister.EXPECT().ListSecrets(gomock.Any()).Return([]ssm.SecretSummary{
+					{Name: "/copilot/myapp/test/secrets/db_password", Version: 2, LastModified: mockNow.Add(-2 * time.Hour)},
+				}, nil)
+				lister.EXPECT().ListSecrets(gomock.Any()).Return([]ssm.SecretSummary{
+					{Name: "/copilot/myapp/prod/secrets/api_key", Version: 1, LastModified: mockNow.Add(-72 * time.Hour)},
+				}, nil)
+				smLister.EXPECT().ListSecrets(gomock.Any()).Return([]secretsmanager.SecretSummary{
+					{Name: "/copilot/myapp/test/secrets/db", LastModified: mockNow.Add(-1 * time.Hour)},
+				}, nil)
+				smLister.EXPECT().ListSecrets(gomock.Any()).Return(nil, nil)
+			},
+			wantedContent: `Name                Environment         Store               Last Modified       Referenced By
+----                -----------         -----               -------------       -------------
+db_password         test                ssm                 2 hours ago         api
+db                  test                secretsmanager      1 hour ago          -
+api_key             prod                ssm                 3 days ago          -
+`,
+		},
+		"writes the secrets in JSON": {
+			inEnvName:    "test",
+			inOutputJSON: true,
+			setupMocks: func(store *mocks.Mockstore, ws *mocks.MockwsWorkloadManifestReader, lister *mocks.MocksecretLister, smLister *mocks.MocksecretsManagerSecretLister) {
+				store.EXPECT().GetEnvironment(mockApp, "test").Return(testEnv, nil)
+				ws.EXPECT().ListWorkloads().Return(nil, nil)
+				lister.EXPECT().ListSecrets(gomock.Any()).Return([]ssm.SecretSummary{
+					{Name: "/copilot/myapp/test/secrets/db_password", Version: 2, LastModified: mockNow},
+				}, nil)
+				smLister.EXPECT().ListSecrets(gomock.Any()).Return(nil, nil)
+			},
+			wantedContent: `{"secrets":[{"name":"db_password","environment":"test","store":"ssm","parameter":"/copilot/myapp/test/secrets/db_password","version":2,"lastModified":"2021-06-01T12:00:00Z","referencedBy":null}]}
+`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			store := mocks.NewMockstore(ctrl)
+			ws := mocks.NewMockwsWorkloadManifestReader(ctrl)
+			lister := mocks.NewMocksecretLister(ctrl)
+			smLister := mocks.NewMocksecretsManagerSecretLister(ctrl)
+			tc.setupMocks(store, ws, lister, smLister)
+			envVersionGetter := mocks.NewMockversionGetter(ctrl)
+			envVersion := tc.inEnvVersion
+			if envVersion == "" {
+				envVersion = deploy.LatestEnvTemplateVersion
+			}
+			envVersionGetter.EXPECT().Version().Return(envVersion, nil).AnyTimes()
+			b := &bytes.Buffer{}
+
+			opts := &secretListOpts{
+				secretListVars: secretListVars{
+					appName:          mockApp,
+					envName:          tc.inEnvName,
+					shouldOutputJSON: tc.inOutputJSON,
+				},
+				store: store,
+				ws:    ws,
+				w:     b,
+				now: func() time.Time {
+					return mockNow
+				},
+				newSecretLister: func(_ *config.Environment) (secretLister, error) {
+					return lister, nil
+				},
+				newSecretsManagerSecretLister: func(_ *config.Environment) (secretsManagerSecretLister, error) {
+					return smLister, nil
+				},
+				newEnvVersionGetter: func(_, _ string) (versionGetter, error) {
+					return envVersionGetter, nil
+				},
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedContent, b.String())
+		})
+	}
+}
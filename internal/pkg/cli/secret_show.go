@@ -0,0 +1,328 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/dustin/go-humanize"
+	"github.com/spf13/cobra"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/secretsmanager"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+)
+
+const (
+	secretShowAppNamePrompt     = "Which application does the secret belong to?"
+	secretShowAppNamePromptHelp = "An application is a collection of related services."
+	secretShowEnvNamePrompt     = "Which environment is the secret in?"
+	secretShowEnvNamePromptHelp = "Secrets are stored separately in each environment."
+	secretShowNamePrompt        = "Which secret would you like to show?"
+
+	maskedSecretValue = "********"
+)
+
+type secretShowVars struct {
+	appName          string
+	envName          string
+	name             string
+	decrypt          bool
+	shouldOutputJSON bool
+}
+
+type secretShowOpts struct {
+	secretShowVars
+
+	store  store
+	prompt prompter
+	sel    appEnvSelector
+	w      io.Writer
+	now    func() time.Time
+
+	newSecretGetter               func(env *config.Environment) (secretGetter, error)
+	newSecretsManagerSecretGetter func(env *config.Environment) (secretsManagerSecretGetter, error)
+	newEnvVersionGetter           func(app, env string) (versionGetter, error)
+
+	// Cached clients of the environment.
+	getter   secretGetter
+	smGetter secretsManagerSecretGetter
+}
+
+// secretDescription is a secret of an environment.
+type secretDescription struct {
+	Name         string    `json:"name"`
+	Environment  string    `json:"environment"`
+	Store        string    `json:"store"`
+	Parameter    string    `json:"parameter"` // Name of the SSM parameter or of the Secrets Manager secret.
+	ARN          string    `json:"arn"`
+	Version      int64     `json:"version"` // Zero for Secrets Manager secrets.
+	LastModified time.Time `json:"lastModified"`
+	Value        string    `json:"value,omitempty"`
+}
+
+func newSecretShowOpts(vars secretShowVars) (*secretShowOpts, error) {
+	store, err := config.NewStore()
+	if err != nil {
+		return nil, fmt.Errorf("new config store: %w", err)
+	}
+	prompter := prompt.New()
+	return &secretShowOpts{
+		secretShowVars: vars,
+		store:          store,
+		prompt:         prompter,
+		sel:            selector.NewSelect(prompter, store),
+		w:              os.Stdout,
+		now:            time.Now,
+		newSecretGetter: func(env *config.Environment) (secretGetter, error) {
+			return newSSMForEnv(env)
+		},
+		newSecretsManagerSecretGetter: func(env *config.Environment) (secretsManagerSecretGetter, error) {
+			return newSecretsManagerForEnv(env)
+		},
+		newEnvVersionGetter: newEnvVersionGetterForSecrets(store),
+	}, nil
+}
+
+// Validate returns an error if the values provided by the user are invalid.
+func (o *secretShowOpts) Validate() error {
+	if o.appName == "" {
+		return nil
+	}
+	if _, err := o.store.GetApplication(o.appName); err != nil {
+		return fmt.Errorf("get application %s: %w", o.appName, err)
+	}
+	if o.envName != "" {
+		if _, err := o.store.GetEnvironment(o.appName, o.envName); err != nil {
+			return fmt.Errorf("get environment %s in application %s: %w", o.envName, o.appName, err)
+		}
+	}
+	return nil
+}
+
+// Ask prompts for fields that are required but not passed in.
+func (o *secretShowOpts) Ask() error {
+	if o.appName == "" {
+		app, err := o.sel.Application(secretShowAppNamePrompt, secretShowAppNamePromptHelp)
+		if err != nil {
+			return fmt.Errorf("select application: %w", err)
+		}
+		o.appName = app
+	}
+	if o.envName == "" {
+		env, err := o.sel.Environment(secretShowEnvNamePrompt, secretShowEnvNamePromptHelp, o.appName)
+		if err != nil {
+			return fmt.Errorf("select environment: %w", err)
+		}
+		o.envName = env
+	}
+	if o.name != "" {
+		return nil
+	}
+	names, err := o.listSecretNames()
+	if err != nil {
+		return err
+	}
+	if len(names) == 0 {
+		return fmt.Errorf("no secrets found in environment %s", o.envName)
+	}
+	name, err := o.prompt.SelectOne(secretShowNamePrompt, "", names, prompt.WithFinalMessage("Secret:"))
+	if err != nil {
+		return fmt.Errorf("select secret: %w", err)
+	}
+	o.name = name
+	return nil
+}
+
+// Execute shows the secret of the environment, masking its value unless it should be decrypted.
+// The secret is looked up in SSM first, then in Secrets Manager.
+func (o *secretShowOpts) Execute() error {
+	description, err := o.describeSecret()
+	if err != nil {
+		return err
+	}
+	if o.shouldOutputJSON {
+		b, err := json.Marshal(description)
+		if err != nil {
+			return fmt.Errorf("marshal secret: %w", err)
+		}
+		fmt.Fprintf(o.w, "%s\n", b)
+		return nil
+	}
+	fmt.Fprint(o.w, o.humanOutput(*description))
+	return nil
+}
+
+// listSecretNames returns the sorted names of the secrets of the environment in both stores.
+func (o *secretShowOpts) listSecretNames() ([]string, error) {
+	getter, smGetter, err := o.secretGetters()
+	if err != nil {
+		return nil, err
+	}
+	tags := map[string]string{
+		deploy.AppTagKey: o.appName,
+		deploy.EnvTagKey: o.envName,
+	}
+	summaries, err := getter.ListSecrets(tags)
+	if err != nil {
+		return nil, fmt.Errorf("list secrets in environment %s: %w", o.envName, err)
+	}
+	smSummaries, err := smGetter.ListSecrets(tags)
+	if err != nil {
+		return nil, fmt.Errorf("list secrets manager secrets in environment %s: %w", o.envName, err)
+	}
+	prefix := secretParameterPrefix(o.appName, o.envName)
+	seen := make(map[string]bool)
+	var names []string
+	for _, name := range append(ssmSecretNames(summaries), secretsManagerSecretNames(smSummaries)...) {
+		name = strings.TrimPrefix(name, prefix)
+		if seen[name] {
+			continue
+		}
+		seen[name] = true
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+func (o *secretShowOpts) describeSecret() (*secretDescription, error) {
+	getter, smGetter, err := o.secretGetters()
+	if err != nil {
+		return nil, err
+	}
+	name := fmt.Sprintf(fmtSecretParameterName, o.appName, o.envName, o.name)
+	secret, err := getter.GetSecret(name, o.decrypt)
+	if err == nil {
+		return &secretDescription{
+			Name:         o.name,
+			Environment:  o.envName,
+			Store:        secretStoreSSM,
+			Parameter:    secret.Name,
+			ARN:          secret.ARN,
+			Version:      secret.Version,
+			LastModified: secret.LastModified,
+			Value:        secret.Value,
+		}, nil
+	}
+	var errParamNotFound *ssm.ErrParameterNotFound
+	if !errors.As(err, &errParamNotFound) {
+		return nil, fmt.Errorf("get secret %s in environment %s: %w", o.name, o.envName, err)
+	}
+	smSecret, err := smGetter.GetSecret(name, o.decrypt)
+	if err != nil {
+		var errSecretNotFound *secretsmanager.ErrSecretNotFound
+		if errors.As(err, &errSecretNotFound) {
+			return nil, fmt.Errorf("secret %s not found in environment %s", o.name, o.envName)
+		}
+		return nil, fmt.Errorf("get secrets manager secret %s in environment %s: %w", o.name, o.envName, err)
+	}
+	return &secretDescription{
+		Name:         o.name,
+		Environment:  o.envName,
+		Store:        secretStoreSecretsManager,
+		Parameter:    smSecret.Name,
+		ARN:          smSecret.ARN,
+		LastModified: smSecret.LastModified,
+		Value:        smSecret.Value,
+	}, nil
+}
+
+func (o *secretShowOpts) secretGetters() (secretGetter, secretsManagerSecretGetter, error) {
+	if o.getter != nil && o.smGetter != nil {
+		return o.getter, o.smGetter, nil
+	}
+	env, err := o.store.GetEnvironment(o.appName, o.envName)
+	if err != nil {
+		return nil, nil, fmt.Errorf("get environment %s in application %s: %w", o.envName, o.appName, err)
+	}
+	if err := validateSecretsManagerEnvVersion(o.newEnvVersionGetter, o.appName, o.envName); err != nil {
+		return nil, nil, err
+	}
+	getter, err := o.newSecretGetter(env)
+	if err != nil {
+		return nil, nil, err
+	}
+	smGetter, err := o.newSecretsManagerSecretGetter(env)
+	if err != nil {
+		return nil, nil, err
+	}
+	o.getter, o.smGetter = getter, smGetter
+	return getter, smGetter, nil
+}
+
+func ssmSecretNames(summaries []ssm.SecretSummary) []string {
+	var names []string
+	for _, summary := range summaries {
+		names = append(names, summary.Name)
+	}
+	return names
+}
+
+func secretsManagerSecretNames(summaries []secretsmanager.SecretSummary) []string {
+	var names []string
+	for _, summary := range summaries {
+		names = append(names, summary.Name)
+	}
+	return names
+}
+
+func (o *secretShowOpts) humanOutput(secret secretDescription) string {
+	value := maskedSecretValue
+	if o.decrypt {
+		value = secret.Value
+	}
+	b := &strings.Builder{}
+	fmt.Fprint(b, color.Bold.Sprint("About\n\n"))
+	fmt.Fprintf(b, "  Name            %s\n", secret.Name)
+	fmt.Fprintf(b, "  Environment     %s\n", secret.Environment)
+	fmt.Fprintf(b, "  Store           %s\n", secret.Store)
+	fmt.Fprintf(b, "  Parameter       %s\n", secret.Parameter)
+	if secret.Store == secretStoreSSM {
+		fmt.Fprintf(b, "  Version         %d\n", secret.Version)
+	}
+	fmt.Fprintf(b, "  Last Modified   %s\n", humanize.RelTime(secret.LastModified, o.now(), "ago", "from now"))
+	fmt.Fprintf(b, "  Value           %s\n", value)
+	return b.String()
+}
+
+// buildSecretShowCmd builds the command for showing a secret of an environment.
+func buildSecretShowCmd() *cobra.Command {
+	vars := secretShowVars{}
+	cmd := &cobra.Command{
+		Use:   "show",
+		Short: "Shows the details of a secret in an environment.",
+		Long: `Shows the details of a secret in an environment, stored in SSM Parameter Store or Secrets Manager.
+The value of the secret is masked unless --decrypt is specified.`,
+		Example: `
+  Shows the details of the "db_password" secret in the "test" environment.
+  /code $ copilot secret show -n db_password --env test
+  Shows the decrypted value of the secret.
+  /code $ copilot secret show -n db_password --env test --decrypt`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newSecretShowOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", secretShowNameFlagDescription)
+	cmd.Flags().BoolVar(&vars.decrypt, decryptFlag, false, secretDecryptFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
+	return cmd
+}
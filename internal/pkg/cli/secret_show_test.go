@@ -0,0 +1,300 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/secretsmanager"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type secretShowMocks struct {
+	store    *mocks.Mockstore
+	prompt   *mocks.Mockprompter
+	sel      *mocks.MockappEnvSelector
+	getter   *mocks.MocksecretGetter
+	smGetter *mocks.MocksecretsManagerSecretGetter
+	version  *mocks.MockversionGetter
+}
+
+func TestSecretShowOpts_Ask(t *testing.T) {
+	const mockApp = "myapp"
+	mockError := errors.New("some error")
+	testEnv := &config.Environment{App: mockApp, Name: "test"}
+	testCases := map[string]struct {
+		inAppName  string
+		inEnvName  string
+		inName     string
+		setupMocks func(m secretShowMocks)
+
+		wantedAppName string
+		wantedEnvName string
+		wantedName    string
+		wantedError   error
+	}{
+		"errors if fail to select environment": {
+			inAppName: mockApp,
+			setupMocks: func(m secretShowMocks) {
+				m.sel.EXPECT().Environment(secretShowEnvNamePrompt, secretShowEnvNamePromptHelp, mockApp).Return("", mockError)
+			},
+			wantedError: fmt.Errorf("select environment: %w", mockError),
+		},
+		"errors if the environment can't manage secrets manager secrets": {
+			inAppName: mockApp,
+			inEnvName: "test",
+			setupMocks: func(m secretShowMocks) {
+				m.store.EXPECT().GetEnvironment(mockApp, "test").Return(testEnv, nil)
+				m.version.EXPECT().Version().Return("v1.10.0", nil)
+			},
+			wantedError: errors.New("environment test is on version v1.10.0, which can't manage Secrets Manager secrets: run `copilot env upgrade --app myapp --name test` first"),
+		},
+		"errors if there are no secrets in the environment": {
+			inAppName: mockApp,
+			inEnvName: "test",
+			setupMocks: func(m secretShowMocks) {
+				m.store.EXPECT().GetEnvironment(mockApp, "test").Return(testEnv, nil)
+				m.version.EXPECT().Version().Return(deploy.LatestEnvTemplateVersion, nil)
+				m.getter.EXPECT().ListSecrets(gomock.Any()).Return(nil, nil)
+				m.smGetter.EXPECT().ListSecrets(gomock.Any()).Return(nil, nil)
+			},
+			wantedError: errors.New("no secrets found in environment test"),
+		},
+		"errors if fail to list secrets manager secrets": {
+			inAppName: mockApp,
+			inEnvName: "test",
+			setupMocks: func(m secretShowMocks) {
+				m.store.EXPECT().GetEnvironment(mockApp, "test").Return(testEnv, nil)
+				m.version.EXPECT().Version().Return(deploy.LatestEnvTemplateVersion, nil)
+				m.getter.EXPECT().ListSecrets(gomock.Any()).Return(nil, nil)
+				m.smGetter.EXPECT().ListSecrets(gomock.Any()).Return(nil, mockError)
+			},
+			wantedError: fmt.Errorf("list secrets manager secrets in environment test: %w", mockError),
+		},
+		"selects the secret among the secrets of the environment in both stores": {
+			setupMocks: func(m secretShowMocks) {
+				m.sel.EXPECT().Application(secretShowAppNamePrompt, secretShowAppNamePromptHelp).Return(mockApp, nil)
+				m.sel.EXPECT().Environment(secretShowEnvNamePrompt, secretShowEnvNamePromptHelp, mockApp).Return("test", nil)
+				m.store.EXPECT().GetEnvironment(mockApp, "test").Return(testEnv, nil)
+				m.version.EXPECT().Version().Return(deploy.LatestEnvTemplateVersion, nil)
+				m.getter.EXPECT().ListSecrets(map[string]string{
+					"copilot-application": mockApp,
+					"copilot-environment": "test",
+				}).Return([]ssm.SecretSummary{
+					{Name: "/copilot/myapp/test/secrets/api_key"},
+					{Name: "/copilot/myapp/test/secrets/db_password"},
+				}, nil)
+				m.smGetter.EXPECT().ListSecrets(map[string]string{
+					"copilot-application": mockApp,
+					"copilot-environment": "test",
+				}).Return([]secretsmanager.SecretSummary{
+					{Name: "/copilot/myapp/test/secrets/db"},
+				}, nil)
+				m.prompt.EXPECT().SelectOne(secretShowNamePrompt, "", []string{"api_key", "db", "db_password"}, gomock.Any()).Return("db_password", nil)
+			},
+			wantedAppName: mockApp,
+			wantedEnvName: "test",
+			wantedName:    "db_password",
+		},
+		"skips prompting if all the flags are provided": {
+			inAppName:     mockApp,
+			inEnvName:     "test",
+			inName:        "db_password",
+			setupMocks:    func(m secretShowMocks) {},
+			wantedAppName: mockApp,
+			wantedEnvName: "test",
+			wantedName:    "db_password",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := secretShowMocks{
+				store:    mocks.NewMockstore(ctrl),
+				prompt:   mocks.NewMockprompter(ctrl),
+				sel:      mocks.NewMockappEnvSelector(ctrl),
+				getter:   mocks.NewMocksecretGetter(ctrl),
+				smGetter: mocks.NewMocksecretsManagerSecretGetter(ctrl),
+				version:  mocks.NewMockversionGetter(ctrl),
+			}
+			tc.setupMocks(m)
+
+			opts := &secretShowOpts{
+				secretShowVars: secretShowVars{
+					appName: tc.inAppName,
+					envName: tc.inEnvName,
+					name:    tc.inName,
+				},
+				store:  m.store,
+				prompt: m.prompt,
+				sel:    m.sel,
+				newSecretGetter: func(_ *config.Environment) (secretGetter, error) {
+					return m.getter, nil
+				},
+				newSecretsManagerSecretGetter: func(_ *config.Environment) (secretsManagerSecretGetter, error) {
+					return m.smGetter, nil
+				},
+				newEnvVersionGetter: func(_, _ string) (versionGetter, error) {
+					return m.version, nil
+				},
+			}
+
+			// WHEN
+			err := opts.Ask()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedAppName, opts.appName)
+			require.Equal(t, tc.wantedEnvName, opts.envName)
+			require.Equal(t, tc.wantedName, opts.name)
+		})
+	}
+}
+
+func TestSecretShowOpts_Execute(t *testing.T) {
+	const mockApp = "myapp"
+	mockError := errors.New("some error")
+	mockNow := time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC)
+	mockSecret := &ssm.Secret{
+		SecretSummary: ssm.SecretSummary{
+			Name:         "/copilot/myapp/test/secrets/db_password",
+			Version:      3,
+			LastModified: mockNow.Add(-2 * time.Hour),
+		},
+		ARN: "arn:aws:ssm:us-west-2:123456789012:parameter/copilot/myapp/test/secrets/db_password",
+	}
+	testCases := map[string]struct {
+		inDecrypt    bool
+		inOutputJSON bool
+		setupMocks   func(m secretShowMocks)
+
+		wantedContent string
+		wantedError   error
+	}{
+		"errors if the secret does not exist in either store": {
+			setupMocks: func(m secretShowMocks) {
+				m.getter.EXPECT().GetSecret("/copilot/myapp/test/secrets/db_password", false).Return(nil, &ssm.ErrParameterNotFound{})
+				m.smGetter.EXPECT().GetSecret("/copilot/myapp/test/secrets/db_password", false).Return(nil, &secretsmanager.ErrSecretNotFound{})
+			},
+			wantedError: errors.New("secret db_password not found in environment test"),
+		},
+		"errors if fail to get secret": {
+			setupMocks: func(m secretShowMocks) {
+				m.getter.EXPECT().GetSecret("/copilot/myapp/test/secrets/db_password", false).Return(nil, mockError)
+			},
+			wantedError: fmt.Errorf("get secret db_password in environment test: %w", mockError),
+		},
+		"errors if fail to get secrets manager secret": {
+			setupMocks: func(m secretShowMocks) {
+				m.getter.EXPECT().GetSecret("/copilot/myapp/test/secrets/db_password", false).Return(nil, &ssm.ErrParameterNotFound{})
+				m.smGetter.EXPECT().GetSecret("/copilot/myapp/test/secrets/db_password", false).Return(nil, mockError)
+			},
+			wantedError: fmt.Errorf("get secrets manager secret db_password in environment test: %w", mockError),
+		},
+		"masks the value of the secret": {
+			setupMocks: func(m secretShowMocks) {
+				m.getter.EXPECT().GetSecret("/copilot/myapp/test/secrets/db_password", false).Return(mockSecret, nil)
+			},
+			wantedContent: `About
+
+  Name            db_password
+  Environment     test
+  Store           ssm
+  Parameter       /copilot/myapp/test/secrets/db_password
+  Version         3
+  Last Modified   2 hours ago
+  Value           ********
+`,
+		},
+		"writes the decrypted secret in JSON": {
+			inDecrypt:    true,
+			inOutputJSON: true,
+			setupMocks: func(m secretShowMocks) {
+				decrypted := *mockSecret
+				decrypted.Value = "hunter2"
+				m.getter.EXPECT().GetSecret("/copilot/myapp/test/secrets/db_password", true).Return(&decrypted, nil)
+			},
+			wantedContent: `{"name":"db_password","environment":"test","store":"ssm","parameter":"/copilot/myapp/test/secrets/db_password","arn":"arn:aws:ssm:us-west-2:123456789012:parameter/copilot/myapp/test/secrets/db_password","version":3,"lastModified":"2021-06-01T10:00:00Z","value":"hunter2"}
+`,
+		},
+		"shows the secret stored in secrets manager": {
+			setupMocks: func(m secretShowMocks) {
+				m.getter.EXPECT().GetSecret("/copilot/myapp/test/secrets/db_password", false).Return(nil, &ssm.ErrParameterNotFound{})
+				m.smGetter.EXPECT().GetSecret("/copilot/myapp/test/secrets/db_password", false).Return(&secretsmanager.Secret{
+					SecretSummary: secretsmanager.SecretSummary{
+						Name:         "/copilot/myapp/test/secrets/db_password",
+						ARN:          "arn:aws:secretsmanager:us-west-2:123456789012:secret:/copilot/myapp/test/secrets/db_password-AbCdEf",
+						LastModified: mockNow.Add(-2 * time.Hour),
+					},
+				}, nil)
+			},
+			wantedContent: `About
+
+  Name            db_password
+  Environment     test
+  Store           secretsmanager
+  Parameter       /copilot/myapp/test/secrets/db_password
+  Last Modified   2 hours ago
+  Value           ********
+`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := secretShowMocks{
+				getter:   mocks.NewMocksecretGetter(ctrl),
+				smGetter: mocks.NewMocksecretsManagerSecretGetter(ctrl),
+			}
+			tc.setupMocks(m)
+			b := &bytes.Buffer{}
+
+			opts := &secretShowOpts{
+				secretShowVars: secretShowVars{
+					appName:          mockApp,
+					envName:          "test",
+					name:             "db_password",
+					decrypt:          tc.inDecrypt,
+					shouldOutputJSON: tc.inOutputJSON,
+				},
+				w: b,
+				now: func() time.Time {
+					return mockNow
+				},
+				getter:   m.getter,
+				smGetter: m.smGetter,
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedContent, b.String())
+		})
+	}
+}
@@ -4,11 +4,73 @@
 package cli
 
 import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/copilot-cli/cmd/copilot/template"
+	"github.com/aws/copilot-cli/internal/pkg/addon"
+	awscloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 	"github.com/aws/copilot-cli/internal/pkg/cli/group"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	cfntemplate "github.com/aws/copilot-cli/internal/pkg/template"
 	"github.com/spf13/cobra"
 )
 
+// AWS CloudFormation resource types of the storage created by "storage init".
+const (
+	dynamoDBTableResourceType         = "AWS::DynamoDB::Table"
+	s3BucketResourceType              = "AWS::S3::Bucket"
+	redisReplicationGroupResourceType = "AWS::ElastiCache::ReplicationGroup"
+	openSearchDomainResourceType      = "AWS::OpenSearchService::Domain"
+	sqsQueueResourceType              = "AWS::SQS::Queue"
+)
+
+const (
+	// Suffixes appended to the storage name to form the logical IDs of the resources created by "storage init".
+	ddbTableLogicalIDSuffix    = ""
+	s3BucketLogicalIDSuffix    = "Bucket"
+	rdsClusterLogicalIDSuffix  = "DBCluster"
+	rdsSecretLogicalIDSuffix   = "AuroraSecret"
+	redisLogicalIDSuffix       = "ReplicationGroup"
+	redisSecretLogicalIDSuffix = "AuthTokenSecret"
+	openSearchLogicalIDSuffix  = "Domain"
+	sqsQueueLogicalIDSuffix    = "Queue"
+
+	// Owner displayed for storage shared by the workloads of an environment.
+	environmentStorageOwner = "environment"
+)
+
+var (
+	// storageTypeFor maps the resource type referred to by the outputs of a "storage init" template to its storage type.
+	storageTypeFor = map[string]string{
+		dynamoDBTableResourceType:         dynamoDBStorageType,
+		s3BucketResourceType:              s3StorageType,
+		redisReplicationGroupResourceType: redisStorageType,
+		openSearchDomainResourceType:      openSearchStorageType,
+		sqsQueueResourceType:              sqsStorageType,
+	}
+	// logicalIDSuffixFor maps a storage type to the suffix of the logical ID of its main resource.
+	logicalIDSuffixFor = map[string]string{
+		dynamoDBStorageType:   ddbTableLogicalIDSuffix,
+		s3StorageType:         s3BucketLogicalIDSuffix,
+		rdsStorageType:        rdsClusterLogicalIDSuffix,
+		redisStorageType:      redisLogicalIDSuffix,
+		openSearchStorageType: openSearchLogicalIDSuffix,
+		sqsStorageType:        sqsQueueLogicalIDSuffix,
+	}
+	// secretLogicalIDSuffixFor maps a storage type to the suffix of the logical ID of its secret.
+	secretLogicalIDSuffixFor = map[string]string{
+		rdsStorageType:   rdsSecretLogicalIDSuffix,
+		redisStorageType: redisSecretLogicalIDSuffix,
+	}
+)
+
 // BuildStorageCmd is the top level command for storage
 func BuildStorageCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -19,6 +81,8 @@ Augment your services with S3 buckets, NoSQL and SQL databases.`,
 	}
 
 	cmd.AddCommand(buildStorageInitCmd())
+	cmd.AddCommand(buildStorageListCmd())
+	cmd.AddCommand(buildStorageShowCmd())
 
 	cmd.SetUsageTemplate(template.Usage)
 
@@ -27,3 +91,195 @@ Augment your services with S3 buckets, NoSQL and SQL databases.`,
 	}
 	return cmd
 }
+
+// storageResource is a storage resource created with "storage init" in the workspace.
+type storageResource struct {
+	Name     string           `json:"name"`
+	Type     string           `json:"type"`
+	Workload string           `json:"workload,omitempty"` // Empty if the storage is shared by the workloads of an environment.
+	Injected []injectedOutput `json:"injected"`           // Outputs of the addon injected into the workloads that access the storage.
+}
+
+// injectedOutput is an output of a storage addon injected into a workload as an environment variable or a secret.
+type injectedOutput struct {
+	Name     string `json:"name"` // Name of the environment variable, e.g. "CACHE_ENDPOINT".
+	IsSecret bool   `json:"isSecret"`
+}
+
+// owner returns the name of the workload that the storage belongs to, or "environment" if it is shared.
+func (s *storageResource) owner() string {
+	if s.Workload == "" {
+		return environmentStorageOwner
+	}
+	return s.Workload
+}
+
+// stackName returns the name of the stack whose addons nested stack holds the storage.
+func (s *storageResource) stackName(app, env string) string {
+	if s.Workload == "" {
+		return stack.NameForEnv(app, env)
+	}
+	return stack.NameForService(app, env, s.Workload)
+}
+
+// physicalID returns the name of the bucket, table, cluster, domain or queue among the physical IDs of the addons stack resources.
+func (s *storageResource) physicalID(resources map[string]string) string {
+	return resources[cfntemplate.StripNonAlphaNumFunc(s.Name)+logicalIDSuffixFor[s.Type]]
+}
+
+// secretARN returns the ARN of the secret holding the credentials of an Aurora or Redis cluster.
+// For other storage types, returns an empty string.
+func (s *storageResource) secretARN(resources map[string]string) string {
+	suffix, ok := secretLogicalIDSuffixFor[s.Type]
+	if !ok {
+		return ""
+	}
+	return resources[cfntemplate.StripNonAlphaNumFunc(s.Name)+suffix]
+}
+
+// listStorageResources returns the storage resources of the workloads and environments in the workspace.
+// Storage resources are identified from the outputs of the addon templates generated by "storage init".
+func listStorageResources(ws wsStorageReader) ([]*storageResource, error) {
+	wls, err := ws.ListWorkloads()
+	if err != nil {
+		return nil, fmt.Errorf("list workloads in workspace: %w", err)
+	}
+	var resources []*storageResource
+	for _, wl := range wls {
+		fnames, err := ws.ReadAddonsDir(wl)
+		if errors.Is(err, os.ErrNotExist) {
+			// The workload doesn't have any addons.
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("read addons directory of workload %s: %w", wl, err)
+		}
+		for _, fname := range fnames {
+			content, err := ws.ReadAddon(wl, fname)
+			if err != nil {
+				return nil, fmt.Errorf("read addon %s of workload %s: %w", fname, wl, err)
+			}
+			if r := parseStorageResource(fname, content, false); r != nil {
+				r.Workload = wl
+				resources = append(resources, r)
+			}
+		}
+	}
+	fnames, err := ws.ReadEnvironmentAddonsDir()
+	if errors.Is(err, os.ErrNotExist) {
+		// There is no storage shared in the environments.
+		return resources, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("read environment addons directory: %w", err)
+	}
+	for _, fname := range fnames {
+		content, err := ws.ReadEnvironmentAddon(fname)
+		if err != nil {
+			return nil, fmt.Errorf("read environment addon %s: %w", fname, err)
+		}
+		if r := parseStorageResource(fname, content, true); r != nil {
+			resources = append(resources, r)
+		}
+	}
+	return resources, nil
+}
+
+// parseStorageResource returns the storage resource defined by an addon template, or nil if the template
+// was not generated by "storage init".
+func parseStorageResource(fname string, content []byte, isEnvAddon bool) *storageResource {
+	ext := filepath.Ext(fname)
+	if ext != ".yml" && ext != ".yaml" {
+		return nil
+	}
+	outputs, err := addon.Outputs(string(content))
+	if err != nil {
+		// Files such as "addons.parameters.yml" are not CloudFormation templates.
+		return nil
+	}
+	name := strings.TrimSuffix(fname, ext)
+	for _, out := range outputs {
+		// The first output of the template that refers to the storage identifies its type.
+		storageType, ok := storageTypeFor[out.ResourceType]
+		switch {
+		case ok && strings.HasPrefix(out.Name, cfntemplate.StripNonAlphaNumFunc(name)):
+		case out.Name == cfntemplate.EnvVarSecretFunc(name) && out.IsSecret:
+			storageType = rdsStorageType
+		default:
+			continue
+		}
+		return &storageResource{
+			Name:     name,
+			Type:     storageType,
+			Injected: injectedOutputs(name, outputs, isEnvAddon),
+		}
+	}
+	return nil
+}
+
+// injectedOutputs returns the outputs of a storage addon that are injected into the workloads accessing the storage.
+// Workloads inject all the outputs of their own addons but managed policies, while they only import the name of the storage
+// and its optional outputs from the addons of an environment.
+func injectedOutputs(name string, outputs []addon.Output, isEnvAddon bool) []injectedOutput {
+	imported := map[string]bool{
+		cfntemplate.EnvVarNameFunc(name): true,
+	}
+	for _, suffix := range stack.EnvAddonOptionalOutputSuffixes {
+		imported[cfntemplate.StripNonAlphaNumFunc(name)+suffix] = true
+	}
+	var injected []injectedOutput
+	for _, out := range outputs {
+		if out.IsManagedPolicy || (isEnvAddon && !imported[out.Name]) {
+			continue
+		}
+		injected = append(injected, injectedOutput{
+			Name:     cfntemplate.ToSnakeCaseFunc(out.Name),
+			IsSecret: out.IsSecret,
+		})
+	}
+	return injected
+}
+
+// addonsStackResources returns the physical IDs of the resources in the addons nested stack of a stack, keyed by logical ID.
+// If the stack or its addons nested stack is not deployed, returns a nil map.
+func addonsStackResources(cfn stackResourcesLister, stackName string) (map[string]string, error) {
+	nestedID, err := physicalIDOf(cfn, stackName, addon.StackName)
+	if err != nil || nestedID == "" {
+		return nil, err
+	}
+	resources, err := cfn.StackResources(nestedID)
+	if err != nil {
+		return nil, fmt.Errorf("list resources of the addons stack of %s: %w", stackName, err)
+	}
+	ids := make(map[string]string)
+	for _, r := range resources {
+		ids[aws.StringValue(r.LogicalResourceId)] = aws.StringValue(r.PhysicalResourceId)
+	}
+	return ids, nil
+}
+
+func physicalIDOf(cfn stackResourcesLister, stackName, logicalID string) (string, error) {
+	resources, err := cfn.StackResources(stackName)
+	if err != nil {
+		var errNotFound *awscloudformation.ErrStackNotFound
+		if errors.As(err, &errNotFound) {
+			return "", nil
+		}
+		return "", fmt.Errorf("list resources of stack %s: %w", stackName, err)
+	}
+	for _, r := range resources {
+		if aws.StringValue(r.LogicalResourceId) == logicalID {
+			return aws.StringValue(r.PhysicalResourceId), nil
+		}
+	}
+	return "", nil
+}
+
+// newCFNForEnv returns a CloudFormation client in the account and region of the environment using its manager role.
+func newCFNForEnv(env *config.Environment) (*awscloudformation.CloudFormation, error) {
+	sess, err := sessions.NewProvider().FromRole(env.ManagerRoleARN, env.Region)
+	if err != nil {
+		return nil, fmt.Errorf("create session from environment manager role %s in region %s: %w", env.ManagerRoleARN, env.Region, err)
+	}
+	return awscloudformation.New(sess), nil
+}
@@ -7,6 +7,7 @@ import (
 	"encoding"
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/aws/copilot-cli/internal/pkg/addon"
 	"github.com/aws/copilot-cli/internal/pkg/config"
@@ -23,28 +24,64 @@ import (
 )
 
 const (
-	dynamoDBStorageType = "DynamoDB"
-	s3StorageType       = "S3"
-	rdsStorageType      = "Aurora"
+	dynamoDBStorageType   = "DynamoDB"
+	s3StorageType         = "S3"
+	rdsStorageType        = "Aurora"
+	redisStorageType      = "Redis"
+	openSearchStorageType = "OpenSearch"
+	sqsStorageType        = "SQS"
 )
 
 var storageTypes = []string{
 	dynamoDBStorageType,
 	s3StorageType,
 	rdsStorageType,
+	redisStorageType,
+	openSearchStorageType,
+	sqsStorageType,
+}
+
+// vpcStorageTypes are the storage types that are deployed in the environment's VPC and
+// only accept traffic from the environment security group.
+var vpcStorageTypes = []string{
+	redisStorageType,
+	openSearchStorageType,
+}
+
+// Lifecycles of storage resources.
+const (
+	workloadStorageLifecycle    = "workload"
+	environmentStorageLifecycle = "environment"
+)
+
+var storageLifecycles = []string{
+	workloadStorageLifecycle,
+	environmentStorageLifecycle,
+}
+
+// envStorageTypes are the storage types that can be shared by the workloads in an environment.
+var envStorageTypes = []string{
+	dynamoDBStorageType,
+	s3StorageType,
 }
 
 // Displayed options for storage types
 const (
-	dynamoDBStorageTypeOption = "DynamoDB"
-	s3StorageTypeOption       = "S3"
-	rdsStorageTypeOption      = "Aurora Serverless"
+	dynamoDBStorageTypeOption   = "DynamoDB"
+	s3StorageTypeOption         = "S3"
+	rdsStorageTypeOption        = "Aurora Serverless"
+	redisStorageTypeOption      = "ElastiCache Redis"
+	openSearchStorageTypeOption = "OpenSearch"
+	sqsStorageTypeOption        = "SQS"
 )
 
 var optionToStorageType = map[string]string{
-	dynamoDBStorageTypeOption: dynamoDBStorageType,
-	s3StorageTypeOption:       s3StorageType,
-	rdsStorageTypeOption:      rdsStorageType,
+	dynamoDBStorageTypeOption:   dynamoDBStorageType,
+	s3StorageTypeOption:         s3StorageType,
+	rdsStorageTypeOption:        rdsStorageType,
+	redisStorageTypeOption:      redisStorageType,
+	openSearchStorageTypeOption: openSearchStorageType,
+	sqsStorageTypeOption:        sqsStorageType,
 }
 
 var storageTypeOptions = map[string]prompt.Option{
@@ -60,21 +97,40 @@ var storageTypeOptions = map[string]prompt.Option{
 		Value: rdsStorageTypeOption,
 		Hint:  "SQL",
 	},
+	redisStorageType: {
+		Value: redisStorageTypeOption,
+		Hint:  "Cache",
+	},
+	openSearchStorageType: {
+		Value: openSearchStorageTypeOption,
+		Hint:  "Search",
+	},
+	sqsStorageType: {
+		Value: sqsStorageTypeOption,
+		Hint:  "Queue",
+	},
 }
 
 const (
 	s3BucketFriendlyText      = "S3 Bucket"
 	dynamoDBTableFriendlyText = "DynamoDB Table"
 	rdsFriendlyText           = "Database Cluster"
+	redisFriendlyText         = "Redis Cluster"
+	openSearchFriendlyText    = "OpenSearch Domain"
+	sqsFriendlyText           = "SQS Queue"
 )
 
 // General-purpose prompts, collected for all storage resources.
 var (
 	fmtStorageInitTypePrompt = "What " + color.Emphasize("type") + " of storage would you like to associate with %s?"
+	storageInitEnvTypePrompt = "What " + color.Emphasize("type") + " of storage would you like to share across the workloads in your environments?"
 	storageInitTypeHelp      = `The type of storage you'd like to add to your workload. 
 DynamoDB is a key-value and document database that delivers single-digit millisecond performance at any scale.
 S3 is a web object store built to store and retrieve any amount of data from anywhere on the Internet.
 Aurora Serverless is an on-demand autoscaling configuration for Amazon Aurora, a MySQL and PostgreSQL-compatible relational database.
+ElastiCache Redis is a managed in-memory data store for caching, sessions and real-time workloads.
+OpenSearch is a managed search and analytics engine, forked from Elasticsearch.
+SQS is a managed message queue to decouple and scale your workloads.
 `
 
 	fmtStorageInitNamePrompt = "What would you like to " + color.Emphasize("name") + " this %s?"
@@ -119,18 +175,63 @@ var attributeTypes = []string{
 	ddbBinaryType,
 }
 
-// RDS Aurora Serverless specific questions and help prompts.
+// Billing modes of a DynamoDB table.
+const (
+	ddbBillingModeOnDemand    = "on-demand"
+	ddbBillingModeProvisioned = "provisioned"
+)
+
+var ddbBillingModes = []string{
+	ddbBillingModeOnDemand,
+	ddbBillingModeProvisioned,
+}
+
+var ddbBillingModeToCFN = map[string]string{
+	ddbBillingModeOnDemand:    addon.DDBBillingModePayPerRequest,
+	ddbBillingModeProvisioned: addon.DDBBillingModeProvisioned,
+}
+
+// Information written to the stream of a DynamoDB table when an item is modified.
+const (
+	ddbStreamKeysOnly        = "keys-only"
+	ddbStreamNewImage        = "new-image"
+	ddbStreamOldImage        = "old-image"
+	ddbStreamNewAndOldImages = "new-and-old-images"
+)
+
+var ddbStreamViewTypes = []string{
+	ddbStreamKeysOnly,
+	ddbStreamNewImage,
+	ddbStreamOldImage,
+	ddbStreamNewAndOldImages,
+}
+
+var ddbStreamViewTypeToCFN = map[string]string{
+	ddbStreamKeysOnly:        "KEYS_ONLY",
+	ddbStreamNewImage:        "NEW_IMAGE",
+	ddbStreamOldImage:        "OLD_IMAGE",
+	ddbStreamNewAndOldImages: "NEW_AND_OLD_IMAGES",
+}
+
+// RDS Aurora specific questions and help prompts.
 var (
 	storageInitRDSInitialDBNamePrompt = "What would you like to name the initial database in your cluster?"
 	storageInitRDSDBEnginePrompt      = "Which database engine would you like to use?"
+	storageInitRDSInstanceClassPrompt = "Which " + color.Emphasize("instance class") + " would you like to use for your cluster?"
+	storageInitRDSInstanceClassHelp   = "The compute and memory capacity of the writer and reader instances. You can change it later in the template."
 )
 
-// RDS Aurora Serverless specific constants and variables.
+// RDS Aurora specific constants and variables.
 const (
 	fmtRDSStorageNameDefault = "%s-cluster"
 
 	engineTypeMySQL      = "MySQL"
 	engineTypePostgreSQL = "PostgreSQL"
+
+	defaultRDSInstanceClass = "db.r6g.large"
+	maxRDSReaders           = 15
+	minRDSBackupRetention   = 1
+	maxRDSBackupRetention   = 35
 )
 
 var engineTypes = []string{
@@ -138,12 +239,76 @@ var engineTypes = []string{
 	engineTypePostgreSQL,
 }
 
+// Capacity types of an Aurora cluster.
+const (
+	rdsClusterTypeServerless   = "serverless"
+	rdsClusterTypeServerlessV2 = "serverless-v2"
+	rdsClusterTypeProvisioned  = "provisioned"
+)
+
+var rdsClusterTypes = []string{
+	rdsClusterTypeServerless,
+	rdsClusterTypeServerlessV2,
+	rdsClusterTypeProvisioned,
+}
+
+var rdsClusterTypeToTemplate = map[string]string{
+	rdsClusterTypeServerless:   addon.RDSClusterTypeServerless,
+	rdsClusterTypeServerlessV2: addon.RDSClusterTypeServerlessV2,
+	rdsClusterTypeProvisioned:  addon.RDSClusterTypeProvisioned,
+}
+
+var rdsInstanceClasses = []string{
+	"db.t4g.medium",
+	defaultRDSInstanceClass,
+	"db.r6g.xlarge",
+	"db.r6g.2xlarge",
+}
+
+// ElastiCache Redis specific questions and help prompts.
+var (
+	storageInitRedisNodeTypePrompt = "Which " + color.Emphasize("node type") + " would you like to use for your Redis cluster?"
+	storageInitRedisNodeTypeHelp   = "The compute and memory capacity of the nodes in the cluster. You can change it later in the template."
+)
+
+// ElastiCache Redis specific constants and variables.
+const (
+	defaultRedisNodeType = "cache.t3.micro"
+)
+
+var redisNodeTypes = []string{
+	defaultRedisNodeType,
+	"cache.t3.small",
+	"cache.t3.medium",
+	"cache.m6g.large",
+	"cache.r6g.large",
+}
+
+// OpenSearch specific questions and help prompts.
+var (
+	storageInitOpenSearchInstanceTypePrompt = "Which " + color.Emphasize("instance type") + " would you like to use for your OpenSearch domain?"
+	storageInitOpenSearchInstanceTypeHelp   = "The instance type of the data nodes in the domain. You can change it later in the template."
+)
+
+// OpenSearch specific constants and variables.
+const (
+	defaultOpenSearchInstanceType = "t3.small.search"
+)
+
+var openSearchInstanceTypes = []string{
+	defaultOpenSearchInstanceType,
+	"t3.medium.search",
+	"m6g.large.search",
+	"r6g.large.search",
+}
+
 var errUnavailableAddonParams = errors.New("addon does not require parameters")
 
 type initStorageVars struct {
 	storageType  string
 	storageName  string
 	workloadName string
+	lifecycle    string
 
 	// Dynamo DB specific values collected via flags or prompts
 	partitionKey string
@@ -151,11 +316,38 @@ type initStorageVars struct {
 	lsiSorts     []string // lsi sort keys collected as "name:T" where T is one of [SNB]
 	noLSI        bool
 	noSort       bool
-
-	// RDS Aurora Serverless specific values collected via flags or prompts
-	rdsEngine         string
-	rdsParameterGroup string
-	rdsInitialDBName  string
+	gsis         []string // gsi keys collected as "name:T" or "name:T,name:T" where T is one of [SNB]
+	ttlAttribute string
+	stream       string
+	billingMode  string
+
+	// S3 specific values collected via flags
+	s3Versioning     bool
+	s3ExpirationDays int
+	s3Transitions    []string // transitions collected as "STORAGE_CLASS:days"
+	s3CORSOrigins    []string
+	s3KMSEncryption  bool
+	s3CloudFront     bool
+
+	// RDS Aurora specific values collected via flags or prompts
+	rdsEngine             string
+	rdsParameterGroup     string
+	rdsInitialDBName      string
+	rdsClusterType        string
+	rdsInstanceClass      string
+	rdsReaders            int
+	rdsBackupRetention    int
+	rdsDeletionProtection bool
+	rdsSnapshotIdentifier string
+
+	// ElastiCache Redis specific values collected via flags or prompts
+	redisNodeType string
+
+	// OpenSearch specific values collected via flags or prompts
+	openSearchInstanceType string
+
+	// SQS specific values collected via flags
+	sqsFIFO bool
 }
 
 type initStorageOpts struct {
@@ -201,6 +393,9 @@ func (o *initStorageOpts) Validate() error {
 	if o.appName == "" {
 		return errNoAppInWorkspace
 	}
+	if err := o.validateLifecycle(); err != nil {
+		return err
+	}
 	if o.workloadName != "" {
 		if err := o.validateWorkloadName(); err != nil {
 			return err
@@ -231,15 +426,47 @@ func (o *initStorageOpts) Validate() error {
 	if err := o.validateDDB(); err != nil {
 		return err
 	}
+	if err := o.validateS3(); err != nil {
+		return err
+	}
 
-	if o.rdsEngine != "" {
-		if err := validateEngine(o.rdsEngine); err != nil {
+	if err := o.validateRDS(); err != nil {
+		return err
+	}
+	if o.redisNodeType != "" {
+		if err := validateRedisNodeType(o.redisNodeType); err != nil {
+			return err
+		}
+	}
+	if o.openSearchInstanceType != "" {
+		if err := validateOpenSearchInstanceType(o.openSearchInstanceType); err != nil {
 			return err
 		}
 	}
 	return nil
 }
 
+func (o *initStorageOpts) validateLifecycle() error {
+	if o.lifecycle == "" {
+		return nil
+	}
+	if !contains(o.lifecycle, storageLifecycles) {
+		return fmt.Errorf("invalid lifecycle %s: must be one of %s", o.lifecycle, prettify(storageLifecycles))
+	}
+	if !o.isEnvScoped() {
+		return nil
+	}
+	if o.workloadName != "" {
+		return fmt.Errorf("cannot specify both --%s and --%s %s", workloadFlag, storageLifecycleFlag, environmentStorageLifecycle)
+	}
+	return nil
+}
+
+// isEnvScoped returns true if the storage is deployed with the environment stack instead of a workload stack.
+func (o *initStorageOpts) isEnvScoped() bool {
+	return o.lifecycle == environmentStorageLifecycle
+}
+
 func (o *initStorageOpts) validateDDB() error {
 	if o.partitionKey != "" {
 		if err := validateKey(o.partitionKey); err != nil {
@@ -265,9 +492,90 @@ func (o *initStorageOpts) validateDDB() error {
 			return err
 		}
 	}
+	if len(o.gsis) != 0 {
+		if err := validateGSIs(o.gsis); err != nil {
+			return err
+		}
+	}
+	if o.ttlAttribute != "" {
+		if err := dynamoAttributeNameValidation(o.ttlAttribute); err != nil {
+			return fmt.Errorf("validate TTL attribute %s: %w", o.ttlAttribute, err)
+		}
+	}
+	if o.stream != "" && !contains(o.stream, ddbStreamViewTypes) {
+		return fmt.Errorf("invalid stream %s: must be one of %s", o.stream, prettify(ddbStreamViewTypes))
+	}
+	if o.billingMode != "" && !contains(o.billingMode, ddbBillingModes) {
+		return fmt.Errorf("invalid billing mode %s: must be one of %s", o.billingMode, prettify(ddbBillingModes))
+	}
+	return nil
+}
+
+func (o *initStorageOpts) validateS3() error {
+	if o.s3ExpirationDays < 0 {
+		return fmt.Errorf("invalid expiration days %d: must be a positive number", o.s3ExpirationDays)
+	}
+	for _, transition := range o.s3Transitions {
+		t, err := addon.S3TransitionFromString(transition)
+		if err != nil {
+			return fmt.Errorf("invalid transition %s: must be of the format '<storageClass>:<days>'", transition)
+		}
+		if !contains(t.StorageClass, addon.S3StorageClasses) {
+			return fmt.Errorf("invalid storage class %s: must be one of %s", t.StorageClass, prettify(addon.S3StorageClasses))
+		}
+		if o.s3ExpirationDays != 0 && t.Days >= o.s3ExpirationDays {
+			return fmt.Errorf("transition %s must happen before objects expire after %d days", transition, o.s3ExpirationDays)
+		}
+	}
+	for _, origin := range o.s3CORSOrigins {
+		if origin != "*" && !strings.HasPrefix(origin, "http://") && !strings.HasPrefix(origin, "https://") {
+			return fmt.Errorf(`invalid CORS origin %s: must be "*" or start with "http://" or "https://"`, origin)
+		}
+	}
+	// CloudFront origin access identities can't read objects encrypted with a customer managed KMS key.
+	if o.s3KMSEncryption && o.s3CloudFront {
+		return fmt.Errorf("cannot specify both --%s and --%s", storageS3KMSEncryptionFlag, storageS3CloudFrontFlag)
+	}
+	return nil
+}
+
+func (o *initStorageOpts) validateRDS() error {
+	if o.rdsEngine != "" {
+		if err := validateEngine(o.rdsEngine); err != nil {
+			return err
+		}
+	}
+	if o.rdsClusterType != "" && !contains(o.rdsClusterType, rdsClusterTypes) {
+		return fmt.Errorf("invalid cluster type %s: must be one of %s", o.rdsClusterType, prettify(rdsClusterTypes))
+	}
+	if o.rdsInstanceClass != "" {
+		if o.rdsClusterType != rdsClusterTypeProvisioned {
+			return fmt.Errorf("cannot specify --%s without --%s %s", storageRDSInstanceClassFlag, storageRDSClusterTypeFlag, rdsClusterTypeProvisioned)
+		}
+		if err := validateRDSInstanceClass(o.rdsInstanceClass); err != nil {
+			return err
+		}
+	}
+	if o.rdsReaders < 0 || o.rdsReaders > maxRDSReaders {
+		return fmt.Errorf("invalid number of readers %d: must be between 0 and %d", o.rdsReaders, maxRDSReaders)
+	}
+	if o.rdsReaders != 0 && !o.hasRDSInstances() {
+		return fmt.Errorf("cannot specify --%s with --%s %s", storageRDSReadersFlag, storageRDSClusterTypeFlag, rdsClusterTypeServerless)
+	}
+	if o.rdsBackupRetention != 0 && (o.rdsBackupRetention < minRDSBackupRetention || o.rdsBackupRetention > maxRDSBackupRetention) {
+		return fmt.Errorf("invalid backup retention %d: must be between %d and %d days", o.rdsBackupRetention, minRDSBackupRetention, maxRDSBackupRetention)
+	}
+	if o.rdsSnapshotIdentifier != "" && o.rdsInitialDBName != "" {
+		return fmt.Errorf("cannot specify both --%s and --%s", storageRDSSnapshotFlag, storageRDSInitialDBFlag)
+	}
 	return nil
 }
 
+// hasRDSInstances returns true if the Aurora cluster has writer and reader instances, that is, if it's not an Aurora Serverless v1 cluster.
+func (o *initStorageOpts) hasRDSInstances() bool {
+	return o.rdsClusterType == rdsClusterTypeServerlessV2 || o.rdsClusterType == rdsClusterTypeProvisioned
+}
+
 func (o *initStorageOpts) Ask() error {
 	if err := o.askStorageWl(); err != nil {
 		return err
@@ -296,7 +604,21 @@ func (o *initStorageOpts) Ask() error {
 			return err
 		}
 		// Ask for initial db name after engine type since the name needs to be validated accordingly.
-		if err := o.askAuroraInitialDBName(); err != nil {
+		// A cluster restored from a snapshot gets its databases from the snapshot.
+		if o.rdsSnapshotIdentifier == "" {
+			if err := o.askAuroraInitialDBName(); err != nil {
+				return err
+			}
+		}
+		if err := o.askAuroraInstanceClass(); err != nil {
+			return err
+		}
+	case redisStorageType:
+		if err := o.askRedisNodeType(); err != nil {
+			return err
+		}
+	case openSearchStorageType:
+		if err := o.askOpenSearchInstanceType(); err != nil {
 			return err
 		}
 	}
@@ -307,12 +629,15 @@ func (o *initStorageOpts) askStorageType() error {
 	if o.storageType != "" {
 		return o.validateStorageType()
 	}
+	types, typePrompt := storageTypes, fmt.Sprintf(fmtStorageInitTypePrompt, color.HighlightUserInput(o.workloadName))
+	if o.isEnvScoped() {
+		types, typePrompt = envStorageTypes, storageInitEnvTypePrompt
+	}
 	var options []prompt.Option
-	for _, st := range storageTypes {
+	for _, st := range types {
 		options = append(options, storageTypeOptions[st])
 	}
-	storageTypeOption, err := o.prompt.SelectOption(fmt.Sprintf(
-		fmtStorageInitTypePrompt, color.HighlightUserInput(o.workloadName)),
+	storageTypeOption, err := o.prompt.SelectOption(typePrompt,
 		storageInitTypeHelp,
 		options,
 		prompt.WithFinalMessage("Storage type:"))
@@ -324,6 +649,9 @@ func (o *initStorageOpts) askStorageType() error {
 }
 
 func (o *initStorageOpts) validateStorageType() error {
+	if o.isEnvScoped() && !contains(o.storageType, envStorageTypes) {
+		return fmt.Errorf("storage type %s cannot be shared in an environment: must be one of %s", o.storageType, prettify(envStorageTypes))
+	}
 	if err := validateStorageType(o.storageType, validateStorageTypeOpts{
 		ws:           o.ws,
 		workloadName: o.workloadName,
@@ -363,6 +691,15 @@ func (o *initStorageOpts) askStorageName() error {
 		friendlyText = dynamoDBTableFriendlyText
 	case rdsStorageType:
 		return o.askStorageNameWithDefault(rdsFriendlyText, fmt.Sprintf(fmtRDSStorageNameDefault, o.workloadName), rdsNameValidation)
+	case redisStorageType:
+		validator = dynamoTableNameValidation
+		friendlyText = redisFriendlyText
+	case openSearchStorageType:
+		validator = dynamoTableNameValidation
+		friendlyText = openSearchFriendlyText
+	case sqsStorageType:
+		validator = dynamoTableNameValidation
+		friendlyText = sqsFriendlyText
 	}
 
 	name, err := o.prompt.Get(fmt.Sprintf(fmtStorageInitNamePrompt,
@@ -378,7 +715,7 @@ func (o *initStorageOpts) askStorageName() error {
 }
 
 func (o *initStorageOpts) askStorageWl() error {
-	if o.workloadName != "" {
+	if o.workloadName != "" || o.isEnvScoped() {
 		return nil
 	}
 	workload, err := o.sel.Workload(storageInitSvcPrompt, "")
@@ -571,6 +908,51 @@ func (o *initStorageOpts) askAuroraInitialDBName() error {
 	return nil
 }
 
+func (o *initStorageOpts) askAuroraInstanceClass() error {
+	if o.rdsClusterType != rdsClusterTypeProvisioned || o.rdsInstanceClass != "" {
+		return nil
+	}
+	instanceClass, err := o.prompt.SelectOne(storageInitRDSInstanceClassPrompt,
+		storageInitRDSInstanceClassHelp,
+		rdsInstanceClasses,
+		prompt.WithFinalMessage("Instance class:"))
+	if err != nil {
+		return fmt.Errorf("select instance class: %w", err)
+	}
+	o.rdsInstanceClass = instanceClass
+	return nil
+}
+
+func (o *initStorageOpts) askRedisNodeType() error {
+	if o.redisNodeType != "" {
+		return nil
+	}
+	nodeType, err := o.prompt.SelectOne(storageInitRedisNodeTypePrompt,
+		storageInitRedisNodeTypeHelp,
+		redisNodeTypes,
+		prompt.WithFinalMessage("Node type:"))
+	if err != nil {
+		return fmt.Errorf("select node type: %w", err)
+	}
+	o.redisNodeType = nodeType
+	return nil
+}
+
+func (o *initStorageOpts) askOpenSearchInstanceType() error {
+	if o.openSearchInstanceType != "" {
+		return nil
+	}
+	instanceType, err := o.prompt.SelectOne(storageInitOpenSearchInstanceTypePrompt,
+		storageInitOpenSearchInstanceTypeHelp,
+		openSearchInstanceTypes,
+		prompt.WithFinalMessage("Instance type:"))
+	if err != nil {
+		return fmt.Errorf("select instance type: %w", err)
+	}
+	o.openSearchInstanceType = instanceType
+	return nil
+}
+
 func (o *initStorageOpts) validateWorkloadName() error {
 	names, err := o.ws.ListWorkloads()
 	if err != nil {
@@ -585,8 +967,10 @@ func (o *initStorageOpts) validateWorkloadName() error {
 }
 
 func (o *initStorageOpts) Execute() error {
-	if err := o.readWorkloadType(); err != nil {
-		return err
+	if !o.isEnvScoped() {
+		if err := o.readWorkloadType(); err != nil {
+			return err
+		}
 	}
 
 	addonBlobs, err := o.addonBlobs()
@@ -594,7 +978,7 @@ func (o *initStorageOpts) Execute() error {
 		return err
 	}
 	for _, addon := range addonBlobs {
-		path, err := o.ws.WriteAddon(addon.blob, o.workloadName, addon.name)
+		path, err := o.writeAddon(addon)
 		if err != nil {
 			e, ok := err.(*workspace.ErrFileExists)
 			if !ok {
@@ -615,6 +999,13 @@ func (o *initStorageOpts) Execute() error {
 	return nil
 }
 
+func (o *initStorageOpts) writeAddon(addon addonBlob) (string, error) {
+	if o.isEnvScoped() {
+		return o.ws.WriteEnvironmentAddon(addon.blob, addon.name)
+	}
+	return o.ws.WriteAddon(addon.blob, o.workloadName, addon.name)
+}
+
 type addonBlob struct {
 	name        string
 	description string
@@ -657,6 +1048,12 @@ func (o *initStorageOpts) newAddonTemplate() (encoding.BinaryMarshaler, error) {
 		templateBlob, err = o.newS3Template()
 	case rdsStorageType:
 		templateBlob, err = o.newRDSTemplate()
+	case redisStorageType:
+		templateBlob, err = o.newRedisTemplate()
+	case openSearchStorageType:
+		templateBlob, err = o.newOpenSearchTemplate()
+	case sqsStorageType:
+		templateBlob, err = o.newSQSTemplate()
 	}
 	if err != nil {
 		return nil, err
@@ -677,8 +1074,20 @@ func (o *initStorageOpts) newAddonParams() (encoding.BinaryMarshaler, error) {
 func (o *initStorageOpts) newDDBTemplate() (*addon.DynamoDBTemplate, error) {
 	props := addon.DynamoDBProps{
 		StorageProps: &addon.StorageProps{
-			Name: o.storageName,
+			Name:      o.storageName,
+			EnvScoped: o.isEnvScoped(),
 		},
+		TTLAttribute:   o.ttlAttribute,
+		StreamViewType: ddbStreamViewTypeToCFN[o.stream],
+		BillingMode:    addon.DDBBillingModePayPerRequest,
+	}
+	if o.billingMode == ddbBillingModeProvisioned {
+		envs, err := o.environmentNames()
+		if err != nil {
+			return nil, err
+		}
+		props.BillingMode = ddbBillingModeToCFN[o.billingMode]
+		props.Envs = envs
 	}
 
 	if err := props.BuildPartitionKey(o.partitionKey); err != nil {
@@ -697,14 +1106,31 @@ func (o *initStorageOpts) newDDBTemplate() (*addon.DynamoDBTemplate, error) {
 		}
 	}
 
+	if err := props.BuildGlobalSecondaryIndex(o.gsis); err != nil {
+		return nil, err
+	}
+
 	return addon.NewDDBTemplate(&props), nil
 }
 
 func (o *initStorageOpts) newS3Template() (*addon.S3Template, error) {
 	props := &addon.S3Props{
 		StorageProps: &addon.StorageProps{
-			Name: o.storageName,
+			Name:      o.storageName,
+			EnvScoped: o.isEnvScoped(),
 		},
+		Versioning:         o.s3Versioning,
+		ExpirationDays:     o.s3ExpirationDays,
+		CORSAllowedOrigins: o.s3CORSOrigins,
+		KMSEncryption:      o.s3KMSEncryption,
+		CloudFront:         o.s3CloudFront,
+	}
+	for _, transition := range o.s3Transitions {
+		t, err := addon.S3TransitionFromString(transition)
+		if err != nil {
+			return nil, err
+		}
+		props.Transitions = append(props.Transitions, t)
 	}
 	return addon.NewS3Template(props), nil
 }
@@ -726,12 +1152,45 @@ func (o *initStorageOpts) newRDSTemplate() (*addon.RDSTemplate, error) {
 	}
 
 	return addon.NewRDSTemplate(addon.RDSProps{
-		ClusterName:    o.storageName,
-		Engine:         engine,
-		InitialDBName:  o.rdsInitialDBName,
-		ParameterGroup: o.rdsParameterGroup,
-		Envs:           envs,
-		WorkloadType:   o.workloadType,
+		ClusterName:           o.storageName,
+		Engine:                engine,
+		InitialDBName:         o.rdsInitialDBName,
+		ParameterGroup:        o.rdsParameterGroup,
+		Envs:                  envs,
+		WorkloadType:          o.workloadType,
+		ClusterType:           rdsClusterTypeToTemplate[o.rdsClusterType],
+		InstanceClass:         o.rdsInstanceClass,
+		Readers:               o.rdsReaders,
+		BackupRetentionPeriod: o.rdsBackupRetention,
+		DeletionProtection:    o.rdsDeletionProtection,
+		SnapshotIdentifier:    o.rdsSnapshotIdentifier,
+	}), nil
+}
+
+func (o *initStorageOpts) newRedisTemplate() (*addon.RedisTemplate, error) {
+	return addon.NewRedisTemplate(&addon.RedisProps{
+		StorageProps: &addon.StorageProps{
+			Name: o.storageName,
+		},
+		NodeType: o.redisNodeType,
+	}), nil
+}
+
+func (o *initStorageOpts) newOpenSearchTemplate() (*addon.OpenSearchTemplate, error) {
+	return addon.NewOpenSearchTemplate(&addon.OpenSearchProps{
+		StorageProps: &addon.StorageProps{
+			Name: o.storageName,
+		},
+		InstanceType: o.openSearchInstanceType,
+	}), nil
+}
+
+func (o *initStorageOpts) newSQSTemplate() (*addon.SQSTemplate, error) {
+	return addon.NewSQSTemplate(&addon.SQSProps{
+		StorageProps: &addon.StorageProps{
+			Name: o.storageName,
+		},
+		FIFO: o.sqsFIFO,
 	}), nil
 }
 
@@ -761,6 +1220,9 @@ func (o *initStorageOpts) readWorkloadType() error {
 }
 
 func (o *initStorageOpts) RecommendActions() error {
+	if o.isEnvScoped() {
+		return o.recommendEnvScopedActions()
+	}
 	var (
 		retrieveEnvVarCode string
 		newVar             string
@@ -781,6 +1243,21 @@ const client = new AWS.SecretsManager({
 const dbSecret = await client.getSecretValue({SecretId: process.env.%s}).promise();
 const {username, host, dbname, password, port} = JSON.parse(dbSecret.SecretString);`, newVar)
 		}
+		if o.hasRDSInstances() {
+			retrieveEnvVarCode += fmt.Sprintf("\nconst readerHost = process.env.%s",
+				template.ToSnakeCaseFunc(template.StripNonAlphaNumFunc(o.storageName)+"ReaderEndpoint"))
+		}
+	case redisStorageType:
+		id := template.StripNonAlphaNumFunc(o.storageName)
+		newVar = template.ToSnakeCaseFunc(id + "Endpoint")
+		retrieveEnvVarCode = fmt.Sprintf("const url = `rediss://:${process.env.%s}@${process.env.%s}:${process.env.%s}`",
+			template.ToSnakeCaseFunc(id+"AuthToken"), newVar, template.ToSnakeCaseFunc(id+"Port"))
+	case openSearchStorageType:
+		newVar = template.ToSnakeCaseFunc(template.StripNonAlphaNumFunc(o.storageName) + "Endpoint")
+		retrieveEnvVarCode = fmt.Sprintf("const node = `https://${process.env.%s}`", newVar)
+	case sqsStorageType:
+		newVar = template.ToSnakeCaseFunc(template.StripNonAlphaNumFunc(o.storageName) + "URL")
+		retrieveEnvVarCode = fmt.Sprintf("const queueUrl = process.env.%s", newVar)
 	}
 
 	actionRetrieveEnvVar := fmt.Sprintf(
@@ -793,10 +1270,80 @@ For example, in JavaScript you can write:
 
 	deployCmd := fmt.Sprintf("copilot deploy --name %s", o.workloadName)
 	actionDeploy := fmt.Sprintf("Run %s to deploy your storage resources.", color.HighlightCode(deployCmd))
-	logRecommendedActions([]string{
+	actions := []string{
 		actionRetrieveEnvVar,
 		actionDeploy,
-	})
+	}
+	if o.stream != "" {
+		actions = append(actions, o.streamAction())
+	}
+	if o.s3CloudFront {
+		actions = append(actions, o.distributionAction())
+	}
+	if o.rdsSnapshotIdentifier != "" {
+		actions = append(actions, o.snapshotAction())
+	}
+	logRecommendedActions(actions)
+	return nil
+}
+
+// snapshotAction returns the action to align the secret of a cluster with the master credentials restored from its snapshot.
+func (o *initStorageOpts) snapshotAction() string {
+	return fmt.Sprintf(`The master credentials of %s are restored from snapshot %s, not from its generated secret.
+After the restore, update the username and password of the secret to the ones of the snapshot,
+or change the master password of the cluster to the one of the secret, for example with %s.`,
+		color.HighlightUserInput(o.storageName), color.HighlightUserInput(o.rdsSnapshotIdentifier),
+		color.HighlightCode("aws rds modify-db-cluster --db-cluster-identifier <cluster> --master-user-password <password>"))
+}
+
+// distributionAction returns the action to serve the objects of an S3 bucket through its CloudFront distribution.
+func (o *initStorageOpts) distributionAction() string {
+	outputName := template.StripNonAlphaNumFunc(o.storageName) + "DistributionURL"
+	if o.isEnvScoped() {
+		return fmt.Sprintf("Serve the objects of %s from the URL of its CloudFront distribution by importing it with %s in a workload's addons.",
+			color.HighlightUserInput(o.storageName), color.HighlightCode(fmt.Sprintf("Fn::ImportValue: %s-<env>-%s", o.appName, outputName)))
+	}
+	return fmt.Sprintf("Serve the objects of %s from the URL of its CloudFront distribution, injected as the environment variable %s and listed by %s.",
+		color.HighlightUserInput(o.storageName), template.ToSnakeCaseFunc(outputName), color.HighlightCode("copilot svc show"))
+}
+
+// streamAction returns the action to consume the stream of a DynamoDB table from other workloads.
+func (o *initStorageOpts) streamAction() string {
+	exportName := fmt.Sprintf("%s-<env>-%s-%sStreamArn", o.appName, o.workloadName, template.StripNonAlphaNumFunc(o.storageName))
+	if o.isEnvScoped() {
+		exportName = fmt.Sprintf("%s-<env>-%sStreamArn", o.appName, template.StripNonAlphaNumFunc(o.storageName))
+	}
+	return fmt.Sprintf("Consume the stream of %s from a worker by importing its ARN with %s in the worker's addons.",
+		color.HighlightUserInput(o.storageName), color.HighlightCode(fmt.Sprintf("Fn::ImportValue: %s", exportName)))
+}
+
+func (o *initStorageOpts) recommendEnvScopedActions() error {
+	newVar := template.ToSnakeCaseFunc(template.EnvVarNameFunc(o.storageName))
+	optIn := fmt.Sprintf(`storage:
+  env_addons:
+    - %s`, o.storageName)
+	actionOptIn := fmt.Sprintf(`Update the manifest of each workload that needs access to %s:
+%s
+The workload's task role is granted access and the name is injected as the environment variable %s.`,
+		color.HighlightUserInput(o.storageName),
+		color.HighlightCodeBlock(optIn),
+		newVar)
+
+	deployEnvCmd := "copilot env deploy --name <env>"
+	actionDeployEnv := fmt.Sprintf("Run %s to deploy your storage resources with each environment.", color.HighlightCode(deployEnvCmd))
+	actionDeploy := fmt.Sprintf("Run %s to deploy the workloads that access the storage.", color.HighlightCode("copilot deploy"))
+	actions := []string{
+		actionOptIn,
+		actionDeployEnv,
+		actionDeploy,
+	}
+	if o.stream != "" {
+		actions = append(actions, o.streamAction())
+	}
+	if o.s3CloudFront {
+		actions = append(actions, o.distributionAction())
+	}
+	logRecommendedActions(actions)
 	return nil
 }
 
@@ -808,16 +1355,33 @@ func buildStorageInitCmd() *cobra.Command {
 		Short: "Creates a new AWS CloudFormation template for a storage resource.",
 		Long: `Creates a new AWS CloudFormation template for a storage resource.
 Storage resources are stored in the Copilot addons directory (e.g. ./copilot/frontend/addons) for a given workload and deployed to your environments when you run ` + color.HighlightCode("copilot deploy") + `. 
-Resource names are injected into your containers as environment variables for easy access.`,
+Resource names are injected into your containers as environment variables for easy access.
+With ` + color.HighlightCode("--lifecycle environment") + `, the storage is stored under ./copilot/environments/addons, deployed with your environments
+when you run ` + color.HighlightCode("copilot env deploy") + `, and shared by the workloads that list it under ` + color.HighlightCode("storage.env_addons") + ` in their manifest.`,
 		Example: `
   Create an S3 bucket named "my-bucket" attached to the "frontend" service.
   /code $ copilot storage init -n my-bucket -t S3 -w frontend
+  Create a versioned S3 bucket whose objects move to Glacier after 90 days and are served through CloudFront.
+  /code $ copilot storage init -n my-assets -t S3 -w frontend --versioning --transition GLACIER:90 \
+  /code  --cors-origin https://example.com --cloudfront
   Create a basic DynamoDB table named "my-table" attached to the "frontend" service with a sort key specified.
   /code $ copilot storage init -n my-table -t DynamoDB -w frontend --partition-key Email:S --sort-key UserId:N --no-lsi
   Create a DynamoDB table with multiple alternate sort keys.
   /code $ copilot storage init -n my-table -t DynamoDB -w frontend --partition-key Email:S --sort-key UserId:N --lsi Points:N --lsi Goodness:N
+  Create a provisioned DynamoDB table with a global secondary index, a TTL attribute and a stream.
+  /code $ copilot storage init -n my-table -t DynamoDB -w frontend --partition-key Id:S --no-sort --gsi Email:S --ttl ExpiresAt --stream new-and-old-images --billing-mode provisioned
   Create an RDS Aurora Serverless cluster using PostgreSQL as the database engine.
-  /code $ copilot storage init -n my-cluster -t Aurora -w frontend --engine PostgreSQL`,
+  /code $ copilot storage init -n my-cluster -t Aurora -w frontend --engine PostgreSQL
+  Create a provisioned Aurora cluster with two readers, restored from a snapshot.
+  /code $ copilot storage init -n my-cluster -t Aurora -w frontend --engine MySQL \
+  /code  --cluster-type provisioned --instance-class db.r6g.large --readers 2 \
+  /code  --backup-retention 14 --deletion-protection --snapshot-identifier my-snapshot
+  Create an ElastiCache Redis cluster attached to the "frontend" service.
+  /code $ copilot storage init -n my-cache -t Redis -w frontend --node-type cache.t3.small
+  Create a FIFO SQS queue attached to the "worker" service.
+  /code $ copilot storage init -n my-queue -t SQS -w worker --fifo
+  Create a DynamoDB table named "users" shared by the workloads in each environment.
+  /code $ copilot storage init -n users -t DynamoDB --lifecycle environment --partition-key UserId:S --no-sort`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newStorageInitOpts(vars)
 			if err != nil {
@@ -829,21 +1393,44 @@ Resource names are injected into your containers as environment variables for ea
 	cmd.Flags().StringVarP(&vars.storageName, nameFlag, nameFlagShort, "", storageFlagDescription)
 	cmd.Flags().StringVarP(&vars.storageType, storageTypeFlag, typeFlagShort, "", storageTypeFlagDescription)
 	cmd.Flags().StringVarP(&vars.workloadName, workloadFlag, workloadFlagShort, "", storageWorkloadFlagDescription)
+	cmd.Flags().StringVar(&vars.lifecycle, storageLifecycleFlag, workloadStorageLifecycle, storageLifecycleFlagDescription)
 
 	cmd.Flags().StringVar(&vars.partitionKey, storagePartitionKeyFlag, "", storagePartitionKeyFlagDescription)
 	cmd.Flags().StringVar(&vars.sortKey, storageSortKeyFlag, "", storageSortKeyFlagDescription)
 	cmd.Flags().StringArrayVar(&vars.lsiSorts, storageLSIConfigFlag, []string{}, storageLSIConfigFlagDescription)
 	cmd.Flags().BoolVar(&vars.noLSI, storageNoLSIFlag, false, storageNoLSIFlagDescription)
 	cmd.Flags().BoolVar(&vars.noSort, storageNoSortFlag, false, storageNoSortFlagDescription)
+	cmd.Flags().StringArrayVar(&vars.gsis, storageGSIConfigFlag, []string{}, storageGSIConfigFlagDescription)
+	cmd.Flags().StringVar(&vars.ttlAttribute, storageTTLFlag, "", storageTTLFlagDescription)
+	cmd.Flags().StringVar(&vars.stream, storageStreamFlag, "", storageStreamFlagDescription)
+	cmd.Flags().StringVar(&vars.billingMode, storageBillingModeFlag, ddbBillingModeOnDemand, storageBillingModeFlagDescription)
+
+	cmd.Flags().BoolVar(&vars.s3Versioning, storageS3VersioningFlag, false, storageS3VersioningFlagDescription)
+	cmd.Flags().IntVar(&vars.s3ExpirationDays, storageS3ExpirationDaysFlag, 0, storageS3ExpirationDaysFlagDescription)
+	cmd.Flags().StringArrayVar(&vars.s3Transitions, storageS3TransitionFlag, []string{}, storageS3TransitionFlagDescription)
+	cmd.Flags().StringArrayVar(&vars.s3CORSOrigins, storageS3CORSOriginFlag, []string{}, storageS3CORSOriginFlagDescription)
+	cmd.Flags().BoolVar(&vars.s3KMSEncryption, storageS3KMSEncryptionFlag, false, storageS3KMSEncryptionFlagDescription)
+	cmd.Flags().BoolVar(&vars.s3CloudFront, storageS3CloudFrontFlag, false, storageS3CloudFrontFlagDescription)
 
 	cmd.Flags().StringVar(&vars.rdsEngine, storageRDSEngineFlag, "", storageRDSEngineFlagDescription)
 	cmd.Flags().StringVar(&vars.rdsInitialDBName, storageRDSInitialDBFlag, "", storageRDSInitialDBFlagDescription)
 	cmd.Flags().StringVar(&vars.rdsParameterGroup, storageRDSParameterGroupFlag, "", storageRDSParameterGroupFlagDescription)
+	cmd.Flags().StringVar(&vars.rdsClusterType, storageRDSClusterTypeFlag, rdsClusterTypeServerless, storageRDSClusterTypeFlagDescription)
+	cmd.Flags().StringVar(&vars.rdsInstanceClass, storageRDSInstanceClassFlag, "", storageRDSInstanceClassFlagDescription)
+	cmd.Flags().IntVar(&vars.rdsReaders, storageRDSReadersFlag, 0, storageRDSReadersFlagDescription)
+	cmd.Flags().IntVar(&vars.rdsBackupRetention, storageRDSBackupRetentionFlag, 0, storageRDSBackupRetentionFlagDescription)
+	cmd.Flags().BoolVar(&vars.rdsDeletionProtection, storageRDSDeletionProtectionFlag, false, storageRDSDeletionProtectionFlagDescription)
+	cmd.Flags().StringVar(&vars.rdsSnapshotIdentifier, storageRDSSnapshotFlag, "", storageRDSSnapshotFlagDescription)
+
+	cmd.Flags().StringVar(&vars.redisNodeType, storageRedisNodeTypeFlag, "", storageRedisNodeTypeFlagDescription)
+	cmd.Flags().StringVar(&vars.openSearchInstanceType, storageOpenSearchInstanceTypeFlag, "", storageOpenSearchInstanceTypeFlagDescription)
+	cmd.Flags().BoolVar(&vars.sqsFIFO, storageSQSFIFOFlag, false, storageSQSFIFOFlagDescription)
 
 	requiredFlags := pflag.NewFlagSet("Required", pflag.ContinueOnError)
 	requiredFlags.AddFlag(cmd.Flags().Lookup(nameFlag))
 	requiredFlags.AddFlag(cmd.Flags().Lookup(storageTypeFlag))
 	requiredFlags.AddFlag(cmd.Flags().Lookup(workloadFlag))
+	requiredFlags.AddFlag(cmd.Flags().Lookup(storageLifecycleFlag))
 
 	ddbFlags := pflag.NewFlagSet("DynamoDB", pflag.ContinueOnError)
 	ddbFlags.AddFlag(cmd.Flags().Lookup(storagePartitionKeyFlag))
@@ -851,18 +1438,49 @@ Resource names are injected into your containers as environment variables for ea
 	ddbFlags.AddFlag(cmd.Flags().Lookup(storageNoSortFlag))
 	ddbFlags.AddFlag(cmd.Flags().Lookup(storageLSIConfigFlag))
 	ddbFlags.AddFlag(cmd.Flags().Lookup(storageNoLSIFlag))
-
-	auroraFlags := pflag.NewFlagSet("Aurora Serverless", pflag.ContinueOnError)
+	ddbFlags.AddFlag(cmd.Flags().Lookup(storageGSIConfigFlag))
+	ddbFlags.AddFlag(cmd.Flags().Lookup(storageTTLFlag))
+	ddbFlags.AddFlag(cmd.Flags().Lookup(storageStreamFlag))
+	ddbFlags.AddFlag(cmd.Flags().Lookup(storageBillingModeFlag))
+
+	s3Flags := pflag.NewFlagSet("S3", pflag.ContinueOnError)
+	s3Flags.AddFlag(cmd.Flags().Lookup(storageS3VersioningFlag))
+	s3Flags.AddFlag(cmd.Flags().Lookup(storageS3ExpirationDaysFlag))
+	s3Flags.AddFlag(cmd.Flags().Lookup(storageS3TransitionFlag))
+	s3Flags.AddFlag(cmd.Flags().Lookup(storageS3CORSOriginFlag))
+	s3Flags.AddFlag(cmd.Flags().Lookup(storageS3KMSEncryptionFlag))
+	s3Flags.AddFlag(cmd.Flags().Lookup(storageS3CloudFrontFlag))
+
+	auroraFlags := pflag.NewFlagSet("Aurora", pflag.ContinueOnError)
 	auroraFlags.AddFlag(cmd.Flags().Lookup(storageRDSEngineFlag))
 	auroraFlags.AddFlag(cmd.Flags().Lookup(storageRDSInitialDBFlag))
 	auroraFlags.AddFlag(cmd.Flags().Lookup(storageRDSParameterGroupFlag))
+	auroraFlags.AddFlag(cmd.Flags().Lookup(storageRDSClusterTypeFlag))
+	auroraFlags.AddFlag(cmd.Flags().Lookup(storageRDSInstanceClassFlag))
+	auroraFlags.AddFlag(cmd.Flags().Lookup(storageRDSReadersFlag))
+	auroraFlags.AddFlag(cmd.Flags().Lookup(storageRDSBackupRetentionFlag))
+	auroraFlags.AddFlag(cmd.Flags().Lookup(storageRDSDeletionProtectionFlag))
+	auroraFlags.AddFlag(cmd.Flags().Lookup(storageRDSSnapshotFlag))
+
+	redisFlags := pflag.NewFlagSet("ElastiCache Redis", pflag.ContinueOnError)
+	redisFlags.AddFlag(cmd.Flags().Lookup(storageRedisNodeTypeFlag))
+
+	openSearchFlags := pflag.NewFlagSet("OpenSearch", pflag.ContinueOnError)
+	openSearchFlags.AddFlag(cmd.Flags().Lookup(storageOpenSearchInstanceTypeFlag))
+
+	sqsFlags := pflag.NewFlagSet("SQS", pflag.ContinueOnError)
+	sqsFlags.AddFlag(cmd.Flags().Lookup(storageSQSFIFOFlag))
 
 	cmd.Annotations = map[string]string{
 		// The order of the sections we want to display.
-		"sections":          `Required,DynamoDB,Aurora Serverless`,
+		"sections":          `Required,S3,DynamoDB,Aurora,ElastiCache Redis,OpenSearch,SQS`,
 		"Required":          requiredFlags.FlagUsages(),
+		"S3":                s3Flags.FlagUsages(),
 		"DynamoDB":          ddbFlags.FlagUsages(),
-		"Aurora Serverless": auroraFlags.FlagUsages(),
+		"Aurora":            auroraFlags.FlagUsages(),
+		"ElastiCache Redis": redisFlags.FlagUsages(),
+		"OpenSearch":        openSearchFlags.FlagUsages(),
+		"SQS":               sqsFlags.FlagUsages(),
 	}
 	cmd.SetUsageTemplate(`{{h1 "Usage"}}{{if .Runnable}}
   {{.UseLine}}{{end}}{{$annotations := .Annotations}}{{$sections := split .Annotations.sections ","}}{{if gt (len $sections) 0}}
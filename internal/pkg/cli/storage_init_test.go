@@ -4,13 +4,16 @@
 package cli
 
 import (
+	"encoding"
 	"errors"
 	"fmt"
 	"testing"
 
 	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
 
+	"github.com/aws/copilot-cli/internal/pkg/addon"
 	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
 	"github.com/aws/copilot-cli/internal/pkg/workspace"
 
@@ -30,6 +33,27 @@ func TestStorageInitOpts_Validate(t *testing.T) {
 		inNoSort      bool
 		inNoLSI       bool
 		inEngine      string
+		inLifecycle   string
+
+		inGSIs                   []string
+		inTTL                    string
+		inStream                 string
+		inBilling                string
+		inRedisNodeType          string
+		inOpenSearchInstanceType string
+
+		inClusterType     string
+		inInstanceClass   string
+		inInitialDBName   string
+		inReaders         int
+		inBackupRetention int
+		inSnapshot        string
+
+		inExpirationDays int
+		inTransitions    []string
+		inCORSOrigins    []string
+		inKMSEncryption  bool
+		inCloudFront     bool
 
 		mockWs    func(m *mocks.MockwsAddonManager)
 		mockStore func(m *mocks.Mockstore)
@@ -173,6 +197,242 @@ func TestStorageInitOpts_Validate(t *testing.T) {
 
 			wantedErr: errors.New("invalid engine type mysql: must be one of \"MySQL\", \"PostgreSQL\""),
 		},
+		"invalid lifecycle": {
+			inAppName:   "bowie",
+			inLifecycle: "app",
+			mockWs:      func(m *mocks.MockwsAddonManager) {},
+			mockStore:   func(m *mocks.Mockstore) {},
+
+			wantedErr: errors.New("invalid lifecycle app: must be one of \"workload\", \"environment\""),
+		},
+		"cannot specify a workload for environment storage": {
+			inAppName:   "bowie",
+			inSvcName:   "frontend",
+			inLifecycle: environmentStorageLifecycle,
+			mockWs:      func(m *mocks.MockwsAddonManager) {},
+			mockStore:   func(m *mocks.Mockstore) {},
+
+			wantedErr: errors.New("cannot specify both --workload and --lifecycle environment"),
+		},
+		"cannot share Aurora in an environment": {
+			inAppName:     "bowie",
+			inStorageType: rdsStorageType,
+			inLifecycle:   environmentStorageLifecycle,
+			mockWs:        func(m *mocks.MockwsAddonManager) {},
+			mockStore:     func(m *mocks.Mockstore) {},
+
+			wantedErr: errors.New("storage type Aurora cannot be shared in an environment: must be one of \"DynamoDB\", \"S3\""),
+		},
+		"invalid global secondary index": {
+			inAppName:     "bowie",
+			inStorageType: dynamoDBStorageType,
+			inGSIs:        []string{"Email:S,Name:S,Age:N"},
+			mockWs:        func(m *mocks.MockwsAddonManager) {},
+			mockStore:     func(m *mocks.Mockstore) {},
+
+			wantedErr: fmt.Errorf("global secondary index Email:S,Name:S,Age:N: %w", errGSIBadFormat),
+		},
+		"invalid stream": {
+			inAppName:     "bowie",
+			inStorageType: dynamoDBStorageType,
+			inStream:      "all",
+			mockWs:        func(m *mocks.MockwsAddonManager) {},
+			mockStore:     func(m *mocks.Mockstore) {},
+
+			wantedErr: errors.New(`invalid stream all: must be one of "keys-only", "new-image", "old-image", "new-and-old-images"`),
+		},
+		"invalid billing mode": {
+			inAppName:     "bowie",
+			inStorageType: dynamoDBStorageType,
+			inBilling:     "free",
+			mockWs:        func(m *mocks.MockwsAddonManager) {},
+			mockStore:     func(m *mocks.Mockstore) {},
+
+			wantedErr: errors.New(`invalid billing mode free: must be one of "on-demand", "provisioned"`),
+		},
+		"valid DynamoDB options": {
+			inAppName:     "bowie",
+			inStorageType: dynamoDBStorageType,
+			inGSIs:        []string{"Email:S", "Owner:S,CreatedAt:N"},
+			inTTL:         "ExpiresAt",
+			inStream:      ddbStreamKeysOnly,
+			inBilling:     ddbBillingModeProvisioned,
+			mockWs:        func(m *mocks.MockwsAddonManager) {},
+			mockStore:     func(m *mocks.Mockstore) {},
+		},
+		"invalid S3 expiration days": {
+			inAppName:        "bowie",
+			inStorageType:    s3StorageType,
+			inExpirationDays: -1,
+			mockWs:           func(m *mocks.MockwsAddonManager) {},
+			mockStore:        func(m *mocks.Mockstore) {},
+
+			wantedErr: errors.New("invalid expiration days -1: must be a positive number"),
+		},
+		"invalid S3 transition format": {
+			inAppName:     "bowie",
+			inStorageType: s3StorageType,
+			inTransitions: []string{"GLACIER"},
+			mockWs:        func(m *mocks.MockwsAddonManager) {},
+			mockStore:     func(m *mocks.Mockstore) {},
+
+			wantedErr: errors.New("invalid transition GLACIER: must be of the format '<storageClass>:<days>'"),
+		},
+		"invalid S3 storage class": {
+			inAppName:     "bowie",
+			inStorageType: s3StorageType,
+			inTransitions: []string{"COLD:30"},
+			mockWs:        func(m *mocks.MockwsAddonManager) {},
+			mockStore:     func(m *mocks.Mockstore) {},
+
+			wantedErr: errors.New(`invalid storage class COLD: must be one of "STANDARD_IA", "ONEZONE_IA", "INTELLIGENT_TIERING", "GLACIER_IR", "GLACIER", "DEEP_ARCHIVE"`),
+		},
+		"S3 transition after expiration": {
+			inAppName:        "bowie",
+			inStorageType:    s3StorageType,
+			inExpirationDays: 60,
+			inTransitions:    []string{"GLACIER:90"},
+			mockWs:           func(m *mocks.MockwsAddonManager) {},
+			mockStore:        func(m *mocks.Mockstore) {},
+
+			wantedErr: errors.New("transition GLACIER:90 must happen before objects expire after 60 days"),
+		},
+		"invalid S3 CORS origin": {
+			inAppName:     "bowie",
+			inStorageType: s3StorageType,
+			inCORSOrigins: []string{"example.com"},
+			mockWs:        func(m *mocks.MockwsAddonManager) {},
+			mockStore:     func(m *mocks.Mockstore) {},
+
+			wantedErr: errors.New(`invalid CORS origin example.com: must be "*" or start with "http://" or "https://"`),
+		},
+		"S3 KMS encryption with CloudFront": {
+			inAppName:       "bowie",
+			inStorageType:   s3StorageType,
+			inKMSEncryption: true,
+			inCloudFront:    true,
+			mockWs:          func(m *mocks.MockwsAddonManager) {},
+			mockStore:       func(m *mocks.Mockstore) {},
+
+			wantedErr: errors.New("cannot specify both --kms-encryption and --cloudfront"),
+		},
+		"valid S3 options": {
+			inAppName:        "bowie",
+			inStorageType:    s3StorageType,
+			inExpirationDays: 365,
+			inTransitions:    []string{"STANDARD_IA:30", "GLACIER:90"},
+			inCORSOrigins:    []string{"https://example.com", "*"},
+			inCloudFront:     true,
+			mockWs:           func(m *mocks.MockwsAddonManager) {},
+			mockStore:        func(m *mocks.Mockstore) {},
+		},
+		"invalid Aurora cluster type": {
+			inAppName:     "bowie",
+			inStorageType: rdsStorageType,
+			inClusterType: "serverless-v3",
+			mockWs:        func(m *mocks.MockwsAddonManager) {},
+			mockStore:     func(m *mocks.Mockstore) {},
+
+			wantedErr: errors.New(`invalid cluster type serverless-v3: must be one of "serverless", "serverless-v2", "provisioned"`),
+		},
+		"Aurora instance class without a provisioned cluster": {
+			inAppName:       "bowie",
+			inStorageType:   rdsStorageType,
+			inClusterType:   rdsClusterTypeServerlessV2,
+			inInstanceClass: "db.r6g.large",
+			mockWs:          func(m *mocks.MockwsAddonManager) {},
+			mockStore:       func(m *mocks.Mockstore) {},
+
+			wantedErr: errors.New("cannot specify --instance-class without --cluster-type provisioned"),
+		},
+		"invalid Aurora instance class": {
+			inAppName:       "bowie",
+			inStorageType:   rdsStorageType,
+			inClusterType:   rdsClusterTypeProvisioned,
+			inInstanceClass: "r6g.large",
+			mockWs:          func(m *mocks.MockwsAddonManager) {},
+			mockStore:       func(m *mocks.Mockstore) {},
+
+			wantedErr: errors.New(`invalid instance class r6g.large: must start with "db."`),
+		},
+		"too many Aurora readers": {
+			inAppName:     "bowie",
+			inStorageType: rdsStorageType,
+			inClusterType: rdsClusterTypeProvisioned,
+			inReaders:     16,
+			mockWs:        func(m *mocks.MockwsAddonManager) {},
+			mockStore:     func(m *mocks.Mockstore) {},
+
+			wantedErr: errors.New("invalid number of readers 16: must be between 0 and 15"),
+		},
+		"Aurora readers with a Serverless v1 cluster": {
+			inAppName:     "bowie",
+			inStorageType: rdsStorageType,
+			inClusterType: rdsClusterTypeServerless,
+			inReaders:     1,
+			mockWs:        func(m *mocks.MockwsAddonManager) {},
+			mockStore:     func(m *mocks.Mockstore) {},
+
+			wantedErr: errors.New("cannot specify --readers with --cluster-type serverless"),
+		},
+		"invalid Aurora backup retention": {
+			inAppName:         "bowie",
+			inStorageType:     rdsStorageType,
+			inBackupRetention: 36,
+			mockWs:            func(m *mocks.MockwsAddonManager) {},
+			mockStore:         func(m *mocks.Mockstore) {},
+
+			wantedErr: errors.New("invalid backup retention 36: must be between 1 and 35 days"),
+		},
+		"Aurora snapshot with an initial database": {
+			inAppName:       "bowie",
+			inStorageType:   rdsStorageType,
+			inSnapshot:      "my-snapshot",
+			inInitialDBName: "main",
+			mockWs:          func(m *mocks.MockwsAddonManager) {},
+			mockStore:       func(m *mocks.Mockstore) {},
+
+			wantedErr: errors.New("cannot specify both --snapshot-identifier and --initial-db"),
+		},
+		"valid Aurora options": {
+			inAppName:         "bowie",
+			inStorageType:     rdsStorageType,
+			inClusterType:     rdsClusterTypeProvisioned,
+			inInstanceClass:   "db.r6g.large",
+			inReaders:         2,
+			inBackupRetention: 14,
+			inSnapshot:        "my-snapshot",
+			mockWs:            func(m *mocks.MockwsAddonManager) {},
+			mockStore:         func(m *mocks.Mockstore) {},
+		},
+		"invalid Redis node type": {
+			inAppName:       "bowie",
+			inStorageType:   redisStorageType,
+			inStorageName:   "cache",
+			inRedisNodeType: "t3.micro",
+			mockWs:          func(m *mocks.MockwsAddonManager) {},
+			mockStore:       func(m *mocks.Mockstore) {},
+
+			wantedErr: errors.New(`invalid node type t3.micro: must start with "cache."`),
+		},
+		"invalid OpenSearch instance type": {
+			inAppName:                "bowie",
+			inStorageType:            openSearchStorageType,
+			inStorageName:            "search",
+			inOpenSearchInstanceType: "t3.small",
+			mockWs:                   func(m *mocks.MockwsAddonManager) {},
+			mockStore:                func(m *mocks.Mockstore) {},
+
+			wantedErr: errors.New(`invalid instance type t3.small: must end with ".search"`),
+		},
+		"successfully validates environment storage": {
+			inAppName:     "bowie",
+			inStorageType: dynamoDBStorageType,
+			inStorageName: "users",
+			inLifecycle:   environmentStorageLifecycle,
+			mockWs:        func(m *mocks.MockwsAddonManager) {},
+			mockStore:     func(m *mocks.Mockstore) {},
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -194,6 +454,27 @@ func TestStorageInitOpts_Validate(t *testing.T) {
 					noLSI:        tc.inNoLSI,
 					noSort:       tc.inNoSort,
 					rdsEngine:    tc.inEngine,
+					lifecycle:    tc.inLifecycle,
+
+					gsis:                   tc.inGSIs,
+					ttlAttribute:           tc.inTTL,
+					stream:                 tc.inStream,
+					billingMode:            tc.inBilling,
+					redisNodeType:          tc.inRedisNodeType,
+					openSearchInstanceType: tc.inOpenSearchInstanceType,
+
+					rdsClusterType:        tc.inClusterType,
+					rdsInstanceClass:      tc.inInstanceClass,
+					rdsInitialDBName:      tc.inInitialDBName,
+					rdsReaders:            tc.inReaders,
+					rdsBackupRetention:    tc.inBackupRetention,
+					rdsSnapshotIdentifier: tc.inSnapshot,
+
+					s3ExpirationDays: tc.inExpirationDays,
+					s3Transitions:    tc.inTransitions,
+					s3CORSOrigins:    tc.inCORSOrigins,
+					s3KMSEncryption:  tc.inKMSEncryption,
+					s3CloudFront:     tc.inCloudFront,
 				},
 				appName: tc.inAppName,
 				ws:      mockWs,
@@ -238,6 +519,9 @@ func TestStorageInitOpts_Ask(t *testing.T) {
 
 		inDBEngine      string
 		inInitialDBName string
+		inClusterType   string
+		inSnapshot      string
+		inLifecycle     string
 
 		mockPrompt func(m *mocks.Mockprompter)
 		mockCfg    func(m *mocks.MockwsSelector)
@@ -266,6 +550,18 @@ func TestStorageInitOpts_Ask(t *testing.T) {
 						Value: rdsStorageTypeOption,
 						Hint:  "SQL",
 					},
+					{
+						Value: redisStorageTypeOption,
+						Hint:  "Cache",
+					},
+					{
+						Value: openSearchStorageTypeOption,
+						Hint:  "Search",
+					},
+					{
+						Value: sqsStorageTypeOption,
+						Hint:  "Queue",
+					},
 				}
 				m.EXPECT().SelectOption(gomock.Any(), gomock.Any(), gomock.Eq(options), gomock.Any()).Return(s3StorageType, nil)
 			},
@@ -273,6 +569,37 @@ func TestStorageInitOpts_Ask(t *testing.T) {
 
 			wantedErr: nil,
 		},
+		"asks for shareable storage type without a workload for environment storage": {
+			inAppName:     wantedAppName,
+			inStorageName: wantedTableName,
+			inPartition:   wantedPartitionKey,
+			inNoSort:      true,
+			inLifecycle:   environmentStorageLifecycle,
+
+			mockPrompt: func(m *mocks.Mockprompter) {
+				options := []prompt.Option{
+					{
+						Value: dynamoDBStorageTypeOption,
+						Hint:  "NoSQL",
+					},
+					{
+						Value: s3StorageTypeOption,
+						Hint:  "Objects",
+					},
+				}
+				m.EXPECT().SelectOption(storageInitEnvTypePrompt, gomock.Any(), gomock.Eq(options), gomock.Any()).Return(dynamoDBStorageTypeOption, nil)
+			},
+			mockCfg: func(m *mocks.MockwsSelector) {},
+
+			wantedVars: &initStorageVars{
+				storageType:  dynamoDBStorageType,
+				storageName:  wantedTableName,
+				partitionKey: wantedPartitionKey,
+				noSort:       true,
+				noLSI:        true,
+				lifecycle:    environmentStorageLifecycle,
+			},
+		},
 		"error if storage type not gotten": {
 			inAppName:     wantedAppName,
 			inSvcName:     wantedSvcName,
@@ -851,6 +1178,130 @@ func TestStorageInitOpts_Ask(t *testing.T) {
 
 			wantedErr: fmt.Errorf("input initial database name: some error"),
 		},
+		"asks for the instance class of a provisioned Aurora cluster restored from a snapshot": {
+			inAppName:     wantedAppName,
+			inSvcName:     wantedSvcName,
+			inStorageName: "db",
+			inStorageType: rdsStorageType,
+			inDBEngine:    wantedDBEngine,
+			inClusterType: rdsClusterTypeProvisioned,
+			inSnapshot:    "my-snapshot",
+
+			mockPrompt: func(m *mocks.Mockprompter) {
+				m.EXPECT().SelectOne(storageInitRDSInstanceClassPrompt, gomock.Any(), rdsInstanceClasses, gomock.Any()).
+					Return(defaultRDSInstanceClass, nil)
+			},
+			mockCfg: func(m *mocks.MockwsSelector) {},
+			mockWS: func(m *mocks.MockwsAddonManager) {
+				m.EXPECT().ReadWorkloadManifest(wantedSvcName).Return(workspace.WorkloadManifest("type: Load Balanced Web Service"), nil)
+			},
+
+			wantedVars: &initStorageVars{
+				storageType:           rdsStorageType,
+				storageName:           "db",
+				workloadName:          wantedSvcName,
+				rdsEngine:             wantedDBEngine,
+				rdsClusterType:        rdsClusterTypeProvisioned,
+				rdsInstanceClass:      defaultRDSInstanceClass,
+				rdsSnapshotIdentifier: "my-snapshot",
+			},
+		},
+		"error if Aurora instance class not gotten": {
+			inAppName:       wantedAppName,
+			inSvcName:       wantedSvcName,
+			inStorageName:   "db",
+			inStorageType:   rdsStorageType,
+			inDBEngine:      wantedDBEngine,
+			inInitialDBName: wantedInitialDBName,
+			inClusterType:   rdsClusterTypeProvisioned,
+
+			mockPrompt: func(m *mocks.Mockprompter) {
+				m.EXPECT().SelectOne(storageInitRDSInstanceClassPrompt, gomock.Any(), gomock.Any(), gomock.Any()).
+					Return("", errors.New("some error"))
+			},
+			mockCfg: func(m *mocks.MockwsSelector) {},
+			mockWS: func(m *mocks.MockwsAddonManager) {
+				m.EXPECT().ReadWorkloadManifest(wantedSvcName).Return(workspace.WorkloadManifest("type: Load Balanced Web Service"), nil)
+			},
+
+			wantedErr: errors.New("select instance class: some error"),
+		},
+		"asks for Redis node type": {
+			inAppName:     wantedAppName,
+			inSvcName:     wantedSvcName,
+			inStorageName: "cache",
+			inStorageType: redisStorageType,
+
+			mockPrompt: func(m *mocks.Mockprompter) {
+				m.EXPECT().SelectOne(storageInitRedisNodeTypePrompt, gomock.Any(), redisNodeTypes, gomock.Any()).
+					Return("cache.t3.small", nil)
+			},
+			mockCfg: func(m *mocks.MockwsSelector) {},
+			mockWS: func(m *mocks.MockwsAddonManager) {
+				m.EXPECT().ReadWorkloadManifest(wantedSvcName).Return(workspace.WorkloadManifest("type: Load Balanced Web Service"), nil)
+			},
+
+			wantedVars: &initStorageVars{
+				storageType:   redisStorageType,
+				storageName:   "cache",
+				workloadName:  wantedSvcName,
+				redisNodeType: "cache.t3.small",
+			},
+		},
+		"error if Redis node type not gotten": {
+			inAppName:     wantedAppName,
+			inSvcName:     wantedSvcName,
+			inStorageName: "cache",
+			inStorageType: redisStorageType,
+
+			mockPrompt: func(m *mocks.Mockprompter) {
+				m.EXPECT().SelectOne(storageInitRedisNodeTypePrompt, gomock.Any(), gomock.Any(), gomock.Any()).
+					Return("", errors.New("some error"))
+			},
+			mockCfg: func(m *mocks.MockwsSelector) {},
+			mockWS: func(m *mocks.MockwsAddonManager) {
+				m.EXPECT().ReadWorkloadManifest(wantedSvcName).Return(workspace.WorkloadManifest("type: Load Balanced Web Service"), nil)
+			},
+
+			wantedErr: errors.New("select node type: some error"),
+		},
+		"asks for OpenSearch instance type": {
+			inAppName:     wantedAppName,
+			inSvcName:     wantedSvcName,
+			inStorageName: "search",
+			inStorageType: openSearchStorageType,
+
+			mockPrompt: func(m *mocks.Mockprompter) {
+				m.EXPECT().SelectOne(storageInitOpenSearchInstanceTypePrompt, gomock.Any(), openSearchInstanceTypes, gomock.Any()).
+					Return(defaultOpenSearchInstanceType, nil)
+			},
+			mockCfg: func(m *mocks.MockwsSelector) {},
+			mockWS: func(m *mocks.MockwsAddonManager) {
+				m.EXPECT().ReadWorkloadManifest(wantedSvcName).Return(workspace.WorkloadManifest("type: Backend Service"), nil)
+			},
+
+			wantedVars: &initStorageVars{
+				storageType:            openSearchStorageType,
+				storageName:            "search",
+				workloadName:           wantedSvcName,
+				openSearchInstanceType: defaultOpenSearchInstanceType,
+			},
+		},
+		"does not ask anything else for SQS": {
+			inAppName:     wantedAppName,
+			inSvcName:     wantedSvcName,
+			inStorageName: "queue",
+			inStorageType: sqsStorageType,
+
+			mockPrompt: func(m *mocks.Mockprompter) {},
+			mockCfg:    func(m *mocks.MockwsSelector) {},
+
+			wantedVars: &initStorageVars{
+				storageType:  sqsStorageType,
+				storageName:  "queue",
+				workloadName: wantedSvcName,
+			},
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -872,8 +1323,11 @@ func TestStorageInitOpts_Ask(t *testing.T) {
 					noLSI:        tc.inNoLSI,
 					noSort:       tc.inNoSort,
 
-					rdsEngine:        tc.inDBEngine,
-					rdsInitialDBName: tc.inInitialDBName,
+					rdsEngine:             tc.inDBEngine,
+					rdsInitialDBName:      tc.inInitialDBName,
+					rdsClusterType:        tc.inClusterType,
+					rdsSnapshotIdentifier: tc.inSnapshot,
+					lifecycle:             tc.inLifecycle,
 				},
 				appName: tc.inAppName,
 				sel:     mockConfig,
@@ -920,10 +1374,24 @@ func TestStorageInitOpts_Execute(t *testing.T) {
 		inLSISorts  []string
 		inNoLSI     bool
 		inNoSort    bool
+		inGSIs      []string
+		inTTL       string
+		inStream    string
+		inBilling   string
 
 		inEngine         string
 		inInitialDBName  string
 		inParameterGroup string
+		inClusterType    string
+		inInstanceClass  string
+		inReaders        int
+		inLifecycle      string
+
+		inVersioning     bool
+		inExpirationDays int
+		inTransitions    []string
+		inCORSOrigins    []string
+		inCloudFront     bool
 
 		mockWs    func(m *mocks.MockwsAddonManager)
 		mockStore func(m *mocks.Mockstore)
@@ -943,6 +1411,34 @@ func TestStorageInitOpts_Execute(t *testing.T) {
 
 			wantedErr: nil,
 		},
+		"happy calls for S3 with versioning, lifecycle, CORS and CloudFront": {
+			inAppName:        wantedAppName,
+			inStorageType:    s3StorageType,
+			inSvcName:        wantedSvcName,
+			inStorageName:    "my-assets",
+			inVersioning:     true,
+			inExpirationDays: 365,
+			inTransitions:    []string{"STANDARD_IA:30", "GLACIER:90"},
+			inCORSOrigins:    []string{"https://example.com"},
+			inCloudFront:     true,
+
+			mockWs: func(m *mocks.MockwsAddonManager) {
+				m.EXPECT().ReadWorkloadManifest(wantedSvcName).Return([]byte("type: Load Balanced Web Service"), nil)
+				m.EXPECT().WriteAddon(gomock.Any(), wantedSvcName, "my-assets").DoAndReturn(func(f encoding.BinaryMarshaler, _, _ string) (string, error) {
+					tpl, ok := f.(*addon.S3Template)
+					require.True(t, ok)
+					require.True(t, tpl.Versioning)
+					require.Equal(t, 365, tpl.ExpirationDays)
+					require.Equal(t, []addon.S3Transition{
+						{StorageClass: "STANDARD_IA", Days: 30},
+						{StorageClass: "GLACIER", Days: 90},
+					}, tpl.Transitions)
+					require.Equal(t, []string{"https://example.com"}, tpl.CORSAllowedOrigins)
+					require.True(t, tpl.CloudFront)
+					return "/frontend/addons/my-assets.yml", nil
+				})
+			},
+		},
 		"happy calls for DDB": {
 			inAppName:     wantedAppName,
 			inStorageType: dynamoDBStorageType,
@@ -959,6 +1455,39 @@ func TestStorageInitOpts_Execute(t *testing.T) {
 
 			wantedErr: nil,
 		},
+		"happy calls for Redis": {
+			inAppName:     wantedAppName,
+			inStorageType: redisStorageType,
+			inSvcName:     wantedSvcName,
+			inStorageName: "my-cache",
+
+			mockWs: func(m *mocks.MockwsAddonManager) {
+				m.EXPECT().ReadWorkloadManifest(wantedSvcName).Return([]byte("type: Load Balanced Web Service"), nil)
+				m.EXPECT().WriteAddon(gomock.Any(), wantedSvcName, "my-cache").Return("/frontend/addons/my-cache.yml", nil)
+			},
+		},
+		"happy calls for OpenSearch": {
+			inAppName:     wantedAppName,
+			inStorageType: openSearchStorageType,
+			inSvcName:     wantedSvcName,
+			inStorageName: "my-search",
+
+			mockWs: func(m *mocks.MockwsAddonManager) {
+				m.EXPECT().ReadWorkloadManifest(wantedSvcName).Return([]byte("type: Backend Service"), nil)
+				m.EXPECT().WriteAddon(gomock.Any(), wantedSvcName, "my-search").Return("/frontend/addons/my-search.yml", nil)
+			},
+		},
+		"happy calls for SQS": {
+			inAppName:     wantedAppName,
+			inStorageType: sqsStorageType,
+			inSvcName:     wantedSvcName,
+			inStorageName: "my-queue",
+
+			mockWs: func(m *mocks.MockwsAddonManager) {
+				m.EXPECT().ReadWorkloadManifest(wantedSvcName).Return([]byte("type: Worker Service"), nil)
+				m.EXPECT().WriteAddon(gomock.Any(), wantedSvcName, "my-queue").Return("/frontend/addons/my-queue.yml", nil)
+			},
+		},
 		"happy calls for DDB with LSI": {
 			inAppName:     wantedAppName,
 			inStorageType: dynamoDBStorageType,
@@ -975,6 +1504,76 @@ func TestStorageInitOpts_Execute(t *testing.T) {
 
 			wantedErr: nil,
 		},
+		"happy calls for provisioned DDB with GSIs, TTL and stream": {
+			inAppName:     wantedAppName,
+			inStorageType: dynamoDBStorageType,
+			inSvcName:     wantedSvcName,
+			inStorageName: "my-table",
+			inNoSort:      true,
+			inPartition:   wantedPartitionKey,
+			inGSIs:        []string{"Email:S", "Owner:S,CreatedAt:N"},
+			inTTL:         "ExpiresAt",
+			inStream:      ddbStreamNewAndOldImages,
+			inBilling:     ddbBillingModeProvisioned,
+
+			mockWs: func(m *mocks.MockwsAddonManager) {
+				m.EXPECT().ReadWorkloadManifest(wantedSvcName).Return([]byte("type: Worker Service"), nil)
+				m.EXPECT().WriteAddon(gomock.Any(), wantedSvcName, "my-table").DoAndReturn(func(f encoding.BinaryMarshaler, _, _ string) (string, error) {
+					tpl, ok := f.(*addon.DynamoDBTemplate)
+					require.True(t, ok)
+					require.Equal(t, addon.DDBBillingModeProvisioned, tpl.BillingMode)
+					require.Equal(t, "NEW_AND_OLD_IMAGES", tpl.StreamViewType)
+					require.Equal(t, "ExpiresAt", tpl.TTLAttribute)
+					require.Equal(t, []string{"test", "prod"}, tpl.Envs)
+					require.Len(t, tpl.GSIs, 2)
+					return "/frontend/addons/my-table.yml", nil
+				})
+			},
+			mockStore: func(m *mocks.Mockstore) {
+				m.EXPECT().ListEnvironments(wantedAppName).Return([]*config.Environment{{Name: "test"}, {Name: "prod"}}, nil)
+			},
+		},
+		"error if environments cannot be listed for provisioned DDB": {
+			inAppName:     wantedAppName,
+			inStorageType: dynamoDBStorageType,
+			inSvcName:     wantedSvcName,
+			inStorageName: "my-table",
+			inNoSort:      true,
+			inPartition:   wantedPartitionKey,
+			inBilling:     ddbBillingModeProvisioned,
+
+			mockWs: func(m *mocks.MockwsAddonManager) {
+				m.EXPECT().ReadWorkloadManifest(wantedSvcName).Return([]byte("type: Worker Service"), nil)
+			},
+			mockStore: func(m *mocks.Mockstore) {
+				m.EXPECT().ListEnvironments(wantedAppName).Return(nil, errors.New("some error"))
+			},
+
+			wantedErr: errors.New("list environments: some error"),
+		},
+		"happy calls for environment S3": {
+			inAppName:     wantedAppName,
+			inStorageType: s3StorageType,
+			inStorageName: "my-bucket",
+			inLifecycle:   environmentStorageLifecycle,
+
+			mockWs: func(m *mocks.MockwsAddonManager) {
+				m.EXPECT().WriteEnvironmentAddon(gomock.Any(), "my-bucket").Return("/environments/addons/my-bucket.yml", nil)
+			},
+		},
+		"happy calls for environment DDB": {
+			inAppName:     wantedAppName,
+			inStorageType: dynamoDBStorageType,
+			inStorageName: "my-table",
+			inNoLSI:       true,
+			inNoSort:      true,
+			inPartition:   wantedPartitionKey,
+			inLifecycle:   environmentStorageLifecycle,
+
+			mockWs: func(m *mocks.MockwsAddonManager) {
+				m.EXPECT().WriteEnvironmentAddon(gomock.Any(), "my-table").Return("/environments/addons/my-table.yml", nil)
+			},
+		},
 		"happy calls for RDS with LBWS": {
 			inSvcName:        wantedSvcName,
 			inStorageType:    rdsStorageType,
@@ -991,6 +1590,31 @@ func TestStorageInitOpts_Execute(t *testing.T) {
 			},
 			wantedErr: nil,
 		},
+		"happy calls for provisioned RDS with readers": {
+			inAppName:       wantedAppName,
+			inSvcName:       wantedSvcName,
+			inStorageType:   rdsStorageType,
+			inStorageName:   "mycluster",
+			inEngine:        engineTypePostgreSQL,
+			inClusterType:   rdsClusterTypeProvisioned,
+			inInstanceClass: "db.r6g.large",
+			inReaders:       2,
+
+			mockWs: func(m *mocks.MockwsAddonManager) {
+				m.EXPECT().ReadWorkloadManifest(wantedSvcName).Return([]byte("type: Backend Service"), nil)
+				m.EXPECT().WriteAddon(gomock.Any(), wantedSvcName, "mycluster").DoAndReturn(func(f encoding.BinaryMarshaler, _, _ string) (string, error) {
+					tpl, ok := f.(*addon.RDSTemplate)
+					require.True(t, ok)
+					require.Equal(t, addon.RDSClusterTypeProvisioned, tpl.ClusterType)
+					require.Equal(t, "db.r6g.large", tpl.InstanceClass)
+					require.Equal(t, 2, tpl.Readers)
+					return "/frontend/addons/mycluster.yml", nil
+				})
+			},
+			mockStore: func(m *mocks.Mockstore) {
+				m.EXPECT().ListEnvironments(wantedAppName).Return([]*config.Environment{{Name: "test"}}, nil)
+			},
+		},
 		"happy calls for RDS with a RDWS": {
 			inSvcName:        wantedSvcName,
 			inStorageType:    rdsStorageType,
@@ -1068,9 +1692,23 @@ func TestStorageInitOpts_Execute(t *testing.T) {
 					lsiSorts:     tc.inLSISorts,
 					noLSI:        tc.inNoLSI,
 					noSort:       tc.inNoSort,
+					gsis:         tc.inGSIs,
+					ttlAttribute: tc.inTTL,
+					stream:       tc.inStream,
+					billingMode:  tc.inBilling,
 
 					rdsEngine:         tc.inEngine,
 					rdsParameterGroup: tc.inParameterGroup,
+					rdsClusterType:    tc.inClusterType,
+					rdsInstanceClass:  tc.inInstanceClass,
+					rdsReaders:        tc.inReaders,
+					lifecycle:         tc.inLifecycle,
+
+					s3Versioning:     tc.inVersioning,
+					s3ExpirationDays: tc.inExpirationDays,
+					s3Transitions:    tc.inTransitions,
+					s3CORSOrigins:    tc.inCORSOrigins,
+					s3CloudFront:     tc.inCloudFront,
 				},
 				appName: tc.inAppName,
 				ws:      mockAddon,
@@ -0,0 +1,236 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+)
+
+const (
+	// Display settings of the storage table.
+	storageListMinCellWidth     = 10  // minimum number of characters in a table's cell.
+	storageListTabWidth         = 4   // number of characters in between columns.
+	storageListCellPaddingWidth = 2   // number of padding characters added by default to a cell.
+	storageListPaddingChar      = ' ' // character in between columns.
+)
+
+type storageListVars struct {
+	appName          string
+	envName          string
+	shouldOutputJSON bool
+}
+
+type storageListOpts struct {
+	storageListVars
+
+	store store
+	ws    wsStorageReader
+	w     io.Writer
+
+	newStackResourcesLister func(env *config.Environment) (stackResourcesLister, error)
+}
+
+// storageListing is a storage resource of the workspace and the name it is deployed as in an environment.
+type storageListing struct {
+	storageResource
+	Environment  string `json:"environment,omitempty"`  // Empty if the storage is not deployed in any environment.
+	DeployedName string `json:"deployedName,omitempty"` // Name of the bucket, table or cluster.
+}
+
+func newStorageListOpts(vars storageListVars) (*storageListOpts, error) {
+	store, err := config.NewStore()
+	if err != nil {
+		return nil, fmt.Errorf("new config store: %w", err)
+	}
+	ws, err := workspace.New()
+	if err != nil {
+		return nil, fmt.Errorf("new workspace: %w", err)
+	}
+	return &storageListOpts{
+		storageListVars: vars,
+		store:           store,
+		ws:              ws,
+		w:               os.Stdout,
+		newStackResourcesLister: func(env *config.Environment) (stackResourcesLister, error) {
+			return newCFNForEnv(env)
+		},
+	}, nil
+}
+
+// Validate returns an error if the values provided by the user are invalid.
+func (o *storageListOpts) Validate() error {
+	if o.appName == "" {
+		return errNoAppInWorkspace
+	}
+	if o.envName != "" {
+		if _, err := o.store.GetEnvironment(o.appName, o.envName); err != nil {
+			return fmt.Errorf("get environment %s in application %s: %w", o.envName, o.appName, err)
+		}
+	}
+	return nil
+}
+
+// Ask is a no-op for this command.
+func (o *storageListOpts) Ask() error {
+	return nil
+}
+
+// Execute lists the storage resources of the workspace along with their deployed names in each environment.
+func (o *storageListOpts) Execute() error {
+	resources, err := listStorageResources(o.ws)
+	if err != nil {
+		return err
+	}
+	envs, err := o.targetEnvs()
+	if err != nil {
+		return err
+	}
+	deployed, err := o.deployedNames(resources, envs)
+	if err != nil {
+		return err
+	}
+
+	listings := make([]storageListing, 0)
+	for _, r := range resources {
+		var found bool
+		for _, env := range envs {
+			name, ok := deployed[env.Name][r]
+			if !ok {
+				continue
+			}
+			found = true
+			listings = append(listings, storageListing{
+				storageResource: *r,
+				Environment:     env.Name,
+				DeployedName:    name,
+			})
+		}
+		if !found {
+			listings = append(listings, storageListing{storageResource: *r})
+		}
+	}
+
+	if o.shouldOutputJSON {
+		data, err := o.jsonOutput(listings)
+		if err != nil {
+			return err
+		}
+		fmt.Fprint(o.w, data)
+		return nil
+	}
+	if len(listings) == 0 {
+		log.Infoln("No storage found in the workspace.")
+		return nil
+	}
+	fmt.Fprint(o.w, o.humanOutput(listings))
+	return nil
+}
+
+func (o *storageListOpts) targetEnvs() ([]*config.Environment, error) {
+	if o.envName != "" {
+		env, err := o.store.GetEnvironment(o.appName, o.envName)
+		if err != nil {
+			return nil, fmt.Errorf("get environment %s in application %s: %w", o.envName, o.appName, err)
+		}
+		return []*config.Environment{env}, nil
+	}
+	envs, err := o.store.ListEnvironments(o.appName)
+	if err != nil {
+		return nil, fmt.Errorf("list environments in application %s: %w", o.appName, err)
+	}
+	return envs, nil
+}
+
+// deployedNames returns the names of the deployed storage resources keyed by environment name.
+func (o *storageListOpts) deployedNames(resources []*storageResource, envs []*config.Environment) (map[string]map[*storageResource]string, error) {
+	names := make(map[string]map[*storageResource]string)
+	if len(resources) == 0 {
+		return names, nil
+	}
+	for _, env := range envs {
+		cfn, err := o.newStackResourcesLister(env)
+		if err != nil {
+			return nil, err
+		}
+		names[env.Name] = make(map[*storageResource]string)
+		stackResources := make(map[string]map[string]string) // Cache the addons stack resources of each stack.
+		for _, r := range resources {
+			stackName := r.stackName(o.appName, env.Name)
+			ids, ok := stackResources[stackName]
+			if !ok {
+				if ids, err = addonsStackResources(cfn, stackName); err != nil {
+					return nil, err
+				}
+				stackResources[stackName] = ids
+			}
+			if name := r.physicalID(ids); name != "" {
+				names[env.Name][r] = name
+			}
+		}
+	}
+	return names, nil
+}
+
+func (o *storageListOpts) humanOutput(listings []storageListing) string {
+	b := &strings.Builder{}
+	writer := tabwriter.NewWriter(b, storageListMinCellWidth, storageListTabWidth, storageListCellPaddingWidth, storageListPaddingChar, 0)
+	fmt.Fprintln(writer, "Name\tType\tWorkload\tEnvironment\tDeployed Name")
+	fmt.Fprintln(writer, "----\t----\t--------\t-----------\t-------------")
+	for _, l := range listings {
+		env, deployedName := "-", "-"
+		if l.Environment != "" {
+			env, deployedName = l.Environment, l.DeployedName
+		}
+		fmt.Fprintf(writer, "%s\t%s\t%s\t%s\t%s\n", l.Name, l.Type, l.owner(), env, deployedName)
+	}
+	writer.Flush()
+	return b.String()
+}
+
+func (o *storageListOpts) jsonOutput(listings []storageListing) (string, error) {
+	type serializedStorage struct {
+		Storage []storageListing `json:"storage"`
+	}
+	b, err := json.Marshal(serializedStorage{Storage: listings})
+	if err != nil {
+		return "", fmt.Errorf("marshal storage: %w", err)
+	}
+	return fmt.Sprintf("%s\n", b), nil
+}
+
+// buildStorageListCmd builds the command for listing the storage resources in the workspace.
+func buildStorageListCmd() *cobra.Command {
+	vars := storageListVars{}
+	cmd := &cobra.Command{
+		Use:   "ls",
+		Short: "Lists the storage resources in the workspace.",
+		Long: `Lists the storage resources created with "copilot storage init" in the workspace,
+and the names they are deployed as in each environment.`,
+		Example: `
+  Lists the storage resources and their names in the "test" environment.
+  /code $ copilot storage ls --env test`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newStorageListOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", storageLsEnvFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
+	return cmd
+}
@@ -0,0 +1,368 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awscloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	mockDDBAddon = `Resources:
+  users:
+    Type: AWS::DynamoDB::Table
+  usersAccessPolicy:
+    Type: AWS::IAM::ManagedPolicy
+Outputs:
+  usersName:
+    Value: !Ref users
+  usersAccessPolicy:
+    Value: !Ref usersAccessPolicy
+`
+	mockS3Addon = `Resources:
+  assetsBucket:
+    Type: AWS::S3::Bucket
+  assetsAccessPolicy:
+    Type: AWS::IAM::ManagedPolicy
+Outputs:
+  assetsName:
+    Value: !Ref assetsBucket
+  assetsArn:
+    Value: !GetAtt assetsBucket.Arn
+  assetsAccessPolicy:
+    Value: !Ref assetsAccessPolicy
+  assetsDistributionURL:
+    Value: !Sub 'https://${assetsDistribution.DomainName}'
+`
+	mockAuroraAddon = `Resources:
+  dbAuroraSecret:
+    Type: AWS::SecretsManager::Secret
+  dbDBCluster:
+    Type: AWS::RDS::DBCluster
+Outputs:
+  dbSecret:
+    Value: !Ref dbAuroraSecret
+  dbReaderEndpoint:
+    Value: !GetAtt dbDBCluster.ReadEndpoint.Address
+`
+	mockRedisAddon = `Resources:
+  cacheAuthTokenSecret:
+    Type: AWS::SecretsManager::Secret
+  cacheReplicationGroup:
+    Type: AWS::ElastiCache::ReplicationGroup
+Outputs:
+  cacheEndpoint:
+    Value: !GetAtt cacheReplicationGroup.PrimaryEndPoint.Address
+  cachePort:
+    Value: !GetAtt cacheReplicationGroup.PrimaryEndPoint.Port
+  cacheAuthToken:
+    Value: !Ref cacheAuthTokenSecret
+`
+	mockCustomAddon = `Resources:
+  MyQueue:
+    Type: AWS::SQS::Queue
+Outputs:
+  MyQueueURL:
+    Value: !Ref MyQueue
+`
+)
+
+func mockStackResource(logicalID, physicalID string) *awscloudformation.StackResource {
+	return &awscloudformation.StackResource{
+		LogicalResourceId:  aws.String(logicalID),
+		PhysicalResourceId: aws.String(physicalID),
+	}
+}
+
+func TestListStorageResources(t *testing.T) {
+	mockError := errors.New("some error")
+	testCases := map[string]struct {
+		setupMocks func(m *mocks.MockwsStorageReader)
+
+		wantedResources []*storageResource
+		wantedError     error
+	}{
+		"errors if fail to list workloads": {
+			setupMocks: func(m *mocks.MockwsStorageReader) {
+				m.EXPECT().ListWorkloads().Return(nil, mockError)
+			},
+			wantedError: fmt.Errorf("list workloads in workspace: %w", mockError),
+		},
+		"errors if fail to read an addon": {
+			setupMocks: func(m *mocks.MockwsStorageReader) {
+				m.EXPECT().ListWorkloads().Return([]string{"api"}, nil)
+				m.EXPECT().ReadAddonsDir("api").Return([]string{"users.yml"}, nil)
+				m.EXPECT().ReadAddon("api", "users.yml").Return(nil, mockError)
+			},
+			wantedError: fmt.Errorf("read addon users.yml of workload api: %w", mockError),
+		},
+		"errors if fail to read the addons directory of a workload": {
+			setupMocks: func(m *mocks.MockwsStorageReader) {
+				m.EXPECT().ListWorkloads().Return([]string{"api"}, nil)
+				m.EXPECT().ReadAddonsDir("api").Return(nil, mockError)
+			},
+			wantedError: fmt.Errorf("read addons directory of workload api: %w", mockError),
+		},
+		"errors if fail to read the environment addons directory": {
+			setupMocks: func(m *mocks.MockwsStorageReader) {
+				m.EXPECT().ListWorkloads().Return(nil, nil)
+				m.EXPECT().ReadEnvironmentAddonsDir().Return(nil, mockError)
+			},
+			wantedError: fmt.Errorf("read environment addons directory: %w", mockError),
+		},
+		"identifies the storage of workloads and environments": {
+			setupMocks: func(m *mocks.MockwsStorageReader) {
+				m.EXPECT().ListWorkloads().Return([]string{"api", "worker", "frontend"}, nil)
+				m.EXPECT().ReadAddonsDir("api").Return([]string{"users.yml", "db.yaml", "addons.parameters.yml", "README.md"}, nil)
+				m.EXPECT().ReadAddon("api", "users.yml").Return([]byte(mockDDBAddon), nil)
+				m.EXPECT().ReadAddon("api", "db.yaml").Return([]byte(mockAuroraAddon), nil)
+				m.EXPECT().ReadAddon("api", "addons.parameters.yml").Return([]byte("Parameters:\n  Key: Value\n"), nil)
+				m.EXPECT().ReadAddon("api", "README.md").Return([]byte("# Addons"), nil)
+				m.EXPECT().ReadAddonsDir("worker").Return([]string{"queue.yml", "cache.yml"}, nil)
+				m.EXPECT().ReadAddon("worker", "queue.yml").Return([]byte(mockCustomAddon), nil)
+				m.EXPECT().ReadAddon("worker", "cache.yml").Return([]byte(mockRedisAddon), nil)
+				m.EXPECT().ReadAddonsDir("frontend").Return(nil, os.ErrNotExist)
+				m.EXPECT().ReadEnvironmentAddonsDir().Return([]string{"assets.yml"}, nil)
+				m.EXPECT().ReadEnvironmentAddon("assets.yml").Return([]byte(mockS3Addon), nil)
+			},
+			wantedResources: []*storageResource{
+				{
+					Name:     "users",
+					Type:     dynamoDBStorageType,
+					Workload: "api",
+					Injected: []injectedOutput{
+						{Name: "USERS_NAME"},
+					},
+				},
+				{
+					Name:     "db",
+					Type:     rdsStorageType,
+					Workload: "api",
+					Injected: []injectedOutput{
+						{Name: "DB_SECRET", IsSecret: true},
+						{Name: "DB_READER_ENDPOINT"},
+					},
+				},
+				{
+					Name:     "cache",
+					Type:     redisStorageType,
+					Workload: "worker",
+					Injected: []injectedOutput{
+						{Name: "CACHE_ENDPOINT"},
+						{Name: "CACHE_PORT"},
+						{Name: "CACHE_AUTH_TOKEN", IsSecret: true},
+					},
+				},
+				{
+					Name: "assets",
+					Type: s3StorageType,
+					Injected: []injectedOutput{
+						{Name: "ASSETS_NAME"},
+						{Name: "ASSETS_DISTRIBUTION_URL"},
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockwsStorageReader(ctrl)
+			tc.setupMocks(m)
+
+			// WHEN
+			resources, err := listStorageResources(m)
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedResources, resources)
+			}
+		})
+	}
+}
+
+func TestStorageListOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inAppName  string
+		inEnvName  string
+		setupMocks func(m *mocks.Mockstore)
+
+		wantedError error
+	}{
+		"errors if not in a workspace with an application": {
+			setupMocks:  func(m *mocks.Mockstore) {},
+			wantedError: errNoAppInWorkspace,
+		},
+		"errors if the environment does not exist": {
+			inAppName: "phonetool",
+			inEnvName: "test",
+			setupMocks: func(m *mocks.Mockstore) {
+				m.EXPECT().GetEnvironment("phonetool", "test").Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("get environment test in application phonetool: some error"),
+		},
+		"success": {
+			inAppName: "phonetool",
+			inEnvName: "test",
+			setupMocks: func(m *mocks.Mockstore) {
+				m.EXPECT().GetEnvironment("phonetool", "test").Return(&config.Environment{}, nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockStore := mocks.NewMockstore(ctrl)
+			tc.setupMocks(mockStore)
+			opts := &storageListOpts{
+				storageListVars: storageListVars{
+					appName: tc.inAppName,
+					envName: tc.inEnvName,
+				},
+				store: mockStore,
+			}
+
+			// WHEN
+			err := opts.Validate()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+type storageListMocks struct {
+	store *mocks.Mockstore
+	ws    *mocks.MockwsStorageReader
+	cfn   *mocks.MockstackResourcesLister
+}
+
+func TestStorageListOpts_Execute(t *testing.T) {
+	testEnv := &config.Environment{App: "phonetool", Name: "test"}
+	prodEnv := &config.Environment{App: "phonetool", Name: "prod"}
+	mockWorkspace := func(m *mocks.MockwsStorageReader) {
+		m.EXPECT().ListWorkloads().Return([]string{"api"}, nil)
+		m.EXPECT().ReadAddonsDir("api").Return([]string{"users.yml"}, nil)
+		m.EXPECT().ReadAddon("api", "users.yml").Return([]byte(mockDDBAddon), nil)
+		m.EXPECT().ReadEnvironmentAddonsDir().Return([]string{"assets.yml"}, nil)
+		m.EXPECT().ReadEnvironmentAddon("assets.yml").Return([]byte(mockS3Addon), nil)
+	}
+	testCases := map[string]struct {
+		shouldOutputJSON bool
+		setupMocks       func(m storageListMocks)
+
+		wantedContent string
+		wantedError   error
+	}{
+		"errors if fail to list the resources of a stack": {
+			setupMocks: func(m storageListMocks) {
+				mockWorkspace(m.ws)
+				m.store.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{testEnv}, nil)
+				m.cfn.EXPECT().StackResources("phonetool-test-api").Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("list resources of stack phonetool-test-api: some error"),
+		},
+		"lists the deployed names of the storage in each environment": {
+			setupMocks: func(m storageListMocks) {
+				mockWorkspace(m.ws)
+				m.store.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{testEnv, prodEnv}, nil)
+				m.cfn.EXPECT().StackResources("phonetool-test-api").Return([]*awscloudformation.StackResource{
+					mockStackResource("Service", "api"),
+					mockStackResource("AddonsStack", "arn:aws:cloudformation:us-west-2:123456789012:stack/phonetool-test-api-AddonsStack/1"),
+				}, nil)
+				m.cfn.EXPECT().StackResources("arn:aws:cloudformation:us-west-2:123456789012:stack/phonetool-test-api-AddonsStack/1").Return([]*awscloudformation.StackResource{
+					mockStackResource("users", "phonetool-test-api-users"),
+					mockStackResource("usersAccessPolicy", "arn:aws:iam::123456789012:policy/users"),
+				}, nil)
+				m.cfn.EXPECT().StackResources("phonetool-test").Return([]*awscloudformation.StackResource{
+					mockStackResource("AddonsStack", "arn:aws:cloudformation:us-west-2:123456789012:stack/phonetool-test-AddonsStack/1"),
+				}, nil)
+				m.cfn.EXPECT().StackResources("arn:aws:cloudformation:us-west-2:123456789012:stack/phonetool-test-AddonsStack/1").Return([]*awscloudformation.StackResource{
+					mockStackResource("assetsBucket", "phonetool-test-assets"),
+				}, nil)
+				m.cfn.EXPECT().StackResources("phonetool-prod-api").Return(nil, &awscloudformation.ErrStackNotFound{})
+				m.cfn.EXPECT().StackResources("phonetool-prod").Return([]*awscloudformation.StackResource{
+					mockStackResource("VPC", "vpc-1234"),
+				}, nil)
+			},
+			wantedContent: `Name      Type      Workload     Environment  Deployed Name
+----      ----      --------     -----------  -------------
+users     DynamoDB  api          test         phonetool-test-api-users
+assets    S3        environment  test         phonetool-test-assets
+`,
+		},
+		"lists undeployed storage in JSON": {
+			shouldOutputJSON: true,
+			setupMocks: func(m storageListMocks) {
+				mockWorkspace(m.ws)
+				m.store.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{testEnv}, nil)
+				m.cfn.EXPECT().StackResources("phonetool-test-api").Return(nil, &awscloudformation.ErrStackNotFound{})
+				m.cfn.EXPECT().StackResources("phonetool-test").Return(nil, nil)
+			},
+			wantedContent: `{"storage":[{"name":"users","type":"DynamoDB","workload":"api","injected":[{"name":"USERS_NAME","isSecret":false}]},{"name":"assets","type":"S3","injected":[{"name":"ASSETS_NAME","isSecret":false},{"name":"ASSETS_DISTRIBUTION_URL","isSecret":false}]}]}
+`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := storageListMocks{
+				store: mocks.NewMockstore(ctrl),
+				ws:    mocks.NewMockwsStorageReader(ctrl),
+				cfn:   mocks.NewMockstackResourcesLister(ctrl),
+			}
+			tc.setupMocks(m)
+			b := &bytes.Buffer{}
+			opts := &storageListOpts{
+				storageListVars: storageListVars{
+					appName:          "phonetool",
+					shouldOutputJSON: tc.shouldOutputJSON,
+				},
+				store: m.store,
+				ws:    m.ws,
+				w:     b,
+				newStackResourcesLister: func(env *config.Environment) (stackResourcesLister, error) {
+					return m.cfn, nil
+				},
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedContent, b.String())
+			}
+		})
+	}
+}
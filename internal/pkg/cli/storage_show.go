@@ -0,0 +1,258 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+)
+
+const (
+	storageShowNamePrompt     = "Which storage resource would you like to show?"
+	storageShowNamePromptHelp = "Storage resources are created with `copilot storage init`."
+)
+
+type storageShowVars struct {
+	appName          string
+	envName          string
+	name             string
+	shouldOutputJSON bool
+}
+
+type storageShowOpts struct {
+	storageShowVars
+
+	store  store
+	ws     wsStorageReader
+	prompt prompter
+	w      io.Writer
+
+	newStackResourcesLister func(env *config.Environment) (stackResourcesLister, error)
+}
+
+// storageDescription holds the connection information of a storage resource in each environment it is deployed in.
+type storageDescription struct {
+	storageResource
+	Deployments []storageDeployment `json:"deployments"`
+}
+
+// storageDeployment is a storage resource deployed in an environment.
+type storageDeployment struct {
+	Environment string `json:"environment"`
+	Name        string `json:"name"`                // Name of the bucket, table, cluster, domain or queue.
+	SecretARN   string `json:"secretARN,omitempty"` // ARN of the secret holding the credentials of an Aurora or Redis cluster.
+}
+
+func newStorageShowOpts(vars storageShowVars) (*storageShowOpts, error) {
+	store, err := config.NewStore()
+	if err != nil {
+		return nil, fmt.Errorf("new config store: %w", err)
+	}
+	ws, err := workspace.New()
+	if err != nil {
+		return nil, fmt.Errorf("new workspace: %w", err)
+	}
+	return &storageShowOpts{
+		storageShowVars: vars,
+		store:           store,
+		ws:              ws,
+		prompt:          prompt.New(),
+		w:               os.Stdout,
+		newStackResourcesLister: func(env *config.Environment) (stackResourcesLister, error) {
+			return newCFNForEnv(env)
+		},
+	}, nil
+}
+
+// Validate returns an error if the values provided by the user are invalid.
+func (o *storageShowOpts) Validate() error {
+	if o.appName == "" {
+		return errNoAppInWorkspace
+	}
+	if o.envName != "" {
+		if _, err := o.store.GetEnvironment(o.appName, o.envName); err != nil {
+			return fmt.Errorf("get environment %s in application %s: %w", o.envName, o.appName, err)
+		}
+	}
+	return nil
+}
+
+// Ask prompts for the storage resource to show if it is not passed in.
+func (o *storageShowOpts) Ask() error {
+	if o.name != "" {
+		return nil
+	}
+	resources, err := listStorageResources(o.ws)
+	if err != nil {
+		return err
+	}
+	if len(resources) == 0 {
+		return fmt.Errorf("no storage found in the workspace: run %s to create one", color.HighlightCode("copilot storage init"))
+	}
+	var names []string
+	for _, r := range resources {
+		names = append(names, r.Name)
+	}
+	name, err := o.prompt.SelectOne(storageShowNamePrompt, storageShowNamePromptHelp, names, prompt.WithFinalMessage("Storage:"))
+	if err != nil {
+		return fmt.Errorf("select storage: %w", err)
+	}
+	o.name = name
+	return nil
+}
+
+// Execute shows the environment variables and secrets injected for the storage resource and its deployed names in each environment.
+func (o *storageShowOpts) Execute() error {
+	resource, err := o.storageResource()
+	if err != nil {
+		return err
+	}
+	envs, err := o.targetEnvs()
+	if err != nil {
+		return err
+	}
+	description := storageDescription{
+		storageResource: *resource,
+		Deployments:     make([]storageDeployment, 0),
+	}
+	for _, env := range envs {
+		cfn, err := o.newStackResourcesLister(env)
+		if err != nil {
+			return err
+		}
+		ids, err := addonsStackResources(cfn, resource.stackName(o.appName, env.Name))
+		if err != nil {
+			return err
+		}
+		name := resource.physicalID(ids)
+		if name == "" {
+			continue
+		}
+		description.Deployments = append(description.Deployments, storageDeployment{
+			Environment: env.Name,
+			Name:        name,
+			SecretARN:   resource.secretARN(ids),
+		})
+	}
+
+	if o.shouldOutputJSON {
+		b, err := json.Marshal(description)
+		if err != nil {
+			return fmt.Errorf("marshal storage: %w", err)
+		}
+		fmt.Fprintf(o.w, "%s\n", b)
+		return nil
+	}
+	fmt.Fprint(o.w, o.humanOutput(description))
+	return nil
+}
+
+func (o *storageShowOpts) storageResource() (*storageResource, error) {
+	resources, err := listStorageResources(o.ws)
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range resources {
+		if r.Name == o.name {
+			return r, nil
+		}
+	}
+	return nil, fmt.Errorf("storage %s not found in the workspace", o.name)
+}
+
+func (o *storageShowOpts) targetEnvs() ([]*config.Environment, error) {
+	if o.envName != "" {
+		env, err := o.store.GetEnvironment(o.appName, o.envName)
+		if err != nil {
+			return nil, fmt.Errorf("get environment %s in application %s: %w", o.envName, o.appName, err)
+		}
+		return []*config.Environment{env}, nil
+	}
+	envs, err := o.store.ListEnvironments(o.appName)
+	if err != nil {
+		return nil, fmt.Errorf("list environments in application %s: %w", o.appName, err)
+	}
+	return envs, nil
+}
+
+func (o *storageShowOpts) humanOutput(storage storageDescription) string {
+	b := &strings.Builder{}
+	fmt.Fprint(b, color.Bold.Sprint("About\n\n"))
+	fmt.Fprintf(b, "  Name            %s\n", storage.Name)
+	fmt.Fprintf(b, "  Type            %s\n", storage.Type)
+	fmt.Fprintf(b, "  Workload        %s\n", storage.owner())
+	for i, out := range storage.Injected {
+		label := "Injected As"
+		if i > 0 {
+			label = ""
+		}
+		injectedAs := "environment variable"
+		if out.IsSecret {
+			injectedAs = "secret"
+		}
+		fmt.Fprintf(b, "  %-16s%s (%s)\n", label, out.Name, injectedAs)
+	}
+	fmt.Fprint(b, color.Bold.Sprint("\nDeployments\n\n"))
+	if len(storage.Deployments) == 0 {
+		fmt.Fprintf(b, "  Not deployed in any environment.\n")
+		return b.String()
+	}
+	writer := tabwriter.NewWriter(b, storageListMinCellWidth, storageListTabWidth, storageListCellPaddingWidth, storageListPaddingChar, 0)
+	if _, hasSecret := secretLogicalIDSuffixFor[storage.Type]; hasSecret {
+		fmt.Fprintln(writer, "  Environment\tCluster\tSecret ARN")
+		fmt.Fprintln(writer, "  -----------\t-------\t----------")
+		for _, d := range storage.Deployments {
+			fmt.Fprintf(writer, "  %s\t%s\t%s\n", d.Environment, d.Name, d.SecretARN)
+		}
+		writer.Flush()
+		return b.String()
+	}
+	fmt.Fprintln(writer, "  Environment\tName")
+	fmt.Fprintln(writer, "  -----------\t----")
+	for _, d := range storage.Deployments {
+		fmt.Fprintf(writer, "  %s\t%s\n", d.Environment, d.Name)
+	}
+	writer.Flush()
+	return b.String()
+}
+
+// buildStorageShowCmd builds the command for showing the connection information of a storage resource.
+func buildStorageShowCmd() *cobra.Command {
+	vars := storageShowVars{}
+	cmd := &cobra.Command{
+		Use:   "show",
+		Short: "Shows the connection information of a storage resource.",
+		Long: `Shows the connection information of a storage resource:
+the environment variables and secrets injected into your workloads, and the deployed bucket,
+table or cluster in each environment along with the ARN of its secret.`,
+		Example: `
+  Shows the deployed names of the "my-table" DynamoDB table.
+  /code $ copilot storage show -n my-table
+  Shows the secret ARN of the "my-cluster" Aurora cluster in the "test" environment.
+  /code $ copilot storage show -n my-cluster --env test`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newStorageShowOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", storageShowEnvFlagDescription)
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", storageShowNameFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
+	return cmd
+}
@@ -0,0 +1,228 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"testing"
+
+	awscloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStorageShowOpts_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		inName     string
+		setupMocks func(ws *mocks.MockwsStorageReader, p *mocks.Mockprompter)
+
+		wantedName  string
+		wantedError error
+	}{
+		"does not prompt if the name is provided": {
+			inName:     "users",
+			setupMocks: func(ws *mocks.MockwsStorageReader, p *mocks.Mockprompter) {},
+
+			wantedName: "users",
+		},
+		"errors if there is no storage in the workspace": {
+			setupMocks: func(ws *mocks.MockwsStorageReader, p *mocks.Mockprompter) {
+				ws.EXPECT().ListWorkloads().Return(nil, nil)
+				ws.EXPECT().ReadEnvironmentAddonsDir().Return(nil, os.ErrNotExist)
+			},
+
+			wantedError: errors.New("no storage found in the workspace: run `copilot storage init` to create one"),
+		},
+		"prompts for the storage in the workspace": {
+			setupMocks: func(ws *mocks.MockwsStorageReader, p *mocks.Mockprompter) {
+				ws.EXPECT().ListWorkloads().Return([]string{"api"}, nil)
+				ws.EXPECT().ReadAddonsDir("api").Return([]string{"users.yml"}, nil)
+				ws.EXPECT().ReadAddon("api", "users.yml").Return([]byte(mockDDBAddon), nil)
+				ws.EXPECT().ReadEnvironmentAddonsDir().Return([]string{"assets.yml"}, nil)
+				ws.EXPECT().ReadEnvironmentAddon("assets.yml").Return([]byte(mockS3Addon), nil)
+				p.EXPECT().SelectOne(storageShowNamePrompt, storageShowNamePromptHelp, []string{"users", "assets"}, gomock.Any()).Return("assets", nil)
+			},
+
+			wantedName: "assets",
+		},
+		"wraps the selection error": {
+			setupMocks: func(ws *mocks.MockwsStorageReader, p *mocks.Mockprompter) {
+				ws.EXPECT().ListWorkloads().Return(nil, nil)
+				ws.EXPECT().ReadEnvironmentAddonsDir().Return([]string{"assets.yml"}, nil)
+				ws.EXPECT().ReadEnvironmentAddon("assets.yml").Return([]byte(mockS3Addon), nil)
+				p.EXPECT().SelectOne(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return("", errors.New("some error"))
+			},
+
+			wantedError: errors.New("select storage: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockWs := mocks.NewMockwsStorageReader(ctrl)
+			mockPrompt := mocks.NewMockprompter(ctrl)
+			tc.setupMocks(mockWs, mockPrompt)
+			opts := &storageShowOpts{
+				storageShowVars: storageShowVars{
+					appName: "phonetool",
+					name:    tc.inName,
+				},
+				ws:     mockWs,
+				prompt: mockPrompt,
+			}
+
+			// WHEN
+			err := opts.Ask()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedName, opts.name)
+			}
+		})
+	}
+}
+
+func TestStorageShowOpts_Execute(t *testing.T) {
+	testEnv := &config.Environment{App: "phonetool", Name: "test"}
+	prodEnv := &config.Environment{App: "phonetool", Name: "prod"}
+	mockWorkspace := func(m *mocks.MockwsStorageReader) {
+		m.EXPECT().ListWorkloads().Return([]string{"api"}, nil)
+		m.EXPECT().ReadAddonsDir("api").Return([]string{"users.yml", "db.yml"}, nil)
+		m.EXPECT().ReadAddon("api", "users.yml").Return([]byte(mockDDBAddon), nil)
+		m.EXPECT().ReadAddon("api", "db.yml").Return([]byte(mockAuroraAddon), nil)
+		m.EXPECT().ReadEnvironmentAddonsDir().Return(nil, os.ErrNotExist)
+	}
+	testCases := map[string]struct {
+		inName           string
+		inEnvName        string
+		shouldOutputJSON bool
+		setupMocks       func(m storageListMocks)
+
+		wantedContent string
+		wantedError   error
+	}{
+		"errors if the storage is not in the workspace": {
+			inName: "assets",
+			setupMocks: func(m storageListMocks) {
+				mockWorkspace(m.ws)
+			},
+			wantedError: errors.New("storage assets not found in the workspace"),
+		},
+		"shows the secret ARN and every injected output of an Aurora cluster": {
+			inName:    "db",
+			inEnvName: "test",
+			setupMocks: func(m storageListMocks) {
+				mockWorkspace(m.ws)
+				m.store.EXPECT().GetEnvironment("phonetool", "test").Return(testEnv, nil)
+				m.cfn.EXPECT().StackResources("phonetool-test-api").Return([]*awscloudformation.StackResource{
+					mockStackResource("AddonsStack", "arn:aws:cloudformation:us-west-2:123456789012:stack/phonetool-test-api-AddonsStack/1"),
+				}, nil)
+				m.cfn.EXPECT().StackResources("arn:aws:cloudformation:us-west-2:123456789012:stack/phonetool-test-api-AddonsStack/1").Return([]*awscloudformation.StackResource{
+					mockStackResource("dbDBCluster", "phonetool-test-api-dbdbcluster"),
+					mockStackResource("dbAuroraSecret", "arn:aws:secretsmanager:us-west-2:123456789012:secret:dbAuroraSecret"),
+				}, nil)
+			},
+			wantedContent: `About
+
+  Name            db
+  Type            Aurora
+  Workload        api
+  Injected As     DB_SECRET (secret)
+                  DB_READER_ENDPOINT (environment variable)
+
+Deployments
+
+  Environment  Cluster                         Secret ARN
+  -----------  -------                         ----------
+  test         phonetool-test-api-dbdbcluster  arn:aws:secretsmanager:us-west-2:123456789012:secret:dbAuroraSecret
+`,
+		},
+		"shows the table name in each environment in JSON": {
+			inName:           "users",
+			shouldOutputJSON: true,
+			setupMocks: func(m storageListMocks) {
+				mockWorkspace(m.ws)
+				m.store.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{testEnv, prodEnv}, nil)
+				m.cfn.EXPECT().StackResources("phonetool-test-api").Return([]*awscloudformation.StackResource{
+					mockStackResource("AddonsStack", "arn:aws:cloudformation:us-west-2:123456789012:stack/phonetool-test-api-AddonsStack/1"),
+				}, nil)
+				m.cfn.EXPECT().StackResources("arn:aws:cloudformation:us-west-2:123456789012:stack/phonetool-test-api-AddonsStack/1").Return([]*awscloudformation.StackResource{
+					mockStackResource("users", "phonetool-test-api-users"),
+				}, nil)
+				m.cfn.EXPECT().StackResources("phonetool-prod-api").Return(nil, &awscloudformation.ErrStackNotFound{})
+			},
+			wantedContent: `{"name":"users","type":"DynamoDB","workload":"api","injected":[{"name":"USERS_NAME","isSecret":false}],"deployments":[{"environment":"test","name":"phonetool-test-api-users"}]}
+`,
+		},
+		"shows storage that is not deployed": {
+			inName: "users",
+			setupMocks: func(m storageListMocks) {
+				mockWorkspace(m.ws)
+				m.store.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{testEnv}, nil)
+				m.cfn.EXPECT().StackResources("phonetool-test-api").Return(nil, &awscloudformation.ErrStackNotFound{})
+			},
+			wantedContent: `About
+
+  Name            users
+  Type            DynamoDB
+  Workload        api
+  Injected As     USERS_NAME (environment variable)
+
+Deployments
+
+  Not deployed in any environment.
+`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := storageListMocks{
+				store: mocks.NewMockstore(ctrl),
+				ws:    mocks.NewMockwsStorageReader(ctrl),
+				cfn:   mocks.NewMockstackResourcesLister(ctrl),
+			}
+			tc.setupMocks(m)
+			b := &bytes.Buffer{}
+			opts := &storageShowOpts{
+				storageShowVars: storageShowVars{
+					appName:          "phonetool",
+					envName:          tc.inEnvName,
+					name:             tc.inName,
+					shouldOutputJSON: tc.shouldOutputJSON,
+				},
+				store: m.store,
+				ws:    m.ws,
+				w:     b,
+				newStackResourcesLister: func(env *config.Environment) (stackResourcesLister, error) {
+					return m.cfn, nil
+				},
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedContent, b.String())
+			}
+		})
+	}
+}
@@ -32,6 +32,7 @@ Services are long-running ECS or App Runner services.`,
 	cmd.AddCommand(buildSvcStatusCmd())
 	cmd.AddCommand(buildSvcLogsCmd())
 	cmd.AddCommand(buildSvcExecCmd())
+	cmd.AddCommand(buildSvcPortForwardCmd())
 	cmd.AddCommand(buildSvcPauseCmd())
 	cmd.AddCommand(buildSvcResumeCmd())
 
@@ -95,6 +95,7 @@ type deploySvcOpts struct {
 	envUpgradeCmd       actionCommand
 	newAppVersionGetter func(string) (versionGetter, error)
 	endpointGetter      endpointGetter
+	envParams           envParamsGetter
 	snsTopicGetter      deployedEnvironmentLister
 	identity            identityService
 	subnetLister        vpcSubnetLister
@@ -284,6 +285,74 @@ func targetEnv(s store, appName, envName string) (*config.Environment, error) {
 	return env, nil
 }
 
+// validateSharedEFSWorkloads returns an error if the manifest mounts the managed EFS directory of a workload that is not in the application.
+func validateSharedEFSWorkloads(s store, appName string, mft interface{}) error {
+	type efsSharer interface {
+		SharedEFSWorkloads() []string
+	}
+	sharer, ok := mft.(efsSharer)
+	if !ok {
+		return nil
+	}
+	names := sharer.SharedEFSWorkloads()
+	if len(names) == 0 {
+		return nil
+	}
+	workloads, err := s.ListWorkloads(appName)
+	if err != nil {
+		return fmt.Errorf("list workloads in application %s: %w", appName, err)
+	}
+	exists := make(map[string]bool)
+	for _, wl := range workloads {
+		exists[wl.Name] = true
+	}
+	for _, name := range names {
+		if !exists[name] {
+			return fmt.Errorf(`workload %s in "from_workload" does not exist in application %s`, name, appName)
+		}
+	}
+	return nil
+}
+
+// envAddonsOutputs returns the names of the outputs of the addons deployed in the environment
+// if the manifest accesses environment storage under "storage.env_addons".
+func envAddonsOutputs(getter endpointGetter, mft interface{}) ([]string, error) {
+	type envAddonsAccessor interface {
+		EnvAddons() []string
+	}
+	accessor, ok := mft.(envAddonsAccessor)
+	if !ok || len(accessor.EnvAddons()) == 0 {
+		return nil, nil
+	}
+	outputs, err := getter.AddonsOutputs()
+	if err != nil {
+		return nil, fmt.Errorf("get outputs of the environment addons: %w", err)
+	}
+	return outputs, nil
+}
+
+// validateEFSOptionsOwner returns an error if the manifest configures the managed EFS file system
+// while another workload already owns its settings in the environment.
+func validateEFSOptionsOwner(getter envParamsGetter, wlName, envName string, mft interface{}) error {
+	type efsOptionsSetter interface {
+		SetsManagedEFSOptions() bool
+	}
+	setter, ok := mft.(efsOptionsSetter)
+	if !ok || !setter.SetsManagedEFSOptions() {
+		return nil
+	}
+	params, err := getter.Params()
+	if err != nil {
+		return fmt.Errorf("get parameters of environment %s: %w", envName, err)
+	}
+	owner := params[stack.EnvParamEFSOptionsOwnerKey]
+	if owner == "" || owner == wlName {
+		return nil
+	}
+	return fmt.Errorf(`EFS settings of environment %s are already configured by workload %s: remove "backups", "lifecycle", "throughput_mode" and "provisioned_throughput" from the manifest of %s or %s`,
+		envName, owner, wlName, owner)
+}
+
 func (o *deploySvcOpts) askSvcName() error {
 	if o.name != "" {
 		return nil
@@ -331,6 +400,7 @@ func (o *deploySvcOpts) configureClients() error {
 		return fmt.Errorf("create describer for environment %s in application %s: %w", o.envName, o.appName, err)
 	}
 	o.envDescriber = d
+	o.envParams = d
 	o.subnetLister = ec2.New(envSession)
 
 	// ECR client against tools account profile AND target environment region.
@@ -527,6 +597,9 @@ func (o *deploySvcOpts) manifest() (interface{}, error) {
 	if err := envMft.Validate(); err != nil {
 		return nil, fmt.Errorf("validate manifest against environment %s: %s", o.envName, err)
 	}
+	if err := validateSharedEFSWorkloads(o.store, o.appName, envMft); err != nil {
+		return nil, err
+	}
 	o.appliedManifest = envMft // cache the results.
 	return envMft, nil
 }
@@ -536,6 +609,14 @@ func (o *deploySvcOpts) runtimeConfig() (*stack.RuntimeConfig, error) {
 	if err != nil {
 		return nil, err
 	}
+	mft, err := o.manifest()
+	if err != nil {
+		return nil, err
+	}
+	envAddonsOutputs, err := envAddonsOutputs(o.endpointGetter, mft)
+	if err != nil {
+		return nil, err
+	}
 
 	if !o.buildRequired {
 		return &stack.RuntimeConfig{
@@ -545,6 +626,7 @@ func (o *deploySvcOpts) runtimeConfig() (*stack.RuntimeConfig, error) {
 			ServiceDiscoveryEndpoint: endpoint,
 			AccountID:                o.targetEnvironment.AccountID,
 			Region:                   o.targetEnvironment.Region,
+			EnvAddonsOutputs:         envAddonsOutputs,
 		}, nil
 	}
 
@@ -572,6 +654,7 @@ func (o *deploySvcOpts) runtimeConfig() (*stack.RuntimeConfig, error) {
 		ServiceDiscoveryEndpoint: endpoint,
 		AccountID:                o.targetEnvironment.AccountID,
 		Region:                   o.targetEnvironment.Region,
+		EnvAddonsOutputs:         envAddonsOutputs,
 	}, nil
 }
 
@@ -622,6 +705,9 @@ func (o *deploySvcOpts) stackConfiguration() (cloudformation.StackConfiguration,
 	var conf cloudformation.StackConfiguration
 	switch t := mft.(type) {
 	case *manifest.LoadBalancedWebService:
+		if o.forceNewUpdate && t.Deployment.IsBlueGreen() {
+			return nil, fmt.Errorf("--%s cannot be used with a %s deployment strategy", forceFlag, manifest.DeploymentStrategyBlueGreen)
+		}
 		if o.targetApp.Domain == "" && t.HasAliases() {
 			log.Errorf(aliasUsedWithoutDomainFriendlyText)
 			return nil, errors.New("alias specified when application is not associated with a domain")
@@ -755,6 +841,13 @@ func (o *deploySvcOpts) stackConfiguration() (cloudformation.StackConfiguration,
 }
 
 func (o *deploySvcOpts) deploySvc() error {
+	mft, err := o.manifest()
+	if err != nil {
+		return err
+	}
+	if err := validateEFSOptionsOwner(o.envParams, o.name, o.envName, mft); err != nil {
+		return err
+	}
 	conf, err := o.stackConfiguration()
 	if err != nil {
 		return err
@@ -155,6 +155,222 @@ func TestSvcDeployOpts_Validate(t *testing.T) {
 	}
 }
 
+func Test_validateSharedEFSWorkloads(t *testing.T) {
+	mftWithSharedEFS := &manifest.BackendService{
+		BackendServiceConfig: manifest.BackendServiceConfig{
+			TaskConfig: manifest.TaskConfig{
+				Storage: manifest.Storage{
+					Volumes: map[string]*manifest.Volume{
+						"uploads": {
+							EFS: manifest.EFSConfigOrBool{
+								Advanced: manifest.EFSVolumeConfiguration{
+									FromWorkload: aws.String("api"),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	testCases := map[string]struct {
+		inManifest interface{}
+		setupMocks func(m *mocks.Mockstore)
+
+		wantedErr error
+	}{
+		"skips manifests without storage": {
+			inManifest: &manifest.RequestDrivenWebService{},
+			setupMocks: func(m *mocks.Mockstore) {
+				m.EXPECT().ListWorkloads(gomock.Any()).Times(0)
+			},
+		},
+		"skips manifests that don't mount the directory of another workload": {
+			inManifest: &manifest.BackendService{},
+			setupMocks: func(m *mocks.Mockstore) {
+				m.EXPECT().ListWorkloads(gomock.Any()).Times(0)
+			},
+		},
+		"wraps the error if the workloads cannot be listed": {
+			inManifest: mftWithSharedEFS,
+			setupMocks: func(m *mocks.Mockstore) {
+				m.EXPECT().ListWorkloads("phonetool").Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("list workloads in application phonetool: some error"),
+		},
+		"errors if the workload is not in the application": {
+			inManifest: mftWithSharedEFS,
+			setupMocks: func(m *mocks.Mockstore) {
+				m.EXPECT().ListWorkloads("phonetool").Return([]*config.Workload{{Name: "frontend"}}, nil)
+			},
+			wantedErr: errors.New(`workload api in "from_workload" does not exist in application phonetool`),
+		},
+		"succeeds if the workload is in the application": {
+			inManifest: mftWithSharedEFS,
+			setupMocks: func(m *mocks.Mockstore) {
+				m.EXPECT().ListWorkloads("phonetool").Return([]*config.Workload{{Name: "frontend"}, {Name: "api"}}, nil)
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockStore := mocks.NewMockstore(ctrl)
+			tc.setupMocks(mockStore)
+
+			// WHEN
+			err := validateSharedEFSWorkloads(mockStore, "phonetool", tc.inManifest)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func Test_validateEFSOptionsOwner(t *testing.T) {
+	mftWithEFSOptions := &manifest.BackendService{
+		BackendServiceConfig: manifest.BackendServiceConfig{
+			TaskConfig: manifest.TaskConfig{
+				Storage: manifest.Storage{
+					Volumes: map[string]*manifest.Volume{
+						"data": {
+							EFS: manifest.EFSConfigOrBool{
+								Advanced: manifest.EFSVolumeConfiguration{
+									ThroughputMode: aws.String("elastic"),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	testCases := map[string]struct {
+		inManifest interface{}
+		setupMocks func(m *mocks.MockenvParamsGetter)
+
+		wantedErr error
+	}{
+		"skips manifests that don't configure managed EFS": {
+			inManifest: &manifest.BackendService{},
+			setupMocks: func(m *mocks.MockenvParamsGetter) {
+				m.EXPECT().Params().Times(0)
+			},
+		},
+		"wraps the error if the environment parameters cannot be retrieved": {
+			inManifest: mftWithEFSOptions,
+			setupMocks: func(m *mocks.MockenvParamsGetter) {
+				m.EXPECT().Params().Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("get parameters of environment test: some error"),
+		},
+		"errors if another workload owns the settings": {
+			inManifest: mftWithEFSOptions,
+			setupMocks: func(m *mocks.MockenvParamsGetter) {
+				m.EXPECT().Params().Return(map[string]string{"EFSOptionsOwner": "api"}, nil)
+			},
+			wantedErr: errors.New(`EFS settings of environment test are already configured by workload api: remove "backups", "lifecycle", "throughput_mode" and "provisioned_throughput" from the manifest of worker or api`),
+		},
+		"succeeds if the workload owns the settings": {
+			inManifest: mftWithEFSOptions,
+			setupMocks: func(m *mocks.MockenvParamsGetter) {
+				m.EXPECT().Params().Return(map[string]string{"EFSOptionsOwner": "worker"}, nil)
+			},
+		},
+		"succeeds if no workload owns the settings": {
+			inManifest: mftWithEFSOptions,
+			setupMocks: func(m *mocks.MockenvParamsGetter) {
+				m.EXPECT().Params().Return(map[string]string{"EFSOptionsOwner": ""}, nil)
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockenvParamsGetter(ctrl)
+			tc.setupMocks(m)
+
+			// WHEN
+			err := validateEFSOptionsOwner(m, "worker", "test", tc.inManifest)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func Test_envAddonsOutputs(t *testing.T) {
+	mftWithEnvAddons := &manifest.BackendService{
+		BackendServiceConfig: manifest.BackendServiceConfig{
+			TaskConfig: manifest.TaskConfig{
+				Storage: manifest.Storage{
+					EnvAddons: []string{"orders"},
+				},
+			},
+		},
+	}
+	testCases := map[string]struct {
+		inManifest interface{}
+		setupMocks func(m *mocks.MockendpointGetter)
+
+		wantedOutputs []string
+		wantedErr     error
+	}{
+		"skips manifests that don't access environment addons": {
+			inManifest: &manifest.BackendService{},
+			setupMocks: func(m *mocks.MockendpointGetter) {
+				m.EXPECT().AddonsOutputs().Times(0)
+			},
+		},
+		"wraps the error if the outputs cannot be retrieved": {
+			inManifest: mftWithEnvAddons,
+			setupMocks: func(m *mocks.MockendpointGetter) {
+				m.EXPECT().AddonsOutputs().Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("get outputs of the environment addons: some error"),
+		},
+		"returns the outputs of the deployed environment addons": {
+			inManifest: mftWithEnvAddons,
+			setupMocks: func(m *mocks.MockendpointGetter) {
+				m.EXPECT().AddonsOutputs().Return([]string{"ordersAccessPolicy", "ordersName"}, nil)
+			},
+			wantedOutputs: []string{"ordersAccessPolicy", "ordersName"},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockendpointGetter(ctrl)
+			tc.setupMocks(m)
+
+			// WHEN
+			outputs, err := envAddonsOutputs(m, tc.inManifest)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedOutputs, outputs)
+		})
+	}
+}
+
 func TestSvcDeployOpts_Ask(t *testing.T) {
 	testCases := map[string]struct {
 		inAppName  string
@@ -608,6 +824,7 @@ func TestSvcDeployOpts_deploySvc(t *testing.T) {
 	tests := map[string]struct {
 		inAliases      manifest.Alias
 		inNLB          manifest.NetworkLoadBalancerConfiguration
+		inDeployment   manifest.DeploymentConfiguration
 		inApp          *config.Application
 		inEnvironment  *config.Environment
 		inBuildRequire bool
@@ -710,6 +927,26 @@ func TestSvcDeployOpts_deploySvc(t *testing.T) {
 			},
 			wantErr: errors.New("alias specified when application is not associated with a domain"),
 		},
+		"force update used with a blue/green deployment": {
+			inForceDeploy: true,
+			inDeployment: manifest.DeploymentConfiguration{
+				Strategy: aws.String("blue_green"),
+				TestPort: aws.Uint16(8080),
+			},
+			inEnvironment: &config.Environment{
+				Name:   mockEnvName,
+				Region: "us-west-2",
+			},
+			inApp: &config.Application{
+				Name: mockAppName,
+			},
+			mock: func(m *deploySvcMocks) {
+				m.mockWs.EXPECT().ReadWorkloadManifest(mockSvcName).Return([]byte{}, nil)
+				m.mockInterpolator.EXPECT().Interpolate("").Return("", nil)
+				m.mockEndpointGetter.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
+			},
+			wantErr: errors.New("--force cannot be used with a blue_green deployment strategy"),
+		},
 		"cannot to find ECR repo": {
 			inBuildRequire: true,
 			inEnvironment: &config.Environment{
@@ -1125,7 +1362,8 @@ func TestSvcDeployOpts_deploySvc(t *testing.T) {
 							RoutingRule: manifest.RoutingRule{
 								Alias: tc.inAliases,
 							},
-							NLBConfig: tc.inNLB,
+							NLBConfig:  tc.inNLB,
+							Deployment: tc.inDeployment,
 						},
 					}, nil
 				},
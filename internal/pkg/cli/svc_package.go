@@ -384,11 +384,16 @@ func (o *packageSvcOpts) getSvcTemplates(env *config.Environment) (*svcCfnTempla
 	if err != nil {
 		return nil, err
 	}
+	envAddonsOutputs, err := envAddonsOutputs(endpointGetter, envMft)
+	if err != nil {
+		return nil, err
+	}
 	rc := stack.RuntimeConfig{
 		AdditionalTags:           app.Tags,
 		ServiceDiscoveryEndpoint: endpoint,
 		AccountID:                env.AccountID,
 		Region:                   env.Region,
+		EnvAddonsOutputs:         envAddonsOutputs,
 	}
 
 	if imgNeedsBuild {
@@ -0,0 +1,302 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/copilot-cli/cmd/copilot/template"
+	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/exec"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/spf13/cobra"
+)
+
+const (
+	svcPortForwardNamePrompt     = "To which service would you like to forward traffic?"
+	svcPortForwardNameHelpPrompt = `Copilot forwards traffic from a local port to one of your chosen service's tasks.
+The task is chosen at random, and the first essential container is used.`
+
+	maxPortNumber = 65535
+)
+
+type svcPortForwardVars struct {
+	appName          string
+	envName          string
+	name             string
+	taskID           string
+	containerName    string
+	localPort        int
+	remotePort       int
+	remoteHost       string
+	skipConfirmation *bool // If nil, we will prompt to upgrade the ssm plugin.
+}
+
+type svcPortForwardOpts struct {
+	svcPortForwardVars
+	store            store
+	sel              deploySelector
+	newSvcDescriber  func(*session.Session) serviceDescriber
+	newPortForwarder func(*session.Session) ssmPortForwarder
+	envSession       func(env *config.Environment) (*session.Session, error)
+	ssmPluginManager ssmPluginManager
+	prompter         prompter
+	// Override in unit test
+	randInt func(int) int
+}
+
+func newSvcPortForwardOpts(vars svcPortForwardVars) (*svcPortForwardOpts, error) {
+	ssmStore, err := config.NewStore()
+	if err != nil {
+		return nil, fmt.Errorf("connect to config store: %w", err)
+	}
+	deployStore, err := deploy.NewStore(ssmStore)
+	if err != nil {
+		return nil, fmt.Errorf("connect to deploy store: %w", err)
+	}
+	return &svcPortForwardOpts{
+		svcPortForwardVars: vars,
+		store:              ssmStore,
+		sel:                selector.NewDeploySelect(prompt.New(), ssmStore, deployStore),
+		newSvcDescriber: func(s *session.Session) serviceDescriber {
+			return ecs.New(s)
+		},
+		newPortForwarder: func(s *session.Session) ssmPortForwarder {
+			return ssm.New(s)
+		},
+		envSession: func(env *config.Environment) (*session.Session, error) {
+			return sessions.NewProvider().FromRole(env.ManagerRoleARN, env.Region)
+		},
+		randInt: func(x int) int {
+			rand.Seed(time.Now().Unix())
+			return rand.Intn(x)
+		},
+		ssmPluginManager: exec.NewSSMPluginCommand(nil),
+		prompter:         prompt.New(),
+	}, nil
+}
+
+// Validate returns an error if the values provided by the user are invalid.
+func (o *svcPortForwardOpts) Validate() error {
+	if o.remotePort == 0 {
+		return fmt.Errorf("`--%s` must be specified", remotePortFlag)
+	}
+	if err := validatePort(remotePortFlag, o.remotePort); err != nil {
+		return err
+	}
+	if o.localPort != 0 {
+		if err := validatePort(localPortFlag, o.localPort); err != nil {
+			return err
+		}
+	}
+	if o.appName != "" {
+		if _, err := o.store.GetApplication(o.appName); err != nil {
+			return err
+		}
+		if o.envName != "" {
+			if _, err := o.store.GetEnvironment(o.appName, o.envName); err != nil {
+				return err
+			}
+		}
+		if o.name != "" {
+			if _, err := o.store.GetService(o.appName, o.name); err != nil {
+				return err
+			}
+		}
+	}
+	return validateSSMBinary(o.prompter, o.ssmPluginManager, o.skipConfirmation)
+}
+
+func validatePort(flag string, port int) error {
+	if port <= 0 || port > maxPortNumber {
+		return fmt.Errorf("`--%s` must be a port number between 1 and %d", flag, maxPortNumber)
+	}
+	return nil
+}
+
+// Ask asks for fields that are required but not passed in.
+func (o *svcPortForwardOpts) Ask() error {
+	if err := o.askApp(); err != nil {
+		return err
+	}
+	return o.askSvcEnvName()
+}
+
+// Execute forwards traffic from a local port to a running task of the service until interrupted.
+func (o *svcPortForwardOpts) Execute() error {
+	wkld, err := o.store.GetWorkload(o.appName, o.name)
+	if err != nil {
+		return fmt.Errorf("get workload: %w", err)
+	}
+	if wkld.Type == manifest.RequestDrivenWebServiceType {
+		return fmt.Errorf("port forwarding to a running container part of a service is not supported for services with type: '%s'", manifest.RequestDrivenWebServiceType)
+	}
+	env, err := o.store.GetEnvironment(o.appName, o.envName)
+	if err != nil {
+		return fmt.Errorf("get environment %s: %w", o.envName, err)
+	}
+	sess, err := o.envSession(env)
+	if err != nil {
+		return err
+	}
+	svcDesc, err := o.newSvcDescriber(sess).DescribeService(o.appName, o.envName, o.name)
+	if err != nil {
+		return fmt.Errorf("describe ECS service for %s in environment %s: %w", o.name, o.envName, err)
+	}
+	task, err := o.selectTask(awsecs.FilterRunningTasks(svcDesc.Tasks))
+	if err != nil {
+		return err
+	}
+	taskID, err := awsecs.TaskID(aws.StringValue(task.TaskArn))
+	if err != nil {
+		return err
+	}
+	container := o.selectContainer()
+	runtimeID, err := containerRuntimeID(task, container)
+	if err != nil {
+		return err
+	}
+
+	localPort := o.localPort
+	if localPort == 0 {
+		localPort = o.remotePort
+	}
+	destination := fmt.Sprintf("port %d of container %s in task %s", o.remotePort, color.HighlightUserInput(container), color.HighlightResource(taskID))
+	if o.remoteHost != "" {
+		destination = fmt.Sprintf("%s through container %s in task %s", color.HighlightResource(fmt.Sprintf("%s:%d", o.remoteHost, o.remotePort)), color.HighlightUserInput(container), color.HighlightResource(taskID))
+	}
+	log.Infof("Forwarding %s to %s.\nPress Ctrl+C to stop.\n", color.HighlightResource(fmt.Sprintf("localhost:%d", localPort)), destination)
+	if err := o.newPortForwarder(sess).StartPortForwardingSession(ssm.PortForwardingSessionInput{
+		Cluster:    svcDesc.ClusterName,
+		TaskID:     taskID,
+		RuntimeID:  runtimeID,
+		LocalPort:  localPort,
+		RemotePort: o.remotePort,
+		RemoteHost: o.remoteHost,
+	}); err != nil {
+		var errStartSession *ssm.ErrStartSession
+		if errors.As(err, &errStartSession) {
+			log.Errorf("Failed to start a port forwarding session. Is %s set in your manifest?\n", color.HighlightCode("exec: true"))
+		}
+		return fmt.Errorf("forward local port %d to task %s: %w", localPort, taskID, err)
+	}
+	return nil
+}
+
+func (o *svcPortForwardOpts) askApp() error {
+	if o.appName != "" {
+		return nil
+	}
+	app, err := o.sel.Application(svcAppNamePrompt, svcAppNameHelpPrompt)
+	if err != nil {
+		return fmt.Errorf("select application: %w", err)
+	}
+	o.appName = app
+	return nil
+}
+
+func (o *svcPortForwardOpts) askSvcEnvName() error {
+	deployedService, err := o.sel.DeployedService(svcPortForwardNamePrompt, svcPortForwardNameHelpPrompt, o.appName, selector.WithEnv(o.envName), selector.WithSvc(o.name))
+	if err != nil {
+		return fmt.Errorf("select deployed service for application %s: %w", o.appName, err)
+	}
+	o.name = deployedService.Svc
+	o.envName = deployedService.Env
+	return nil
+}
+
+func (o *svcPortForwardOpts) selectTask(tasks []*awsecs.Task) (*awsecs.Task, error) {
+	if len(tasks) == 0 {
+		return nil, fmt.Errorf("found no running task for service %s in environment %s", o.name, o.envName)
+	}
+	if o.taskID == "" {
+		return tasks[o.randInt(len(tasks))], nil
+	}
+	for _, task := range tasks {
+		taskID, err := awsecs.TaskID(aws.StringValue(task.TaskArn))
+		if err != nil {
+			return nil, err
+		}
+		if strings.HasPrefix(taskID, o.taskID) {
+			return task, nil
+		}
+	}
+	return nil, fmt.Errorf("found no running task whose ID is prefixed with %s", o.taskID)
+}
+
+func (o *svcPortForwardOpts) selectContainer() string {
+	if o.containerName != "" {
+		return o.containerName
+	}
+	// The first essential container is named with the workload name.
+	return o.name
+}
+
+func containerRuntimeID(task *awsecs.Task, containerName string) (string, error) {
+	for _, container := range task.Containers {
+		if aws.StringValue(container.Name) != containerName {
+			continue
+		}
+		if container.RuntimeId == nil {
+			return "", fmt.Errorf("container %s is not running yet", containerName)
+		}
+		return aws.StringValue(container.RuntimeId), nil
+	}
+	return "", fmt.Errorf("container %s not found in task %s", containerName, aws.StringValue(task.TaskArn))
+}
+
+// buildSvcPortForwardCmd builds the command for forwarding a local port to a running task of a service.
+func buildSvcPortForwardCmd() *cobra.Command {
+	vars := svcPortForwardVars{}
+	var skipPrompt bool
+	cmd := &cobra.Command{
+		Use:   "port-forward",
+		Short: "Forward a local port to a running task part of a service.",
+		Example: `
+  Reach the debug port 8080 of a task of the "api" service on localhost:8080.
+  /code $ copilot svc port-forward -a my-app -e test -n api --remote 8080
+  Listen on localhost:5432 and forward the traffic to a database reachable from the task prefixed with ID "8c38184".
+  /code $ copilot svc port-forward -n api --task-id 8c38184 --local 5432 --remote 5432 --remote-host mydb.cluster-abc.us-west-2.rds.amazonaws.com`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newSvcPortForwardOpts(vars)
+			if err != nil {
+				return err
+			}
+			if cmd.Flags().Changed(yesFlag) {
+				opts.skipConfirmation = aws.Bool(false)
+				if skipPrompt {
+					opts.skipConfirmation = aws.Bool(true)
+				}
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", nameFlagDescription)
+	cmd.Flags().IntVar(&vars.localPort, localPortFlag, 0, localPortFlagDescription)
+	cmd.Flags().IntVar(&vars.remotePort, remotePortFlag, 0, remotePortFlagDescription)
+	cmd.Flags().StringVar(&vars.remoteHost, remoteHostFlag, "", remoteHostFlagDescription)
+	cmd.Flags().StringVar(&vars.taskID, taskIDFlag, "", portForwardTaskIDFlagDescription)
+	cmd.Flags().StringVar(&vars.containerName, containerFlag, "", portForwardContainerFlagDescription)
+	cmd.Flags().BoolVar(&skipPrompt, yesFlag, false, execYesFlagDescription)
+
+	cmd.SetUsageTemplate(template.Usage)
+	return cmd
+}
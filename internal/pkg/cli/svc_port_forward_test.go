@@ -0,0 +1,264 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	sdkecs "github.com/aws/aws-sdk-go/service/ecs"
+	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/ecs"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type svcPortForwardMocks struct {
+	store            *mocks.Mockstore
+	svcDescriber     *mocks.MockserviceDescriber
+	portForwarder    *mocks.MockssmPortForwarder
+	ssmPluginManager *mocks.MockssmPluginManager
+}
+
+func TestSvcPortForward_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inApp        string
+		inSvc        string
+		inLocalPort  int
+		inRemotePort int
+		setupMocks   func(m svcPortForwardMocks)
+
+		wantedError error
+	}{
+		"remote port is required": {
+			setupMocks:  func(m svcPortForwardMocks) {},
+			wantedError: errors.New("`--remote` must be specified"),
+		},
+		"invalid remote port": {
+			inRemotePort: 70000,
+			setupMocks:   func(m svcPortForwardMocks) {},
+			wantedError:  errors.New("`--remote` must be a port number between 1 and 65535"),
+		},
+		"invalid local port": {
+			inLocalPort:  -1,
+			inRemotePort: 8080,
+			setupMocks:   func(m svcPortForwardMocks) {},
+			wantedError:  errors.New("`--local` must be a port number between 1 and 65535"),
+		},
+		"should bubble error if cannot get service configuration": {
+			inApp:        "my-app",
+			inSvc:        "api",
+			inRemotePort: 8080,
+			setupMocks: func(m svcPortForwardMocks) {
+				m.store.EXPECT().GetApplication("my-app").Return(&config.Application{}, nil)
+				m.store.EXPECT().GetService("my-app", "api").Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("some error"),
+		},
+		"should validate the ssm plugin": {
+			inApp:        "my-app",
+			inSvc:        "api",
+			inLocalPort:  9090,
+			inRemotePort: 8080,
+			setupMocks: func(m svcPortForwardMocks) {
+				m.store.EXPECT().GetApplication("my-app").Return(&config.Application{}, nil)
+				m.store.EXPECT().GetService("my-app", "api").Return(&config.Workload{}, nil)
+				m.ssmPluginManager.EXPECT().ValidateBinary().Return(errors.New("some error"))
+			},
+			wantedError: errors.New("validate ssm plugin: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := svcPortForwardMocks{
+				store:            mocks.NewMockstore(ctrl),
+				ssmPluginManager: mocks.NewMockssmPluginManager(ctrl),
+			}
+			tc.setupMocks(m)
+
+			opts := &svcPortForwardOpts{
+				svcPortForwardVars: svcPortForwardVars{
+					appName:    tc.inApp,
+					name:       tc.inSvc,
+					localPort:  tc.inLocalPort,
+					remotePort: tc.inRemotePort,
+				},
+				store:            m.store,
+				ssmPluginManager: m.ssmPluginManager,
+			}
+
+			err := opts.Validate()
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestSvcPortForward_Execute(t *testing.T) {
+	const (
+		mockTaskARN = "arn:aws:ecs:us-west-2:123456789:task/mockCluster/mockTaskID"
+	)
+	mockWl := config.Workload{
+		App:  "mockApp",
+		Name: "mockSvc",
+		Type: "Load Balanced Web Service",
+	}
+	mockRunningTask := &awsecs.Task{
+		TaskArn:    aws.String(mockTaskARN),
+		LastStatus: aws.String("RUNNING"),
+		Containers: []*sdkecs.Container{
+			{
+				Name:      aws.String("mockSvc"),
+				RuntimeId: aws.String("mockRuntimeID"),
+			},
+			{
+				Name: aws.String("sidecar"),
+			},
+		},
+	}
+	mockError := errors.New("some error")
+	testCases := map[string]struct {
+		containerName string
+		localPort     int
+		remoteHost    string
+		setupMocks    func(m svcPortForwardMocks)
+
+		wantedError error
+	}{
+		"return error if service type is Request-Driven Web Service": {
+			setupMocks: func(m svcPortForwardMocks) {
+				m.store.EXPECT().GetWorkload("mockApp", "mockSvc").Return(&config.Workload{
+					Type: "Request-Driven Web Service",
+				}, nil)
+			},
+			wantedError: fmt.Errorf("port forwarding to a running container part of a service is not supported for services with type: 'Request-Driven Web Service'"),
+		},
+		"return error if fail to describe service": {
+			setupMocks: func(m svcPortForwardMocks) {
+				m.store.EXPECT().GetWorkload("mockApp", "mockSvc").Return(&mockWl, nil)
+				m.store.EXPECT().GetEnvironment("mockApp", "mockEnv").Return(&config.Environment{}, nil)
+				m.svcDescriber.EXPECT().DescribeService("mockApp", "mockEnv", "mockSvc").Return(nil, mockError)
+			},
+			wantedError: fmt.Errorf("describe ECS service for mockSvc in environment mockEnv: some error"),
+		},
+		"return error if the container has not started": {
+			containerName: "sidecar",
+			setupMocks: func(m svcPortForwardMocks) {
+				m.store.EXPECT().GetWorkload("mockApp", "mockSvc").Return(&mockWl, nil)
+				m.store.EXPECT().GetEnvironment("mockApp", "mockEnv").Return(&config.Environment{}, nil)
+				m.svcDescriber.EXPECT().DescribeService("mockApp", "mockEnv", "mockSvc").Return(&ecs.ServiceDesc{
+					ClusterName: "mockCluster",
+					Tasks:       []*awsecs.Task{mockRunningTask},
+				}, nil)
+			},
+			wantedError: fmt.Errorf("container sidecar is not running yet"),
+		},
+		"return error if fail to forward port": {
+			setupMocks: func(m svcPortForwardMocks) {
+				m.store.EXPECT().GetWorkload("mockApp", "mockSvc").Return(&mockWl, nil)
+				m.store.EXPECT().GetEnvironment("mockApp", "mockEnv").Return(&config.Environment{}, nil)
+				m.svcDescriber.EXPECT().DescribeService("mockApp", "mockEnv", "mockSvc").Return(&ecs.ServiceDesc{
+					ClusterName: "mockCluster",
+					Tasks:       []*awsecs.Task{mockRunningTask},
+				}, nil)
+				m.portForwarder.EXPECT().StartPortForwardingSession(gomock.Any()).Return(mockError)
+			},
+			wantedError: fmt.Errorf("forward local port 8080 to task mockTaskID: some error"),
+		},
+		"forward the remote port on the same local port by default": {
+			setupMocks: func(m svcPortForwardMocks) {
+				m.store.EXPECT().GetWorkload("mockApp", "mockSvc").Return(&mockWl, nil)
+				m.store.EXPECT().GetEnvironment("mockApp", "mockEnv").Return(&config.Environment{}, nil)
+				m.svcDescriber.EXPECT().DescribeService("mockApp", "mockEnv", "mockSvc").Return(&ecs.ServiceDesc{
+					ClusterName: "mockCluster",
+					Tasks:       []*awsecs.Task{mockRunningTask},
+				}, nil)
+				m.portForwarder.EXPECT().StartPortForwardingSession(ssm.PortForwardingSessionInput{
+					Cluster:    "mockCluster",
+					TaskID:     "mockTaskID",
+					RuntimeID:  "mockRuntimeID",
+					LocalPort:  8080,
+					RemotePort: 8080,
+				}).Return(nil)
+			},
+		},
+		"forward to a remote host": {
+			localPort:  5432,
+			remoteHost: "mydb.cluster-abc.us-west-2.rds.amazonaws.com",
+			setupMocks: func(m svcPortForwardMocks) {
+				m.store.EXPECT().GetWorkload("mockApp", "mockSvc").Return(&mockWl, nil)
+				m.store.EXPECT().GetEnvironment("mockApp", "mockEnv").Return(&config.Environment{}, nil)
+				m.svcDescriber.EXPECT().DescribeService("mockApp", "mockEnv", "mockSvc").Return(&ecs.ServiceDesc{
+					ClusterName: "mockCluster",
+					Tasks:       []*awsecs.Task{mockRunningTask},
+				}, nil)
+				m.portForwarder.EXPECT().StartPortForwardingSession(ssm.PortForwardingSessionInput{
+					Cluster:    "mockCluster",
+					TaskID:     "mockTaskID",
+					RuntimeID:  "mockRuntimeID",
+					LocalPort:  5432,
+					RemotePort: 8080,
+					RemoteHost: "mydb.cluster-abc.us-west-2.rds.amazonaws.com",
+				}).Return(nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := svcPortForwardMocks{
+				store:         mocks.NewMockstore(ctrl),
+				svcDescriber:  mocks.NewMockserviceDescriber(ctrl),
+				portForwarder: mocks.NewMockssmPortForwarder(ctrl),
+			}
+			tc.setupMocks(m)
+
+			opts := &svcPortForwardOpts{
+				svcPortForwardVars: svcPortForwardVars{
+					appName:       "mockApp",
+					envName:       "mockEnv",
+					name:          "mockSvc",
+					containerName: tc.containerName,
+					localPort:     tc.localPort,
+					remotePort:    8080,
+					remoteHost:    tc.remoteHost,
+				},
+				store: m.store,
+				newSvcDescriber: func(_ *session.Session) serviceDescriber {
+					return m.svcDescriber
+				},
+				newPortForwarder: func(_ *session.Session) ssmPortForwarder {
+					return m.portForwarder
+				},
+				envSession: func(_ *config.Environment) (*session.Session, error) {
+					return &session.Session{}, nil
+				},
+				randInt: func(i int) int { return 0 },
+			}
+
+			err := opts.Execute()
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
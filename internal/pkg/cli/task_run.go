@@ -6,6 +6,7 @@ package cli
 import (
 	"errors"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 	"strings"
@@ -16,6 +17,7 @@ import (
 
 	"github.com/aws/copilot-cli/internal/pkg/docker/dockerengine"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/arn"
 
 	awscloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
@@ -86,6 +88,7 @@ type runTaskVars struct {
 
 	groupName string
 
+	fromSvc               string
 	image                 string
 	dockerfilePath        string
 	dockerfileContextPath string
@@ -108,6 +111,8 @@ type runTaskVars struct {
 	resourceTags map[string]string
 
 	follow                bool
+	followExitCode        bool
+	logFile               string
 	generateCommandTarget string
 
 	os   string
@@ -117,6 +122,7 @@ type runTaskVars struct {
 type runTaskOpts struct {
 	runTaskVars
 	isDockerfileSet bool
+	isTaskSizeSet   bool
 	nFlag           int
 
 	// Interfaces to interact with dependencies.
@@ -132,6 +138,7 @@ type runTaskOpts struct {
 	eventsWriter         eventsWriter
 	defaultClusterGetter defaultClusterGetter
 	publicIPGetter       publicIPGetter
+	tasksDescriber       tasksDescriber
 
 	provider          sessionProvider
 	sess              *session.Session
@@ -140,8 +147,8 @@ type runTaskOpts struct {
 	// Configurer functions.
 	configureRuntimeOpts func() error
 	configureRepository  func() error
-	// NOTE: configureEventsWriter is only called when tailing logs (i.e. --follow is specified)
-	configureEventsWriter func(tasks []*task.Task)
+	// NOTE: configureEventsWriter is only called when tailing logs (i.e. --follow or --follow-exit-code is specified)
+	configureEventsWriter func(tasks []*task.Task, additionalWriters ...io.Writer)
 
 	configureECSServiceDescriber func(session *session.Session) ecs.ECSServiceDescriber
 	configureServiceDescriber    func(session *session.Session) ecs.ServiceDescriber
@@ -177,6 +184,7 @@ func newTaskRunOpts(vars runTaskVars) (*runTaskOpts, error) {
 		opts.deployer = cloudformation.New(opts.sess)
 		opts.defaultClusterGetter = awsecs.New(opts.sess)
 		opts.publicIPGetter = ec2.New(opts.sess)
+		opts.tasksDescriber = awsecs.New(opts.sess)
 		return nil
 	}
 
@@ -191,8 +199,12 @@ func newTaskRunOpts(vars runTaskVars) (*runTaskOpts, error) {
 		return nil
 	}
 
-	opts.configureEventsWriter = func(tasks []*task.Task) {
-		opts.eventsWriter = logging.NewTaskClient(opts.sess, opts.groupName, tasks)
+	opts.configureEventsWriter = func(tasks []*task.Task, additionalWriters ...io.Writer) {
+		if opts.fromSvc != "" {
+			opts.eventsWriter = logging.NewWorkloadTaskClient(opts.sess, opts.appName, opts.env, opts.fromSvc, tasks, additionalWriters...)
+			return
+		}
+		opts.eventsWriter = logging.NewTaskClient(opts.sess, opts.groupName, tasks, additionalWriters...)
 	}
 
 	opts.configureECSServiceDescriber = func(session *session.Session) ecs.ECSServiceDescriber {
@@ -215,6 +227,30 @@ func (o *runTaskOpts) configureRunner() (taskRunner, error) {
 	vpcGetter := ec2.New(o.sess)
 	ecsService := awsecs.New(o.sess)
 
+	if o.fromSvc != "" {
+		var command []string
+		if o.command != "" {
+			var err error
+			command, err = shlex.Split(o.command)
+			if err != nil {
+				return nil, fmt.Errorf("split command %s into tokens using shell-style rules: %w", o.command, err)
+			}
+		}
+		return &task.WorkloadRunner{
+			Count: o.count,
+
+			App:      o.appName,
+			Env:      o.env,
+			Workload: o.fromSvc,
+
+			Command: command,
+			EnvVars: o.envVars,
+
+			Describer: ecs.New(o.sess),
+			Starter:   ecsService,
+		}, nil
+	}
+
 	if o.env != "" {
 		deployStore, err := deploy.NewStore(o.store)
 		if err != nil {
@@ -303,6 +339,14 @@ func (o *runTaskOpts) Validate() error {
 		return errNumNotPositive
 	}
 
+	if o.logFile != "" && !o.follow && !o.followExitCode {
+		return fmt.Errorf("must specify `--%s` or `--%s` with `--%s`", followFlag, followExitCodeFlag, logFileFlag)
+	}
+
+	if err := o.validateFlagsWithFromSvc(); err != nil {
+		return err
+	}
+
 	if o.groupName != "" {
 		if err := basicNameValidation(o.groupName); err != nil {
 			return err
@@ -376,6 +420,53 @@ func (o *runTaskOpts) Validate() error {
 		}
 	}
 
+	if o.fromSvc != "" {
+		if _, err := o.store.GetService(o.appName, o.fromSvc); err != nil {
+			return fmt.Errorf("get service %s: %w", o.fromSvc, err)
+		}
+	}
+
+	return nil
+}
+
+func (o *runTaskOpts) validateFlagsWithFromSvc() error {
+	if o.fromSvc == "" {
+		return nil
+	}
+
+	if o.env == "" {
+		return fmt.Errorf("must specify `--%s` with `--%s`", envFlag, fromSvcFlag)
+	}
+
+	// The task definition of the service is used as is, so these flags cannot be applied.
+	incompatibleFlags := []struct {
+		name  string
+		isSet bool
+	}{
+		{taskGroupNameFlag, o.groupName != ""},
+		{imageFlag, o.image != ""},
+		{dockerFileFlag, o.isDockerfileSet},
+		{dockerFileContextFlag, o.dockerfileContextPath != ""},
+		{imageTagFlag, o.imageTag != ""},
+		{taskRoleFlag, o.taskRole != ""},
+		{executionRoleFlag, o.executionRole != ""},
+		{osFlag, o.os != ""},
+		{archFlag, o.arch != ""},
+		{subnetsFlag, o.subnets != nil},
+		{securityGroupsFlag, o.securityGroups != nil},
+		{taskDefaultFlag, o.useDefaultSubnetsAndCluster},
+		{secretsFlag, o.secrets != nil},
+		{entrypointFlag, o.entrypoint != ""},
+		{resourceTagsFlag, o.resourceTags != nil},
+	}
+	for _, flag := range incompatibleFlags {
+		if flag.isSet {
+			return fmt.Errorf("cannot specify both `--%s` and `--%s`", fromSvcFlag, flag.name)
+		}
+	}
+	if o.isTaskSizeSet {
+		return fmt.Errorf("cannot specify `--%s` or `--%s` with `--%s`", cpuFlag, memoryFlag, fromSvcFlag)
+	}
 	return nil
 }
 
@@ -519,6 +610,9 @@ func (o *runTaskOpts) Execute() error {
 		return o.generateCommand()
 	}
 
+	if o.fromSvc != "" {
+		o.groupName = o.fromSvc
+	}
 	if o.groupName == "" {
 		dir, err := os.Getwd()
 		if err != nil {
@@ -552,29 +646,32 @@ func (o *runTaskOpts) Execute() error {
 		}
 	}
 
-	if err := o.deployTaskResources(); err != nil {
-		return err
-	}
-
-	// NOTE: repository has to be configured only after task resources are deployed
-	if err := o.configureRepository(); err != nil {
-		return err
-	}
-
-	// NOTE: if image is not provided, then we build the image and push to ECR repo
-	if o.image == "" {
-		if err := o.buildAndPushImage(); err != nil {
+	// NOTE: tasks run from a service reuse its task definition, so there are no resources to deploy.
+	if o.fromSvc == "" {
+		if err := o.deployTaskResources(); err != nil {
 			return err
 		}
 
-		tag := imageTagLatest
-		if o.imageTag != "" {
-			tag = o.imageTag
-		}
-		o.image = fmt.Sprintf(fmtImageURI, o.repository.URI(), tag)
-		if err := o.updateTaskResources(); err != nil {
+		// NOTE: repository has to be configured only after task resources are deployed
+		if err := o.configureRepository(); err != nil {
 			return err
 		}
+
+		// NOTE: if image is not provided, then we build the image and push to ECR repo
+		if o.image == "" {
+			if err := o.buildAndPushImage(); err != nil {
+				return err
+			}
+
+			tag := imageTagLatest
+			if o.imageTag != "" {
+				tag = o.imageTag
+			}
+			o.image = fmt.Sprintf(fmtImageURI, o.repository.URI(), tag)
+			if err := o.updateTaskResources(); err != nil {
+				return err
+			}
+		}
 	}
 
 	tasks, err := o.runTask()
@@ -584,12 +681,14 @@ func (o *runTaskOpts) Execute() error {
 
 	o.showPublicIPs(tasks)
 
-	if o.follow {
-		o.configureEventsWriter(tasks)
-		if err := o.displayLogStream(); err != nil {
+	if o.follow || o.followExitCode {
+		if err := o.followLogs(tasks); err != nil {
 			return err
 		}
 	}
+	if o.followExitCode {
+		return o.showExitCodes(tasks)
+	}
 	return nil
 }
 
@@ -730,6 +829,79 @@ func (o *runTaskOpts) displayLogStream() error {
 	return nil
 }
 
+func (o *runTaskOpts) followLogs(tasks []*task.Task) error {
+	var additionalWriters []io.Writer
+	if o.logFile != "" {
+		f, err := o.fs.Create(o.logFile)
+		if err != nil {
+			return fmt.Errorf("create log file %s: %w", o.logFile, err)
+		}
+		defer f.Close()
+		additionalWriters = append(additionalWriters, f)
+	}
+	o.configureEventsWriter(tasks, additionalWriters...)
+	return o.displayLogStream()
+}
+
+// showExitCodes prints the stopped reason of each task and the exit code of each of its containers.
+// It returns an error if any essential container did not exit successfully.
+func (o *runTaskOpts) showExitCodes(tasks []*task.Task) error {
+	taskARNs := make([]string, len(tasks))
+	for idx, t := range tasks {
+		taskARNs[idx] = t.TaskARN
+	}
+	// NOTE: all tasks are deployed to the same cluster.
+	stoppedTasks, err := o.tasksDescriber.DescribeTasks(tasks[0].ClusterARN, taskARNs)
+	if err != nil {
+		return fmt.Errorf("describe stopped tasks: %w", err)
+	}
+	taskDefs := make(map[string]*awsecs.TaskDefinition)
+	var errFailed *errEssentialContainerFailed
+	for _, t := range stoppedTasks {
+		taskDefARN := aws.StringValue(t.TaskDefinitionArn)
+		taskDef, ok := taskDefs[taskDefARN]
+		if !ok {
+			taskDef, err = o.tasksDescriber.TaskDefinition(taskDefARN)
+			if err != nil {
+				return fmt.Errorf("get task definition %s: %w", taskDefARN, err)
+			}
+			taskDefs[taskDefARN] = taskDef
+		}
+		taskID, err := awsecs.TaskID(aws.StringValue(t.TaskArn))
+		if err != nil {
+			return err
+		}
+		log.Infof("Task %s stopped: %s\n", taskID, aws.StringValue(t.StoppedReason))
+		for _, container := range t.Containers {
+			name := aws.StringValue(container.Name)
+			if container.ExitCode == nil {
+				log.Infof("  - %s: no exit code (%s)\n", name, aws.StringValue(container.Reason))
+			} else {
+				log.Infof("  - %s: exit code %d\n", name, aws.Int64Value(container.ExitCode))
+			}
+			essential, err := taskDef.IsEssential(name)
+			if err != nil {
+				return fmt.Errorf("check if container %s is essential: %w", name, err)
+			}
+			succeeded := container.ExitCode != nil && aws.Int64Value(container.ExitCode) == 0
+			if !essential || succeeded {
+				continue
+			}
+			if errFailed == nil {
+				errFailed = &errEssentialContainerFailed{
+					taskID:    taskID,
+					container: name,
+					exitCode:  container.ExitCode,
+				}
+			}
+		}
+	}
+	if errFailed != nil {
+		return errFailed
+	}
+	return nil
+}
+
 func (o *runTaskOpts) runTask() ([]*task.Task, error) {
 	o.spinner.Start(fmt.Sprintf("Waiting for %s to be running for %s.", english.Plural(o.count, "task", ""), o.groupName))
 	tasks, err := o.runner.Run()
@@ -928,7 +1100,11 @@ func BuildTaskRunCmd() *cobra.Command {
   Run a task using the current workspace with specific subnets and security groups.
   /code $ copilot task run --subnets subnet-123,subnet-456 --security-groups sg-123,sg-456
   Run a task with a command.
-  /code $ copilot task run --command "python migrate-script.py"`,
+  /code $ copilot task run --command "python migrate-script.py"
+  Run a database migration in CI, save its logs, and exit with the container's exit code.
+  /code $ copilot task run -n db-migrate --env test --follow-exit-code --log-file migrate.log
+  Run a one-off migration with the task definition and network configuration of the "api" service.
+  /code $ copilot task run --from-svc api --env prod --command "rake db:migrate"`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newTaskRunOpts(vars)
 			if err != nil {
@@ -938,6 +1114,9 @@ func BuildTaskRunCmd() *cobra.Command {
 			if cmd.Flags().Changed(dockerFileFlag) {
 				opts.isDockerfileSet = true
 			}
+			if cmd.Flags().Changed(cpuFlag) || cmd.Flags().Changed(memoryFlag) {
+				opts.isTaskSizeSet = true
+			}
 			return run(opts)
 		}),
 	}
@@ -949,6 +1128,7 @@ func BuildTaskRunCmd() *cobra.Command {
 	cmd.Flags().StringVar(&vars.dockerfileContextPath, dockerFileContextFlag, "", dockerFileContextFlagDescription)
 	cmd.Flags().StringVarP(&vars.image, imageFlag, imageFlagShort, "", imageFlagDescription)
 	cmd.Flags().StringVar(&vars.imageTag, imageTagFlag, "", taskImageTagFlagDescription)
+	cmd.Flags().StringVar(&vars.fromSvc, fromSvcFlag, "", fromSvcFlagDescription)
 
 	cmd.Flags().StringVar(&vars.appName, appFlag, "", taskAppFlagDescription)
 	cmd.Flags().StringVar(&vars.env, envFlag, "", taskEnvFlagDescription)
@@ -971,6 +1151,8 @@ func BuildTaskRunCmd() *cobra.Command {
 	cmd.Flags().StringToStringVar(&vars.resourceTags, resourceTagsFlag, nil, resourceTagsFlagDescription)
 
 	cmd.Flags().BoolVar(&vars.follow, followFlag, false, followFlagDescription)
+	cmd.Flags().BoolVar(&vars.followExitCode, followExitCodeFlag, false, followExitCodeFlagDescription)
+	cmd.Flags().StringVar(&vars.logFile, logFileFlag, "", logFileFlagDescription)
 	cmd.Flags().StringVar(&vars.generateCommandTarget, generateCommandFlag, "", generateCommandFlagDescription)
 
 	// group flags.
@@ -982,6 +1164,7 @@ func BuildTaskRunCmd() *cobra.Command {
 	buildFlags.AddFlag(cmd.Flags().Lookup(dockerFileContextFlag))
 	buildFlags.AddFlag(cmd.Flags().Lookup(imageFlag))
 	buildFlags.AddFlag(cmd.Flags().Lookup(imageTagFlag))
+	buildFlags.AddFlag(cmd.Flags().Lookup(fromSvcFlag))
 
 	placementFlags := pflag.NewFlagSet("Placement", pflag.ContinueOnError)
 	placementFlags.AddFlag(cmd.Flags().Lookup(appFlag))
@@ -1007,6 +1190,8 @@ func BuildTaskRunCmd() *cobra.Command {
 
 	utilityFlags := pflag.NewFlagSet("Utility", pflag.ContinueOnError)
 	utilityFlags.AddFlag(cmd.Flags().Lookup(followFlag))
+	utilityFlags.AddFlag(cmd.Flags().Lookup(followExitCodeFlag))
+	utilityFlags.AddFlag(cmd.Flags().Lookup(logFileFlag))
 	utilityFlags.AddFlag(cmd.Flags().Lookup(generateCommandFlag))
 
 	// prettify help menu.
@@ -1044,3 +1229,24 @@ func BuildTaskRunCmd() *cobra.Command {
 `)
 	return cmd
 }
+
+type errEssentialContainerFailed struct {
+	taskID    string
+	container string
+	exitCode  *int64
+}
+
+func (e *errEssentialContainerFailed) Error() string {
+	if e.exitCode == nil {
+		return fmt.Sprintf("essential container %s in task %s stopped without an exit code", e.container, e.taskID)
+	}
+	return fmt.Sprintf("essential container %s in task %s exited with code %d", e.container, e.taskID, aws.Int64Value(e.exitCode))
+}
+
+// ExitCode returns the exit code of the failed container, or 1 if the container did not report one.
+func (e *errEssentialContainerFailed) ExitCode() int {
+	if e.exitCode == nil {
+		return 1
+	}
+	return int(aws.Int64Value(e.exitCode))
+}
@@ -6,10 +6,14 @@ package cli
 import (
 	"errors"
 	"fmt"
+	"io"
 	"path/filepath"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
+	sdkecs "github.com/aws/aws-sdk-go/service/ecs"
+	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
 
 	"github.com/aws/copilot-cli/internal/pkg/docker/dockerengine"
 
@@ -70,6 +74,11 @@ func TestTaskRunOpts_Validate(t *testing.T) {
 
 		inDefault               bool
 		inGenerateCommandTarget string
+		inFollow                bool
+		inFollowExitCode        bool
+		inLogFile               string
+		inFromSvc               string
+		isTaskSizeSet           bool
 
 		appName         string
 		isDockerfileSet bool
@@ -174,6 +183,16 @@ func TestTaskRunOpts_Validate(t *testing.T) {
 			},
 			wantedError: errNumNotPositive,
 		},
+		"log file without following logs": {
+			basicOpts:   defaultOpts,
+			inLogFile:   "migrate.log",
+			wantedError: errors.New("must specify `--follow` or `--follow-exit-code` with `--log-file`"),
+		},
+		"valid log file with follow exit code": {
+			basicOpts:        defaultOpts,
+			inLogFile:        "migrate.log",
+			inFollowExitCode: true,
+		},
 		"invalid number of CPU units": {
 			basicOpts: basicOpts{
 				inCount:  1,
@@ -379,6 +398,104 @@ func TestTaskRunOpts_Validate(t *testing.T) {
 
 			wantedError: errors.New("cannot specify `--generate-cmd` with any other flag"),
 		},
+		"from-svc without env": {
+			basicOpts: defaultOpts,
+
+			appName:   "my-app",
+			inFromSvc: "api",
+
+			wantedError: errors.New("must specify `--env` with `--from-svc`"),
+		},
+		"from-svc with image": {
+			basicOpts: defaultOpts,
+
+			appName:   "my-app",
+			inEnv:     "prod",
+			inFromSvc: "api",
+			inImage:   "nginx",
+
+			wantedError: errors.New("cannot specify both `--from-svc` and `--image`"),
+		},
+		"from-svc with arch": {
+			basicOpts: defaultOpts,
+
+			appName:   "my-app",
+			inEnv:     "prod",
+			inFromSvc: "api",
+			inArch:    "arm64",
+
+			wantedError: errors.New("cannot specify both `--from-svc` and `--platform-arch`"),
+		},
+		"from-svc with subnets": {
+			basicOpts: defaultOpts,
+
+			appName:   "my-app",
+			inEnv:     "prod",
+			inFromSvc: "api",
+			inSubnets: []string{"subnet-1"},
+
+			wantedError: errors.New("cannot specify both `--from-svc` and `--subnets`"),
+		},
+		"from-svc with security groups": {
+			basicOpts: defaultOpts,
+
+			appName:          "my-app",
+			inEnv:            "prod",
+			inFromSvc:        "api",
+			inSecurityGroups: []string{"sg-1"},
+
+			wantedError: errors.New("cannot specify both `--from-svc` and `--security-groups`"),
+		},
+		"from-svc with default": {
+			basicOpts: defaultOpts,
+
+			appName:   "my-app",
+			inEnv:     "prod",
+			inFromSvc: "api",
+			inDefault: true,
+
+			wantedError: errors.New("cannot specify both `--from-svc` and `--default`"),
+		},
+		"from-svc with task size": {
+			basicOpts: defaultOpts,
+
+			appName:       "my-app",
+			inEnv:         "prod",
+			inFromSvc:     "api",
+			isTaskSizeSet: true,
+
+			wantedError: errors.New("cannot specify `--cpu` or `--memory` with `--from-svc`"),
+		},
+		"from-svc with unknown service": {
+			basicOpts: defaultOpts,
+
+			appName:   "my-app",
+			inEnv:     "prod",
+			inFromSvc: "api",
+			mockStore: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("my-app").Return(&config.Application{Name: "my-app"}, nil)
+				m.EXPECT().GetEnvironment("my-app", "prod").Return(&config.Environment{App: "my-app", Name: "prod"}, nil)
+				m.EXPECT().GetService("my-app", "api").Return(nil, errors.New("some error"))
+			},
+
+			wantedError: errors.New("get service api: some error"),
+		},
+		"valid from-svc with command and env var overrides": {
+			basicOpts: defaultOpts,
+
+			appName:   "my-app",
+			inEnv:     "prod",
+			inFromSvc: "api",
+			inCommand: "rake db:migrate",
+			inEnvVars: map[string]string{
+				"RAILS_ENV": "production",
+			},
+			mockStore: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("my-app").Return(&config.Application{Name: "my-app"}, nil)
+				m.EXPECT().GetEnvironment("my-app", "prod").Return(&config.Environment{App: "my-app", Name: "prod"}, nil)
+				m.EXPECT().GetService("my-app", "api").Return(&config.Workload{Name: "api"}, nil)
+			},
+		},
 	}
 
 	for name, tc := range testCases {
@@ -411,8 +528,13 @@ func TestTaskRunOpts_Validate(t *testing.T) {
 					generateCommandTarget:       tc.inGenerateCommandTarget,
 					os:                          tc.inOS,
 					arch:                        tc.inArch,
+					follow:                      tc.inFollow,
+					followExitCode:              tc.inFollowExitCode,
+					logFile:                     tc.inLogFile,
+					fromSvc:                     tc.inFromSvc,
 				},
 				isDockerfileSet: tc.isDockerfileSet,
+				isTaskSizeSet:   tc.isTaskSizeSet,
 				nFlag:           2,
 
 				fs:    &afero.Afero{Fs: afero.NewMemMapFs()},
@@ -692,6 +814,7 @@ type runTaskMocks struct {
 	defaultClusterGetter *mocks.MockdefaultClusterGetter
 	publicIPGetter       *mocks.MockpublicIPGetter
 	provider             *mocks.MocksessionProvider
+	tasksDescriber       *mocks.MocktasksDescriber
 }
 
 func mockHasDefaultCluster(m runTaskMocks) {
@@ -715,19 +838,24 @@ func TestTaskRunOpts_Execute(t *testing.T) {
 	}
 
 	testCases := map[string]struct {
-		inSecrets    map[string]string
-		inImage      string
-		inTag        string
-		inDockerCtx  string
-		inFollow     bool
-		inCommand    string
-		inEntryPoint string
+		inSecrets        map[string]string
+		inImage          string
+		inTag            string
+		inDockerCtx      string
+		inFollow         bool
+		inFollowExitCode bool
+		inLogFile        string
+		inFromSvc        string
+		inCommand        string
+		inEntryPoint     string
 
 		inEnv string
 
 		setupMocks func(m runTaskMocks)
 
-		wantedError error
+		wantedError          error
+		wantedNumLogWriters  int
+		wantedFailedExitCode int
 	}{
 		"check if default cluster exists if deploying to default cluster": {
 			setupMocks: func(m runTaskMocks) {
@@ -948,6 +1076,154 @@ func TestTaskRunOpts_Execute(t *testing.T) {
 			},
 			wantedError: errors.New("write events: error writing events"),
 		},
+		"run task from a service without deploying task resources": {
+			inEnv:     "prod",
+			inFromSvc: "api",
+			inCommand: "rake db:migrate",
+			setupMocks: func(m runTaskMocks) {
+				m.store.EXPECT().GetEnvironment(gomock.Any(), "prod").Return(&config.Environment{}, nil)
+				m.provider.EXPECT().FromRole(gomock.Any(), gomock.Any())
+				m.defaultClusterGetter.EXPECT().HasDefaultCluster().Times(0)
+				m.deployer.EXPECT().DeployTask(gomock.Any(), gomock.Any()).Times(0)
+				m.repository.EXPECT().BuildAndPush(gomock.Any(), gomock.Any()).Times(0)
+				m.runner.EXPECT().Run().Return([]*task.Task{
+					{
+						TaskARN: "task-1",
+					},
+				}, nil)
+			},
+		},
+		"write logs to a file": {
+			inFollow:  true,
+			inLogFile: "migrate.log",
+			inImage:   "image",
+			setupMocks: func(m runTaskMocks) {
+				m.provider.EXPECT().Default().Return(&session.Session{}, nil)
+				m.deployer.EXPECT().DeployTask(gomock.Any(), gomock.Any()).AnyTimes()
+				m.runner.EXPECT().Run().Return([]*task.Task{
+					{
+						TaskARN: "task-1",
+					},
+				}, nil)
+				m.eventsWriter.EXPECT().WriteEventsUntilStopped().Times(1).Return(nil)
+				mockHasDefaultCluster(m)
+			},
+			wantedNumLogWriters: 1,
+		},
+		"fail to describe stopped tasks": {
+			inFollowExitCode: true,
+			inImage:          "image",
+			setupMocks: func(m runTaskMocks) {
+				m.provider.EXPECT().Default().Return(&session.Session{}, nil)
+				m.deployer.EXPECT().DeployTask(gomock.Any(), gomock.Any()).AnyTimes()
+				m.runner.EXPECT().Run().Return([]*task.Task{
+					{
+						TaskARN:    "arn:aws:ecs:us-west-2:123456789:task/my-cluster/task-1",
+						ClusterARN: "my-cluster",
+					},
+				}, nil)
+				m.eventsWriter.EXPECT().WriteEventsUntilStopped().Times(1).Return(nil)
+				m.tasksDescriber.EXPECT().DescribeTasks("my-cluster", []string{"arn:aws:ecs:us-west-2:123456789:task/my-cluster/task-1"}).
+					Return(nil, errors.New("some error"))
+				mockHasDefaultCluster(m)
+			},
+			wantedError: errors.New("describe stopped tasks: some error"),
+		},
+		"succeed if only non-essential containers failed": {
+			inFollowExitCode: true,
+			inImage:          "image",
+			setupMocks: func(m runTaskMocks) {
+				m.provider.EXPECT().Default().Return(&session.Session{}, nil)
+				m.deployer.EXPECT().DeployTask(gomock.Any(), gomock.Any()).AnyTimes()
+				m.runner.EXPECT().Run().Return([]*task.Task{
+					{
+						TaskARN:    "arn:aws:ecs:us-west-2:123456789:task/my-cluster/task-1",
+						ClusterARN: "my-cluster",
+					},
+				}, nil)
+				m.eventsWriter.EXPECT().WriteEventsUntilStopped().Times(1).Return(nil)
+				m.tasksDescriber.EXPECT().DescribeTasks("my-cluster", gomock.Any()).Return([]*awsecs.Task{
+					{
+						TaskArn:           aws.String("arn:aws:ecs:us-west-2:123456789:task/my-cluster/task-1"),
+						TaskDefinitionArn: aws.String("my-task-def"),
+						StoppedReason:     aws.String("Essential container in task exited"),
+						Containers: []*sdkecs.Container{
+							{
+								Name:     aws.String("my-task"),
+								ExitCode: aws.Int64(0),
+							},
+							{
+								Name:     aws.String("logger"),
+								ExitCode: aws.Int64(1),
+							},
+						},
+					},
+				}, nil)
+				m.tasksDescriber.EXPECT().TaskDefinition("my-task-def").Return(&awsecs.TaskDefinition{
+					ContainerDefinitions: []*sdkecs.ContainerDefinition{
+						{
+							Name: aws.String("my-task"),
+						},
+						{
+							Name:      aws.String("logger"),
+							Essential: aws.Bool(false),
+						},
+					},
+				}, nil)
+				mockHasDefaultCluster(m)
+			},
+		},
+		"return the exit code of a failed essential container": {
+			inFollowExitCode: true,
+			inImage:          "image",
+			setupMocks: func(m runTaskMocks) {
+				m.provider.EXPECT().Default().Return(&session.Session{}, nil)
+				m.deployer.EXPECT().DeployTask(gomock.Any(), gomock.Any()).AnyTimes()
+				m.runner.EXPECT().Run().Return([]*task.Task{
+					{
+						TaskARN:    "arn:aws:ecs:us-west-2:123456789:task/my-cluster/task-1",
+						ClusterARN: "my-cluster",
+					},
+					{
+						TaskARN:    "arn:aws:ecs:us-west-2:123456789:task/my-cluster/task-2",
+						ClusterARN: "my-cluster",
+					},
+				}, nil)
+				m.eventsWriter.EXPECT().WriteEventsUntilStopped().Times(1).Return(nil)
+				m.tasksDescriber.EXPECT().DescribeTasks("my-cluster", gomock.Any()).Return([]*awsecs.Task{
+					{
+						TaskArn:           aws.String("arn:aws:ecs:us-west-2:123456789:task/my-cluster/task-1"),
+						TaskDefinitionArn: aws.String("my-task-def"),
+						Containers: []*sdkecs.Container{
+							{
+								Name:     aws.String("my-task"),
+								ExitCode: aws.Int64(0),
+							},
+						},
+					},
+					{
+						TaskArn:           aws.String("arn:aws:ecs:us-west-2:123456789:task/my-cluster/task-2"),
+						TaskDefinitionArn: aws.String("my-task-def"),
+						Containers: []*sdkecs.Container{
+							{
+								Name:     aws.String("my-task"),
+								ExitCode: aws.Int64(3),
+							},
+						},
+					},
+				}, nil)
+				m.tasksDescriber.EXPECT().TaskDefinition("my-task-def").Times(1).Return(&awsecs.TaskDefinition{
+					ContainerDefinitions: []*sdkecs.ContainerDefinition{
+						{
+							Name: aws.String("my-task"),
+						},
+					},
+				}, nil)
+				mockHasDefaultCluster(m)
+			},
+			wantedError:          errors.New("essential container my-task in task task-2 exited with code 3"),
+			wantedFailedExitCode: 3,
+		},
 	}
 
 	for name, tc := range testCases {
@@ -964,6 +1240,7 @@ func TestTaskRunOpts_Execute(t *testing.T) {
 				defaultClusterGetter: mocks.NewMockdefaultClusterGetter(ctrl),
 				publicIPGetter:       mocks.NewMockpublicIPGetter(ctrl),
 				provider:             mocks.NewMocksessionProvider(ctrl),
+				tasksDescriber:       mocks.NewMocktasksDescriber(ctrl),
 			}
 			tc.setupMocks(mocks)
 
@@ -975,28 +1252,35 @@ func TestTaskRunOpts_Execute(t *testing.T) {
 					imageTag:              tc.inTag,
 					dockerfileContextPath: tc.inDockerCtx,
 
-					env:        tc.inEnv,
-					follow:     tc.inFollow,
-					secrets:    tc.inSecrets,
-					command:    tc.inCommand,
-					entrypoint: tc.inEntryPoint,
+					env:            tc.inEnv,
+					follow:         tc.inFollow,
+					followExitCode: tc.inFollowExitCode,
+					logFile:        tc.inLogFile,
+					fromSvc:        tc.inFromSvc,
+					secrets:        tc.inSecrets,
+					command:        tc.inCommand,
+					entrypoint:     tc.inEntryPoint,
 				},
 				spinner:  &mockSpinner{},
 				store:    mocks.store,
 				provider: mocks.provider,
+				fs:       afero.NewMemMapFs(),
 			}
 			opts.configureRuntimeOpts = func() error {
 				opts.runner = mocks.runner
 				opts.deployer = mocks.deployer
 				opts.defaultClusterGetter = mocks.defaultClusterGetter
 				opts.publicIPGetter = mocks.publicIPGetter
+				opts.tasksDescriber = mocks.tasksDescriber
 				return nil
 			}
 			opts.configureRepository = func() error {
 				opts.repository = mocks.repository
 				return nil
 			}
-			opts.configureEventsWriter = func(tasks []*task.Task) {
+			var numLogWriters int
+			opts.configureEventsWriter = func(tasks []*task.Task, additionalWriters ...io.Writer) {
+				numLogWriters = len(additionalWriters)
 				opts.eventsWriter = mocks.eventsWriter
 			}
 
@@ -1006,6 +1290,12 @@ func TestTaskRunOpts_Execute(t *testing.T) {
 			} else {
 				require.NoError(t, err)
 			}
+			require.Equal(t, tc.wantedNumLogWriters, numLogWriters)
+			if tc.wantedFailedExitCode != 0 {
+				var ec interface{ ExitCode() int }
+				require.True(t, errors.As(err, &ec))
+				require.Equal(t, tc.wantedFailedExitCode, ec.ExitCode())
+			}
 		})
 	}
 }
@@ -29,6 +29,7 @@ import (
 var (
 	errValueEmpty           = errors.New("value must not be empty")
 	errValueTooLong         = errors.New("value must not exceed 255 characters")
+	errPipelineNameTooLong  = errors.New("value must not exceed 100 characters")
 	errValueBadFormat       = errors.New("value must start with a letter, contain only lower-case letters, numbers, and hyphens, and have no consecutive or trailing hyphen")
 	errValueNotAString      = errors.New("value must be a string")
 	errValueNotAStringSlice = errors.New("value must be a string slice")
@@ -44,7 +45,9 @@ var (
 
 // Addons validation errors.
 var (
-	fmtErrInvalidStorageType = "invalid storage type %s: must be one of %s"
+	fmtErrInvalidStorageType            = "invalid storage type %s: must be one of %s"
+	fmtErrInvalidRedisNodeType          = `invalid node type %s: must start with "cache."`
+	fmtErrInvalidOpenSearchInstanceType = `invalid instance type %s: must end with ".search"`
 
 	// S3 errors.
 	errS3ValueBadSize      = errors.New("value must be between 3 and 63 characters in length")
@@ -58,11 +61,15 @@ var (
 	errValueBadFormatWithPeriodUnderscore = errors.New("value must contain only alphanumeric characters and ._-")
 	errDDBAttributeBadFormat              = errors.New("value must be of the form <name>:<T> where T is one of S, N, or B")
 	errTooManyLSIKeys                     = errors.New("number of specified LSI sort keys must be 5 or less")
+	errTooManyGSIs                        = errors.New("number of specified global secondary indexes must be 20 or less")
+	errGSIBadFormat                       = errors.New("value must be of the form <name>:<T> or <name>:<T>,<name>:<T> where T is one of S, N, or B")
 
 	// Aurora-Serverless-specific errors.
 	errInvalidRDSNameCharacters    = errors.New("value must start with a letter")
 	errRDWSNotConnectedToVPC       = fmt.Errorf("%s requires a VPC connection", manifest.RequestDrivenWebServiceType)
+	errRDWSNotInEnvSecurityGroup   = fmt.Errorf("%s is not in the environment security group", manifest.RequestDrivenWebServiceType)
 	fmtErrInvalidEngineType        = "invalid engine type %s: must be one of %s"
+	fmtErrInvalidRDSInstanceClass  = `invalid instance class %s: must start with "db."`
 	fmtErrInvalidDBNameCharacters  = "invalid database name %s: must contain only alphanumeric characters and underscore; should start with a letter"
 	errInvalidSecretNameCharacters = errors.New("value must contain only letters, numbers, periods, hyphens and underscores")
 
@@ -300,6 +307,29 @@ func validateStorageType(val interface{}, opts validateStorageTypeOpts) error {
 	if storageType == rdsStorageType {
 		return validateAuroraStorageType(opts.ws, opts.workloadName)
 	}
+	if contains(storageType, vpcStorageTypes) {
+		return validateVPCStorageType(storageType, opts.ws, opts.workloadName)
+	}
+	return nil
+}
+
+// validateVPCStorageType returns an error if the workload can't reach storage that only accepts
+// traffic from the environment security group.
+func validateVPCStorageType(storageType string, ws manifestReader, workloadName string) error {
+	if workloadName == "" {
+		return nil // Workload not yet selected while validating storage type flag.
+	}
+	mft, err := ws.ReadWorkloadManifest(workloadName)
+	if err != nil {
+		return fmt.Errorf("invalid storage type %s: read manifest file for %s: %w", storageType, workloadName, err)
+	}
+	mftType, err := mft.WorkloadType()
+	if err != nil {
+		return fmt.Errorf("invalid storage type %s: read type of workload from manifest file for %s: %w", storageType, workloadName, err)
+	}
+	if mftType == manifest.RequestDrivenWebServiceType {
+		return fmt.Errorf("invalid storage type %s: %w", storageType, errRDWSNotInEnvSecurityGroup)
+	}
 	return nil
 }
 
@@ -390,6 +420,39 @@ func validateDBNameCharacters(name string) error {
 	return fmt.Errorf(fmtErrInvalidDBNameCharacters, name)
 }
 
+func validateRedisNodeType(val interface{}) error {
+	nodeType, ok := val.(string)
+	if !ok {
+		return errValueNotAString
+	}
+	if !strings.HasPrefix(nodeType, "cache.") {
+		return fmt.Errorf(fmtErrInvalidRedisNodeType, nodeType)
+	}
+	return nil
+}
+
+func validateOpenSearchInstanceType(val interface{}) error {
+	instanceType, ok := val.(string)
+	if !ok {
+		return errValueNotAString
+	}
+	if !strings.HasSuffix(instanceType, ".search") {
+		return fmt.Errorf(fmtErrInvalidOpenSearchInstanceType, instanceType)
+	}
+	return nil
+}
+
+func validateRDSInstanceClass(val interface{}) error {
+	instanceClass, ok := val.(string)
+	if !ok {
+		return errValueNotAString
+	}
+	if !strings.HasPrefix(instanceClass, "db.") {
+		return fmt.Errorf(fmtErrInvalidRDSInstanceClass, instanceClass)
+	}
+	return nil
+}
+
 func validateEngine(val interface{}) error {
 	engine, ok := val.(string)
 	if !ok {
@@ -410,6 +473,16 @@ func validateEnvironmentName(val interface{}) error {
 	return nil
 }
 
+func validatePipelineName(val interface{}) error {
+	if err := basicNameValidation(val); err != nil {
+		return fmt.Errorf("pipeline name %v is invalid: %w", val, err)
+	}
+	if len(val.(string)) > 100 {
+		return fmt.Errorf("pipeline name %v is invalid: %w", val, errPipelineNameTooLong)
+	}
+	return nil
+}
+
 func basicNameValidation(val interface{}) error {
 	s, ok := val.(string)
 	if !ok {
@@ -679,6 +752,28 @@ func validateLSIs(val interface{}) error {
 	return nil
 }
 
+func validateGSIs(val interface{}) error {
+	s, ok := val.([]string)
+	if !ok {
+		return errValueNotAStringSlice
+	}
+	if len(s) > 20 {
+		return errTooManyGSIs
+	}
+	for _, gsi := range s {
+		keys := strings.Split(gsi, ",")
+		if len(keys) > 2 {
+			return fmt.Errorf("global secondary index %s: %w", gsi, errGSIBadFormat)
+		}
+		for _, key := range keys {
+			if err := validateKey(key); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
 func validateSubscribe(noSubscription bool, subscribeTags []string) error {
 	// --no-subscriptions and --subscribe are mutually exclusive.
 	if noSubscription && len(subscribeTags) != 0 {
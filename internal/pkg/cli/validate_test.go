@@ -379,6 +379,46 @@ type: Request-Driven Web Service
 			},
 			want: errors.New("invalid storage type Aurora: Request-Driven Web Service requires a VPC connection"),
 		},
+		"should return an error if Redis is selected for a RDWS": {
+			input: "Redis",
+			optionals: validateStorageTypeOpts{
+				ws: mockManifestReader{
+					out: []byte(`
+name: api
+type: Request-Driven Web Service
+network:
+  vpc:
+    placement: private
+`),
+				},
+				workloadName: "api",
+			},
+			want: errors.New("invalid storage type Redis: Request-Driven Web Service is not in the environment security group"),
+		},
+		"should allow OpenSearch if the workload type is not a RDWS": {
+			input: "OpenSearch",
+			optionals: validateStorageTypeOpts{
+				ws: mockManifestReader{
+					out: []byte(`
+name: api
+type: Backend Service
+`),
+				},
+				workloadName: "api",
+			},
+		},
+		"should allow SQS for a RDWS": {
+			input: "SQS",
+			optionals: validateStorageTypeOpts{
+				ws: mockManifestReader{
+					out: []byte(`
+name: api
+type: Request-Driven Web Service
+`),
+				},
+				workloadName: "api",
+			},
+		},
 		"should succeed if Aurora is selected and RDWS is connected to a VPC": {
 			input: "Aurora",
 			optionals: validateStorageTypeOpts{
@@ -465,6 +505,41 @@ func TestValidateLSIs(t *testing.T) {
 	}
 }
 
+func TestValidateGSIs(t *testing.T) {
+	testCases := map[string]struct {
+		input []string
+		want  error
+	}{
+		"good case": {
+			input: []string{"email:S", "owner:S,createdAt:N"},
+			want:  nil,
+		},
+		"bad key": {
+			input: []string{"email"},
+			want:  errDDBAttributeBadFormat,
+		},
+		"too many keys": {
+			input: []string{"a:S,b:S,c:S"},
+			want:  fmt.Errorf("global secondary index a:S,b:S,c:S: %w", errGSIBadFormat),
+		},
+		"too many indexes": {
+			input: []string{"a:S", "b:S", "c:S", "d:S", "e:S", "f:S", "g:S", "h:S", "i:S", "j:S", "k:S",
+				"l:S", "m:S", "n:S", "o:S", "p:S", "q:S", "r:S", "s:S", "t:S", "u:S"},
+			want: errTooManyGSIs,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got := validateGSIs(tc.input)
+			if tc.want == nil {
+				require.NoError(t, got)
+			} else {
+				require.EqualError(t, got, tc.want.Error())
+			}
+		})
+	}
+}
+
 func TestValidateCIDR(t *testing.T) {
 	testCases := map[string]struct {
 		inputCIDR string
@@ -7,6 +7,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	sdkcloudformation "github.com/aws/aws-sdk-go/service/cloudformation"
@@ -426,6 +427,69 @@ func (cf CloudFormation) AddPipelineResourcesToApp(
 	return nil
 }
 
+// AddPipelineStagesToApp makes sure that the application has regional resources (ECR repositories, KMS keys and S3 buckets)
+// in every region that the stages of a pipeline deploy to, and that the accounts of the stages' environments can access them.
+// Environments can be added to the application after the pipeline is created, so the stack set is reconciled on every pipeline deployment.
+func (cf CloudFormation) AddPipelineStagesToApp(app *config.Application, stages []deploy.PipelineStage) error {
+	appConfig := stack.NewAppStackConfig(&deploy.CreateAppInput{
+		Name:           app.Name,
+		AccountID:      app.AccountID,
+		AdditionalTags: app.Tags,
+		Version:        deploy.LatestAppTemplateVersion,
+	})
+	previouslyDeployedConfig, err := cf.getLastDeployedAppConfig(appConfig)
+	if err != nil {
+		return fmt.Errorf("get previously deployed stackset: %w", err)
+	}
+
+	accounts := append([]string{}, previouslyDeployedConfig.Accounts...)
+	addedAccounts := make(map[string]bool)
+	for _, account := range accounts {
+		addedAccounts[account] = true
+	}
+	for _, stage := range stages {
+		if stage.AssociatedEnvironment == nil || addedAccounts[stage.AccountID] {
+			continue
+		}
+		accounts = append(accounts, stage.AccountID)
+		addedAccounts[stage.AccountID] = true
+	}
+	if len(accounts) != len(previouslyDeployedConfig.Accounts) {
+		if err := cf.deployAppConfig(appConfig, &stack.AppResourcesConfig{
+			Version:  previouslyDeployedConfig.Version + 1,
+			Services: previouslyDeployedConfig.Services,
+			Accounts: accounts,
+			App:      appConfig.Name,
+		}); err != nil {
+			return fmt.Errorf("add accounts of pipeline stages to application %s: %w", app.Name, err)
+		}
+	}
+
+	summaries, err := cf.appStackSet.InstanceSummaries(appConfig.StackSetName())
+	if err != nil {
+		return fmt.Errorf("list stack instances of application %s: %w", app.Name, err)
+	}
+	deployedRegions := make(map[string]bool)
+	for _, summary := range summaries {
+		deployedRegions[summary.Region] = true
+	}
+	var missingRegions []string
+	for _, stage := range stages {
+		if stage.AssociatedEnvironment == nil || deployedRegions[stage.Region] {
+			continue
+		}
+		missingRegions = append(missingRegions, stage.Region)
+		deployedRegions[stage.Region] = true
+	}
+	if len(missingRegions) == 0 {
+		return nil
+	}
+	if err := cf.appStackSet.CreateInstancesAndWait(appConfig.StackSetName(), []string{appConfig.AccountID}, missingRegions); err != nil {
+		return fmt.Errorf("add stack instances in regions %s for application %s: %w", strings.Join(missingRegions, ", "), app.Name, err)
+	}
+	return nil
+}
+
 func (cf CloudFormation) deployAppConfig(appConfig *stack.AppStackConfig, resources *stack.AppResourcesConfig) error {
 	newTemplateToDeploy, err := appConfig.ResourceTemplate(resources)
 	if err != nil {
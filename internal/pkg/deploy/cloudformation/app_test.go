@@ -422,6 +422,127 @@ func TestCloudFormation_AddPipelineResourcesToApp(t *testing.T) {
 	getRegionFromClient = actual
 }
 
+func TestCloudFormation_AddPipelineStagesToApp(t *testing.T) {
+	mockApp := config.Application{
+		Name:      "testapp",
+		AccountID: "1234",
+	}
+	mockStages := []deploy.PipelineStage{
+		{
+			AssociatedEnvironment: &deploy.AssociatedEnvironment{Name: "test", AccountID: "1234", Region: "us-west-2"},
+		},
+		{
+			AssociatedEnvironment: &deploy.AssociatedEnvironment{Name: "prod", AccountID: "5678", Region: "eu-west-1"},
+		},
+		{
+			AssociatedEnvironment: &deploy.AssociatedEnvironment{Name: "prod-us", AccountID: "5678", Region: "us-west-2"},
+		},
+	}
+	testCases := map[string]struct {
+		mockStackSet func(t *testing.T, ctrl *gomock.Controller) stackSetClient
+		wantedErr    error
+	}{
+		"adds the accounts and the regions of the stages that are missing": {
+			mockStackSet: func(t *testing.T, ctrl *gomock.Controller) stackSetClient {
+				m := mocks.NewMockstackSetClient(ctrl)
+				body, err := yaml.Marshal(stack.DeployedAppMetadata{
+					Metadata: stack.AppResourcesConfig{
+						Accounts: []string{"1234"},
+						Services: []string{"api"},
+						Version:  3,
+					},
+				})
+				require.NoError(t, err)
+				m.EXPECT().Describe(gomock.Any()).Return(stackset.Description{
+					Template: string(body),
+				}, nil)
+				m.EXPECT().UpdateAndWait(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil).
+					Do(func(_, template string, _ ...stackset.CreateOrUpdateOption) {
+						configToDeploy, err := stack.AppConfigFrom(&template)
+						require.NoError(t, err)
+						require.Equal(t, []string{"1234", "5678"}, configToDeploy.Accounts)
+						require.Equal(t, []string{"api"}, configToDeploy.Services)
+						require.Equal(t, 4, configToDeploy.Version)
+					})
+				m.EXPECT().InstanceSummaries(gomock.Any()).Return([]stackset.InstanceSummary{
+					{
+						Region:  "us-west-2",
+						Account: mockApp.AccountID,
+					},
+				}, nil)
+				m.EXPECT().CreateInstancesAndWait(gomock.Any(), []string{"1234"}, []string{"eu-west-1"}).Return(nil)
+				return m
+			},
+		},
+		"does not update the stack set if the accounts and the regions already exist": {
+			mockStackSet: func(t *testing.T, ctrl *gomock.Controller) stackSetClient {
+				m := mocks.NewMockstackSetClient(ctrl)
+				body, err := yaml.Marshal(stack.DeployedAppMetadata{
+					Metadata: stack.AppResourcesConfig{
+						Accounts: []string{"1234", "5678"},
+						Version:  3,
+					},
+				})
+				require.NoError(t, err)
+				m.EXPECT().Describe(gomock.Any()).Return(stackset.Description{
+					Template: string(body),
+				}, nil)
+				m.EXPECT().UpdateAndWait(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+				m.EXPECT().InstanceSummaries(gomock.Any()).Return([]stackset.InstanceSummary{
+					{
+						Region:  "us-west-2",
+						Account: mockApp.AccountID,
+					},
+					{
+						Region:  "eu-west-1",
+						Account: mockApp.AccountID,
+					},
+				}, nil)
+				m.EXPECT().CreateInstancesAndWait(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+				return m
+			},
+		},
+		"wraps the error if fail to create the stack instances": {
+			mockStackSet: func(t *testing.T, ctrl *gomock.Controller) stackSetClient {
+				m := mocks.NewMockstackSetClient(ctrl)
+				body, err := yaml.Marshal(stack.DeployedAppMetadata{
+					Metadata: stack.AppResourcesConfig{
+						Accounts: []string{"1234", "5678"},
+					},
+				})
+				require.NoError(t, err)
+				m.EXPECT().Describe(gomock.Any()).Return(stackset.Description{
+					Template: string(body),
+				}, nil)
+				m.EXPECT().InstanceSummaries(gomock.Any()).Return(nil, nil)
+				m.EXPECT().CreateInstancesAndWait(gomock.Any(), []string{"1234"}, []string{"us-west-2", "eu-west-1"}).Return(errors.New("some error"))
+				return m
+			},
+			wantedErr: errors.New("add stack instances in regions us-west-2, eu-west-1 for application testapp: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			cf := CloudFormation{
+				appStackSet: tc.mockStackSet(t, ctrl),
+				region:      "us-west-2",
+			}
+
+			got := cf.AddPipelineStagesToApp(&mockApp, mockStages)
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, got, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, got)
+			}
+		})
+	}
+}
+
 func TestCloudFormation_AddServiceToApp(t *testing.T) {
 	mockApp := config.Application{
 		Name:      "testapp",
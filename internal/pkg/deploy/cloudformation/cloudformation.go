@@ -14,6 +14,8 @@ import (
 
 	"github.com/aws/copilot-cli/internal/pkg/aws/s3"
 
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatchlogs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/codedeploy"
 	"github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
 
 	"github.com/aws/copilot-cli/internal/pkg/aws/codestar"
@@ -38,6 +40,14 @@ const (
 	// CloudFormation resource types.
 	ecsServiceResourceType    = "AWS::ECS::Service"
 	envControllerResourceType = "Custom::EnvControllerFunction"
+	preHookResourceType       = "Custom::PreHookFunction"
+	blueGreenResourceType     = "Custom::BlueGreenDeploymentFunction"
+
+	// preHookTaskStartedBy is the "startedBy" value of the tasks run by the pre-deployment hook function.
+	preHookTaskStartedBy = "copilot-pre-hook"
+
+	// Environment stack outputs.
+	envOutputClusterID = "ClusterId"
 )
 
 // StackConfiguration represents the set of methods needed to deploy a cloudformation stack.
@@ -50,6 +60,15 @@ type StackConfiguration interface {
 
 type ecsClient interface {
 	stream.ECSServiceDescriber
+	stream.ECSTaskLister
+}
+
+type logsClient interface {
+	stream.LogEventsGetter
+}
+
+type codeDeployClient interface {
+	stream.CodeDeployDeploymentDescriber
 }
 
 type cfnClient interface {
@@ -104,6 +123,8 @@ type CloudFormation struct {
 	codeStarClient codeStarClient
 	cpClient       codePipelineClient
 	ecsClient      ecsClient
+	logsClient     logsClient
+	cdClient       codeDeployClient
 	regionalClient func(region string) cfnClient
 	appStackSet    stackSetClient
 	s3Client       s3Client
@@ -117,6 +138,8 @@ func New(sess *session.Session) CloudFormation {
 		codeStarClient: codestar.New(sess),
 		cpClient:       codepipeline.New(sess),
 		ecsClient:      ecs.New(sess),
+		logsClient:     cloudwatchlogs.New(sess),
+		cdClient:       codedeploy.New(sess),
 		regionalClient: func(region string) cfnClient {
 			return cloudformation.New(sess.Copy(&aws.Config{
 				Region: aws.String(region),
@@ -289,6 +312,30 @@ func (cf CloudFormation) changeRenderers(in changeRenderersInput) ([]progress.Re
 				return nil, err
 			}
 			renderer = r
+		case aws.StringValue(change.ResourceChange.ResourceType) == preHookResourceType:
+			r, err := cf.createPreHookRenderer(&preHookRendererInput{
+				g:                 in.g,
+				ctx:               in.ctx,
+				workloadStackName: in.stackName,
+				change:            change,
+				description:       description,
+				serviceStack:      in.stackStreamer,
+				renderOpts:        in.opts,
+			})
+			if err != nil {
+				return nil, err
+			}
+			renderer = r
+		case aws.StringValue(change.ResourceChange.ResourceType) == blueGreenResourceType:
+			renderer = progress.ListeningBlueGreenDeploymentRenderer(progress.BlueGreenDeploymentConfig{
+				Description:     description,
+				RenderOpts:      in.opts,
+				Group:           in.g,
+				Ctx:             in.ctx,
+				ActionStreamer:  in.stackStreamer,
+				ActionLogicalID: logicalID,
+				Deployer:        cf.cdClient,
+			})
 		case aws.StringValue(change.ResourceChange.ResourceType) == ecsServiceResourceType:
 			renderer = progress.ListeningECSServiceResourceRenderer(in.stackStreamer, cf.ecsClient, logicalID, description, progress.ECSServiceRendererOpts{
 				Group:      in.g,
@@ -365,6 +412,52 @@ func (cf CloudFormation) createEnvControllerRenderer(in *envControllerRendererIn
 	}), nil
 }
 
+type preHookRendererInput struct {
+	g                 *errgroup.Group
+	ctx               context.Context
+	workloadStackName string
+	change            *sdkcloudformation.Change
+	description       string
+	serviceStack      progress.StackSubscriber
+	renderOpts        progress.RenderOptions
+}
+
+func (cf CloudFormation) createPreHookRenderer(in *preHookRendererInput) (progress.DynamicRenderer, error) {
+	workload, err := cf.cfnClient.Describe(in.workloadStackName)
+	if err != nil {
+		return nil, err
+	}
+	app, env, svc := parseAppNameFromTags(workload.Tags), parseEnvNameFromTags(workload.Tags), parseServiceNameFromTags(workload.Tags)
+	envStack, err := cf.cfnClient.Describe(fmt.Sprintf("%s-%s", app, env))
+	if err != nil {
+		return nil, err
+	}
+	var cluster string
+	for _, out := range envStack.Outputs {
+		if aws.StringValue(out.OutputKey) == envOutputClusterID {
+			cluster = aws.StringValue(out.OutputValue)
+		}
+	}
+	family := fmt.Sprintf("%s-%s-%s", app, env, svc)
+	return progress.ListeningPreHookRenderer(progress.PreHookConfig{
+		Description:     in.description,
+		RenderOpts:      in.renderOpts,
+		Group:           in.g,
+		Ctx:             in.ctx,
+		ActionStreamer:  in.serviceStack,
+		ActionLogicalID: aws.StringValue(in.change.ResourceChange.LogicalResourceId),
+		TaskLister:      cf.ecsClient,
+		LogsGetter:      cf.logsClient,
+		LogsConfig: stream.ECSTaskLogStreamerConfig{
+			Cluster:   cluster,
+			Family:    family,
+			StartedBy: preHookTaskStartedBy,
+			Container: svc,
+			LogGroup:  fmt.Sprintf("/copilot/%s", family),
+		},
+	}), nil
+}
+
 func (cf CloudFormation) errOnFailedStack(stackName string) error {
 	stack, err := cf.cfnClient.Describe(stackName)
 	if err != nil {
@@ -434,6 +527,15 @@ func parseEnvNameFromTags(tags []*sdkcloudformation.Tag) string {
 	return ""
 }
 
+func parseServiceNameFromTags(tags []*sdkcloudformation.Tag) string {
+	for _, t := range tags {
+		if aws.StringValue(t.Key) == deploy.ServiceTagKey {
+			return aws.StringValue(t.Value)
+		}
+	}
+	return ""
+}
+
 func stopSpinner(spinner *progress.Spinner, err error, label string) {
 	if err == nil {
 		spinner.Stop(log.Ssuccessf("%s\n", label))
@@ -15,6 +15,8 @@ import (
 	sdkcloudformation "github.com/aws/aws-sdk-go/service/cloudformation"
 	awsecs "github.com/aws/aws-sdk-go/service/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatchlogs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/codedeploy"
 	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/mocks"
 	"github.com/aws/copilot-cli/internal/pkg/term/progress"
@@ -328,6 +330,200 @@ Resources:
 	require.Contains(t, buf.String(), "Updating environment", "env stack description is rendered")
 }
 
+func testDeployWorkload_RenderNewlyCreatedStackWithPreHook(t *testing.T, stackName string, when func(w progress.FileWriter, cf CloudFormation) error) {
+	// GIVEN
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockCFN := mocks.NewMockcfnClient(ctrl)
+	mockECS := mocks.NewMockecsClient(ctrl)
+	mockLogs := mocks.NewMocklogsClient(ctrl)
+	deploymentTime := time.Date(2020, time.November, 23, 18, 0, 0, 0, time.UTC)
+
+	mockCFN.EXPECT().Create(gomock.Any()).Return("1234", nil)
+	mockCFN.EXPECT().DescribeChangeSet("1234", stackName).Return(&cloudformation.ChangeSetDescription{
+		Changes: []*sdkcloudformation.Change{
+			{
+				ResourceChange: &sdkcloudformation.ResourceChange{
+					LogicalResourceId: aws.String("PreHookAction0"),
+					ResourceType:      aws.String("Custom::PreHookFunction"),
+				},
+			},
+		},
+	}, nil)
+	mockCFN.EXPECT().TemplateBodyFromChangeSet("1234", stackName).Return(`
+Resources:
+  PreHookAction0:
+    Metadata:
+      'aws:copilot:description': 'Run a one-off task'
+    Type: Custom::PreHookFunction
+`, nil)
+	mockCFN.EXPECT().Describe(stackName).Return(&cloudformation.StackDescription{
+		Tags: []*sdkcloudformation.Tag{
+			{
+				Key:   aws.String("copilot-application"),
+				Value: aws.String("my-app"),
+			},
+			{
+				Key:   aws.String("copilot-environment"),
+				Value: aws.String("my-env"),
+			},
+			{
+				Key:   aws.String("copilot-service"),
+				Value: aws.String("my-svc"),
+			},
+		},
+	}, nil)
+	mockCFN.EXPECT().Describe("my-app-my-env").Return(&cloudformation.StackDescription{
+		Outputs: []*sdkcloudformation.Output{
+			{
+				OutputKey:   aws.String("ClusterId"),
+				OutputValue: aws.String("my-cluster"),
+			},
+		},
+	}, nil)
+	mockCFN.EXPECT().DescribeStackEvents(&sdkcloudformation.DescribeStackEventsInput{
+		StackName: aws.String(stackName),
+	}).Return(&sdkcloudformation.DescribeStackEventsOutput{
+		StackEvents: []*sdkcloudformation.StackEvent{
+			{
+				EventId:           aws.String("1"),
+				LogicalResourceId: aws.String("PreHookAction0"),
+				ResourceType:      aws.String("Custom::PreHookFunction"),
+				ResourceStatus:    aws.String("CREATE_IN_PROGRESS"),
+				Timestamp:         aws.Time(deploymentTime),
+			},
+			{
+				EventId:           aws.String("2"),
+				LogicalResourceId: aws.String("PreHookAction0"),
+				ResourceType:      aws.String("Custom::PreHookFunction"),
+				ResourceStatus:    aws.String("CREATE_COMPLETE"),
+				Timestamp:         aws.Time(deploymentTime.Add(time.Minute)),
+			},
+			{
+				EventId:           aws.String("3"),
+				LogicalResourceId: aws.String(stackName),
+				ResourceType:      aws.String("AWS::CloudFormation::Stack"),
+				ResourceStatus:    aws.String("CREATE_COMPLETE"),
+				Timestamp:         aws.Time(deploymentTime.Add(time.Minute)),
+			},
+		},
+	}, nil).AnyTimes()
+	mockECS.EXPECT().TasksStartedBy("my-cluster", "my-app-my-env-my-svc", "copilot-pre-hook").Return([]*ecs.Task{
+		{
+			TaskArn:    aws.String("arn:aws:ecs:us-west-2:1111:task/my-cluster/1234"),
+			LastStatus: aws.String("STOPPED"),
+			CreatedAt:  aws.Time(deploymentTime.Add(time.Second)),
+		},
+	}, nil).AnyTimes()
+	mockLogs.EXPECT().LogEvents(gomock.Any()).DoAndReturn(func(opts cloudwatchlogs.LogEventsOpts) (*cloudwatchlogs.LogEventsOutput, error) {
+		require.Equal(t, "/copilot/my-app-my-env-my-svc", opts.LogGroup)
+		require.Equal(t, []string{"copilot/my-svc/1234"}, opts.LogStreams)
+		return &cloudwatchlogs.LogEventsOutput{
+			Events: []*cloudwatchlogs.Event{
+				{
+					Message: "migrations applied",
+				},
+			},
+		}, nil
+	}).AnyTimes()
+	mockCFN.EXPECT().Describe(stackName).Return(&cloudformation.StackDescription{
+		StackStatus: aws.String("CREATE_COMPLETE"),
+	}, nil)
+	client := CloudFormation{cfnClient: mockCFN, ecsClient: mockECS, logsClient: mockLogs}
+	buf := new(strings.Builder)
+
+	// WHEN
+	err := when(mockFileWriter{Writer: buf}, client)
+
+	// THEN
+	require.NoError(t, err)
+	require.Contains(t, buf.String(), "Run a one-off task", "resource should be rendered")
+	require.Contains(t, buf.String(), "migrations applied", "logs of the task should be rendered")
+}
+
+func testDeployWorkload_RenderUpdatedStackWithBlueGreenDeployment(t *testing.T, stackName string, when func(w progress.FileWriter, cf CloudFormation) error) {
+	// GIVEN
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockCFN := mocks.NewMockcfnClient(ctrl)
+	mockCD := mocks.NewMockcodeDeployClient(ctrl)
+	deploymentTime := time.Date(2020, time.November, 23, 18, 0, 0, 0, time.UTC)
+
+	mockCFN.EXPECT().Create(gomock.Any()).Return("", &cloudformation.ErrStackAlreadyExists{})
+	mockCFN.EXPECT().Update(gomock.Any()).Return("1234", nil)
+	mockCFN.EXPECT().DescribeChangeSet("1234", stackName).Return(&cloudformation.ChangeSetDescription{
+		Changes: []*sdkcloudformation.Change{
+			{
+				ResourceChange: &sdkcloudformation.ResourceChange{
+					LogicalResourceId: aws.String("BlueGreenDeploymentAction"),
+					ResourceType:      aws.String("Custom::BlueGreenDeploymentFunction"),
+				},
+			},
+		},
+	}, nil)
+	mockCFN.EXPECT().TemplateBodyFromChangeSet("1234", stackName).Return(`
+Resources:
+  BlueGreenDeploymentAction:
+    Metadata:
+      'aws:copilot:description': 'Start a blue/green deployment'
+    Type: Custom::BlueGreenDeploymentFunction
+`, nil)
+	mockCFN.EXPECT().DescribeStackEvents(&sdkcloudformation.DescribeStackEventsInput{
+		StackName: aws.String(stackName),
+	}).Return(&sdkcloudformation.DescribeStackEventsOutput{
+		StackEvents: []*sdkcloudformation.StackEvent{
+			{
+				EventId:            aws.String("1"),
+				LogicalResourceId:  aws.String("BlueGreenDeploymentAction"),
+				PhysicalResourceId: aws.String("bluegreen/stack/BlueGreenDeploymentAction"),
+				ResourceType:       aws.String("Custom::BlueGreenDeploymentFunction"),
+				ResourceStatus:     aws.String("UPDATE_IN_PROGRESS"),
+				Timestamp:          aws.Time(deploymentTime),
+			},
+			{
+				EventId:            aws.String("2"),
+				LogicalResourceId:  aws.String("BlueGreenDeploymentAction"),
+				PhysicalResourceId: aws.String("d-ABCDEF123"),
+				ResourceType:       aws.String("Custom::BlueGreenDeploymentFunction"),
+				ResourceStatus:     aws.String("UPDATE_COMPLETE"),
+				Timestamp:          aws.Time(deploymentTime.Add(time.Second)),
+			},
+			{
+				EventId:           aws.String("3"),
+				LogicalResourceId: aws.String(stackName),
+				ResourceType:      aws.String("AWS::CloudFormation::Stack"),
+				ResourceStatus:    aws.String("UPDATE_COMPLETE"),
+				Timestamp:         aws.Time(deploymentTime.Add(time.Minute)),
+			},
+		},
+	}, nil).AnyTimes()
+	mockCD.EXPECT().ECSDeployment("d-ABCDEF123").Return(&codedeploy.ECSDeployment{
+		ID:     "d-ABCDEF123",
+		Status: "Succeeded",
+		TaskSets: []codedeploy.ECSTaskSet{
+			{
+				Label:         "Green",
+				DesiredCount:  1,
+				RunningCount:  1,
+				TrafficWeight: 100,
+			},
+		},
+	}, nil).AnyTimes()
+	mockCFN.EXPECT().Describe(stackName).Return(&cloudformation.StackDescription{
+		StackStatus: aws.String("UPDATE_COMPLETE"),
+	}, nil)
+	client := CloudFormation{cfnClient: mockCFN, cdClient: mockCD}
+	buf := new(strings.Builder)
+
+	// WHEN
+	err := when(mockFileWriter{Writer: buf}, client)
+
+	// THEN
+	require.NoError(t, err)
+	require.Contains(t, buf.String(), "Start a blue/green deployment", "resource should be rendered")
+	require.Contains(t, buf.String(), "GREEN", "task sets of the deployment should be rendered")
+}
+
 func testDeployWorkload_RenderNewlyCreatedStackWithAddons(t *testing.T, stackName string, when func(w progress.FileWriter, cf CloudFormation) error) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
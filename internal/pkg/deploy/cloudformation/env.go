@@ -128,6 +128,24 @@ func (cf CloudFormation) UpgradeLegacyEnvironment(in *deploy.CreateEnvironmentIn
 	})
 }
 
+// DeployEnvironmentAddons updates an environment stack's template to the latest version and points its addons nested stack
+// to the template at in.AddonsTemplateURL. If in.AddonsTemplateURL is empty, then the addons nested stack is removed.
+// The values of all other parameters are kept.
+func (cf CloudFormation) DeployEnvironmentAddons(in *deploy.CreateEnvironmentInput) error {
+	return cf.upgradeEnvironment(in, func(param *awscfn.Parameter) *awscfn.Parameter {
+		if aws.StringValue(param.ParameterKey) == stack.EnvParamAddonsTemplateURLKey {
+			return &awscfn.Parameter{
+				ParameterKey:   param.ParameterKey,
+				ParameterValue: aws.String(in.AddonsTemplateURL),
+			}
+		}
+		return &awscfn.Parameter{
+			ParameterKey:     param.ParameterKey,
+			UsePreviousValue: aws.Bool(true),
+		}
+	})
+}
+
 func (cf CloudFormation) upgradeEnvironment(in *deploy.CreateEnvironmentInput, transformParam func(param *awscfn.Parameter) *awscfn.Parameter) error {
 	s, err := toStack(stack.NewEnvStackConfig(in))
 	if err != nil {
@@ -149,9 +167,20 @@ func (cf CloudFormation) upgradeEnvironment(in *deploy.CreateEnvironmentInput, t
 
 		// Keep the parameters and tags of the stack.
 		var params []*awscfn.Parameter
+		hasAddonsParam := false
 		for _, param := range descr.Parameters {
+			if aws.StringValue(param.ParameterKey) == stack.EnvParamAddonsTemplateURLKey {
+				hasAddonsParam = true
+			}
 			params = append(params, transformParam(param))
 		}
+		if !hasAddonsParam && in.AddonsTemplateURL != "" {
+			// Stacks created before environment addons were supported don't have the parameter yet.
+			params = append(params, &awscfn.Parameter{
+				ParameterKey:   aws.String(stack.EnvParamAddonsTemplateURLKey),
+				ParameterValue: aws.String(in.AddonsTemplateURL),
+			})
+		}
 		s.Parameters = params
 		s.Tags = descr.Tags
 
@@ -210,6 +210,141 @@ func TestCloudFormation_UpgradeLegacyEnvironment(t *testing.T) {
 	}
 }
 
+func TestCloudFormation_DeployEnvironmentAddons(t *testing.T) {
+	withAddons := mockCreateEnvInput
+	withAddons.AddonsTemplateURL = "https://mockbucket.s3-us-west-2.amazonaws.com/environments.addons.stack.yml"
+	testCases := map[string]struct {
+		in           *deploy.CreateEnvironmentInput
+		mockDeployer func(t *testing.T, ctrl *gomock.Controller) *CloudFormation
+
+		wantedErr error
+	}{
+		"replaces the addons template URL and preserves existing params": {
+			in: &withAddons,
+			mockDeployer: func(t *testing.T, ctrl *gomock.Controller) *CloudFormation {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().Describe("phonetool-test").Return(&cloudformation.StackDescription{
+					Parameters: []*awscfn.Parameter{
+						{
+							ParameterKey:   aws.String("ALBWorkloads"),
+							ParameterValue: aws.String("frontend,admin"),
+						},
+						{
+							ParameterKey:   aws.String("AddonsTemplateURL"),
+							ParameterValue: aws.String(""),
+						},
+					},
+				}, nil)
+				m.EXPECT().UpdateAndWait(gomock.Any()).Return(nil).Do(func(s *cloudformation.Stack) {
+					require.ElementsMatch(t, s.Parameters, []*awscfn.Parameter{
+						{
+							ParameterKey:     aws.String("ALBWorkloads"),
+							UsePreviousValue: aws.Bool(true),
+						},
+						{
+							ParameterKey:   aws.String("AddonsTemplateURL"),
+							ParameterValue: aws.String("https://mockbucket.s3-us-west-2.amazonaws.com/environments.addons.stack.yml"),
+						},
+					})
+				})
+
+				return &CloudFormation{
+					cfnClient: m,
+				}
+			},
+		},
+		"adds the addons template URL if the stack does not have the parameter yet": {
+			in: &withAddons,
+			mockDeployer: func(t *testing.T, ctrl *gomock.Controller) *CloudFormation {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().Describe("phonetool-test").Return(&cloudformation.StackDescription{
+					Parameters: []*awscfn.Parameter{
+						{
+							ParameterKey:   aws.String("ALBWorkloads"),
+							ParameterValue: aws.String("frontend,admin"),
+						},
+					},
+				}, nil)
+				m.EXPECT().UpdateAndWait(gomock.Any()).Return(nil).Do(func(s *cloudformation.Stack) {
+					require.ElementsMatch(t, s.Parameters, []*awscfn.Parameter{
+						{
+							ParameterKey:     aws.String("ALBWorkloads"),
+							UsePreviousValue: aws.Bool(true),
+						},
+						{
+							ParameterKey:   aws.String("AddonsTemplateURL"),
+							ParameterValue: aws.String("https://mockbucket.s3-us-west-2.amazonaws.com/environments.addons.stack.yml"),
+						},
+					})
+				})
+
+				return &CloudFormation{
+					cfnClient: m,
+				}
+			},
+		},
+		"removes the addons stack if there is no addons template": {
+			in: &mockCreateEnvInput,
+			mockDeployer: func(t *testing.T, ctrl *gomock.Controller) *CloudFormation {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().Describe("phonetool-test").Return(&cloudformation.StackDescription{
+					Parameters: []*awscfn.Parameter{
+						{
+							ParameterKey:   aws.String("AddonsTemplateURL"),
+							ParameterValue: aws.String("https://mockbucket.s3-us-west-2.amazonaws.com/environments.addons.stack.yml"),
+						},
+					},
+				}, nil)
+				m.EXPECT().UpdateAndWait(gomock.Any()).Return(nil).Do(func(s *cloudformation.Stack) {
+					require.ElementsMatch(t, s.Parameters, []*awscfn.Parameter{
+						{
+							ParameterKey:   aws.String("AddonsTemplateURL"),
+							ParameterValue: aws.String(""),
+						},
+					})
+				})
+
+				return &CloudFormation{
+					cfnClient: m,
+				}
+			},
+		},
+		"wrap error on unexpected update err": {
+			in: &withAddons,
+			mockDeployer: func(t *testing.T, ctrl *gomock.Controller) *CloudFormation {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().Describe(gomock.Any()).Return(&cloudformation.StackDescription{}, nil)
+				m.EXPECT().UpdateAndWait(gomock.Any()).Return(errors.New("some error"))
+
+				return &CloudFormation{
+					cfnClient: m,
+				}
+			},
+
+			wantedErr: errors.New("update and wait for stack phonetool-test: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			cf := tc.mockDeployer(t, ctrl)
+
+			// WHEN
+			err := cf.DeployEnvironmentAddons(tc.in)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestCloudFormation_EnvironmentTemplate(t *testing.T) {
 	testCases := map[string]struct {
 		inAppName string
@@ -12,6 +12,8 @@ import (
 	cloudformation "github.com/aws/aws-sdk-go/service/cloudformation"
 	cloudformation0 "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	stackset "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation/stackset"
+	cloudwatchlogs "github.com/aws/copilot-cli/internal/pkg/aws/cloudwatchlogs"
+	codedeploy "github.com/aws/copilot-cli/internal/pkg/aws/codedeploy"
 	ecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
 	gomock "github.com/golang/mock/gomock"
 )
@@ -135,6 +137,97 @@ func (mr *MockecsClientMockRecorder) Service(clusterName, serviceName interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Service", reflect.TypeOf((*MockecsClient)(nil).Service), clusterName, serviceName)
 }
 
+// TasksStartedBy mocks base method.
+func (m *MockecsClient) TasksStartedBy(cluster, family, startedBy string) ([]*ecs.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TasksStartedBy", cluster, family, startedBy)
+	ret0, _ := ret[0].([]*ecs.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TasksStartedBy indicates an expected call of TasksStartedBy.
+func (mr *MockecsClientMockRecorder) TasksStartedBy(cluster, family, startedBy interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TasksStartedBy", reflect.TypeOf((*MockecsClient)(nil).TasksStartedBy), cluster, family, startedBy)
+}
+
+// MocklogsClient is a mock of logsClient interface.
+type MocklogsClient struct {
+	ctrl     *gomock.Controller
+	recorder *MocklogsClientMockRecorder
+}
+
+// MocklogsClientMockRecorder is the mock recorder for MocklogsClient.
+type MocklogsClientMockRecorder struct {
+	mock *MocklogsClient
+}
+
+// NewMocklogsClient creates a new mock instance.
+func NewMocklogsClient(ctrl *gomock.Controller) *MocklogsClient {
+	mock := &MocklogsClient{ctrl: ctrl}
+	mock.recorder = &MocklogsClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocklogsClient) EXPECT() *MocklogsClientMockRecorder {
+	return m.recorder
+}
+
+// LogEvents mocks base method.
+func (m *MocklogsClient) LogEvents(opts cloudwatchlogs.LogEventsOpts) (*cloudwatchlogs.LogEventsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LogEvents", opts)
+	ret0, _ := ret[0].(*cloudwatchlogs.LogEventsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LogEvents indicates an expected call of LogEvents.
+func (mr *MocklogsClientMockRecorder) LogEvents(opts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogEvents", reflect.TypeOf((*MocklogsClient)(nil).LogEvents), opts)
+}
+
+// MockcodeDeployClient is a mock of codeDeployClient interface.
+type MockcodeDeployClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockcodeDeployClientMockRecorder
+}
+
+// MockcodeDeployClientMockRecorder is the mock recorder for MockcodeDeployClient.
+type MockcodeDeployClientMockRecorder struct {
+	mock *MockcodeDeployClient
+}
+
+// NewMockcodeDeployClient creates a new mock instance.
+func NewMockcodeDeployClient(ctrl *gomock.Controller) *MockcodeDeployClient {
+	mock := &MockcodeDeployClient{ctrl: ctrl}
+	mock.recorder = &MockcodeDeployClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockcodeDeployClient) EXPECT() *MockcodeDeployClientMockRecorder {
+	return m.recorder
+}
+
+// ECSDeployment mocks base method.
+func (m *MockcodeDeployClient) ECSDeployment(deploymentID string) (*codedeploy.ECSDeployment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ECSDeployment", deploymentID)
+	ret0, _ := ret[0].(*codedeploy.ECSDeployment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ECSDeployment indicates an expected call of ECSDeployment.
+func (mr *MockcodeDeployClientMockRecorder) ECSDeployment(deploymentID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ECSDeployment", reflect.TypeOf((*MockcodeDeployClient)(nil).ECSDeployment), deploymentID)
+}
+
 // MockcfnClient is a mock of cfnClient interface.
 type MockcfnClient struct {
 	ctrl     *gomock.Controller
@@ -84,6 +84,10 @@ func (s *BackendService) Template() (string, error) {
 	if err != nil {
 		return "", err
 	}
+	storage, err := s.storageOpts(s.manifest.Storage)
+	if err != nil {
+		return "", err
+	}
 	sidecars, err := convertSidecar(s.manifest.Sidecars)
 	if err != nil {
 		return "", fmt.Errorf("convert the sidecar configuration for service %s: %w", s.name, err)
@@ -115,9 +119,21 @@ func (s *BackendService) Template() (string, error) {
 	if err != nil {
 		return "", err
 	}
+	preHooks, err := convertDeployHooks(s.manifest.DeployConfig.PreHooks)
+	if err != nil {
+		return "", err
+	}
+	var preHookLambda string
+	if len(preHooks) != 0 {
+		lambda, err := s.parser.Read(preHookPath)
+		if err != nil {
+			return "", fmt.Errorf("read pre hook lambda: %w", err)
+		}
+		preHookLambda = lambda.String()
+	}
 	content, err := s.parser.ParseBackendService(template.WorkloadOpts{
 		Variables:                s.manifest.BackendServiceConfig.Variables,
-		Secrets:                  s.manifest.BackendServiceConfig.Secrets,
+		Secrets:                  convertSecrets(s.manifest.BackendServiceConfig.Secrets),
 		NestedStack:              addonsOutputs,
 		AddonsExtraParams:        addonsParams,
 		Sidecars:                 sidecars,
@@ -131,7 +147,9 @@ func (s *BackendService) Template() (string, error) {
 		DockerLabels:             s.manifest.ImageConfig.Image.DockerLabels,
 		DesiredCountLambda:       desiredCountLambda.String(),
 		EnvControllerLambda:      envControllerLambda.String(),
-		Storage:                  convertStorageOpts(s.manifest.Name, s.manifest.Storage),
+		PreHookLambda:            preHookLambda,
+		PreHooks:                 preHooks,
+		Storage:                  storage,
 		Network:                  convertNetworkConfig(s.manifest.Network),
 		EntryPoint:               entrypoint,
 		Command:                  command,
@@ -36,6 +36,8 @@ const (
 	envParamAppDNSKey                = "AppDNSName"
 	envParamAppDNSDelegationRoleKey  = "AppDNSDelegationRole"
 	EnvParamAliasesKey               = "Aliases"
+	EnvParamAddonsTemplateURLKey     = "AddonsTemplateURL"
+	EnvParamEFSOptionsOwnerKey       = "EFSOptionsOwner"
 
 	// Output keys.
 	EnvOutputVPCID                   = "VpcId"
@@ -134,6 +136,10 @@ func (e *EnvStackConfig) Parameters() ([]*cloudformation.Parameter, error) {
 			ParameterKey:   aws.String(EnvParamServiceDiscoveryEndpoint),
 			ParameterValue: aws.String(fmt.Sprintf(fmtServiceDiscoveryEndpoint, e.in.Name, e.in.App.Name)),
 		},
+		{
+			ParameterKey:   aws.String(EnvParamAddonsTemplateURLKey),
+			ParameterValue: aws.String(e.in.AddonsTemplateURL),
+		},
 	}, nil
 }
 
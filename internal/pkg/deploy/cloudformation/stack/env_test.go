@@ -76,6 +76,8 @@ func TestEnv_Parameters(t *testing.T) {
 	deploymentInput := mockDeployEnvironmentInput()
 	deploymentInputWithDNS := mockDeployEnvironmentInput()
 	deploymentInputWithDNS.App.DNSName = "ecs.aws"
+	deploymentInputWithAddons := mockDeployEnvironmentInput()
+	deploymentInputWithAddons.AddonsTemplateURL = "https://mockbucket.s3-us-west-2.amazonaws.com/env.addons.stack.yml"
 	testCases := map[string]struct {
 		input *deploy.CreateEnvironmentInput
 		want  []*cloudformation.Parameter
@@ -107,6 +109,10 @@ func TestEnv_Parameters(t *testing.T) {
 					ParameterKey:   aws.String(EnvParamServiceDiscoveryEndpoint),
 					ParameterValue: aws.String("env.project.local"),
 				},
+				{
+					ParameterKey:   aws.String(EnvParamAddonsTemplateURLKey),
+					ParameterValue: aws.String(""),
+				},
 			},
 		},
 		"with DNS": {
@@ -136,6 +142,43 @@ func TestEnv_Parameters(t *testing.T) {
 					ParameterKey:   aws.String(EnvParamServiceDiscoveryEndpoint),
 					ParameterValue: aws.String("env.project.local"),
 				},
+				{
+					ParameterKey:   aws.String(EnvParamAddonsTemplateURLKey),
+					ParameterValue: aws.String(""),
+				},
+			},
+		},
+		"with addons": {
+			input: deploymentInputWithAddons,
+			want: []*cloudformation.Parameter{
+				{
+					ParameterKey:   aws.String(envParamAppNameKey),
+					ParameterValue: aws.String(deploymentInputWithAddons.App.Name),
+				},
+				{
+					ParameterKey:   aws.String(envParamEnvNameKey),
+					ParameterValue: aws.String(deploymentInputWithAddons.Name),
+				},
+				{
+					ParameterKey:   aws.String(envParamToolsAccountPrincipalKey),
+					ParameterValue: aws.String(deploymentInputWithAddons.App.AccountPrincipalARN),
+				},
+				{
+					ParameterKey:   aws.String(envParamAppDNSKey),
+					ParameterValue: aws.String(""),
+				},
+				{
+					ParameterKey:   aws.String(envParamAppDNSDelegationRoleKey),
+					ParameterValue: aws.String(""),
+				},
+				{
+					ParameterKey:   aws.String(EnvParamServiceDiscoveryEndpoint),
+					ParameterValue: aws.String("env.project.local"),
+				},
+				{
+					ParameterKey:   aws.String(EnvParamAddonsTemplateURLKey),
+					ParameterValue: aws.String("https://mockbucket.s3-us-west-2.amazonaws.com/env.addons.stack.yml"),
+				},
 			},
 		},
 	}
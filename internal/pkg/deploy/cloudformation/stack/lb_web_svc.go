@@ -21,6 +21,8 @@ const (
 	lbWebSvcRulePriorityGeneratorPath = "custom-resources/alb-rule-priority-generator.js"
 	desiredCountGeneratorPath         = "custom-resources/desired-count-delegation.js"
 	envControllerPath                 = "custom-resources/env-controller.js"
+	preHookPath                       = "custom-resources/pre-hook.js"
+	blueGreenDeploymentPath           = "custom-resources/blue-green-deployment.js"
 )
 
 // Parameter logical IDs for a load balanced web service.
@@ -148,6 +150,10 @@ func (s *LoadBalancedWebService) Template() (string, error) {
 	if err != nil {
 		return "", err
 	}
+	storage, err := s.storageOpts(s.manifest.Storage)
+	if err != nil {
+		return "", err
+	}
 	sidecars, err := convertSidecar(s.manifest.Sidecars)
 	if err != nil {
 		return "", fmt.Errorf("convert the sidecar configuration for service %s: %w", s.name, err)
@@ -202,9 +208,35 @@ func (s *LoadBalancedWebService) Template() (string, error) {
 	if err != nil {
 		return "", err
 	}
+	preHooks, err := convertDeployHooks(s.manifest.DeployConfig.PreHooks)
+	if err != nil {
+		return "", err
+	}
+	var preHookLambda string
+	if len(preHooks) != 0 {
+		lambda, err := s.parser.Read(preHookPath)
+		if err != nil {
+			return "", fmt.Errorf("read pre hook lambda: %w", err)
+		}
+		preHookLambda = lambda.String()
+	}
+	blueGreen := convertBlueGreenDeployment(s.manifest.Deployment)
+	var blueGreenLambda string
+	if blueGreen != nil {
+		if addonsOutputs != nil && len(addonsOutputs.SecurityGroupOutputs) > 0 {
+			// ECS can't update the security groups of a service deployed by CodeDeploy once it's created.
+			return "", fmt.Errorf("addons of service %s cannot output security groups with a %s deployment strategy",
+				s.name, manifest.DeploymentStrategyBlueGreen)
+		}
+		lambda, err := s.parser.Read(blueGreenDeploymentPath)
+		if err != nil {
+			return "", fmt.Errorf("read blue/green deployment lambda: %w", err)
+		}
+		blueGreenLambda = lambda.String()
+	}
 	content, err := s.parser.ParseLoadBalancedWebService(template.WorkloadOpts{
 		Variables:                      s.manifest.TaskConfig.Variables,
-		Secrets:                        s.manifest.TaskConfig.Secrets,
+		Secrets:                        convertSecrets(s.manifest.TaskConfig.Secrets),
 		Aliases:                        aliases,
 		NestedStack:                    addonsOutputs,
 		AddonsExtraParams:              addonsParams,
@@ -223,7 +255,11 @@ func (s *LoadBalancedWebService) Template() (string, error) {
 		RulePriorityLambda:             rulePriorityLambda.String(),
 		DesiredCountLambda:             desiredCountLambda.String(),
 		EnvControllerLambda:            envControllerLambda.String(),
-		Storage:                        convertStorageOpts(s.manifest.Name, s.manifest.Storage),
+		PreHookLambda:                  preHookLambda,
+		PreHooks:                       preHooks,
+		BlueGreen:                      blueGreen,
+		BlueGreenDeploymentLambda:      blueGreenLambda,
+		Storage:                        storage,
 		Network:                        convertNetworkConfig(s.manifest.Network),
 		EntryPoint:                     entrypoint,
 		Command:                        command,
@@ -211,6 +211,31 @@ Outputs:
 			wantedTemplate: "",
 			wantedError:    fmt.Errorf("some error"),
 		},
+		"addons with security group outputs for a blue/green service": {
+			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, c *LoadBalancedWebService) {
+				m := mocks.NewMockloadBalancedWebSvcReadParser(ctrl)
+				m.EXPECT().Read(lbWebSvcRulePriorityGeneratorPath).Return(&template.Content{Buffer: bytes.NewBufferString("something")}, nil)
+				m.EXPECT().Read(desiredCountGeneratorPath).Return(&template.Content{Buffer: bytes.NewBufferString("something")}, nil)
+				m.EXPECT().Read(envControllerPath).Return(&template.Content{Buffer: bytes.NewBufferString("something")}, nil)
+				mft := *testLBWebServiceManifest
+				mft.Deployment = manifest.DeploymentConfiguration{
+					Strategy: aws.String(manifest.DeploymentStrategyBlueGreen),
+					TestPort: aws.Uint16(8080),
+				}
+				addons := mockAddons{
+					tpl: `Resources:
+  MySecurityGroup:
+    Type: AWS::EC2::SecurityGroup
+Outputs:
+  MySecurityGroupId:
+    Value: !Ref MySecurityGroup`,
+				}
+				c.parser = m
+				c.manifest = &mft
+				c.wkld.addons = addons
+			},
+			wantedError: fmt.Errorf("addons of service frontend cannot output security groups with a blue_green deployment strategy"),
+		},
 		"render template without addons": {
 			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, c *LoadBalancedWebService) {
 				m := mocks.NewMockloadBalancedWebSvcReadParser(ctrl)
@@ -4,6 +4,8 @@
 package stack
 
 import (
+	"strconv"
+
 	"github.com/aws/aws-sdk-go/service/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/template"
 
@@ -39,6 +41,7 @@ func (p *pipelineStackConfig) Template() (string, error) {
 			_, ok := source.(connectionName)
 			return ok
 		},
+		"quote": strconv.Quote,
 	}))
 	if err != nil {
 		return "", err
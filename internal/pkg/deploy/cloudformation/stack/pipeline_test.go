@@ -15,6 +15,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/template/mocks"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
 
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 )
@@ -121,6 +122,177 @@ func mockAssociatedEnv(envName, region string) *deploy.AssociatedEnvironment {
 	}
 }
 
+func TestPipelineStackConfig_Template_BuildProject(t *testing.T) {
+	type policyStatement struct {
+		Action   []string    `yaml:"Action"`
+		Resource interface{} `yaml:"Resource"`
+	}
+	type pipelineTemplate struct {
+		Resources struct {
+			BuildProjectPolicy struct {
+				Properties struct {
+					PolicyDocument struct {
+						Statement []policyStatement `yaml:"Statement"`
+					} `yaml:"PolicyDocument"`
+				} `yaml:"Properties"`
+			} `yaml:"BuildProjectPolicy"`
+			BuildProject struct {
+				Properties struct {
+					Cache       map[string]interface{} `yaml:"Cache"`
+					Environment struct {
+						ComputeType          string              `yaml:"ComputeType"`
+						PrivilegedMode       bool                `yaml:"PrivilegedMode"`
+						EnvironmentVariables []map[string]string `yaml:"EnvironmentVariables"`
+					} `yaml:"Environment"`
+					Source struct {
+						BuildSpec string `yaml:"BuildSpec"`
+					} `yaml:"Source"`
+				} `yaml:"Properties"`
+			} `yaml:"BuildProject"`
+		} `yaml:"Resources"`
+	}
+	defaultEnvVars := []map[string]string{
+		{"Name": "AWS_ACCOUNT_ID", "Value": "${AWS::AccountId}"},
+		{"Name": "PARTITION", "Value": "AWS::Partition"},
+	}
+	testCases := map[string]struct {
+		inBuild *deploy.Build
+
+		wantedCache           map[string]interface{}
+		wantedComputeType     string
+		wantedPrivilegedMode  bool
+		wantedEnvVars         []map[string]string
+		wantedBuildspec       string
+		wantedCachePolicyRsrc []interface{}
+	}{
+		"references the buildspec file and caches docker layers by default": {
+			inBuild: deploy.PipelineBuildFromManifest(nil),
+
+			wantedCache: map[string]interface{}{
+				"Type":  "LOCAL",
+				"Modes": []interface{}{"LOCAL_DOCKER_LAYER_CACHE"},
+			},
+			wantedComputeType:    "BUILD_GENERAL1_SMALL",
+			wantedPrivilegedMode: true,
+			wantedEnvVars:        defaultEnvVars,
+			wantedBuildspec:      "copilot/buildspec.yml",
+		},
+		"renders an inline buildspec and caches files in S3": {
+			inBuild: &deploy.Build{
+				Image:         "aws/codebuild/amazonlinux2-x86_64-standard:3.0",
+				BuildspecPath: "copilot/buildspec.yml",
+				Buildspec: `version: 0.2
+phases:
+  build:
+    commands:
+      - make build
+`,
+				ComputeType: "BUILD_GENERAL1_LARGE",
+				EnvironmentVariables: map[string]string{
+					"GOFLAGS": "-mod=vendor",
+				},
+				Cache: deploy.BuildCache{
+					S3Location: "my-cache-bucket/pipeline",
+				},
+			},
+
+			wantedCache: map[string]interface{}{
+				"Type":     "S3",
+				"Location": "my-cache-bucket/pipeline",
+			},
+			wantedComputeType: "BUILD_GENERAL1_LARGE",
+			wantedEnvVars: append(append([]map[string]string{}, defaultEnvVars...), map[string]string{
+				"Name":  "GOFLAGS",
+				"Value": "-mod=vendor",
+			}),
+			wantedBuildspec: `version: 0.2
+phases:
+  build:
+    commands:
+      - make build
+`,
+			wantedCachePolicyRsrc: []interface{}{
+				"arn:aws:s3:::my-cache-bucket",
+				"arn:aws:s3:::my-cache-bucket/*",
+			},
+		},
+		"disables the cache": {
+			inBuild: &deploy.Build{
+				Image:          "aws/codebuild/amazonlinux2-x86_64-standard:3.0",
+				BuildspecPath:  "copilot/buildspec.yml",
+				ComputeType:    "BUILD_GENERAL1_SMALL",
+				PrivilegedMode: true,
+			},
+
+			wantedCache: map[string]interface{}{
+				"Type": "NO_CACHE",
+			},
+			wantedComputeType:    "BUILD_GENERAL1_SMALL",
+			wantedPrivilegedMode: true,
+			wantedEnvVars:        defaultEnvVars,
+			wantedBuildspec:      "copilot/buildspec.yml",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			in := mockCreatePipelineInput()
+			in.Build = tc.inBuild
+			pipeline := NewPipelineStackConfig(in)
+
+			// WHEN
+			tpl, err := pipeline.Template()
+
+			// THEN
+			require.NoError(t, err)
+			var got pipelineTemplate
+			require.NoError(t, yaml.Unmarshal([]byte(tpl), &got))
+			project := got.Resources.BuildProject.Properties
+			require.Equal(t, tc.wantedCache, project.Cache)
+			require.Equal(t, tc.wantedComputeType, project.Environment.ComputeType)
+			require.Equal(t, tc.wantedPrivilegedMode, project.Environment.PrivilegedMode)
+			require.Equal(t, tc.wantedEnvVars, project.Environment.EnvironmentVariables)
+			require.Equal(t, tc.wantedBuildspec, project.Source.BuildSpec)
+
+			var cachePolicyRsrc []interface{}
+			for _, statement := range got.Resources.BuildProjectPolicy.Properties.PolicyDocument.Statement {
+				if len(statement.Action) > 0 && statement.Action[len(statement.Action)-1] == "s3:GetBucketLocation" {
+					cachePolicyRsrc = statement.Resource.([]interface{})
+				}
+			}
+			require.Equal(t, tc.wantedCachePolicyRsrc, cachePolicyRsrc)
+		})
+	}
+}
+
+func TestPipelineStackConfig_Template_ECRSource(t *testing.T) {
+	// GIVEN
+	in := mockCreatePipelineInput()
+	in.Source = &deploy.ECRSource{
+		ProviderName:    "ECR",
+		RepositoryName:  "upstream/api",
+		ImageTag:        "release",
+		Workload:        "api",
+		WorkspaceBucket: "chicken-us-west-2",
+		WorkspaceKey:    "manual/pipelines/pipetest/workspace.zip",
+	}
+	in.Build = deploy.PipelineBuildFromManifest(nil)
+	pipeline := NewPipelineStackConfig(in)
+
+	// WHEN
+	tpl, err := pipeline.Template()
+
+	// THEN
+	require.NoError(t, err)
+	var got map[string]interface{}
+	require.NoError(t, yaml.Unmarshal([]byte(tpl), &got), "template should be valid YAML")
+	require.Contains(t, tpl, `EnvironmentVariables: !Sub '[{"name":"COPILOT_IMAGE_WORKLOAD","value":"api","type":"PLAINTEXT"},{"name":"COPILOT_IMAGE_DIGEST","value":"#{SourceVariables.ImageDigest}","type":"PLAINTEXT"},{"name":"COPILOT_IMAGE_URI","value":"${AWS::AccountId}.dkr.ecr.${AWS::Region}.${AWS::URLSuffix}/upstream/api@#{SourceVariables.ImageDigest}","type":"PLAINTEXT"}]'`,
+		"expected the build action to copy the pushed image to the repositories of the workload and deploy it by its digest")
+	require.Contains(t, tpl, `Resource: !Sub 'arn:${AWS::Partition}:ecr:${AWS::Region}:${AWS::AccountId}:repository/upstream/api'`,
+		"expected the build project to be able to pull the pushed image")
+}
+
 func mockCreatePipelineInput() *deploy.CreatePipelineInput {
 	return &deploy.CreatePipelineInput{
 		AppName: projectName,
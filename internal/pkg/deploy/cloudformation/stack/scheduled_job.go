@@ -4,6 +4,7 @@
 package stack
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"regexp"
@@ -12,7 +13,9 @@ import (
 	"unicode"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/aws-sdk-go/service/sfn"
 	"github.com/aws/copilot-cli/internal/pkg/addon"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
 	"github.com/aws/copilot-cli/internal/pkg/template"
@@ -127,6 +130,10 @@ func (j *ScheduledJob) Template() (string, error) {
 	if err != nil {
 		return "", err
 	}
+	storage, err := j.storageOpts(j.manifest.Storage)
+	if err != nil {
+		return "", err
+	}
 	sidecars, err := convertSidecar(j.manifest.Sidecars)
 	if err != nil {
 		return "", fmt.Errorf("convert the sidecar configuration for job %s: %w", j.name, err)
@@ -135,9 +142,9 @@ func (j *ScheduledJob) Template() (string, error) {
 	if err != nil {
 		return "", fmt.Errorf(`convert "publish" field for job %s: %w`, j.name, err)
 	}
-	schedule, err := j.awsSchedule()
+	trigger, err := j.trigger()
 	if err != nil {
-		return "", fmt.Errorf("convert schedule for job %s: %w", j.name, err)
+		return "", err
 	}
 	stateMachine, err := j.stateMachineOpts()
 	if err != nil {
@@ -158,16 +165,18 @@ func (j *ScheduledJob) Template() (string, error) {
 
 	content, err := j.parser.ParseScheduledJob(template.WorkloadOpts{
 		Variables:                j.manifest.Variables,
-		Secrets:                  j.manifest.Secrets,
+		Secrets:                  convertSecrets(j.manifest.Secrets),
 		NestedStack:              addonsOutputs,
 		AddonsExtraParams:        addonsParams,
 		Sidecars:                 sidecars,
-		ScheduleExpression:       schedule,
+		ScheduleExpression:       trigger.schedule,
+		EventPattern:             trigger.eventPattern,
+		TopicTrigger:             trigger.topic,
 		StateMachine:             stateMachine,
 		HealthCheck:              convertContainerHealthCheck(j.manifest.ImageConfig.HealthCheck),
 		LogConfig:                convertLogging(j.manifest.Logging),
 		DockerLabels:             j.manifest.ImageConfig.Image.DockerLabels,
-		Storage:                  convertStorageOpts(j.manifest.Name, j.manifest.Storage),
+		Storage:                  storage,
 		Network:                  convertNetworkConfig(j.manifest.Network),
 		EntryPoint:               entrypoint,
 		Command:                  command,
@@ -195,20 +204,20 @@ func (j *ScheduledJob) Parameters() ([]*cloudformation.Parameter, error) {
 	if err != nil {
 		return nil, err
 	}
-	schedule, err := j.awsSchedule()
-	if err != nil {
-		return nil, err
-	}
-	return append(wkldParams, []*cloudformation.Parameter{
-		{
+	if j.manifest.On.Schedule != nil {
+		schedule, err := j.awsSchedule()
+		if err != nil {
+			return nil, err
+		}
+		wkldParams = append(wkldParams, &cloudformation.Parameter{
 			ParameterKey:   aws.String(ScheduledJobScheduleParamKey),
 			ParameterValue: aws.String(schedule),
-		},
-		{
-			ParameterKey:   aws.String(WorkloadEnvFileARNParamKey),
-			ParameterValue: aws.String(j.rc.EnvFileARN),
-		},
-	}...), nil
+		})
+	}
+	return append(wkldParams, &cloudformation.Parameter{
+		ParameterKey:   aws.String(WorkloadEnvFileARNParamKey),
+		ParameterValue: aws.String(j.rc.EnvFileARN),
+	}), nil
 }
 
 // SerializedParameters returns the CloudFormation stack's parameters serialized
@@ -217,6 +226,98 @@ func (j *ScheduledJob) SerializedParameters() (string, error) {
 	return j.templateConfiguration(j)
 }
 
+// jobTrigger holds the converted trigger of a job. Only one of the fields is set.
+type jobTrigger struct {
+	schedule     string
+	eventPattern string
+	topic        *template.TopicSubscription
+}
+
+// trigger converts the "on" field of the manifest to the event source that triggers the job.
+func (j *ScheduledJob) trigger() (jobTrigger, error) {
+	on := j.manifest.On
+	switch {
+	case on.S3 != nil:
+		pattern, err := s3EventPattern(on.S3)
+		if err != nil {
+			return jobTrigger{}, fmt.Errorf("convert s3 trigger for job %s: %w", j.name, err)
+		}
+		return jobTrigger{eventPattern: pattern}, nil
+	case on.EventPattern != nil:
+		pattern, err := json.Marshal(on.EventPattern)
+		if err != nil {
+			return jobTrigger{}, fmt.Errorf("convert event pattern for job %s: %w", j.name, err)
+		}
+		return jobTrigger{eventPattern: string(pattern)}, nil
+	case on.After != nil:
+		pattern, err := j.afterEventPattern(aws.StringValue(on.After))
+		if err != nil {
+			return jobTrigger{}, fmt.Errorf("convert after trigger for job %s: %w", j.name, err)
+		}
+		return jobTrigger{eventPattern: pattern}, nil
+	case on.Topic != nil:
+		return jobTrigger{
+			topic: &template.TopicSubscription{
+				Name:    on.Topic.Name,
+				Service: on.Topic.Service,
+			},
+		}, nil
+	default:
+		schedule, err := j.awsSchedule()
+		if err != nil {
+			return jobTrigger{}, fmt.Errorf("convert schedule for job %s: %w", j.name, err)
+		}
+		return jobTrigger{schedule: schedule}, nil
+	}
+}
+
+// s3EventPattern returns the JSON-encoded EventBridge event pattern that matches objects created in the bucket.
+func s3EventPattern(trigger *manifest.S3JobTrigger) (string, error) {
+	detail := map[string]interface{}{
+		"bucket": map[string]interface{}{
+			"name": []string{aws.StringValue(trigger.Bucket)},
+		},
+	}
+	if prefix := aws.StringValue(trigger.Prefix); prefix != "" {
+		detail["object"] = map[string]interface{}{
+			"key": []interface{}{
+				map[string]string{"prefix": prefix},
+			},
+		}
+	}
+	pattern, err := json.Marshal(map[string]interface{}{
+		"source":      []string{"aws.s3"},
+		"detail-type": []string{"Object Created"},
+		"detail":      detail,
+	})
+	if err != nil {
+		return "", err
+	}
+	return string(pattern), nil
+}
+
+// afterEventPattern returns the JSON-encoded EventBridge event pattern that matches successful executions
+// of the state machine of another job in the same environment.
+func (j *ScheduledJob) afterEventPattern(job string) (string, error) {
+	partition, ok := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), j.rc.Region)
+	if !ok {
+		return "", fmt.Errorf("find the partition for region %s", j.rc.Region)
+	}
+	stateMachineARN := fmt.Sprintf("arn:%s:states:%s:%s:stateMachine:%s-%s-%s", partition.ID(), j.rc.Region, j.rc.AccountID, j.app, j.env, job)
+	pattern, err := json.Marshal(map[string]interface{}{
+		"source":      []string{"aws.states"},
+		"detail-type": []string{"Step Functions Execution Status Change"},
+		"detail": map[string]interface{}{
+			"status":          []string{sfn.ExecutionStatusSucceeded},
+			"stateMachineArn": []string{stateMachineARN},
+		},
+	})
+	if err != nil {
+		return "", err
+	}
+	return string(pattern), nil
+}
+
 // awsSchedule converts the Schedule string to the format required by Cloudwatch Events
 // https://docs.aws.amazon.com/lambda/latest/dg/services-cloudwatchevents-expressions.html
 // Cron expressions must have an sixth "year" field, and must contain at least one ? (either-or)
@@ -378,7 +479,7 @@ func toAWSCron(schedule string) (string, error) {
 	return fmt.Sprintf(fmtCronScheduleExpression, strings.Join(sched, " ")), nil
 }
 
-// StateMachine converts the Timeout and Retries fields to an instance of template.StateMachineOpts
+// StateMachine converts the Timeout, Retries and Concurrency fields to an instance of template.StateMachineOpts
 // It also performs basic validations to provide a fast feedback loop to the customer.
 func (j *ScheduledJob) stateMachineOpts() (*template.StateMachineOpts, error) {
 	var timeoutSeconds *int
@@ -404,7 +505,8 @@ func (j *ScheduledJob) stateMachineOpts() (*template.StateMachineOpts, error) {
 		retries = aws.Int(inRetries)
 	}
 	return &template.StateMachineOpts{
-		Timeout: timeoutSeconds,
-		Retries: retries,
+		Timeout:     timeoutSeconds,
+		Retries:     retries,
+		Concurrency: j.manifest.Concurrency,
 	}, nil
 }
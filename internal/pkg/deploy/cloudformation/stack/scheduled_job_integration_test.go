@@ -23,14 +23,33 @@ import (
 )
 
 const (
-	jobManifestPath   = "job-manifest.yml"
-	jobStackPath      = "job-test.stack.yml"
 	jobParamsPath     = "job-test.params.json"
 	envControllerPath = "custom-resources/env-controller.js"
 )
 
 func TestScheduledJob_Template(t *testing.T) {
-	path := filepath.Join("testdata", "workloads", jobManifestPath)
+	testCases := map[string]struct {
+		manifestPath string
+		stackPath    string
+	}{
+		"with managed EFS": {
+			manifestPath: "job-manifest.yml",
+			stackPath:    "job-test.stack.yml",
+		},
+		"with managed EFS options": {
+			manifestPath: "job-efs-options-manifest.yml",
+			stackPath:    "job-efs-options-test.stack.yml",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			testScheduledJobTemplate(t, tc.manifestPath, tc.stackPath)
+		})
+	}
+}
+
+func testScheduledJobTemplate(t *testing.T, manifestPath, stackPath string) {
+	path := filepath.Join("testdata", "workloads", manifestPath)
 	manifestBytes, err := ioutil.ReadFile(path)
 	require.NoError(t, err)
 
@@ -65,7 +84,7 @@ func TestScheduledJob_Template(t *testing.T) {
 		mActual := make(map[interface{}]interface{})
 		require.NoError(t, yaml.Unmarshal(actualBytes, mActual))
 
-		expected, err := ioutil.ReadFile(filepath.Join("testdata", "workloads", jobStackPath))
+		expected, err := ioutil.ReadFile(filepath.Join("testdata", "workloads", stackPath))
 		require.NoError(t, err, "should be able to read expected bytes")
 		expectedBytes := []byte(expected)
 		mExpected := make(map[interface{}]interface{})
@@ -346,9 +346,10 @@ func TestScheduledJob_awsSchedule(t *testing.T) {
 
 func TestScheduledJob_stateMachine(t *testing.T) {
 	testCases := map[string]struct {
-		inputTimeout    string
-		inputRetries    int
-		wantedConfig    template.StateMachineOpts
+		inputTimeout     string
+		inputRetries     int
+		inputConcurrency *int
+		wantedConfig     template.StateMachineOpts
 		wantedError     error
 		wantedErrorType interface{}
 	}{
@@ -374,6 +375,12 @@ func TestScheduledJob_stateMachine(t *testing.T) {
 				Retries: aws.Int(2),
 			},
 		},
+		"just concurrency": {
+			inputConcurrency: aws.Int(1),
+			wantedConfig: template.StateMachineOpts{
+				Concurrency: aws.Int(1),
+			},
+		},
 		"negative retries": {
 			inputRetries: -4,
 			wantedError:  errors.New("number of retries cannot be negative"),
@@ -406,6 +413,9 @@ func TestScheduledJob_stateMachine(t *testing.T) {
 							Retries: aws.Int(tc.inputRetries),
 							Timeout: aws.String(tc.inputTimeout),
 						},
+						JobConcurrencyConfig: manifest.JobConcurrencyConfig{
+							Concurrency: tc.inputConcurrency,
+						},
 					},
 				},
 			}
@@ -421,6 +431,7 @@ func TestScheduledJob_stateMachine(t *testing.T) {
 				require.NoError(t, err)
 				require.Equal(t, aws.IntValue(tc.wantedConfig.Retries), aws.IntValue(parsedStateMachine.Retries))
 				require.Equal(t, aws.IntValue(tc.wantedConfig.Timeout), aws.IntValue(parsedStateMachine.Timeout))
+				require.Equal(t, tc.wantedConfig.Concurrency, parsedStateMachine.Concurrency)
 			}
 		})
 	}
@@ -603,3 +614,127 @@ func TestScheduledJob_SerializedParameters(t *testing.T) {
 		})
 	}
 }
+
+func TestScheduledJob_trigger(t *testing.T) {
+	testCases := map[string]struct {
+		in       manifest.JobTriggerConfig
+		inRegion string
+
+		wanted      jobTrigger
+		wantedError error
+	}{
+		"converts a schedule": {
+			in: manifest.JobTriggerConfig{
+				Schedule: aws.String("@daily"),
+			},
+			wanted: jobTrigger{
+				schedule: "cron(0 0 * * ? *)",
+			},
+		},
+		"error if schedule is invalid": {
+			in: manifest.JobTriggerConfig{
+				Schedule: aws.String(""),
+			},
+			wantedError: errors.New(`convert schedule for job mailer: missing required field "schedule" in manifest for job mailer`),
+		},
+		"converts an s3 trigger without a prefix": {
+			in: manifest.JobTriggerConfig{
+				S3: &manifest.S3JobTrigger{
+					Bucket: aws.String("uploads"),
+				},
+			},
+			wanted: jobTrigger{
+				eventPattern: `{"detail":{"bucket":{"name":["uploads"]}},"detail-type":["Object Created"],"source":["aws.s3"]}`,
+			},
+		},
+		"converts an s3 trigger with a prefix": {
+			in: manifest.JobTriggerConfig{
+				S3: &manifest.S3JobTrigger{
+					Bucket: aws.String("uploads"),
+					Prefix: aws.String("reports/"),
+				},
+			},
+			wanted: jobTrigger{
+				eventPattern: `{"detail":{"bucket":{"name":["uploads"]},"object":{"key":[{"prefix":"reports/"}]}},"detail-type":["Object Created"],"source":["aws.s3"]}`,
+			},
+		},
+		"converts an event pattern": {
+			in: manifest.JobTriggerConfig{
+				EventPattern: map[string]interface{}{
+					"source":      []interface{}{"aws.ecr"},
+					"detail-type": []interface{}{"ECR Image Action"},
+				},
+			},
+			wanted: jobTrigger{
+				eventPattern: `{"detail-type":["ECR Image Action"],"source":["aws.ecr"]}`,
+			},
+		},
+		"converts an after trigger": {
+			in: manifest.JobTriggerConfig{
+				After: aws.String("extract"),
+			},
+			wanted: jobTrigger{
+				eventPattern: `{"detail":{"stateMachineArn":["arn:aws:states:us-west-2:123456789012:stateMachine:etl-test-extract"],"status":["SUCCEEDED"]},"detail-type":["Step Functions Execution Status Change"],"source":["aws.states"]}`,
+			},
+		},
+		"error if the partition of an after trigger cannot be found": {
+			in: manifest.JobTriggerConfig{
+				After: aws.String("extract"),
+			},
+			inRegion:    "unknown-region",
+			wantedError: errors.New("convert after trigger for job mailer: find the partition for region unknown-region"),
+		},
+		"converts a topic": {
+			in: manifest.JobTriggerConfig{
+				Topic: &manifest.TopicJobTrigger{
+					Name:    aws.String("orders"),
+					Service: aws.String("api"),
+				},
+			},
+			wanted: jobTrigger{
+				topic: &template.TopicSubscription{
+					Name:    aws.String("orders"),
+					Service: aws.String("api"),
+				},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			region := "us-west-2"
+			if tc.inRegion != "" {
+				region = tc.inRegion
+			}
+			job := &ScheduledJob{
+				ecsWkld: &ecsWkld{
+					wkld: &wkld{
+						name: "mailer",
+						app:  "etl",
+						env:  "test",
+						rc: RuntimeConfig{
+							AccountID: "123456789012",
+							Region:    region,
+						},
+					},
+				},
+				manifest: &manifest.ScheduledJob{
+					ScheduledJobConfig: manifest.ScheduledJobConfig{
+						On: tc.in,
+					},
+				},
+			}
+
+			// WHEN
+			got, err := job.trigger()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
@@ -36,6 +36,12 @@ const (
 	defaultNLBProtocol     = "TCP_UDP"
 )
 
+// CodeDeploy traffic routing types for blue/green deployments.
+const (
+	trafficRoutingTimeBasedCanary = "TimeBasedCanary"
+	trafficRoutingTimeBasedLinear = "TimeBasedLinear"
+)
+
 // Supported capacityproviders for Fargate services
 const (
 	capacityProviderFargateSpot = "FARGATE_SPOT"
@@ -46,6 +52,22 @@ var (
 	taskDefOverrideRulePrefixes = []string{"Resources", "TaskDefinition", "Properties"}
 )
 
+// convertSecrets converts the manifest secrets of a container into the format parsable by the templates pkg.
+func convertSecrets(secrets map[string]manifest.Secret) map[string]template.Secret {
+	if len(secrets) == 0 {
+		return nil
+	}
+	m := make(map[string]template.Secret)
+	for name, secret := range secrets {
+		if secret.IsSecretsManagerName() {
+			m[name] = template.SecretFromSecretsManager(secret.Value(), aws.StringValue(secret.FromSecretsManager.Key))
+			continue
+		}
+		m[name] = template.SecretFromSSMOrARN(secret.Value())
+	}
+	return m
+}
+
 // convertSidecar converts the manifest sidecar configuration into a format parsable by the templates pkg.
 func convertSidecar(s map[string]*manifest.SidecarConfig) ([]*template.SidecarOpts, error) {
 	if s == nil {
@@ -73,7 +95,7 @@ func convertSidecar(s map[string]*manifest.SidecarConfig) ([]*template.SidecarOp
 			Port:       port,
 			Protocol:   protocol,
 			CredsParam: config.CredsParam,
-			Secrets:    config.Secrets,
+			Secrets:    convertSecrets(config.Secrets),
 			Variables:  config.Variables,
 			Storage: template.SidecarStorageOpts{
 				MountPoints: mp,
@@ -388,6 +410,8 @@ func convertStorageOpts(wlName *string, in manifest.Storage) *template.StorageOp
 		MountPoints:       convertMountPoints(in.Volumes),
 		EFSPerms:          convertEFSPermissions(in.Volumes),
 		ManagedVolumeInfo: convertManagedFSInfo(wlName, in.Volumes),
+		ManagedFSOptions:  convertManagedFSOptions(in.Volumes),
+		EnvAddons:         in.EnvAddons,
 	}
 }
 
@@ -473,16 +497,21 @@ func convertManagedFSInfo(wlName *string, input map[string]*manifest.Volume) *te
 		if volume.EmptyVolume() || !volume.EFS.UseManagedFS() {
 			continue
 		}
+		// A workload mounting the directory of another workload gets its own access point to that directory.
+		dirName := wlName
+		if volume.EFS.Advanced.FromWorkload != nil {
+			dirName = volume.EFS.Advanced.FromWorkload
+		}
 		uid := volume.EFS.Advanced.UID
 		gid := volume.EFS.Advanced.GID
 		if uid == nil && gid == nil {
-			crc := aws.Uint32(getRandomUIDGID(wlName))
+			crc := aws.Uint32(getRandomUIDGID(dirName))
 			uid = crc
 			gid = crc
 		}
 		output = &template.ManagedVolumeCreationInfo{
 			Name:    aws.String(name),
-			DirName: wlName,
+			DirName: dirName,
 			UID:     uid,
 			GID:     gid,
 		}
@@ -490,6 +519,41 @@ func convertManagedFSInfo(wlName *string, input map[string]*manifest.Volume) *te
 	return output
 }
 
+// convertManagedFSOptions returns the settings of the Copilot-managed file system specified by the managed EFS volume.
+func convertManagedFSOptions(input map[string]*manifest.Volume) *template.ManagedFileSystemOptions {
+	for _, volume := range input {
+		if volume.EmptyVolume() || !volume.EFS.UseManagedFS() || volume.EFS.Advanced.EmptyFileSystemConfig() {
+			continue
+		}
+		config := volume.EFS.Advanced
+		var opts template.ManagedFileSystemOptions
+		if config.Backups != nil {
+			opts.BackupPolicy = disabled
+			if aws.BoolValue(config.Backups) {
+				opts.BackupPolicy = enabled
+			}
+		}
+		if days := config.Lifecycle.TransitionToIA; days != nil {
+			opts.TransitionToIA = fmt.Sprintf("AFTER_%d_DAYS", aws.IntValue(days))
+			if aws.IntValue(days) == 1 {
+				opts.TransitionToIA = "AFTER_1_DAY"
+			}
+		}
+		if config.Lifecycle.TransitionToPrimary != nil {
+			opts.TransitionToPrimary = "NONE"
+			if aws.BoolValue(config.Lifecycle.TransitionToPrimary) {
+				opts.TransitionToPrimary = "AFTER_1_ACCESS"
+			}
+		}
+		opts.ThroughputMode = aws.StringValue(config.ThroughputMode)
+		if config.ProvisionedThroughput != nil {
+			opts.ProvisionedThroughput = strconv.Itoa(aws.IntValue(config.ProvisionedThroughput))
+		}
+		return &opts
+	}
+	return nil
+}
+
 // getRandomUIDGID returns the 32-bit checksum of the service name for use as CreationInfo in the EFS Access Point.
 // See https://stackoverflow.com/a/14210379/5890422 for discussion of the possibility of collisions in CRC32 with
 // small numbers of hashes.
@@ -621,6 +685,49 @@ func convertCommand(command manifest.CommandOverride) ([]string, error) {
 	return out, nil
 }
 
+func convertDeployHooks(hooks []manifest.DeployHook) ([]*template.DeployHookOpts, error) {
+	var out []*template.DeployHookOpts
+	for i, hook := range hooks {
+		command, err := convertCommand(hook.Command)
+		if err != nil {
+			return nil, fmt.Errorf(`convert "pre_hooks[%d]": %w`, i, err)
+		}
+		out = append(out, &template.DeployHookOpts{
+			Command: command,
+		})
+	}
+	return out, nil
+}
+
+// convertBlueGreenDeployment returns nil if the service is not deployed with the blue/green strategy.
+func convertBlueGreenDeployment(d manifest.DeploymentConfiguration) *template.BlueGreenDeploymentOpts {
+	if !d.IsBlueGreen() {
+		return nil
+	}
+	opts := &template.BlueGreenDeploymentOpts{
+		TestPort:       aws.Uint16Value(d.TestPort),
+		RollbackAlarms: d.RollbackAlarms,
+	}
+	if d.TerminationWait != nil {
+		opts.TerminationWaitMinutes = int(d.TerminationWait.Minutes())
+	}
+	switch aws.StringValue(d.TrafficShifting.Type) {
+	case manifest.TrafficShiftingCanary:
+		opts.TrafficRouting = &template.TrafficRoutingOpts{
+			Type:            trafficRoutingTimeBasedCanary,
+			Percentage:      aws.IntValue(d.TrafficShifting.Percentage),
+			IntervalMinutes: int(d.TrafficShifting.Interval.Minutes()),
+		}
+	case manifest.TrafficShiftingLinear:
+		opts.TrafficRouting = &template.TrafficRoutingOpts{
+			Type:            trafficRoutingTimeBasedLinear,
+			Percentage:      aws.IntValue(d.TrafficShifting.Percentage),
+			IntervalMinutes: int(d.TrafficShifting.Interval.Minutes()),
+		}
+	}
+	return opts
+}
+
 func convertPublish(topics []manifest.Topic, accountID, region, app, env, svc string) (*template.PublishOpts, error) {
 	if len(topics) == 0 {
 		return nil, nil
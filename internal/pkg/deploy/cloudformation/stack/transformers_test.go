@@ -4,6 +4,7 @@
 package stack
 
 import (
+	"errors"
 	"fmt"
 	"testing"
 	"time"
@@ -19,6 +20,8 @@ import (
 func Test_convertSidecar(t *testing.T) {
 	mockImage := aws.String("mockImage")
 	mockMap := map[string]string{"foo": "bar"}
+	mockSecrets := map[string]manifest.Secret{"foo": {From: aws.String("bar")}}
+	mockWantedSecrets := map[string]template.Secret{"foo": template.SecretFromSSMOrARN("bar")}
 	mockCredsParam := aws.String("mockCredsParam")
 	testCases := map[string]struct {
 		inPort            *string
@@ -46,7 +49,7 @@ func Test_convertSidecar(t *testing.T) {
 				Port:       aws.String("2000"),
 				CredsParam: mockCredsParam,
 				Image:      mockImage,
-				Secrets:    mockMap,
+				Secrets:    mockWantedSecrets,
 				Variables:  mockMap,
 				Essential:  aws.Bool(true),
 			},
@@ -61,7 +64,7 @@ func Test_convertSidecar(t *testing.T) {
 				Protocol:   aws.String("udp"),
 				CredsParam: mockCredsParam,
 				Image:      mockImage,
-				Secrets:    mockMap,
+				Secrets:    mockWantedSecrets,
 				Variables:  mockMap,
 				Essential:  aws.Bool(true),
 			},
@@ -78,7 +81,7 @@ func Test_convertSidecar(t *testing.T) {
 				Port:       aws.String("2000"),
 				CredsParam: mockCredsParam,
 				Image:      mockImage,
-				Secrets:    mockMap,
+				Secrets:    mockWantedSecrets,
 				Variables:  mockMap,
 				Essential:  aws.Bool(true),
 				DependsOn: map[string]string{
@@ -98,7 +101,7 @@ func Test_convertSidecar(t *testing.T) {
 				Port:       aws.String("2000"),
 				CredsParam: mockCredsParam,
 				Image:      mockImage,
-				Secrets:    mockMap,
+				Secrets:    mockWantedSecrets,
 				Variables:  mockMap,
 				Essential:  aws.Bool(false),
 				DockerLabels: map[string]string{
@@ -111,7 +114,7 @@ func Test_convertSidecar(t *testing.T) {
 				Name:       aws.String("foo"),
 				CredsParam: mockCredsParam,
 				Image:      mockImage,
-				Secrets:    mockMap,
+				Secrets:    mockWantedSecrets,
 				Variables:  mockMap,
 				Essential:  aws.Bool(false),
 				EntryPoint: nil,
@@ -127,7 +130,7 @@ func Test_convertSidecar(t *testing.T) {
 				Name:       aws.String("foo"),
 				CredsParam: mockCredsParam,
 				Image:      mockImage,
-				Secrets:    mockMap,
+				Secrets:    mockWantedSecrets,
 				Variables:  mockMap,
 				Essential:  aws.Bool(false),
 				EntryPoint: []string{"bin"},
@@ -143,7 +146,7 @@ func Test_convertSidecar(t *testing.T) {
 				Name:       aws.String("foo"),
 				CredsParam: mockCredsParam,
 				Image:      mockImage,
-				Secrets:    mockMap,
+				Secrets:    mockWantedSecrets,
 				Variables:  mockMap,
 				Essential:  aws.Bool(false),
 				EntryPoint: []string{"bin", "arg"},
@@ -159,7 +162,7 @@ func Test_convertSidecar(t *testing.T) {
 				Name:       aws.String("foo"),
 				CredsParam: mockCredsParam,
 				Image:      mockImage,
-				Secrets:    mockMap,
+				Secrets:    mockWantedSecrets,
 				Variables:  mockMap,
 				Essential:  aws.Bool(false),
 				EntryPoint: nil,
@@ -175,7 +178,7 @@ func Test_convertSidecar(t *testing.T) {
 				Name:       aws.String("foo"),
 				CredsParam: mockCredsParam,
 				Image:      mockImage,
-				Secrets:    mockMap,
+				Secrets:    mockWantedSecrets,
 				Variables:  mockMap,
 				Essential:  aws.Bool(false),
 				EntryPoint: nil,
@@ -191,7 +194,7 @@ func Test_convertSidecar(t *testing.T) {
 				Name:       aws.String("foo"),
 				CredsParam: mockCredsParam,
 				Image:      mockImage,
-				Secrets:    mockMap,
+				Secrets:    mockWantedSecrets,
 				Variables:  mockMap,
 				Essential:  aws.Bool(false),
 				HealthCheck: &template.ContainerHealthCheck{
@@ -210,7 +213,7 @@ func Test_convertSidecar(t *testing.T) {
 				"foo": {
 					CredsParam:    mockCredsParam,
 					Image:         mockImage,
-					Secrets:       mockMap,
+					Secrets:       mockSecrets,
 					Variables:     mockMap,
 					Essential:     aws.Bool(tc.inEssential),
 					Port:          tc.inPort,
@@ -232,6 +235,37 @@ func Test_convertSidecar(t *testing.T) {
 	}
 }
 
+func Test_convertSecrets(t *testing.T) {
+	testCases := map[string]struct {
+		in     map[string]manifest.Secret
+		wanted map[string]template.Secret
+	}{
+		"returns nil if there are no secrets": {},
+		"converts ssm parameters and secrets manager secrets": {
+			in: map[string]manifest.Secret{
+				"GITHUB_TOKEN": {From: aws.String("GITHUB_TOKEN")},
+				"DB_PASSWORD": {FromSecretsManager: manifest.SecretsManagerSecret{
+					Name: aws.String("/copilot/myapp/test/secrets/db"),
+					Key:  aws.String("password"),
+				}},
+				"API_KEY": {FromSecretsManager: manifest.SecretsManagerSecret{
+					Name: aws.String("api_key"),
+				}},
+			},
+			wanted: map[string]template.Secret{
+				"GITHUB_TOKEN": template.SecretFromSSMOrARN("GITHUB_TOKEN"),
+				"DB_PASSWORD":  template.SecretFromSecretsManager("/copilot/myapp/test/secrets/db", "password"),
+				"API_KEY":      template.SecretFromSecretsManager("api_key", ""),
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, convertSecrets(tc.in))
+		})
+	}
+}
+
 func Test_convertAdvancedCount(t *testing.T) {
 	mockRange := manifest.IntRangeBand("1-10")
 	mockPerc := manifest.Percentage(70)
@@ -759,6 +793,26 @@ func Test_convertManagedFSInfo(t *testing.T) {
 			},
 			wantVolumes: map[string]manifest.Volume{},
 		},
+		"with directory shared from another workload": {
+			inVolumes: map[string]*manifest.Volume{
+				"uploads": {
+					EFS: manifest.EFSConfigOrBool{
+						Advanced: manifest.EFSVolumeConfiguration{
+							FromWorkload: aws.String("api"),
+						},
+					},
+					MountPointOpts: manifest.MountPointOpts{
+						ContainerPath: aws.String("/var/uploads"),
+					},
+				},
+			},
+			wantManagedConfig: &template.ManagedVolumeCreationInfo{
+				Name:    aws.String("uploads"),
+				DirName: aws.String("api"),
+				UID:     aws.Uint32(2902841359),
+				GID:     aws.Uint32(2902841359),
+			},
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -770,12 +824,102 @@ func Test_convertManagedFSInfo(t *testing.T) {
 		})
 	}
 }
+
+func Test_convertManagedFSOptions(t *testing.T) {
+	testCases := map[string]struct {
+		inEFS manifest.EFSConfigOrBool
+
+		wanted *template.ManagedFileSystemOptions
+	}{
+		"no file system options": {
+			inEFS: manifest.EFSConfigOrBool{
+				Enabled: aws.Bool(true),
+			},
+		},
+		"BYO file system": {
+			inEFS: manifest.EFSConfigOrBool{
+				Advanced: manifest.EFSVolumeConfiguration{
+					FileSystemID: aws.String("fs-1234"),
+				},
+			},
+		},
+		"with backups disabled and elastic throughput": {
+			inEFS: manifest.EFSConfigOrBool{
+				Advanced: manifest.EFSVolumeConfiguration{
+					Backups:        aws.Bool(false),
+					ThroughputMode: aws.String("elastic"),
+				},
+			},
+			wanted: &template.ManagedFileSystemOptions{
+				BackupPolicy:   "DISABLED",
+				ThroughputMode: "elastic",
+			},
+		},
+		"with lifecycle transitions and provisioned throughput": {
+			inEFS: manifest.EFSConfigOrBool{
+				Advanced: manifest.EFSVolumeConfiguration{
+					Backups: aws.Bool(true),
+					Lifecycle: manifest.EFSLifecycle{
+						TransitionToIA:      aws.Int(1),
+						TransitionToPrimary: aws.Bool(true),
+					},
+					ThroughputMode:        aws.String("provisioned"),
+					ProvisionedThroughput: aws.Int(128),
+				},
+			},
+			wanted: &template.ManagedFileSystemOptions{
+				BackupPolicy:          "ENABLED",
+				TransitionToIA:        "AFTER_1_DAY",
+				TransitionToPrimary:   "AFTER_1_ACCESS",
+				ThroughputMode:        "provisioned",
+				ProvisionedThroughput: "128",
+			},
+		},
+		"with days to transition to IA": {
+			inEFS: manifest.EFSConfigOrBool{
+				Advanced: manifest.EFSVolumeConfiguration{
+					Lifecycle: manifest.EFSLifecycle{
+						TransitionToIA:      aws.Int(90),
+						TransitionToPrimary: aws.Bool(false),
+					},
+				},
+			},
+			wanted: &template.ManagedFileSystemOptions{
+				TransitionToIA:      "AFTER_90_DAYS",
+				TransitionToPrimary: "NONE",
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// WHEN
+			got := convertManagedFSOptions(map[string]*manifest.Volume{
+				"data": {
+					EFS: tc.inEFS,
+					MountPointOpts: manifest.MountPointOpts{
+						ContainerPath: aws.String("/data"),
+					},
+				},
+			})
+
+			// THEN
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
 func Test_convertStorageOpts(t *testing.T) {
 	testCases := map[string]struct {
 		inVolumes   map[string]*manifest.Volume
 		inEphemeral *int
+		inEnvAddons []string
 		wantOpts    template.StorageOpts
 	}{
+		"environment addons": {
+			inEnvAddons: []string{"users", "assets"},
+			wantOpts: template.StorageOpts{
+				EnvAddons: []string{"users", "assets"},
+			},
+		},
 		"minimal configuration": {
 			inVolumes: map[string]*manifest.Volume{
 				"wordpress": {
@@ -1113,6 +1257,7 @@ func Test_convertStorageOpts(t *testing.T) {
 			s := manifest.Storage{
 				Volumes:   tc.inVolumes,
 				Ephemeral: tc.inEphemeral,
+				EnvAddons: tc.inEnvAddons,
 			}
 
 			// WHEN
@@ -1123,6 +1268,8 @@ func Test_convertStorageOpts(t *testing.T) {
 			require.ElementsMatch(t, tc.wantOpts.MountPoints, got.MountPoints)
 			require.ElementsMatch(t, tc.wantOpts.Volumes, got.Volumes)
 			require.Equal(t, tc.wantOpts.ManagedVolumeInfo, got.ManagedVolumeInfo)
+			require.Equal(t, tc.wantOpts.ManagedFSOptions, got.ManagedFSOptions)
+			require.Equal(t, tc.wantOpts.EnvAddons, got.EnvAddons)
 		})
 	}
 }
@@ -1239,6 +1386,133 @@ func Test_convertEphemeral(t *testing.T) {
 	}
 }
 
+func Test_convertDeployHooks(t *testing.T) {
+	testCases := map[string]struct {
+		inHooks []manifest.DeployHook
+
+		wanted      []*template.DeployHookOpts
+		wantedError error
+	}{
+		"no hooks": {},
+		"error if a command can't be parsed": {
+			inHooks: []manifest.DeployHook{
+				{
+					Command: manifest.CommandOverride{
+						String: aws.String(`./migrate "up`),
+					},
+				},
+			},
+			wantedError: errors.New(`convert "pre_hooks[0]": convert "command" to string slice: convert string into tokens using shell-style rules: EOF found when expecting closing quote`),
+		},
+		"converts each hook's command into a string slice": {
+			inHooks: []manifest.DeployHook{
+				{
+					Command: manifest.CommandOverride{
+						String: aws.String("./migrate up"),
+					},
+				},
+				{
+					Command: manifest.CommandOverride{
+						StringSlice: []string{"./seed", "--env", "test"},
+					},
+				},
+			},
+			wanted: []*template.DeployHookOpts{
+				{
+					Command: []string{"./migrate", "up"},
+				},
+				{
+					Command: []string{"./seed", "--env", "test"},
+				},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := convertDeployHooks(tc.inHooks)
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
+func Test_convertBlueGreenDeployment(t *testing.T) {
+	durationp := func(d time.Duration) *time.Duration {
+		return &d
+	}
+	testCases := map[string]struct {
+		in manifest.DeploymentConfiguration
+
+		wanted *template.BlueGreenDeploymentOpts
+	}{
+		"nil if the strategy is not blue/green": {
+			in: manifest.DeploymentConfiguration{
+				Strategy: aws.String(manifest.DeploymentStrategyRolling),
+			},
+		},
+		"shifts all traffic at once by default": {
+			in: manifest.DeploymentConfiguration{
+				Strategy: aws.String(manifest.DeploymentStrategyBlueGreen),
+				TestPort: aws.Uint16(8080),
+			},
+			wanted: &template.BlueGreenDeploymentOpts{
+				TestPort: 8080,
+			},
+		},
+		"canary traffic shifting with rollback alarms": {
+			in: manifest.DeploymentConfiguration{
+				Strategy: aws.String(manifest.DeploymentStrategyBlueGreen),
+				TrafficShifting: manifest.TrafficShifting{
+					Type:       aws.String(manifest.TrafficShiftingCanary),
+					Percentage: aws.Int(10),
+					Interval:   durationp(5 * time.Minute),
+				},
+				TestPort:        aws.Uint16(9000),
+				TerminationWait: durationp(time.Hour),
+				RollbackAlarms:  []string{"frontend-5xx"},
+			},
+			wanted: &template.BlueGreenDeploymentOpts{
+				TrafficRouting: &template.TrafficRoutingOpts{
+					Type:            "TimeBasedCanary",
+					Percentage:      10,
+					IntervalMinutes: 5,
+				},
+				TestPort:               9000,
+				TerminationWaitMinutes: 60,
+				RollbackAlarms:         []string{"frontend-5xx"},
+			},
+		},
+		"linear traffic shifting": {
+			in: manifest.DeploymentConfiguration{
+				Strategy: aws.String(manifest.DeploymentStrategyBlueGreen),
+				TrafficShifting: manifest.TrafficShifting{
+					Type:       aws.String(manifest.TrafficShiftingLinear),
+					Percentage: aws.Int(20),
+					Interval:   durationp(2 * time.Minute),
+				},
+				TestPort: aws.Uint16(8080),
+			},
+			wanted: &template.BlueGreenDeploymentOpts{
+				TrafficRouting: &template.TrafficRoutingOpts{
+					Type:            "TimeBasedLinear",
+					Percentage:      20,
+					IntervalMinutes: 2,
+				},
+				TestPort: 8080,
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, convertBlueGreenDeployment(tc.in))
+		})
+	}
+}
+
 func Test_convertPublish(t *testing.T) {
 	accountId := "123456789123"
 	partition := "aws"
@@ -82,6 +82,10 @@ func (s *WorkerService) Template() (string, error) {
 	if err != nil {
 		return "", err
 	}
+	storage, err := s.storageOpts(s.manifest.Storage)
+	if err != nil {
+		return "", err
+	}
 	sidecars, err := convertSidecar(s.manifest.Sidecars)
 	if err != nil {
 		return "", fmt.Errorf("convert the sidecar configuration for service %s: %w", s.name, err)
@@ -116,9 +120,21 @@ func (s *WorkerService) Template() (string, error) {
 	if err != nil {
 		return "", fmt.Errorf(`convert "publish" field for service %s: %w`, s.name, err)
 	}
+	preHooks, err := convertDeployHooks(s.manifest.DeployConfig.PreHooks)
+	if err != nil {
+		return "", err
+	}
+	var preHookLambda string
+	if len(preHooks) != 0 {
+		lambda, err := s.parser.Read(preHookPath)
+		if err != nil {
+			return "", fmt.Errorf("read pre hook lambda: %w", err)
+		}
+		preHookLambda = lambda.String()
+	}
 	content, err := s.parser.ParseWorkerService(template.WorkloadOpts{
 		Variables:                      s.manifest.WorkerServiceConfig.Variables,
-		Secrets:                        s.manifest.WorkerServiceConfig.Secrets,
+		Secrets:                        convertSecrets(s.manifest.WorkerServiceConfig.Secrets),
 		NestedStack:                    addonsOutputs,
 		AddonsExtraParams:              addonsParams,
 		Sidecars:                       sidecars,
@@ -132,8 +148,10 @@ func (s *WorkerService) Template() (string, error) {
 		DockerLabels:                   s.manifest.ImageConfig.Image.DockerLabels,
 		DesiredCountLambda:             desiredCountLambda.String(),
 		EnvControllerLambda:            envControllerLambda.String(),
+		PreHookLambda:                  preHookLambda,
+		PreHooks:                       preHooks,
 		BacklogPerTaskCalculatorLambda: backlogPerTaskLambda.String(),
-		Storage:                        convertStorageOpts(s.manifest.Name, s.manifest.Storage),
+		Storage:                        storage,
 		Network:                        convertNetworkConfig(s.manifest.Network),
 		EntryPoint:                     entrypoint,
 		Command:                        command,
@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
@@ -16,13 +17,20 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
 	"github.com/aws/copilot-cli/internal/pkg/template"
 	"github.com/aws/copilot-cli/internal/pkg/template/override"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
 )
 
 // Template rendering configuration common across workloads.
 const (
 	wkldParamsTemplatePath = "workloads/params.json.tmpl"
+
+	imageDigestPrefix = "sha256:"
 )
 
+// EnvAddonOptionalOutputSuffixes are the suffixes of the outputs that an environment addon exports only if it's configured with them,
+// such as the URL of the CloudFront distribution of a bucket or the ARN of the stream of a table.
+var EnvAddonOptionalOutputSuffixes = []string{"DistributionURL", "StreamArn"}
+
 // Parameter logical IDs common across workloads.
 const (
 	WorkloadAppNameParamKey           = "AppName"
@@ -71,6 +79,7 @@ type RuntimeConfig struct {
 	ServiceDiscoveryEndpoint string // Endpoint for the service discovery namespace in the environment.
 	AccountID                string
 	Region                   string
+	EnvAddonsOutputs         []string // Names of the outputs of the addons deployed in the environment, only read if the manifest lists "storage.env_addons".
 }
 
 // ECRImage represents configuration about the pushed ECR image that is needed to
@@ -83,9 +92,12 @@ type ECRImage struct {
 
 // GetLocation returns the ECR image URI.
 // If a tag is provided by the user or discovered from git then prioritize referring to the image via the tag.
-// Otherwise, each image after a push to ECR will get a digest and we refer to the image via the digest.
+// The tag can also be an image digest, such as the one passed by an ECR pipeline source. Otherwise, each image after a push to ECR will get a digest and we refer to the image via the digest.
 // Finally, if no digest or tag is present, this occurs with the "package" commands, we default to the "latest" tag.
 func (i ECRImage) GetLocation() string {
+	if strings.HasPrefix(i.ImageTag, imageDigestPrefix) {
+		return fmt.Sprintf("%s@%s", i.RepoURL, i.ImageTag)
+	}
 	if i.ImageTag != "" {
 		return fmt.Sprintf("%s:%s", i.RepoURL, i.ImageTag)
 	}
@@ -212,6 +224,44 @@ func (w *wkld) addonsOutputs() (*template.WorkloadNestedStackOpts, error) {
 	}, nil
 }
 
+type errEnvAddonNotDeployed struct {
+	name string
+	env  string
+}
+
+func (e *errEnvAddonNotDeployed) Error() string {
+	return fmt.Sprintf("environment addon %s is not deployed in environment %s", e.name, e.env)
+}
+
+// RecommendActions returns recommended actions to be taken after the error.
+func (e *errEnvAddonNotDeployed) RecommendActions() string {
+	return fmt.Sprintf("Run %s to deploy the addons shared in the environment.", color.HighlightCode(fmt.Sprintf("copilot env deploy --name %s", e.env)))
+}
+
+// storageOpts converts the storage of the manifest, and looks up the outputs exported by the addons deployed in the environment
+// that the workload accesses besides their names and access policies.
+func (w *wkld) storageOpts(in manifest.Storage) (*template.StorageOpts, error) {
+	opts := convertStorageOpts(aws.String(w.name), in)
+	if opts == nil || len(in.EnvAddons) == 0 {
+		return opts, nil
+	}
+	exported := make(map[string]bool)
+	for _, output := range w.rc.EnvAddonsOutputs {
+		exported[output] = true
+	}
+	for _, name := range in.EnvAddons {
+		if !exported[template.EnvVarNameFunc(name)] || !exported[template.StripNonAlphaNumFunc(name)+"AccessPolicy"] {
+			return nil, &errEnvAddonNotDeployed{name: name, env: w.env}
+		}
+		for _, suffix := range EnvAddonOptionalOutputSuffixes {
+			if id := template.StripNonAlphaNumFunc(name) + suffix; exported[id] {
+				opts.EnvAddonOutputs = append(opts.EnvAddonOutputs, id)
+			}
+		}
+	}
+	return opts, nil
+}
+
 func (w *wkld) addonsParameters() (string, error) {
 	params, err := w.addons.Parameters()
 	if err != nil {
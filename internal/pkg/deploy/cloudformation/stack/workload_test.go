@@ -4,8 +4,11 @@
 package stack
 
 import (
+	"errors"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
 	"github.com/stretchr/testify/require"
 )
 
@@ -30,6 +33,13 @@ func TestECRImage_GetLocation(t *testing.T) {
 			},
 			wanted: "aws_account_id.dkr.ecr.us-west-2.amazonaws.com/amazonlinux@sha256:f1d4ae3f7261a72e98c6ebefe9985cf10a0ea5bd762585a43e0700ed99863807",
 		},
+		"should refer to the image via the digest if the tag is a digest": {
+			in: ECRImage{
+				RepoURL:  "aws_account_id.dkr.ecr.us-west-2.amazonaws.com/amazonlinux",
+				ImageTag: "sha256:f1d4ae3f7261a72e98c6ebefe9985cf10a0ea5bd762585a43e0700ed99863807",
+			},
+			wanted: "aws_account_id.dkr.ecr.us-west-2.amazonaws.com/amazonlinux@sha256:f1d4ae3f7261a72e98c6ebefe9985cf10a0ea5bd762585a43e0700ed99863807",
+		},
 		"should use the latest image if nothing is provided": {
 			in: ECRImage{
 				RepoURL: "aws_account_id.dkr.ecr.us-west-2.amazonaws.com/amazonlinux",
@@ -44,3 +54,79 @@ func TestECRImage_GetLocation(t *testing.T) {
 		})
 	}
 }
+
+func TestWkld_storageOpts(t *testing.T) {
+	deployedOutputs := []string{
+		"myassetsName", "myassetsAccessPolicy", "myassetsDistributionURL",
+		"ordersName", "ordersAccessPolicy", "ordersStreamArn",
+		"usersName", "usersAccessPolicy",
+	}
+	testCases := map[string]struct {
+		in              manifest.Storage
+		deployedOutputs []string
+
+		wantedOutputs []string
+		wantedError   error
+	}{
+		"does not look up the environment addons if the workload doesn't access any": {
+			in: manifest.Storage{
+				Ephemeral: aws.Int(50),
+			},
+		},
+		"errors if the environment doesn't have addons deployed": {
+			in: manifest.Storage{
+				EnvAddons: []string{"orders"},
+			},
+			wantedError: errors.New("environment addon orders is not deployed in environment test"),
+		},
+		"errors if an environment addon that the workload accesses is not deployed": {
+			in: manifest.Storage{
+				EnvAddons: []string{"orders", "sessions"},
+			},
+			deployedOutputs: deployedOutputs,
+			wantedError:     errors.New("environment addon sessions is not deployed in environment test"),
+		},
+		"errors if an environment addon is deployed without its access policy": {
+			in: manifest.Storage{
+				EnvAddons: []string{"carts"},
+			},
+			deployedOutputs: []string{"cartsName"},
+			wantedError:     errors.New("environment addon carts is not deployed in environment test"),
+		},
+		"injects the optional outputs of the environment addons that the workload accesses": {
+			in: manifest.Storage{
+				EnvAddons: []string{"my-assets", "orders", "users"},
+			},
+			deployedOutputs: deployedOutputs,
+			wantedOutputs:   []string{"myassetsDistributionURL", "ordersStreamArn"},
+		},
+		"skips the outputs of the environment addons that the workload doesn't access": {
+			in: manifest.Storage{
+				EnvAddons: []string{"orders"},
+			},
+			deployedOutputs: deployedOutputs,
+			wantedOutputs:   []string{"ordersStreamArn"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			w := &wkld{
+				name: "api",
+				env:  "test",
+				rc: RuntimeConfig{
+					EnvAddonsOutputs: tc.deployedOutputs,
+				},
+			}
+
+			opts, err := w.storageOpts(tc.in)
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedOutputs, opts.EnvAddonOutputs)
+		})
+	}
+}
@@ -91,6 +91,12 @@ func TestCloudFormation_DeployService(t *testing.T) {
 	t.Run("renders a stack with an ECS service", func(t *testing.T) {
 		testDeployWorkload_RenderNewlyCreatedStackWithECSService(t, "myapp-myenv-mysvc", when)
 	})
+	t.Run("renders a stack with a pre-deployment hook and its task logs", func(t *testing.T) {
+		testDeployWorkload_RenderNewlyCreatedStackWithPreHook(t, "myapp-myenv-mysvc", when)
+	})
+	t.Run("renders a stack with a blue/green deployment and its traffic shifting", func(t *testing.T) {
+		testDeployWorkload_RenderUpdatedStackWithBlueGreenDeployment(t, "myapp-myenv-mysvc", when)
+	})
 	t.Run("renders a stack with addons template if stack creation is successful", func(t *testing.T) {
 		testDeployWorkload_RenderNewlyCreatedStackWithAddons(t, "myapp-myenv-mysvc", when)
 	})
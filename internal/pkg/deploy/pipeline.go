@@ -9,9 +9,12 @@ import (
 	"errors"
 	"fmt"
 	"regexp"
+	"sort"
+	"strings"
 
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/arn"
 )
 
@@ -23,7 +26,13 @@ const (
 	fmtErrMissingProperty    = "missing `%s` in properties"
 	fmtErrPropertyNotAString = "property `%s` is not a string"
 
-	defaultPipelineBuildImage = "aws/codebuild/amazonlinux2-x86_64-standard:3.0"
+	defaultPipelineBuildImage       = "aws/codebuild/amazonlinux2-x86_64-standard:3.0"
+	defaultPipelineBuildspecPath    = "copilot/buildspec.yml"
+	defaultPipelineBuildComputeType = "BUILD_GENERAL1_SMALL"
+	defaultPipelineImageTag         = "latest"
+
+	// The approval action runs first in a stage, deployments start right after it.
+	deployActionRunOrderStart = 2
 )
 
 var (
@@ -62,11 +71,76 @@ type CreatePipelineInput struct {
 	AdditionalTags map[string]string
 }
 
+// RollsBackStages returns true if any stage of the pipeline is rolled back when its post deployments fail.
+// Stage rollbacks require a V2 pipeline.
+func (in *CreatePipelineInput) RollsBackStages() bool {
+	for _, stage := range in.Stages {
+		if len(stage.PostDeployments) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// NotifiesApprovals returns true if any stage of the pipeline sends notifications when its manual approval is pending.
+func (in *CreatePipelineInput) NotifiesApprovals() bool {
+	for _, stage := range in.Stages {
+		if stage.ApprovalNotifications != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// HasApprovalWebhooks returns true if any stage of the pipeline forwards its approval notifications to a webhook.
+func (in *CreatePipelineInput) HasApprovalWebhooks() bool {
+	for _, stage := range in.Stages {
+		if stage.ApprovalNotifications != nil && stage.ApprovalNotifications.Webhook != "" {
+			return true
+		}
+	}
+	return false
+}
+
 // Build represents CodeBuild project used in the CodePipeline
 // to build and test Docker image.
 type Build struct {
 	// The URI that identifies the Docker image to use for this build project.
 	Image string
+
+	// The path to the buildspec file, relative to the root of the source repository.
+	BuildspecPath string
+
+	// The content of the buildspec rendered from the pipeline manifest.
+	// If set, it is used instead of the buildspec file at BuildspecPath.
+	Buildspec string
+
+	// The compute type of the build environment, e.g. BUILD_GENERAL1_SMALL.
+	ComputeType string
+
+	// Whether the build environment runs in privileged mode to build Docker images.
+	PrivilegedMode bool
+
+	// Additional environment variables available to the build.
+	EnvironmentVariables map[string]string
+
+	// The cache used by the build project.
+	Cache BuildCache
+}
+
+// BuildCache represents the cache of a build project.
+// The project either caches Docker layers locally or caches files in an S3 location.
+type BuildCache struct {
+	// Whether Docker layers are cached locally.
+	DockerLayers bool
+
+	// The S3 location, in the form of "bucket/prefix", to store the cache in.
+	S3Location string
+}
+
+// S3Bucket returns the name of the bucket of the S3 cache location.
+func (c BuildCache) S3Bucket() string {
+	return strings.SplitN(c.S3Location, "/", 2)[0]
 }
 
 // ArtifactBucket represents an S3 bucket used by the CodePipeline to store
@@ -131,6 +205,26 @@ type BitbucketSource struct {
 	OutputArtifactFormat string
 }
 
+// ECRSource defines an image source of the pipeline, triggered when an image tag is pushed to an ECR repository.
+// The pushed image is copied to the ECR repositories of the workload before it is deployed.
+// Since the repository only holds images, the workspace to deploy from is read from a zip file in an S3 bucket.
+type ECRSource struct {
+	ProviderName   string
+	RepositoryName string
+	ImageTag       string
+	Workload       string
+	// The S3 location of the zipped copilot directory of the workspace.
+	WorkspaceBucket string
+	WorkspaceKey    string
+}
+
+// S3Source defines an object source of the pipeline, triggered when a zip file is uploaded to an S3 bucket.
+type S3Source struct {
+	ProviderName string
+	Bucket       string
+	ObjectKey    string
+}
+
 func convertRequiredProperty(properties map[string]interface{}, key string) (string, error) {
 	v, ok := properties[key]
 	if !ok {
@@ -158,6 +252,41 @@ func convertOptionalProperty(properties map[string]interface{}, key string, defa
 // PipelineSourceFromManifest processes manifest info about the source based on provider type.
 // The return boolean is true for CodeStar Connections sources that require a polling prompt.
 func PipelineSourceFromManifest(mfSource *manifest.Source) (source interface{}, shouldPrompt bool, err error) {
+	switch mfSource.ProviderName {
+	case manifest.ECRProviderName:
+		repository, err := convertRequiredProperty(mfSource.Properties, "repository")
+		if err != nil {
+			return nil, false, err
+		}
+		tag, err := convertOptionalProperty(mfSource.Properties, "tag", defaultPipelineImageTag)
+		if err != nil {
+			return nil, false, err
+		}
+		workload, err := convertRequiredProperty(mfSource.Properties, "workload")
+		if err != nil {
+			return nil, false, err
+		}
+		return &ECRSource{
+			ProviderName:   manifest.ECRProviderName,
+			RepositoryName: repository,
+			ImageTag:       tag,
+			Workload:       workload,
+		}, false, nil
+	case manifest.S3ProviderName:
+		bucket, err := convertRequiredProperty(mfSource.Properties, "bucket")
+		if err != nil {
+			return nil, false, err
+		}
+		key, err := convertRequiredProperty(mfSource.Properties, "key")
+		if err != nil {
+			return nil, false, err
+		}
+		return &S3Source{
+			ProviderName: manifest.S3ProviderName,
+			Bucket:       bucket,
+			ObjectKey:    key,
+		}, false, nil
+	}
 	branch, err := convertOptionalProperty(mfSource.Properties, "branch", DefaultPipelineBranch)
 	if err != nil {
 		return nil, false, err
@@ -236,13 +365,39 @@ func PipelineSourceFromManifest(mfSource *manifest.Source) (source interface{},
 
 // PipelineBuildFromManifest processes manifest info about the build project settings.
 func PipelineBuildFromManifest(mfBuild *manifest.Build) (build *Build) {
-	image := defaultPipelineBuildImage
-	if mfBuild != nil && mfBuild.Image != "" {
-		image = mfBuild.Image
+	build = &Build{
+		Image:          defaultPipelineBuildImage,
+		BuildspecPath:  defaultPipelineBuildspecPath,
+		ComputeType:    defaultPipelineBuildComputeType,
+		PrivilegedMode: true,
+		Cache: BuildCache{
+			DockerLayers: true,
+		},
+	}
+	if mfBuild == nil {
+		return build
+	}
+	if mfBuild.Image != "" {
+		build.Image = mfBuild.Image
+	}
+	if mfBuild.ComputeType != "" {
+		build.ComputeType = mfBuild.ComputeType
 	}
-	return &Build{
-		Image: image,
+	if mfBuild.Privileged != nil {
+		build.PrivilegedMode = aws.BoolValue(mfBuild.Privileged)
+	}
+	build.EnvironmentVariables = mfBuild.Env
+	if mfBuild.Cache != nil {
+		if mfBuild.Cache.DockerLayers != nil {
+			build.Cache.DockerLayers = aws.BoolValue(mfBuild.Cache.DockerLayers)
+		}
+		if mfBuild.Cache.S3 != nil {
+			build.Cache = BuildCache{
+				S3Location: mfBuild.Cache.S3.Location,
+			}
+		}
 	}
+	return build
 }
 
 // GitHubPersonalAccessTokenSecretID returns the ID of the secret in the
@@ -432,7 +587,85 @@ type PipelineStage struct {
 	*AssociatedEnvironment
 	LocalWorkloads   []string
 	RequiresApproval bool
-	TestCommands     []string
+	// ApprovalNotifications configures where to notify that the manual approval of the stage is pending.
+	ApprovalNotifications *manifest.ApprovalNotifications
+	TestCommands          []string
+	// Deployments restricts the workloads deployed in the stage and the order in which they are deployed.
+	// If empty, all LocalWorkloads are deployed in parallel.
+	Deployments manifest.Deployments
+	// PostDeployments are the checks run after the workloads are deployed.
+	// If any of them fails, the stage is rolled back to its last successful artifacts.
+	PostDeployments []PostDeployment
+}
+
+// PostDeployment represents a CodeBuild action that verifies a stage after its workloads are deployed.
+type PostDeployment struct {
+	Name     string   // Name of the check.
+	Commands []string // Commands run by the CodeBuild project of the check.
+}
+
+// DeployAction represents a CodePipeline action that deploys the CloudFormation stack of a workload.
+type DeployAction struct {
+	Name     string // Name of the workload.
+	RunOrder int    // Order of the action within the stage, actions with the same RunOrder run in parallel.
+}
+
+// DeployActions returns the actions deploying the workloads of the stage.
+// A workload's action runs after the actions of all the workloads it depends on.
+func (s *PipelineStage) DeployActions() []DeployAction {
+	if len(s.Deployments) == 0 {
+		var actions []DeployAction
+		for _, wl := range s.LocalWorkloads {
+			actions = append(actions, DeployAction{
+				Name:     wl,
+				RunOrder: deployActionRunOrderStart,
+			})
+		}
+		return actions
+	}
+	depths := make(map[string]int)
+	var depth func(name string) int
+	depth = func(name string) int {
+		if d, ok := depths[name]; ok {
+			return d
+		}
+		d := 0
+		if deployment := s.Deployments[name]; deployment != nil {
+			for _, dep := range deployment.DependsOn {
+				if depDepth := depth(dep) + 1; depDepth > d {
+					d = depDepth
+				}
+			}
+		}
+		depths[name] = d
+		return d
+	}
+	var actions []DeployAction
+	for name := range s.Deployments {
+		actions = append(actions, DeployAction{
+			Name:     name,
+			RunOrder: deployActionRunOrderStart + depth(name),
+		})
+	}
+	sort.Slice(actions, func(i, j int) bool {
+		if actions[i].RunOrder != actions[j].RunOrder {
+			return actions[i].RunOrder < actions[j].RunOrder
+		}
+		return actions[i].Name < actions[j].Name
+	})
+	return actions
+}
+
+// TestCommandsRunOrder returns the order of the test commands and post deployment actions,
+// which run after every deployment of the stage.
+func (s *PipelineStage) TestCommandsRunOrder() int {
+	runOrder := deployActionRunOrderStart
+	for _, action := range s.DeployActions() {
+		if action.RunOrder > runOrder {
+			runOrder = action.RunOrder
+		}
+	}
+	return runOrder + 1
 }
 
 // WorkloadTemplatePath returns the full path to the workload CFN template
@@ -169,6 +169,72 @@ func TestPipelineSourceFromManifest(t *testing.T) {
 			expectedShouldPrompt: false,
 			expectedErr:          errors.New("missing `repository` in properties"),
 		},
+		"transforms ECR source": {
+			mfSource: &manifest.Source{
+				ProviderName: manifest.ECRProviderName,
+				Properties: map[string]interface{}{
+					"repository": "my-app/api",
+					"tag":        "release",
+					"workload":   "api",
+				},
+			},
+			expectedDeploySource: &ECRSource{
+				ProviderName:   manifest.ECRProviderName,
+				RepositoryName: "my-app/api",
+				ImageTag:       "release",
+				Workload:       "api",
+			},
+			expectedShouldPrompt: false,
+		},
+		"use default tag `latest` if tag is not configured": {
+			mfSource: &manifest.Source{
+				ProviderName: manifest.ECRProviderName,
+				Properties: map[string]interface{}{
+					"repository": "my-app/api",
+					"workload":   "api",
+				},
+			},
+			expectedDeploySource: &ECRSource{
+				ProviderName:   manifest.ECRProviderName,
+				RepositoryName: "my-app/api",
+				ImageTag:       "latest",
+				Workload:       "api",
+			},
+			expectedShouldPrompt: false,
+		},
+		"error out if the workload of the ECR source is not configured": {
+			mfSource: &manifest.Source{
+				ProviderName: manifest.ECRProviderName,
+				Properties: map[string]interface{}{
+					"repository": "my-app/api",
+				},
+			},
+			expectedErr: errors.New("missing `workload` in properties"),
+		},
+		"transforms S3 source": {
+			mfSource: &manifest.Source{
+				ProviderName: manifest.S3ProviderName,
+				Properties: map[string]interface{}{
+					"bucket": "my-bucket",
+					"key":    "source/api.zip",
+				},
+			},
+			expectedDeploySource: &S3Source{
+				ProviderName: manifest.S3ProviderName,
+				Bucket:       "my-bucket",
+				ObjectKey:    "source/api.zip",
+			},
+			expectedShouldPrompt: false,
+		},
+		"error out if the object key of the S3 source is not configured": {
+			mfSource: &manifest.Source{
+				ProviderName: manifest.S3ProviderName,
+				Properties: map[string]interface{}{
+					"bucket": "my-bucket",
+				},
+			},
+			expectedErr: errors.New("missing `key` in properties"),
+		},
 		"errors if user changed provider name in manifest to unsupported source": {
 			mfSource: &manifest.Source{
 				ProviderName: "BitCommitHubBucket",
@@ -206,7 +272,13 @@ func TestPipelineBuildFromManifest(t *testing.T) {
 		"set default image if not be specified in manifest": {
 			mfBuild: nil,
 			expectedBuild: &Build{
-				Image: defaultImage,
+				Image:          defaultImage,
+				BuildspecPath:  "copilot/buildspec.yml",
+				ComputeType:    "BUILD_GENERAL1_SMALL",
+				PrivilegedMode: true,
+				Cache: BuildCache{
+					DockerLayers: true,
+				},
 			},
 		},
 		"set image according to manifest": {
@@ -214,7 +286,53 @@ func TestPipelineBuildFromManifest(t *testing.T) {
 				Image: "aws/codebuild/standard:3.0",
 			},
 			expectedBuild: &Build{
-				Image: "aws/codebuild/standard:3.0",
+				Image:          "aws/codebuild/standard:3.0",
+				BuildspecPath:  "copilot/buildspec.yml",
+				ComputeType:    "BUILD_GENERAL1_SMALL",
+				PrivilegedMode: true,
+				Cache: BuildCache{
+					DockerLayers: true,
+				},
+			},
+		},
+		"set build environment according to manifest": {
+			mfBuild: &manifest.Build{
+				ComputeType: "BUILD_GENERAL1_LARGE",
+				Privileged:  aws.Bool(false),
+				Env: map[string]string{
+					"GOFLAGS": "-mod=vendor",
+				},
+				Cache: &manifest.BuildCache{
+					DockerLayers: aws.Bool(false),
+				},
+			},
+			expectedBuild: &Build{
+				Image:          defaultImage,
+				BuildspecPath:  "copilot/buildspec.yml",
+				ComputeType:    "BUILD_GENERAL1_LARGE",
+				PrivilegedMode: false,
+				EnvironmentVariables: map[string]string{
+					"GOFLAGS": "-mod=vendor",
+				},
+			},
+		},
+		"replace docker layer cache with s3 cache": {
+			mfBuild: &manifest.Build{
+				Cache: &manifest.BuildCache{
+					S3: &manifest.BuildS3Cache{
+						Location: "my-bucket/cache",
+						Paths:    []string{"/root/.m2/**/*"},
+					},
+				},
+			},
+			expectedBuild: &Build{
+				Image:          defaultImage,
+				BuildspecPath:  "copilot/buildspec.yml",
+				ComputeType:    "BUILD_GENERAL1_SMALL",
+				PrivilegedMode: true,
+				Cache: BuildCache{
+					S3Location: "my-bucket/cache",
+				},
 			},
 		},
 	}
@@ -226,6 +344,59 @@ func TestPipelineBuildFromManifest(t *testing.T) {
 	}
 }
 
+func TestBuildCache_S3Bucket(t *testing.T) {
+	require.Equal(t, "my-bucket", BuildCache{S3Location: "my-bucket/cache/go"}.S3Bucket())
+	require.Equal(t, "my-bucket", BuildCache{S3Location: "my-bucket"}.S3Bucket())
+}
+
+func TestPipelineStage_DeployActions(t *testing.T) {
+	testCases := map[string]struct {
+		stage PipelineStage
+
+		wantedActions              []DeployAction
+		wantedTestCommandsRunOrder int
+	}{
+		"deploys all local workloads in parallel without deployments": {
+			stage: PipelineStage{
+				LocalWorkloads: []string{"frontend", "api"},
+			},
+			wantedActions: []DeployAction{
+				{Name: "frontend", RunOrder: 2},
+				{Name: "api", RunOrder: 2},
+			},
+			wantedTestCommandsRunOrder: 3,
+		},
+		"orders deployments by their dependencies": {
+			stage: PipelineStage{
+				LocalWorkloads: []string{"frontend", "api", "migration", "worker", "unused"},
+				Deployments: manifest.Deployments{
+					"frontend": &manifest.Deployment{
+						DependsOn: []string{"api", "migration"},
+					},
+					"api": &manifest.Deployment{
+						DependsOn: []string{"migration"},
+					},
+					"worker":    nil,
+					"migration": &manifest.Deployment{},
+				},
+			},
+			wantedActions: []DeployAction{
+				{Name: "migration", RunOrder: 2},
+				{Name: "worker", RunOrder: 2},
+				{Name: "api", RunOrder: 3},
+				{Name: "frontend", RunOrder: 4},
+			},
+			wantedTestCommandsRunOrder: 5,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wantedActions, tc.stage.DeployActions())
+			require.Equal(t, tc.wantedTestCommandsRunOrder, tc.stage.TestCommandsRunOrder())
+		})
+	}
+}
+
 func TestParseOwnerAndRepo(t *testing.T) {
 	testCases := map[string]struct {
 		src            *GitHubSource
@@ -314,3 +485,91 @@ func TestParseRepo(t *testing.T) {
 		})
 	}
 }
+
+func TestCreatePipelineInput_RollsBackStages(t *testing.T) {
+	testCases := map[string]struct {
+		stages []PipelineStage
+		wanted bool
+	}{
+		"no stage has post deployments": {
+			stages: []PipelineStage{
+				{
+					TestCommands: []string{"make test"},
+				},
+			},
+			wanted: false,
+		},
+		"a stage has post deployments": {
+			stages: []PipelineStage{
+				{},
+				{
+					PostDeployments: []PostDeployment{
+						{
+							Name:     "smoke",
+							Commands: []string{"make smoke"},
+						},
+					},
+				},
+			},
+			wanted: true,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			in := &CreatePipelineInput{
+				Stages: tc.stages,
+			}
+			require.Equal(t, tc.wanted, in.RollsBackStages())
+		})
+	}
+}
+
+func TestCreatePipelineInput_ApprovalNotifications(t *testing.T) {
+	testCases := map[string]struct {
+		stages []PipelineStage
+
+		wantedNotifiesApprovals   bool
+		wantedHasApprovalWebhooks bool
+	}{
+		"no stage has approval notifications": {
+			stages: []PipelineStage{
+				{
+					RequiresApproval: true,
+				},
+			},
+		},
+		"a stage notifies approvals by email": {
+			stages: []PipelineStage{
+				{},
+				{
+					RequiresApproval: true,
+					ApprovalNotifications: &manifest.ApprovalNotifications{
+						Emails: []string{"release@example.com"},
+					},
+				},
+			},
+			wantedNotifiesApprovals: true,
+		},
+		"a stage notifies approvals to a webhook": {
+			stages: []PipelineStage{
+				{
+					RequiresApproval: true,
+					ApprovalNotifications: &manifest.ApprovalNotifications{
+						Webhook: "https://hooks.slack.com/services/T000/B000/XXXX",
+					},
+				},
+			},
+			wantedNotifiesApprovals:   true,
+			wantedHasApprovalWebhooks: true,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			in := &CreatePipelineInput{
+				Stages: tc.stages,
+			}
+			require.Equal(t, tc.wantedNotifiesApprovals, in.NotifiesApprovals())
+			require.Equal(t, tc.wantedHasApprovalWebhooks, in.HasApprovalWebhooks())
+		})
+	}
+}
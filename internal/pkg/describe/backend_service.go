@@ -84,6 +84,7 @@ func (d *BackendServiceDescriber) Describe() (HumanJSONStringer, error) {
 	var services []*ServiceDiscovery
 	var envVars []*containerEnvVar
 	var secrets []*secret
+	var cdns []*Distribution
 	for _, env := range environments {
 		err := d.initClients(env)
 		if err != nil {
@@ -130,6 +131,11 @@ func (d *BackendServiceDescriber) Describe() (HumanJSONStringer, error) {
 			return nil, fmt.Errorf("retrieve secrets: %w", err)
 		}
 		secrets = append(secrets, flattenSecrets(env, webSvcSecrets)...)
+		addonsOutputs, err := d.ecsServiceDescribers[env].AddonsOutputs()
+		if err != nil {
+			return nil, fmt.Errorf("retrieve addons outputs: %w", err)
+		}
+		cdns = append(cdns, flattenDistributions(env, addonsOutputs)...)
 	}
 
 	resources := make(map[string][]*stack.Resource)
@@ -155,6 +161,7 @@ func (d *BackendServiceDescriber) Describe() (HumanJSONStringer, error) {
 		ServiceDiscovery: services,
 		Variables:        envVars,
 		Secrets:          secrets,
+		Distributions:    cdns,
 		Resources:        resources,
 
 		environments: environments,
@@ -170,6 +177,7 @@ type backendSvcDesc struct {
 	ServiceDiscovery serviceDiscoveries   `json:"serviceDiscovery"`
 	Variables        containerEnvVars     `json:"variables"`
 	Secrets          secrets              `json:"secrets,omitempty"`
+	Distributions    distributions        `json:"distributions,omitempty"`
 	Resources        deployedSvcResources `json:"resources,omitempty"`
 
 	environments []string `json:"-"`
@@ -207,6 +215,11 @@ func (w *backendSvcDesc) HumanString() string {
 		writer.Flush()
 		w.Secrets.humanString(writer)
 	}
+	if len(w.Distributions) != 0 {
+		fmt.Fprint(writer, color.Bold.Sprint("\nCloudFront Distributions\n\n"))
+		writer.Flush()
+		w.Distributions.humanString(writer)
+	}
 	if len(w.Resources) != 0 {
 		fmt.Fprint(writer, color.Bold.Sprint("\nResources\n"))
 		writer.Flush()
@@ -161,6 +161,7 @@ func TestBackendServiceDescriber_Describe(t *testing.T) {
 							ValueFrom: "GH_WEBHOOK_SECRET",
 						},
 					}, nil),
+					m.ecsDescriber.EXPECT().AddonsOutputs().Return(nil, nil),
 					m.ecsDescriber.EXPECT().Params().Return(map[string]string{
 						cfnstack.LBWebServiceContainerPortParamKey: "5000",
 						cfnstack.WorkloadTaskCountParamKey:         "2",
@@ -186,6 +187,7 @@ func TestBackendServiceDescriber_Describe(t *testing.T) {
 							ValueFrom: "SHHHHHHHH",
 						},
 					}, nil),
+					m.ecsDescriber.EXPECT().AddonsOutputs().Return(nil, nil),
 					m.ecsDescriber.EXPECT().Params().Return(map[string]string{
 						cfnstack.LBWebServiceContainerPortParamKey: "-1",
 						cfnstack.WorkloadTaskCountParamKey:         "2",
@@ -205,6 +207,7 @@ func TestBackendServiceDescriber_Describe(t *testing.T) {
 					}, nil),
 					m.ecsDescriber.EXPECT().Secrets().Return(
 						nil, nil),
+					m.ecsDescriber.EXPECT().AddonsOutputs().Return(nil, nil),
 					m.ecsDescriber.EXPECT().ServiceStackResources().Return([]*stack.Resource{
 						{
 							Type:       "AWS::EC2::SecurityGroupIngress",
@@ -11,6 +11,7 @@ import (
 	"strings"
 	"text/tabwriter"
 
+	"github.com/aws/copilot-cli/internal/pkg/addon"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
@@ -50,6 +51,8 @@ type EnvDescriber struct {
 	deployStore DeployedEnvServicesLister
 	cfn         stackDescriber
 
+	newStackDescriber func(stackName string) stackDescriber
+
 	// Cached values for reuse.
 	description *EnvDescription
 }
@@ -81,6 +84,9 @@ func NewEnvDescriber(opt NewEnvDescriberConfig) (*EnvDescriber, error) {
 		configStore: opt.ConfigStore,
 		deployStore: opt.DeployStore,
 		cfn:         stack.NewStackDescriber(cfnstack.NameForEnv(opt.App, opt.Env), sess),
+		newStackDescriber: func(stackName string) stackDescriber {
+			return stack.NewStackDescriber(stackName, sess)
+		},
 	}, nil
 }
 
@@ -178,6 +184,31 @@ func (d *EnvDescriber) ServiceDiscoveryEndpoint() (string, error) {
 	return fmt.Sprintf(fmtLegacySvcDiscoveryEndpoint, d.app), nil
 }
 
+// AddonsOutputs returns the names of the outputs of the addons nested stack deployed in the environment.
+// If the environment doesn't have addons deployed, returns an empty list.
+func (d *EnvDescriber) AddonsOutputs() ([]string, error) {
+	resources, err := d.cfn.Resources()
+	if err != nil {
+		return nil, fmt.Errorf("retrieve resources of environment %s: %w", d.env.Name, err)
+	}
+	for _, r := range resources {
+		if r.LogicalID != addon.StackName {
+			continue
+		}
+		descr, err := d.newStackDescriber(r.PhysicalID).Describe()
+		if err != nil {
+			return nil, fmt.Errorf("retrieve addons stack of environment %s: %w", d.env.Name, err)
+		}
+		outputs := make([]string, 0, len(descr.Outputs))
+		for name := range descr.Outputs {
+			outputs = append(outputs, name)
+		}
+		sort.Strings(outputs)
+		return outputs, nil
+	}
+	return nil, nil
+}
+
 func (d *EnvDescriber) loadStackInfo() (map[string]string, EnvironmentVPC, error) {
 	var environmentVPC EnvironmentVPC
 
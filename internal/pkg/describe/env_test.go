@@ -365,6 +365,83 @@ func TestEnvDescriber_ServiceDiscoveryEndpoint(t *testing.T) {
 	}
 }
 
+func TestEnvDescriber_AddonsOutputs(t *testing.T) {
+	testCases := map[string]struct {
+		setupMocks func(env, addons *mocks.MockstackDescriber)
+
+		wantedOutputs []string
+		wantedErr     error
+	}{
+		"error if fail to retrieve environment resources": {
+			setupMocks: func(env, _ *mocks.MockstackDescriber) {
+				env.EXPECT().Resources().Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("retrieve resources of environment test: some error"),
+		},
+		"no outputs if the environment doesn't have addons": {
+			setupMocks: func(env, _ *mocks.MockstackDescriber) {
+				env.EXPECT().Resources().Return([]*stack.Resource{
+					{LogicalID: "VPC", PhysicalID: "vpc-123"},
+				}, nil)
+			},
+		},
+		"error if fail to describe the addons stack": {
+			setupMocks: func(env, addons *mocks.MockstackDescriber) {
+				env.EXPECT().Resources().Return([]*stack.Resource{
+					{LogicalID: "AddonsStack", PhysicalID: "arn:aws:cloudformation:us-west-2:123456789012:stack/phonetool-test-AddonsStack-1/abc"},
+				}, nil)
+				addons.EXPECT().Describe().Return(stack.StackDescription{}, errors.New("some error"))
+			},
+			wantedErr: errors.New("retrieve addons stack of environment test: some error"),
+		},
+		"return the sorted names of the addons outputs": {
+			setupMocks: func(env, addons *mocks.MockstackDescriber) {
+				env.EXPECT().Resources().Return([]*stack.Resource{
+					{LogicalID: "VPC", PhysicalID: "vpc-123"},
+					{LogicalID: "AddonsStack", PhysicalID: "arn:aws:cloudformation:us-west-2:123456789012:stack/phonetool-test-AddonsStack-1/abc"},
+				}, nil)
+				addons.EXPECT().Describe().Return(stack.StackDescription{
+					Outputs: map[string]string{
+						"mybucketName":         "phonetool-test-mybucket",
+						"mybucketAccessPolicy": "arn:aws:iam::123456789012:policy/mybucket",
+					},
+				}, nil)
+			},
+			wantedOutputs: []string{"mybucketAccessPolicy", "mybucketName"},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			env := mocks.NewMockstackDescriber(ctrl)
+			addons := mocks.NewMockstackDescriber(ctrl)
+			tc.setupMocks(env, addons)
+			d := &EnvDescriber{
+				app: "phonetool",
+				env: &config.Environment{Name: "test"},
+				cfn: env,
+				newStackDescriber: func(stackName string) stackDescriber {
+					require.Equal(t, "arn:aws:cloudformation:us-west-2:123456789012:stack/phonetool-test-AddonsStack-1/abc", stackName)
+					return addons
+				},
+			}
+
+			// WHEN
+			actual, err := d.AddonsOutputs()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedOutputs, actual)
+		})
+	}
+}
+
 func TestEnvDescription_JSONString(t *testing.T) {
 	testApp := &config.Application{
 		Name: "testApp",
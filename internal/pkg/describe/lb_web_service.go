@@ -99,6 +99,7 @@ func (d *LBWebServiceDescriber) Describe() (HumanJSONStringer, error) {
 	var serviceDiscoveries []*ServiceDiscovery
 	var envVars []*containerEnvVar
 	var secrets []*secret
+	var cdns []*Distribution
 	for _, env := range environments {
 		err := d.initClients(env)
 		if err != nil {
@@ -145,6 +146,11 @@ func (d *LBWebServiceDescriber) Describe() (HumanJSONStringer, error) {
 			return nil, fmt.Errorf("retrieve secrets: %w", err)
 		}
 		secrets = append(secrets, flattenSecrets(env, webSvcSecrets)...)
+		addonsOutputs, err := d.ecsServiceDescribers[env].AddonsOutputs()
+		if err != nil {
+			return nil, fmt.Errorf("retrieve addons outputs: %w", err)
+		}
+		cdns = append(cdns, flattenDistributions(env, addonsOutputs)...)
 	}
 	resources := make(map[string][]*stack.Resource)
 	if d.enableResources {
@@ -170,6 +176,7 @@ func (d *LBWebServiceDescriber) Describe() (HumanJSONStringer, error) {
 		ServiceDiscovery: serviceDiscoveries,
 		Variables:        envVars,
 		Secrets:          secrets,
+		Distributions:    cdns,
 		Resources:        resources,
 
 		environments: environments,
@@ -265,6 +272,7 @@ type webSvcDesc struct {
 	ServiceDiscovery serviceDiscoveries   `json:"serviceDiscovery"`
 	Variables        containerEnvVars     `json:"variables"`
 	Secrets          secrets              `json:"secrets,omitempty"`
+	Distributions    distributions        `json:"distributions,omitempty"`
 	Resources        deployedSvcResources `json:"resources,omitempty"`
 
 	environments []string
@@ -310,6 +318,11 @@ func (w *webSvcDesc) HumanString() string {
 		writer.Flush()
 		w.Secrets.humanString(writer)
 	}
+	if len(w.Distributions) != 0 {
+		fmt.Fprint(writer, color.Bold.Sprint("\nCloudFront Distributions\n\n"))
+		writer.Flush()
+		w.Distributions.humanString(writer)
+	}
 	if len(w.Resources) != 0 {
 		fmt.Fprint(writer, color.Bold.Sprint("\nResources\n"))
 		writer.Flush()
@@ -0,0 +1,88 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/pkg/describe/pipeline_history.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	codepipeline "github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockpipelineExecutionLister is a mock of pipelineExecutionLister interface.
+type MockpipelineExecutionLister struct {
+	ctrl     *gomock.Controller
+	recorder *MockpipelineExecutionListerMockRecorder
+}
+
+// MockpipelineExecutionListerMockRecorder is the mock recorder for MockpipelineExecutionLister.
+type MockpipelineExecutionListerMockRecorder struct {
+	mock *MockpipelineExecutionLister
+}
+
+// NewMockpipelineExecutionLister creates a new mock instance.
+func NewMockpipelineExecutionLister(ctrl *gomock.Controller) *MockpipelineExecutionLister {
+	mock := &MockpipelineExecutionLister{ctrl: ctrl}
+	mock.recorder = &MockpipelineExecutionListerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockpipelineExecutionLister) EXPECT() *MockpipelineExecutionListerMockRecorder {
+	return m.recorder
+}
+
+// ListExecutions mocks base method.
+func (m *MockpipelineExecutionLister) ListExecutions(pipelineName string, maxResults int) ([]codepipeline.ExecutionSummary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListExecutions", pipelineName, maxResults)
+	ret0, _ := ret[0].([]codepipeline.ExecutionSummary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListExecutions indicates an expected call of ListExecutions.
+func (mr *MockpipelineExecutionListerMockRecorder) ListExecutions(pipelineName, maxResults interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListExecutions", reflect.TypeOf((*MockpipelineExecutionLister)(nil).ListExecutions), pipelineName, maxResults)
+}
+
+// MockcommitAuthorGetter is a mock of commitAuthorGetter interface.
+type MockcommitAuthorGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockcommitAuthorGetterMockRecorder
+}
+
+// MockcommitAuthorGetterMockRecorder is the mock recorder for MockcommitAuthorGetter.
+type MockcommitAuthorGetterMockRecorder struct {
+	mock *MockcommitAuthorGetter
+}
+
+// NewMockcommitAuthorGetter creates a new mock instance.
+func NewMockcommitAuthorGetter(ctrl *gomock.Controller) *MockcommitAuthorGetter {
+	mock := &MockcommitAuthorGetter{ctrl: ctrl}
+	mock.recorder = &MockcommitAuthorGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockcommitAuthorGetter) EXPECT() *MockcommitAuthorGetterMockRecorder {
+	return m.recorder
+}
+
+// CommitAuthor mocks base method.
+func (m *MockcommitAuthorGetter) CommitAuthor(repository, commitID string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CommitAuthor", repository, commitID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CommitAuthor indicates an expected call of CommitAuthor.
+func (mr *MockcommitAuthorGetterMockRecorder) CommitAuthor(repository, commitID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CommitAuthor", reflect.TypeOf((*MockcommitAuthorGetter)(nil).CommitAuthor), repository, commitID)
+}
@@ -249,6 +249,21 @@ func (m *MockworkloadStackDescriber) EXPECT() *MockworkloadStackDescriberMockRec
 	return m.recorder
 }
 
+// AddonsOutputs mocks base method.
+func (m *MockworkloadStackDescriber) AddonsOutputs() (map[string]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddonsOutputs")
+	ret0, _ := ret[0].(map[string]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddonsOutputs indicates an expected call of AddonsOutputs.
+func (mr *MockworkloadStackDescriberMockRecorder) AddonsOutputs() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddonsOutputs", reflect.TypeOf((*MockworkloadStackDescriber)(nil).AddonsOutputs))
+}
+
 // Outputs mocks base method.
 func (m *MockworkloadStackDescriber) Outputs() (map[string]string, error) {
 	m.ctrl.T.Helper()
@@ -317,6 +332,21 @@ func (m *MockecsDescriber) EXPECT() *MockecsDescriberMockRecorder {
 	return m.recorder
 }
 
+// AddonsOutputs mocks base method.
+func (m *MockecsDescriber) AddonsOutputs() (map[string]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddonsOutputs")
+	ret0, _ := ret[0].(map[string]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddonsOutputs indicates an expected call of AddonsOutputs.
+func (mr *MockecsDescriberMockRecorder) AddonsOutputs() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddonsOutputs", reflect.TypeOf((*MockecsDescriber)(nil).AddonsOutputs))
+}
+
 // EnvVars mocks base method.
 func (m *MockecsDescriber) EnvVars() ([]*ecs.ContainerEnvVar, error) {
 	m.ctrl.T.Helper()
@@ -430,6 +460,21 @@ func (m *MockapprunnerDescriber) EXPECT() *MockapprunnerDescriberMockRecorder {
 	return m.recorder
 }
 
+// AddonsOutputs mocks base method.
+func (m *MockapprunnerDescriber) AddonsOutputs() (map[string]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddonsOutputs")
+	ret0, _ := ret[0].(map[string]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddonsOutputs indicates an expected call of AddonsOutputs.
+func (mr *MockapprunnerDescriberMockRecorder) AddonsOutputs() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddonsOutputs", reflect.TypeOf((*MockapprunnerDescriber)(nil).AddonsOutputs))
+}
+
 // Outputs mocks base method.
 func (m *MockapprunnerDescriber) Outputs() (map[string]string, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,177 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/codecommit"
+	"github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+)
+
+const (
+	shortCommitIDLength   = 7  // Number of characters displayed of a commit ID.
+	maxCommitMessageWidth = 50 // Number of characters displayed at most of a commit message.
+)
+
+const (
+	codeCommitProviderName = "CodeCommit"
+	authorUnavailable      = "unavailable" // Displayed for sources whose commit authors can't be retrieved.
+)
+
+type pipelineExecutionLister interface {
+	ListExecutions(pipelineName string, maxResults int) ([]codepipeline.ExecutionSummary, error)
+}
+
+type commitAuthorGetter interface {
+	CommitAuthor(repository, commitID string) (string, error)
+}
+
+// PipelineHistoryDescriber retrieves the past executions of a pipeline.
+type PipelineHistoryDescriber struct {
+	pipelineName string
+	limit        int
+	pipelineSvc  pipelineExecutionLister
+	commitSvc    commitAuthorGetter
+}
+
+// PipelineHistory contains the most recent executions of a pipeline.
+type PipelineHistory struct {
+	PipelineName string                          `json:"pipelineName"`
+	Executions   []codepipeline.ExecutionSummary `json:"executions"`
+}
+
+// NewPipelineHistoryDescriber instantiates a describer for the limit most recent executions of a pipeline.
+func NewPipelineHistoryDescriber(pipelineName string, limit int) (*PipelineHistoryDescriber, error) {
+	sess, err := sessions.NewProvider().Default()
+	if err != nil {
+		return nil, err
+	}
+	return &PipelineHistoryDescriber{
+		pipelineName: pipelineName,
+		limit:        limit,
+		pipelineSvc:  codepipeline.New(sess),
+		commitSvc:    codecommit.New(sess),
+	}, nil
+}
+
+// Describe returns the most recent executions of a pipeline.
+// CodePipeline doesn't return the authors of source revisions, so they are retrieved from the repository for CodeCommit sources
+// when possible. The authors of GitHub, Bitbucket and CodeStar connection sources are not available.
+func (d *PipelineHistoryDescriber) Describe() (HumanJSONStringer, error) {
+	executions, err := d.pipelineSvc.ListExecutions(d.pipelineName, d.limit)
+	if err != nil {
+		return nil, fmt.Errorf("get pipeline history: %w", err)
+	}
+	authors := make(map[string]string) // Executions often share revisions, keyed by repository and commit ID.
+	for _, execution := range executions {
+		for i, revision := range execution.Revisions {
+			if revision.Provider != codeCommitProviderName {
+				continue
+			}
+			key := revision.Repository + "/" + revision.ID
+			author, ok := authors[key]
+			if !ok {
+				author, err = d.commitSvc.CommitAuthor(revision.Repository, revision.ID)
+				if err != nil {
+					// The author is best effort, e.g. the repository could be deleted since the execution.
+					log.Debugf("get author of revision %s: %v\n", revision.ID, err)
+					author = ""
+				}
+				authors[key] = author
+			}
+			execution.Revisions[i].Author = author
+		}
+	}
+	return &PipelineHistory{
+		PipelineName: d.pipelineName,
+		Executions:   executions,
+	}, nil
+}
+
+// JSONString returns stringified PipelineHistory struct with json format.
+func (p PipelineHistory) JSONString() (string, error) {
+	b, err := json.Marshal(p)
+	if err != nil {
+		return "", fmt.Errorf("marshal pipeline history: %w", err)
+	}
+	return fmt.Sprintf("%s\n", b), nil
+}
+
+// HumanString returns stringified PipelineHistory struct with human readable format.
+func (p PipelineHistory) HumanString() string {
+	var b bytes.Buffer
+	writer := tabwriter.NewWriter(&b, minCellWidth, tabWidth, cellPaddingWidth, paddingChar, noAdditionalFormatting)
+	fmt.Fprint(writer, color.Bold.Sprint("Pipeline History\n\n"))
+	writer.Flush()
+	headers := []string{"Execution ID", "Status", "Started", "Duration", "Commit", "Author", "Message", "Triggered By"}
+	fmt.Fprintf(writer, "  %s\n", strings.Join(headers, "\t"))
+	fmt.Fprintf(writer, "  %s\n", strings.Join(underline(headers), "\t"))
+	for _, execution := range p.Executions {
+		commit, author, message := "-", "-", "-"
+		if len(execution.Revisions) > 0 {
+			revision := execution.Revisions[0]
+			commit = shortCommitID(revision.ID)
+			switch {
+			case revision.Author != "":
+				author = revision.Author
+			case revision.Provider != "" && revision.Provider != codeCommitProviderName:
+				author = authorUnavailable
+			}
+			message = firstLine(revision.Message, maxCommitMessageWidth)
+		}
+		fmt.Fprintf(writer, "  %s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+			execution.ID,
+			checkStatusColor(execution.Status),
+			humanizeTime(execution.StartedAt),
+			execution.Duration().Round(time.Second),
+			commit,
+			author,
+			message,
+			triggeredBy(execution),
+		)
+	}
+	writer.Flush()
+	return b.String()
+}
+
+func shortCommitID(id string) string {
+	if len(id) <= shortCommitIDLength {
+		return id
+	}
+	return id[:shortCommitIDLength]
+}
+
+// firstLine returns the first line of a message, truncated to maxWidth characters.
+func firstLine(msg string, maxWidth int) string {
+	line := strings.SplitN(strings.TrimSpace(msg), "\n", 2)[0]
+	if line == "" {
+		return "-"
+	}
+	if runes := []rune(line); len(runes) > maxWidth {
+		return string(runes[:maxWidth-3]) + "..."
+	}
+	return line
+}
+
+// triggeredBy returns who or what started the execution.
+// Executions started manually are attributed to the name of the IAM identity, and other executions to their trigger, e.g. "Webhook".
+func triggeredBy(execution codepipeline.ExecutionSummary) string {
+	if execution.TriggeredBy == "" || !strings.HasPrefix(execution.TriggeredBy, "arn:") {
+		if execution.Trigger == "" {
+			return "-"
+		}
+		return execution.Trigger
+	}
+	parts := strings.Split(execution.TriggeredBy, "/")
+	return parts[len(parts)-1]
+}
@@ -0,0 +1,215 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
+	"github.com/aws/copilot-cli/internal/pkg/describe/mocks"
+	"github.com/dustin/go-humanize"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+var mockExecutions = []codepipeline.ExecutionSummary{
+	{
+		ID:          "a1b2c3d4-5678-90ab-cdef-111111111111",
+		Status:      "InProgress",
+		StartedAt:   mockParsedTime().Add(time.Hour),
+		UpdatedAt:   mockParsedTime().Add(time.Hour + 90*time.Second),
+		Trigger:     "StartPipelineExecution",
+		TriggeredBy: "arn:aws:sts::123456789012:assumed-role/Admin/alice",
+	},
+	{
+		ID:        "a1b2c3d4-5678-90ab-cdef-000000000000",
+		Status:    "Succeeded",
+		StartedAt: mockParsedTime(),
+		UpdatedAt: mockParsedTime().Add(12 * time.Minute),
+		Revisions: []codepipeline.SourceRevision{
+			{
+				ActionName: "SourceCodeFor-dinder",
+				ID:         "abcdef1234567890",
+				Message:    "Add a new badgoose feature that keeps the geese away from the pond\n\nMore details.",
+				Author:     "Alice",
+			},
+		},
+		Trigger: "Webhook",
+	},
+}
+
+type pipelineHistoryMocks struct {
+	pipelineSvc *mocks.MockpipelineExecutionLister
+	commitSvc   *mocks.MockcommitAuthorGetter
+}
+
+func TestPipelineHistoryDescriber_Describe(t *testing.T) {
+	// codeCommitExecutions returns new executions for every test case since the describer fills in the authors.
+	codeCommitExecutions := func() []codepipeline.ExecutionSummary {
+		var executions []codepipeline.ExecutionSummary
+		for _, id := range []string{"2", "1"} {
+			executions = append(executions, codepipeline.ExecutionSummary{
+				ID:     id,
+				Status: "Succeeded",
+				Revisions: []codepipeline.SourceRevision{
+					{
+						ActionName: "SourceCodeFor-dinder",
+						Provider:   "CodeCommit",
+						Repository: "badgoose",
+						ID:         "abcdef1234567890",
+					},
+				},
+			})
+		}
+		return executions
+	}
+	testCases := map[string]struct {
+		setupMocks func(m pipelineHistoryMocks)
+
+		expectedOutput HumanJSONStringer
+		expectedError  error
+	}{
+		"wraps error if fail to list executions": {
+			setupMocks: func(m pipelineHistoryMocks) {
+				m.pipelineSvc.EXPECT().ListExecutions(mockPipelineName, 10).Return(nil, errors.New("some error"))
+			},
+			expectedError: errors.New("get pipeline history: some error"),
+		},
+		"leaves the author empty if fail to get the author of a CodeCommit revision": {
+			setupMocks: func(m pipelineHistoryMocks) {
+				m.pipelineSvc.EXPECT().ListExecutions(mockPipelineName, 10).Return(codeCommitExecutions(), nil)
+				m.commitSvc.EXPECT().CommitAuthor("badgoose", "abcdef1234567890").Return("", errors.New("some error")).Times(1)
+			},
+			expectedOutput: &PipelineHistory{
+				PipelineName: mockPipelineName,
+				Executions:   codeCommitExecutions(),
+			},
+		},
+		"returns the executions": {
+			setupMocks: func(m pipelineHistoryMocks) {
+				m.pipelineSvc.EXPECT().ListExecutions(mockPipelineName, 10).Return(mockExecutions, nil)
+				m.commitSvc.EXPECT().CommitAuthor(gomock.Any(), gomock.Any()).Times(0)
+			},
+			expectedOutput: &PipelineHistory{
+				PipelineName: mockPipelineName,
+				Executions:   mockExecutions,
+			},
+		},
+		"fills in the authors of CodeCommit revisions once per commit": {
+			setupMocks: func(m pipelineHistoryMocks) {
+				m.pipelineSvc.EXPECT().ListExecutions(mockPipelineName, 10).Return(codeCommitExecutions(), nil)
+				m.commitSvc.EXPECT().CommitAuthor("badgoose", "abcdef1234567890").Return("Alice", nil).Times(1)
+			},
+			expectedOutput: &PipelineHistory{
+				PipelineName: mockPipelineName,
+				Executions: func() []codepipeline.ExecutionSummary {
+					executions := codeCommitExecutions()
+					for i := range executions {
+						executions[i].Revisions[0].Author = "Alice"
+					}
+					return executions
+				}(),
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := pipelineHistoryMocks{
+				pipelineSvc: mocks.NewMockpipelineExecutionLister(ctrl),
+				commitSvc:   mocks.NewMockcommitAuthorGetter(ctrl),
+			}
+			tc.setupMocks(m)
+			describer := &PipelineHistoryDescriber{
+				pipelineName: mockPipelineName,
+				limit:        10,
+				pipelineSvc:  m.pipelineSvc,
+				commitSvc:    m.commitSvc,
+			}
+
+			// WHEN
+			history, err := describer.Describe()
+
+			// THEN
+			if tc.expectedError != nil {
+				require.EqualError(t, err, tc.expectedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expectedOutput, history)
+			}
+		})
+	}
+}
+
+func TestPipelineHistory_String(t *testing.T) {
+	oldHumanize := humanizeTime
+	humanizeTime = func(then time.Time) string {
+		now, _ := time.Parse(time.RFC3339, "2020-06-19T00:00:00+00:00")
+		return humanize.RelTime(then, now, "ago", "from now")
+	}
+	defer func() {
+		humanizeTime = oldHumanize
+	}()
+	history := &PipelineHistory{
+		PipelineName: mockPipelineName,
+		Executions:   mockExecutions,
+	}
+
+	human := history.HumanString()
+	json, err := history.JSONString()
+
+	require.NoError(t, err)
+	require.Equal(t, `Pipeline History
+
+  Execution ID                          Status      Started       Duration  Commit    Author    Message                                             Triggered By
+  ------------                          ------      -------       --------  ------    ------    -------                                             ------------
+  a1b2c3d4-5678-90ab-cdef-111111111111  InProgress  4 months ago  1m30s     -         -         -                                                   alice
+  a1b2c3d4-5678-90ab-cdef-000000000000  Succeeded   4 months ago  12m0s     abcdef1   Alice     Add a new badgoose feature that keeps the geese...  Webhook
+`, human)
+	require.Equal(t, `{"pipelineName":"pipeline-dinder-badgoose-repo","executions":[{"id":"a1b2c3d4-5678-90ab-cdef-111111111111","status":"InProgress","startedAt":"2020-02-02T16:04:05Z","updatedAt":"2020-02-02T16:05:35Z","trigger":"StartPipelineExecution","triggeredBy":"arn:aws:sts::123456789012:assumed-role/Admin/alice"},{"id":"a1b2c3d4-5678-90ab-cdef-000000000000","status":"Succeeded","startedAt":"2020-02-02T15:04:05Z","updatedAt":"2020-02-02T15:16:05Z","sourceRevisions":[{"actionName":"SourceCodeFor-dinder","id":"abcdef1234567890","message":"Add a new badgoose feature that keeps the geese away from the pond\n\nMore details.","author":"Alice"}],"trigger":"Webhook"}]}`+"\n", json)
+}
+
+func TestPipelineHistory_HumanStringUnavailableAuthor(t *testing.T) {
+	oldHumanize := humanizeTime
+	humanizeTime = func(then time.Time) string {
+		now, _ := time.Parse(time.RFC3339, "2020-06-19T00:00:00+00:00")
+		return humanize.RelTime(then, now, "ago", "from now")
+	}
+	defer func() {
+		humanizeTime = oldHumanize
+	}()
+	history := &PipelineHistory{
+		PipelineName: mockPipelineName,
+		Executions: []codepipeline.ExecutionSummary{
+			{
+				ID:        "a1b2c3d4-5678-90ab-cdef-222222222222",
+				Status:    "Succeeded",
+				StartedAt: mockParsedTime(),
+				UpdatedAt: mockParsedTime().Add(12 * time.Minute),
+				Revisions: []codepipeline.SourceRevision{
+					{
+						ActionName: "SourceCodeFor-dinder",
+						Provider:   "CodeStarSourceConnection",
+						ID:         "1234567abcdef",
+						Message:    "Fix the pond",
+					},
+				},
+				Trigger: "Webhook",
+			},
+		},
+	}
+
+	require.Equal(t, `Pipeline History
+
+  Execution ID                          Status     Started       Duration  Commit    Author       Message       Triggered By
+  ------------                          ------     -------       --------  ------    ------       -------       ------------
+  a1b2c3d4-5678-90ab-cdef-222222222222  Succeeded  4 months ago  12m0s     1234567   unavailable  Fix the pond  Webhook
+`, history.HumanString())
+}
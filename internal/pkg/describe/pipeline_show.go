@@ -97,7 +97,7 @@ func (p *Pipeline) HumanString() string {
 	writer.Flush()
 	fmt.Fprint(writer, color.Bold.Sprint("\nStages\n\n"))
 	writer.Flush()
-	headers := []string{"Name", "Category", "Provider", "Details"}
+	headers := []string{"Name", "Category", "Provider", "Regions", "Details"}
 	fmt.Fprintf(writer, "  %s\n", strings.Join(headers, "\t"))
 	fmt.Fprintf(writer, "  %s\n", strings.Join(underline(headers), "\t"))
 	for _, stage := range p.Stages {
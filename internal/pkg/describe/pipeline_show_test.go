@@ -36,18 +36,21 @@ var mockPipeline = &codepipeline.Pipeline{
 			Name:     "Source",
 			Category: "Source",
 			Provider: "GitHub",
+			Regions:  []string{"us-west-2"},
 			Details:  "Repository: badgoose/repo",
 		},
 		{
 			Name:     "Build",
 			Category: "Build",
 			Provider: "CodeBuild",
+			Regions:  []string{"us-west-2"},
 			Details:  "BuildProject: pipeline-dinder-badgoose-repo-BuildProject",
 		},
 		{
 			Name:     "DeployTo-test",
 			Category: "Deploy",
 			Provider: "CloudFormation",
+			Regions:  []string{"us-east-1"},
 			Details:  "StackName: dinder-test-test",
 		},
 	},
@@ -213,11 +216,11 @@ func TestPipelineDescriber_String(t *testing.T) {
 
 Stages
 
-  Name           Category  Provider        Details
-  ----           --------  --------        -------
-  Source         Source    GitHub          Repository: badgoose/repo
-  Build          Build     CodeBuild       BuildProject: pipeline-dinder-badgoose-repo-BuildProject
-  DeployTo-test  Deploy    CloudFormation  StackName: dinder-test-test
+  Name           Category  Provider        Regions    Details
+  ----           --------  --------        -------    -------
+  Source         Source    GitHub          us-west-2  Repository: badgoose/repo
+  Build          Build     CodeBuild       us-west-2  BuildProject: pipeline-dinder-badgoose-repo-BuildProject
+  DeployTo-test  Deploy    CloudFormation  us-east-1  StackName: dinder-test-test
 
 Resources
     AWS::CodeBuild::Project      pipeline-dinder-badgoose-repo-BuildProject
@@ -227,7 +230,7 @@ Resources
     AWS::IAM::Role               pipeline-dinder-badgoose-repo-PipelineRole-100SEEQN6CU0F
     AWS::IAM::Policy             pipel-Pipe-EO4QGE10RJ8F
 `,
-			expectedJSONString: "{\"name\":\"pipeline-dinder-badgoose-repo\",\"region\":\"us-west-2\",\"accountId\":\"1234567890\",\"stages\":[{\"name\":\"Source\",\"category\":\"Source\",\"provider\":\"GitHub\",\"details\":\"Repository: badgoose/repo\"},{\"name\":\"Build\",\"category\":\"Build\",\"provider\":\"CodeBuild\",\"details\":\"BuildProject: pipeline-dinder-badgoose-repo-BuildProject\"},{\"name\":\"DeployTo-test\",\"category\":\"Deploy\",\"provider\":\"CloudFormation\",\"details\":\"StackName: dinder-test-test\"}],\"createdAt\":\"2020-02-02T15:04:05Z\",\"updatedAt\":\"2020-02-02T15:04:05Z\",\"resources\":[{\"type\":\"AWS::CodeBuild::Project\",\"physicalID\":\"pipeline-dinder-badgoose-repo-BuildProject\"},{\"type\":\"AWS::IAM::Policy\",\"physicalID\":\"pipel-Buil-1PEASDDL44ID2\"},{\"type\":\"AWS::IAM::Role\",\"physicalID\":\"pipeline-dinder-badgoose-repo-BuildProjectRole-A4V6VSG1XIIJ\"},{\"type\":\"AWS::CodePipeline::Pipeline\",\"physicalID\":\"pipeline-dinder-badgoose-repo\"},{\"type\":\"AWS::IAM::Role\",\"physicalID\":\"pipeline-dinder-badgoose-repo-PipelineRole-100SEEQN6CU0F\"},{\"type\":\"AWS::IAM::Policy\",\"physicalID\":\"pipel-Pipe-EO4QGE10RJ8F\"}]}\n",
+			expectedJSONString: "{\"name\":\"pipeline-dinder-badgoose-repo\",\"region\":\"us-west-2\",\"accountId\":\"1234567890\",\"stages\":[{\"name\":\"Source\",\"category\":\"Source\",\"provider\":\"GitHub\",\"regions\":[\"us-west-2\"],\"details\":\"Repository: badgoose/repo\"},{\"name\":\"Build\",\"category\":\"Build\",\"provider\":\"CodeBuild\",\"regions\":[\"us-west-2\"],\"details\":\"BuildProject: pipeline-dinder-badgoose-repo-BuildProject\"},{\"name\":\"DeployTo-test\",\"category\":\"Deploy\",\"provider\":\"CloudFormation\",\"regions\":[\"us-east-1\"],\"details\":\"StackName: dinder-test-test\"}],\"createdAt\":\"2020-02-02T15:04:05Z\",\"updatedAt\":\"2020-02-02T15:04:05Z\",\"resources\":[{\"type\":\"AWS::CodeBuild::Project\",\"physicalID\":\"pipeline-dinder-badgoose-repo-BuildProject\"},{\"type\":\"AWS::IAM::Policy\",\"physicalID\":\"pipel-Buil-1PEASDDL44ID2\"},{\"type\":\"AWS::IAM::Role\",\"physicalID\":\"pipeline-dinder-badgoose-repo-BuildProjectRole-A4V6VSG1XIIJ\"},{\"type\":\"AWS::CodePipeline::Pipeline\",\"physicalID\":\"pipeline-dinder-badgoose-repo\"},{\"type\":\"AWS::IAM::Role\",\"physicalID\":\"pipeline-dinder-badgoose-repo-PipelineRole-100SEEQN6CU0F\"},{\"type\":\"AWS::IAM::Policy\",\"physicalID\":\"pipel-Pipe-EO4QGE10RJ8F\"}]}\n",
 		},
 		"correct output without resources": {
 			inPipeline: &Pipeline{*mockPipeline, nil},
@@ -241,13 +244,13 @@ Resources
 
 Stages
 
-  Name           Category  Provider        Details
-  ----           --------  --------        -------
-  Source         Source    GitHub          Repository: badgoose/repo
-  Build          Build     CodeBuild       BuildProject: pipeline-dinder-badgoose-repo-BuildProject
-  DeployTo-test  Deploy    CloudFormation  StackName: dinder-test-test
+  Name           Category  Provider        Regions    Details
+  ----           --------  --------        -------    -------
+  Source         Source    GitHub          us-west-2  Repository: badgoose/repo
+  Build          Build     CodeBuild       us-west-2  BuildProject: pipeline-dinder-badgoose-repo-BuildProject
+  DeployTo-test  Deploy    CloudFormation  us-east-1  StackName: dinder-test-test
 `,
-			expectedJSONString: "{\"name\":\"pipeline-dinder-badgoose-repo\",\"region\":\"us-west-2\",\"accountId\":\"1234567890\",\"stages\":[{\"name\":\"Source\",\"category\":\"Source\",\"provider\":\"GitHub\",\"details\":\"Repository: badgoose/repo\"},{\"name\":\"Build\",\"category\":\"Build\",\"provider\":\"CodeBuild\",\"details\":\"BuildProject: pipeline-dinder-badgoose-repo-BuildProject\"},{\"name\":\"DeployTo-test\",\"category\":\"Deploy\",\"provider\":\"CloudFormation\",\"details\":\"StackName: dinder-test-test\"}],\"createdAt\":\"2020-02-02T15:04:05Z\",\"updatedAt\":\"2020-02-02T15:04:05Z\"}\n",
+			expectedJSONString: "{\"name\":\"pipeline-dinder-badgoose-repo\",\"region\":\"us-west-2\",\"accountId\":\"1234567890\",\"stages\":[{\"name\":\"Source\",\"category\":\"Source\",\"provider\":\"GitHub\",\"regions\":[\"us-west-2\"],\"details\":\"Repository: badgoose/repo\"},{\"name\":\"Build\",\"category\":\"Build\",\"provider\":\"CodeBuild\",\"regions\":[\"us-west-2\"],\"details\":\"BuildProject: pipeline-dinder-badgoose-repo-BuildProject\"},{\"name\":\"DeployTo-test\",\"category\":\"Deploy\",\"provider\":\"CloudFormation\",\"regions\":[\"us-east-1\"],\"details\":\"StackName: dinder-test-test\"}],\"createdAt\":\"2020-02-02T15:04:05Z\",\"updatedAt\":\"2020-02-02T15:04:05Z\"}\n",
 		},
 	}
 	for _, tc := range testCases {
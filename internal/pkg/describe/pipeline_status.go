@@ -76,8 +76,43 @@ func (p PipelineStatus) HumanString() string {
 		fmt.Fprint(writer, stage.HumanString())
 	}
 	writer.Flush()
+	var hasChecks bool
+	for _, stage := range p.StageStates {
+		if len(stage.PostDeploymentChecks()) > 0 {
+			hasChecks = true
+			break
+		}
+	}
+	if hasChecks {
+		fmt.Fprint(writer, color.Bold.Sprint("\nPost-deployment Checks\n\n"))
+		writer.Flush()
+		headers := []string{"Stage", "Check", "Status"}
+		fmt.Fprintf(writer, "  %s\n", strings.Join(headers, "\t"))
+		fmt.Fprintf(writer, "  %s\n", strings.Join(underline(headers), "\t"))
+		for _, stage := range p.StageStates {
+			for _, check := range stage.PostDeploymentChecks() {
+				fmt.Fprintf(writer, "  %s\t%s\t%s\n", stage.StageName, check.Name, checkStatusColor(check.Status))
+			}
+		}
+		writer.Flush()
+	}
 	fmt.Fprint(writer, color.Bold.Sprint("\nLast Deployment\n\n"))
 	fmt.Fprintf(writer, "  %s\t%s\n", "Updated At", humanizeTime(p.UpdatedAt))
 	writer.Flush()
 	return b.String()
 }
+
+func checkStatusColor(status string) string {
+	switch status {
+	case "Succeeded":
+		return color.Green.Sprint(status)
+	case "InProgress":
+		return color.Yellow.Sprint(status)
+	case "Failed", "Abandoned":
+		return color.Red.Sprint(status)
+	case "":
+		return "-"
+	default:
+		return status
+	}
+}
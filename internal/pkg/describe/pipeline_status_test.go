@@ -169,6 +169,53 @@ Last Deployment
 `,
 			expectedJSONString: "{\"pipelineName\":\"pipeline-dinder-badgoose-repo\",\"stageStates\":[{\"stageName\":\"Source\",\"transition\":\"\"},{\"stageName\":\"Build\",\"actions\":[{\"name\":\"action1\",\"status\":\"Failed\"},{\"name\":\"action2\",\"status\":\"InProgress\"},{\"name\":\"action3\",\"status\":\"Succeeded\"}],\"transition\":\"ENABLED\"},{\"stageName\":\"DeployTo-test\",\"actions\":[{\"name\":\"action1\",\"status\":\"Succeeded\"}],\"transition\":\"DISABLED\"},{\"stageName\":\"DeployTo-prod\",\"actions\":[{\"name\":\"action1\",\"status\":\"Succeeded\"},{\"name\":\"TestCommands\",\"status\":\"Failed\"}],\"transition\":\"\"}],\"updatedAt\":\"2020-02-02T15:04:05Z\"}\n",
 		},
+		"shows the results of post deployment checks": {
+			testPipelineStatus: &PipelineStatus{codepipeline.PipelineState{
+				PipelineName: "pipeline-dinder-badgoose-repo",
+				StageStates: []*codepipeline.StageState{
+					{
+						StageName: "DeployTo-test",
+						Actions: []codepipeline.StageAction{
+							{
+								Name:   "CreateOrUpdate-api-test",
+								Status: "Succeeded",
+							},
+							{
+								Name:   "PostDeployment-integration",
+								Status: "Failed",
+							},
+							{
+								Name:   "PostDeployment-smoke",
+								Status: "Succeeded",
+							},
+						},
+						Transition: "ENABLED",
+					},
+				},
+				UpdatedAt: mockParsedTime(),
+			}},
+			expectedHumanString: `Pipeline Status
+
+Stage                           Transition  Status
+-----                           ----------  ------
+DeployTo-test                   ENABLED     Failed
+├── CreateOrUpdate-api-test                 Succeeded
+├── PostDeployment-integration              Failed
+└── PostDeployment-smoke                    Succeeded
+
+Post-deployment Checks
+
+  Stage          Check        Status
+  -----          -----        ------
+  DeployTo-test  integration  Failed
+  DeployTo-test  smoke        Succeeded
+
+Last Deployment
+
+  Updated At  4 months ago
+`,
+			expectedJSONString: "{\"pipelineName\":\"pipeline-dinder-badgoose-repo\",\"stageStates\":[{\"stageName\":\"DeployTo-test\",\"actions\":[{\"name\":\"CreateOrUpdate-api-test\",\"status\":\"Succeeded\"},{\"name\":\"PostDeployment-integration\",\"status\":\"Failed\"},{\"name\":\"PostDeployment-smoke\",\"status\":\"Succeeded\"}],\"transition\":\"ENABLED\"}],\"updatedAt\":\"2020-02-02T15:04:05Z\"}\n",
+		},
 	}
 	for _, tc := range testCases {
 		human := tc.testPipelineStatus.HumanString()
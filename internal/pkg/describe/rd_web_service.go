@@ -65,6 +65,7 @@ func (d *RDWebServiceDescriber) Describe() (HumanJSONStringer, error) {
 	var routes []*WebServiceRoute
 	var configs []*ServiceConfig
 	var envVars envVars
+	var cdns []*Distribution
 	resources := make(map[string][]*stack.Resource)
 	for _, env := range environments {
 		err := d.initClients(env)
@@ -95,6 +96,12 @@ func (d *RDWebServiceDescriber) Describe() (HumanJSONStringer, error) {
 			})
 		}
 
+		addonsOutputs, err := d.envSvcDescribers[env].AddonsOutputs()
+		if err != nil {
+			return nil, fmt.Errorf("retrieve addons outputs: %w", err)
+		}
+		cdns = append(cdns, flattenDistributions(env, addonsOutputs)...)
+
 		if d.enableResources {
 			stackResources, err := d.envSvcDescribers[env].ServiceStackResources()
 			if err != nil {
@@ -111,6 +118,7 @@ func (d *RDWebServiceDescriber) Describe() (HumanJSONStringer, error) {
 		AppRunnerConfigurations: configs,
 		Routes:                  routes,
 		Variables:               envVars,
+		Distributions:           cdns,
 		Resources:               resources,
 
 		environments: environments,
@@ -125,6 +133,7 @@ type rdWebSvcDesc struct {
 	AppRunnerConfigurations appRunnerConfigurations `json:"configurations"`
 	Routes                  []*WebServiceRoute      `json:"routes"`
 	Variables               envVars                 `json:"variables"`
+	Distributions           distributions           `json:"distributions,omitempty"`
 	Resources               deployedSvcResources    `json:"resources,omitempty"`
 
 	environments []string `json:"-"`
@@ -163,6 +172,11 @@ func (w *rdWebSvcDesc) HumanString() string {
 	fmt.Fprint(writer, color.Bold.Sprint("\nVariables\n\n"))
 	writer.Flush()
 	w.Variables.humanString(writer)
+	if len(w.Distributions) != 0 {
+		fmt.Fprint(writer, color.Bold.Sprint("\nCloudFront Distributions\n\n"))
+		writer.Flush()
+		w.Distributions.humanString(writer)
+	}
 
 	if len(w.Resources) != 0 {
 		fmt.Fprint(writer, color.Bold.Sprint("\nResources\n"))
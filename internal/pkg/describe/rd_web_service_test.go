@@ -95,6 +95,7 @@ func TestRDWebServiceDescriber_Describe(t *testing.T) {
 				gomock.InOrder(
 					m.storeSvc.EXPECT().ListEnvironmentsDeployedTo(testApp, testSvc).Return([]string{testEnv}, nil),
 					m.ecsSvcDescriber.EXPECT().Service().Return(&apprunner.Service{}, nil),
+					m.ecsSvcDescriber.EXPECT().AddonsOutputs().Return(nil, nil),
 					m.ecsSvcDescriber.EXPECT().ServiceStackResources().Return(nil, mockErr),
 				)
 			},
@@ -118,6 +119,7 @@ func TestRDWebServiceDescriber_Describe(t *testing.T) {
 							},
 						},
 					}, nil),
+					m.ecsSvcDescriber.EXPECT().AddonsOutputs().Return(nil, nil),
 					m.ecsSvcDescriber.EXPECT().ServiceStackResources().Return([]*stack.Resource{
 						{
 							Type:       "AWS::AppRunner::Service",
@@ -137,6 +139,7 @@ func TestRDWebServiceDescriber_Describe(t *testing.T) {
 							},
 						},
 					}, nil),
+					m.ecsSvcDescriber.EXPECT().AddonsOutputs().Return(nil, nil),
 					m.ecsSvcDescriber.EXPECT().ServiceStackResources().Return([]*stack.Resource{
 						{
 							Type:       "AWS::AppRunner::Service",
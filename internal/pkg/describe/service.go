@@ -9,8 +9,10 @@ import (
 	"io"
 	"net/url"
 	"sort"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/copilot-cli/internal/pkg/addon"
 	"github.com/aws/copilot-cli/internal/pkg/aws/apprunner"
 	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
 
@@ -29,6 +31,11 @@ const (
 
 const apprunnerServiceType = "AWS::AppRunner::Service"
 
+const (
+	// Suffix of the addons outputs holding the URL of a CloudFront distribution created by "storage init".
+	distributionURLOutputSuffix = "DistributionURL"
+)
+
 // ConfigStoreSvc wraps methods of config store.
 type ConfigStoreSvc interface {
 	GetEnvironment(appName string, environmentName string) (*config.Environment, error)
@@ -54,6 +61,7 @@ type apprunnerClient interface {
 type workloadStackDescriber interface {
 	Params() (map[string]string, error)
 	Outputs() (map[string]string, error)
+	AddonsOutputs() (map[string]string, error)
 	ServiceStackResources() ([]*stack.Resource, error)
 }
 
@@ -81,6 +89,8 @@ type serviceStackDescriber struct {
 
 	cfn  stackDescriber
 	sess *session.Session
+
+	newStackDescriber func(stackName string) stackDescriber
 }
 
 // ECSServiceDescriber retrieves information about a non-App Runner service.
@@ -189,6 +199,26 @@ func (d *serviceStackDescriber) ServiceStackResources() ([]*stack.Resource, erro
 	return resources, nil
 }
 
+// AddonsOutputs returns the outputs of the addons nested stack of the service.
+// If the service doesn't have addons, returns nil.
+func (d *serviceStackDescriber) AddonsOutputs() (map[string]string, error) {
+	svcResources, err := d.cfn.Resources()
+	if err != nil {
+		return nil, err
+	}
+	for _, svcResource := range svcResources {
+		if svcResource.LogicalID != addon.StackName {
+			continue
+		}
+		descr, err := d.newStackDescriber(svcResource.PhysicalID).Describe()
+		if err != nil {
+			return nil, err
+		}
+		return descr.Outputs, nil
+	}
+	return nil, nil
+}
+
 // Platform returns the platform of the task definition.
 func (d *ECSServiceDescriber) Platform() (*awsecs.ContainerPlatform, error) {
 	taskDefinition, err := d.ecsClient.TaskDefinition(d.app, d.env, d.service)
@@ -273,6 +303,9 @@ func newServiceStackDescriber(opt NewServiceConfig) (*serviceStackDescriber, err
 
 		cfn:  stack.NewStackDescriber(cfnstack.NameForService(opt.App, opt.Env, opt.Svc), sess),
 		sess: sess,
+		newStackDescriber: func(stackName string) stackDescriber {
+			return stack.NewStackDescriber(stackName, sess)
+		},
 	}, nil
 }
 
@@ -315,6 +348,42 @@ func (c appRunnerConfigurations) humanString(w io.Writer) {
 	printTable(w, headers, rows)
 }
 
+// Distribution contains serialized information about a CloudFront distribution that serves the objects of an S3 bucket.
+type Distribution struct {
+	Environment string `json:"environment"`
+	Bucket      string `json:"bucket"`
+	URL         string `json:"url"`
+}
+
+type distributions []*Distribution
+
+func (d distributions) humanString(w io.Writer) {
+	headers := []string{"Environment", "Bucket", "URL"}
+	var rows [][]string
+	for _, distribution := range d {
+		rows = append(rows, []string{distribution.Environment, distribution.Bucket, distribution.URL})
+	}
+
+	printTable(w, headers, rows)
+}
+
+// flattenDistributions returns the CloudFront distributions found in the addons outputs of a service, sorted by bucket.
+func flattenDistributions(env string, addonsOutputs map[string]string) []*Distribution {
+	var out []*Distribution
+	for name, value := range addonsOutputs {
+		if !strings.HasSuffix(name, distributionURLOutputSuffix) {
+			continue
+		}
+		out = append(out, &Distribution{
+			Environment: env,
+			Bucket:      strings.TrimSuffix(name, distributionURLOutputSuffix),
+			URL:         value,
+		})
+	}
+	sort.SliceStable(out, func(i, j int) bool { return out[i].Bucket < out[j].Bucket })
+	return out
+}
+
 // envVar contains serialized environment variables for a service.
 type envVar struct {
 	Environment string `json:"environment"`
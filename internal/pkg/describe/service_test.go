@@ -301,6 +301,103 @@ func TestServiceDescriber_ServiceStackResources(t *testing.T) {
 	}
 }
 
+func TestServiceDescriber_AddonsOutputs(t *testing.T) {
+	const addonsStackID = "arn:aws:cloudformation:us-west-2:1234567890:stack/phonetool-test-jobs-AddonsStack-1/1"
+	testCases := map[string]struct {
+		setupMocks func(cfn, addonsCFN *mocks.MockstackDescriber)
+
+		wantedStackName string
+		wantedOutputs   map[string]string
+		wantedError     error
+	}{
+		"returns error when fail to describe stack resources": {
+			setupMocks: func(cfn, _ *mocks.MockstackDescriber) {
+				cfn.EXPECT().Resources().Return(nil, errors.New("some error"))
+			},
+
+			wantedError: fmt.Errorf("some error"),
+		},
+		"returns nil if the service doesn't have addons": {
+			setupMocks: func(cfn, _ *mocks.MockstackDescriber) {
+				cfn.EXPECT().Resources().Return([]*stack.Resource{
+					{
+						Type:       "AWS::EC2::SecurityGroup",
+						PhysicalID: "sg-0758ed6b233743530",
+						LogicalID:  "EnvironmentSecurityGroup",
+					},
+				}, nil)
+			},
+		},
+		"returns error when fail to describe the addons stack": {
+			setupMocks: func(cfn, addonsCFN *mocks.MockstackDescriber) {
+				cfn.EXPECT().Resources().Return([]*stack.Resource{
+					{
+						Type:       "AWS::CloudFormation::Stack",
+						PhysicalID: addonsStackID,
+						LogicalID:  "AddonsStack",
+					},
+				}, nil)
+				addonsCFN.EXPECT().Describe().Return(stack.StackDescription{}, errors.New("some error"))
+			},
+
+			wantedStackName: addonsStackID,
+			wantedError:     fmt.Errorf("some error"),
+		},
+		"returns the outputs of the addons stack": {
+			setupMocks: func(cfn, addonsCFN *mocks.MockstackDescriber) {
+				cfn.EXPECT().Resources().Return([]*stack.Resource{
+					{
+						Type:       "AWS::CloudFormation::Stack",
+						PhysicalID: addonsStackID,
+						LogicalID:  "AddonsStack",
+					},
+				}, nil)
+				addonsCFN.EXPECT().Describe().Return(stack.StackDescription{
+					Outputs: map[string]string{
+						"assetsDistributionURL": "https://d111111abcdef8.cloudfront.net",
+					},
+				}, nil)
+			},
+
+			wantedStackName: addonsStackID,
+			wantedOutputs: map[string]string{
+				"assetsDistributionURL": "https://d111111abcdef8.cloudfront.net",
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockCFN := mocks.NewMockstackDescriber(ctrl)
+			mockAddonsCFN := mocks.NewMockstackDescriber(ctrl)
+			tc.setupMocks(mockCFN, mockAddonsCFN)
+
+			d := &serviceStackDescriber{
+				cfn: mockCFN,
+				newStackDescriber: func(stackName string) stackDescriber {
+					require.Equal(t, tc.wantedStackName, stackName)
+					return mockAddonsCFN
+				},
+			}
+
+			// WHEN
+			actual, err := d.AddonsOutputs()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedOutputs, actual)
+			}
+		})
+	}
+}
+
 func TestServiceDescriber_Platform(t *testing.T) {
 	const (
 		testApp = "phonetool"
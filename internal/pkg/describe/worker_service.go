@@ -66,6 +66,7 @@ func (d *WorkerServiceDescriber) Describe() (HumanJSONStringer, error) {
 	var configs []*ECSServiceConfig
 	var envVars []*containerEnvVar
 	var secrets []*secret
+	var cdns []*Distribution
 	for _, env := range environments {
 		err := d.initClients(env)
 		if err != nil {
@@ -99,6 +100,11 @@ func (d *WorkerServiceDescriber) Describe() (HumanJSONStringer, error) {
 			return nil, fmt.Errorf("retrieve secrets: %w", err)
 		}
 		secrets = append(secrets, flattenSecrets(env, webSvcSecrets)...)
+		addonsOutputs, err := d.svcStackDescriber[env].AddonsOutputs()
+		if err != nil {
+			return nil, fmt.Errorf("retrieve addons outputs: %w", err)
+		}
+		cdns = append(cdns, flattenDistributions(env, addonsOutputs)...)
 	}
 
 	resources := make(map[string][]*stack.Resource)
@@ -123,6 +129,7 @@ func (d *WorkerServiceDescriber) Describe() (HumanJSONStringer, error) {
 		Configurations: configs,
 		Variables:      envVars,
 		Secrets:        secrets,
+		Distributions:  cdns,
 		Resources:      resources,
 
 		environments: environments,
@@ -137,6 +144,7 @@ type workerSvcDesc struct {
 	Configurations ecsConfigurations    `json:"configurations"`
 	Variables      containerEnvVars     `json:"variables"`
 	Secrets        secrets              `json:"secrets,omitempty"`
+	Distributions  distributions        `json:"distributions,omitempty"`
 	Resources      deployedSvcResources `json:"resources,omitempty"`
 
 	environments []string `json:"-"`
@@ -171,6 +179,11 @@ func (w *workerSvcDesc) HumanString() string {
 		writer.Flush()
 		w.Secrets.humanString(writer)
 	}
+	if len(w.Distributions) != 0 {
+		fmt.Fprint(writer, color.Bold.Sprint("\nCloudFront Distributions\n\n"))
+		writer.Flush()
+		w.Distributions.humanString(writer)
+	}
 	if len(w.Resources) != 0 {
 		fmt.Fprint(writer, color.Bold.Sprint("\nResources\n"))
 		writer.Flush()
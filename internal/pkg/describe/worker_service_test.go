@@ -142,6 +142,7 @@ func TestWorkerServiceDescriber_Describe(t *testing.T) {
 							ValueFrom: "GH_WEBHOOK_SECRET",
 						},
 					}, nil),
+					m.ecsDescriber.EXPECT().AddonsOutputs().Return(nil, nil),
 					m.ecsDescriber.EXPECT().Params().Return(map[string]string{
 						cfnstack.LBWebServiceContainerPortParamKey: "-",
 						cfnstack.WorkloadTaskCountParamKey:         "2",
@@ -166,6 +167,7 @@ func TestWorkerServiceDescriber_Describe(t *testing.T) {
 							ValueFrom: "SECRET",
 						},
 					}, nil),
+					m.ecsDescriber.EXPECT().AddonsOutputs().Return(nil, nil),
 					m.ecsDescriber.EXPECT().Params().Return(map[string]string{
 						cfnstack.LBWebServiceContainerPortParamKey: "-",
 						cfnstack.WorkloadTaskCountParamKey:         "2",
@@ -185,6 +187,7 @@ func TestWorkerServiceDescriber_Describe(t *testing.T) {
 					}, nil),
 					m.ecsDescriber.EXPECT().Secrets().Return(
 						nil, nil),
+					m.ecsDescriber.EXPECT().AddonsOutputs().Return(nil, nil),
 					m.ecsDescriber.EXPECT().ServiceStackResources().Return([]*stack.Resource{
 						{
 							Type:       "AWS::EC2::SecurityGroupIngress",
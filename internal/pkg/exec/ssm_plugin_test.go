@@ -13,6 +13,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/aws-sdk-go/service/ssm"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 )
@@ -83,3 +84,64 @@ func TestSSMPluginCommand_StartSession(t *testing.T) {
 		})
 	}
 }
+
+func TestSSMPluginCommand_StartPortForwardingSession(t *testing.T) {
+	mockSession := &ssm.StartSessionOutput{
+		SessionId:  aws.String("mockSessionID"),
+		StreamUrl:  aws.String("mockStreamURL"),
+		TokenValue: aws.String("mockTokenValue"),
+	}
+	mockInput := &ssm.StartSessionInput{
+		Target:       aws.String("ecs:cluster_task_runtime"),
+		DocumentName: aws.String("AWS-StartPortForwardingSession"),
+		Parameters: map[string][]*string{
+			"portNumber":      aws.StringSlice([]string{"8080"}),
+			"localPortNumber": aws.StringSlice([]string{"9090"}),
+		},
+	}
+	wantedArgs := []string{
+		`{"SessionId":"mockSessionID","StreamUrl":"mockStreamURL","TokenValue":"mockTokenValue"}`,
+		"us-west-2",
+		"StartSession",
+		"",
+		`{"DocumentName":"AWS-StartPortForwardingSession","Parameters":{"localPortNumber":["9090"],"portNumber":["8080"]},"Target":"ecs:cluster_task_runtime"}`,
+		"https://ssm.us-west-2.amazonaws.com",
+	}
+	tests := map[string]struct {
+		setupMocks  func(m *Mockrunner)
+		wantedError error
+	}{
+		"return error if fail to start session": {
+			setupMocks: func(m *Mockrunner) {
+				m.EXPECT().InteractiveRun(ssmPluginBinaryName, wantedArgs).Return(errors.New("some error"))
+			},
+			wantedError: fmt.Errorf("start session: some error"),
+		},
+		"success": {
+			setupMocks: func(m *Mockrunner) {
+				m.EXPECT().InteractiveRun(ssmPluginBinaryName, wantedArgs).Return(nil)
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockRunner := NewMockrunner(ctrl)
+			tc.setupMocks(mockRunner)
+			s := SSMPluginCommand{
+				runner: mockRunner,
+				sess: &session.Session{
+					Config: &aws.Config{
+						Region: aws.String("us-west-2"),
+					},
+				},
+			}
+			err := s.StartPortForwardingSession(mockSession, mockInput)
+			if tc.wantedError != nil {
+				require.EqualError(t, tc.wantedError, err.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
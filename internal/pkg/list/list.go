@@ -5,12 +5,16 @@ package list
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"strings"
 	"text/tabwriter"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
 )
 
 const (
@@ -31,12 +35,14 @@ type Store interface {
 	GetApplication(appName string) (*config.Application, error)
 	ListJobs(appName string) ([]*config.Workload, error)
 	ListServices(appName string) ([]*config.Workload, error)
+	ListEnvironments(appName string) ([]*config.Environment, error)
 }
 
 // Workspace wraps the methods required to interact with a local workspace.
 type Workspace interface {
 	ListJobs() ([]string, error)
 	ListServices() ([]string, error)
+	ReadWorkloadManifest(name string) (workspace.WorkloadManifest, error)
 }
 
 // JobListWriter holds all the metadata and clients needed to list all jobs in a given
@@ -84,12 +90,99 @@ func (l *JobListWriter) Write(appName string) error {
 			return err
 		}
 		fmt.Fprint(l.Out, data)
-	} else {
-		humanOutput(wklds, l.Out)
+		return nil
+	}
+	envs, err := l.Store.ListEnvironments(appName)
+	if err != nil {
+		return fmt.Errorf("list environments for application %s: %w", appName, err)
+	}
+	triggers := make(map[string]string)
+	for _, wkld := range wklds {
+		trigger, err := l.jobTrigger(wkld.Name, envs)
+		if err != nil {
+			return err
+		}
+		triggers[wkld.Name] = trigger
 	}
+	humanOutputWithTriggers(wklds, triggers, l.Out)
 	return nil
 }
 
+// jobTrigger returns a human-readable description of the event that triggers a job after applying the
+// overrides of each environment to its manifest in the workspace.
+// If the environments trigger the job differently, the trigger is listed per environment.
+func (l *JobListWriter) jobTrigger(name string, envs []*config.Environment) (string, error) {
+	raw, err := l.Ws.ReadWorkloadManifest(name)
+	if err != nil {
+		var errNoFile *workspace.ErrFileNotExists
+		var errNoWs *workspace.ErrWorkspaceNotFound
+		if errors.As(err, &errNoFile) || errors.As(err, &errNoWs) {
+			// The job's manifest is not in this workspace, so its trigger is unknown.
+			return "-", nil
+		}
+		return "", fmt.Errorf("read manifest for %s %s: %w", jobWorkloadType, name, err)
+	}
+	if len(envs) == 0 {
+		return jobTriggerInEnv(raw, name, "")
+	}
+	triggers := make([]string, len(envs))
+	sameInAllEnvs := true
+	for i, env := range envs {
+		trigger, err := jobTriggerInEnv(raw, name, env.Name)
+		if err != nil {
+			return "", err
+		}
+		triggers[i] = trigger
+		if triggers[i] != triggers[0] {
+			sameInAllEnvs = false
+		}
+	}
+	if sameInAllEnvs {
+		return triggers[0], nil
+	}
+	perEnv := make([]string, len(envs))
+	for i, env := range envs {
+		perEnv[i] = fmt.Sprintf("%s: %s", env.Name, triggers[i])
+	}
+	return strings.Join(perEnv, "; "), nil
+}
+
+// jobTriggerInEnv returns the trigger of a job once the overrides of the environment are applied.
+// The manifest is unmarshaled again for every environment because applying overrides can modify its maps in place.
+func jobTriggerInEnv(raw []byte, name, envName string) (string, error) {
+	mft, err := manifest.UnmarshalWorkload(raw)
+	if err != nil {
+		return "", fmt.Errorf("unmarshal manifest for %s %s: %w", jobWorkloadType, name, err)
+	}
+	if envName != "" {
+		mft, err = mft.ApplyEnv(envName)
+		if err != nil {
+			return "", fmt.Errorf("apply environment %s override to %s %s: %w", envName, jobWorkloadType, name, err)
+		}
+	}
+	job, ok := mft.(*manifest.ScheduledJob)
+	if !ok {
+		return "", nil
+	}
+	return describeJobTrigger(job.On), nil
+}
+
+func describeJobTrigger(on manifest.JobTriggerConfig) string {
+	switch {
+	case on.Schedule != nil:
+		return aws.StringValue(on.Schedule)
+	case on.After != nil:
+		return fmt.Sprintf("after %s", aws.StringValue(on.After))
+	case on.S3 != nil:
+		return fmt.Sprintf("s3://%s/%s", aws.StringValue(on.S3.Bucket), aws.StringValue(on.S3.Prefix))
+	case on.Topic != nil:
+		return fmt.Sprintf("topic %s from %s", aws.StringValue(on.Topic.Name), aws.StringValue(on.Topic.Service))
+	case on.EventPattern != nil:
+		return "event pattern"
+	}
+	return ""
+}
+
 // Write lists all services, either locally or in the workspace, and writes the output to a writer.
 func (l *SvcListWriter) Write(appName string) error {
 	if _, err := l.Store.GetApplication(appName); err != nil {
@@ -153,6 +246,17 @@ func humanOutput(wklds []*config.Workload, w io.Writer) {
 	writer.Flush()
 }
 
+func humanOutputWithTriggers(wklds []*config.Workload, triggers map[string]string, w io.Writer) {
+	writer := tabwriter.NewWriter(w, minCellWidth, tabWidth, cellPaddingWidth, paddingChar, noAdditionalFormatting)
+	headers := []string{"Name", "Type", "Trigger"}
+	fmt.Fprintf(writer, "%s\n", strings.Join(headers, "\t"))
+	fmt.Fprintf(writer, "%s\n", strings.Join(underline(headers), "\t"))
+	for _, wkld := range wklds {
+		fmt.Fprintf(writer, "%s\t%s\t%s\n", wkld.Name, wkld.Type, triggers[wkld.Name])
+	}
+	writer.Flush()
+}
+
 func (l *SvcListWriter) jsonOutputSvcs(svcs []*config.Workload) (string, error) {
 	type out struct {
 		Services []*config.Workload `json:"services"`
@@ -10,6 +10,7 @@ import (
 
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/list/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 )
@@ -36,11 +37,7 @@ func TestList_JobListWriter(t *testing.T) {
 			inputAppName:   mockAppName,
 			inputWriteJSON: false,
 
-			wantedContent: `Name                Type
-----                ----
-badgoose            Scheduled Job
-farmer              Scheduled Job
-`,
+			wantedContent: "Name                Type                Trigger\n----                ----                -------\nbadgoose            Scheduled Job       @daily\nfarmer              Scheduled Job       -\n",
 			mocking: func() {
 				mockStore.EXPECT().
 					GetApplication(gomock.Eq("barnyard")).
@@ -52,6 +49,56 @@ farmer              Scheduled Job
 						{Name: "badgoose", Type: "Scheduled Job"},
 						{Name: "farmer", Type: "Scheduled Job"},
 					}, nil)
+				mockStore.EXPECT().ListEnvironments("barnyard").Return(nil, nil)
+				mockWs.EXPECT().ReadWorkloadManifest("badgoose").Return(workspace.WorkloadManifest(`name: badgoose
+type: Scheduled Job
+on:
+  schedule: "@daily"`), nil)
+				mockWs.EXPECT().ReadWorkloadManifest("farmer").Return(nil, &workspace.ErrFileNotExists{FileName: "manifest.yml"})
+			},
+		},
+		"should show the trigger of each environment when overrides differ": {
+			inputAppName: mockAppName,
+
+			wantedContent: "Name                Type                Trigger\n----                ----                -------\nextract             Scheduled Job       @hourly\ntransform           Scheduled Job       prod: after extract; test: @daily\n",
+			mocking: func() {
+				mockStore.EXPECT().GetApplication("barnyard").
+					Return(&config.Application{}, nil)
+				mockStore.EXPECT().ListJobs("barnyard").
+					Return([]*config.Workload{
+						{Name: "extract", Type: "Scheduled Job"},
+						{Name: "transform", Type: "Scheduled Job"},
+					}, nil)
+				mockStore.EXPECT().ListEnvironments("barnyard").Return([]*config.Environment{
+					{Name: "prod"},
+					{Name: "test"},
+				}, nil)
+				mockWs.EXPECT().ReadWorkloadManifest("extract").Return(workspace.WorkloadManifest(`name: extract
+type: Scheduled Job
+on:
+  schedule: "@hourly"`), nil)
+				mockWs.EXPECT().ReadWorkloadManifest("transform").Return(workspace.WorkloadManifest(`name: transform
+type: Scheduled Job
+on:
+  after: extract
+environments:
+  test:
+    on:
+      schedule: "@daily"`), nil)
+			},
+		},
+		"with failed call to list environments": {
+			inputAppName: mockAppName,
+
+			wantedError: fmt.Errorf("list environments for application barnyard: error"),
+			mocking: func() {
+				mockStore.EXPECT().GetApplication("barnyard").
+					Return(&config.Application{}, nil)
+				mockStore.EXPECT().ListJobs("barnyard").
+					Return([]*config.Workload{
+						{Name: "badgoose", Type: "Scheduled Job"},
+					}, nil)
+				mockStore.EXPECT().ListEnvironments("barnyard").Return(nil, mockError)
 			},
 		},
 		"should succeed writing json": {
@@ -92,7 +139,7 @@ farmer              Scheduled Job
 			inputAppName:   mockAppName,
 			inputListLocal: true,
 
-			wantedContent: "Name                Type\n----                ----\nbadgoose            Scheduled Job\n",
+			wantedContent: "Name                Type                Trigger\n----                ----                -------\nbadgoose            Scheduled Job       @daily\n",
 
 			mocking: func() {
 				mockStore.EXPECT().GetApplication("barnyard").
@@ -103,6 +150,55 @@ farmer              Scheduled Job
 						{Name: "farmer", Type: "Scheduled Job"},
 					}, nil)
 				mockWs.EXPECT().ListJobs().Return([]string{"badgoose"}, nil)
+				mockStore.EXPECT().ListEnvironments("barnyard").Return(nil, nil)
+				mockWs.EXPECT().ReadWorkloadManifest("badgoose").Return(workspace.WorkloadManifest(`name: badgoose
+type: Scheduled Job
+on:
+  schedule: "@daily"`), nil)
+			},
+		},
+		"listing local jobs chained after other jobs": {
+			inputAppName:   mockAppName,
+			inputListLocal: true,
+
+			wantedContent: "Name                Type                Trigger\n----                ----                -------\nextract             Scheduled Job       @hourly\ntransform           Scheduled Job       after extract\n",
+
+			mocking: func() {
+				mockStore.EXPECT().GetApplication("barnyard").
+					Return(&config.Application{}, nil)
+				mockStore.EXPECT().ListJobs("barnyard").
+					Return([]*config.Workload{
+						{Name: "extract", Type: "Scheduled Job"},
+						{Name: "transform", Type: "Scheduled Job"},
+					}, nil)
+				mockWs.EXPECT().ListJobs().Return([]string{"extract", "transform"}, nil)
+				mockStore.EXPECT().ListEnvironments("barnyard").Return(nil, nil)
+				mockWs.EXPECT().ReadWorkloadManifest("extract").Return(workspace.WorkloadManifest(`name: extract
+type: Scheduled Job
+on:
+  schedule: "@hourly"`), nil)
+				mockWs.EXPECT().ReadWorkloadManifest("transform").Return(workspace.WorkloadManifest(`name: transform
+type: Scheduled Job
+on:
+  after: extract`), nil)
+			},
+		},
+		"with failed call to read a local job manifest": {
+			inputAppName:   mockAppName,
+			inputListLocal: true,
+
+			wantedError: fmt.Errorf("read manifest for job badgoose: error"),
+
+			mocking: func() {
+				mockStore.EXPECT().GetApplication("barnyard").
+					Return(&config.Application{}, nil)
+				mockStore.EXPECT().ListJobs("barnyard").
+					Return([]*config.Workload{
+						{Name: "badgoose", Type: "Scheduled Job"},
+					}, nil)
+				mockWs.EXPECT().ListJobs().Return([]string{"badgoose"}, nil)
+				mockStore.EXPECT().ListEnvironments("barnyard").Return(nil, nil)
+				mockWs.EXPECT().ReadWorkloadManifest("badgoose").Return(nil, mockError)
 			},
 		},
 		"with failed call to ListJobs": {
@@ -121,7 +217,7 @@ farmer              Scheduled Job
 			inputAppName:   mockAppName,
 			inputListLocal: true,
 
-			wantedContent: "Name                Type\n----                ----\n",
+			wantedContent: "Name                Type                Trigger\n----                ----                -------\n",
 
 			mocking: func() {
 				mockStore.EXPECT().GetApplication("barnyard").
@@ -132,6 +228,7 @@ farmer              Scheduled Job
 						{Name: "farmer", Type: "Scheduled Job"},
 					}, nil)
 				mockWs.EXPECT().ListJobs().Return([]string{}, nil)
+				mockStore.EXPECT().ListEnvironments("barnyard").Return(nil, nil)
 			},
 		},
 		"with no local jobs json": {
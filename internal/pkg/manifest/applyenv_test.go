@@ -651,17 +651,17 @@ func TestApplyEnv_MapToString(t *testing.T) {
 	}{
 		"map upserted": {
 			inSvc: func(svc *LoadBalancedWebService) {
-				svc.TaskConfig.Secrets = map[string]string{
+				svc.TaskConfig.Variables = map[string]string{
 					"secret1": "the secret sauce is mole",
 					"secret2": "the secret agent is johnny rivers",
 				}
-				svc.Environments["test"].TaskConfig.Secrets = map[string]string{
+				svc.Environments["test"].TaskConfig.Variables = map[string]string{
 					"secret1": "the secret sauce is blue cheese which has mold in it",
 					"secret3": "the secret route is through egypt",
 				}
 			},
 			wanted: func(svc *LoadBalancedWebService) {
-				svc.TaskConfig.Secrets = map[string]string{
+				svc.TaskConfig.Variables = map[string]string{
 					"secret1": "the secret sauce is blue cheese which has mold in it", // Overridden.
 					"secret2": "the secret agent is johnny rivers",                    // Kept.
 					"secret3": "the secret route is through egypt",                    // Appended
@@ -670,14 +670,14 @@ func TestApplyEnv_MapToString(t *testing.T) {
 		},
 		"map not overridden by zero map": {
 			inSvc: func(svc *LoadBalancedWebService) {
-				svc.TaskConfig.Secrets = map[string]string{
+				svc.TaskConfig.Variables = map[string]string{
 					"secret1": "the secret sauce is mole",
 					"secret2": "the secret agent man is johnny rivers",
 				}
-				svc.Environments["test"].TaskConfig.Secrets = map[string]string{}
+				svc.Environments["test"].TaskConfig.Variables = map[string]string{}
 			},
 			wanted: func(svc *LoadBalancedWebService) {
-				svc.TaskConfig.Secrets = map[string]string{
+				svc.TaskConfig.Variables = map[string]string{
 					"secret1": "the secret sauce is mole",
 					"secret2": "the secret agent man is johnny rivers",
 				}
@@ -685,13 +685,13 @@ func TestApplyEnv_MapToString(t *testing.T) {
 		},
 		"map not overridden": {
 			inSvc: func(svc *LoadBalancedWebService) {
-				svc.TaskConfig.Secrets = map[string]string{
+				svc.TaskConfig.Variables = map[string]string{
 					"secret1": "the secret sauce is mole",
 					"secret2": "the secret agent man is johnny rivers",
 				}
 			},
 			wanted: func(svc *LoadBalancedWebService) {
-				svc.TaskConfig.Secrets = map[string]string{
+				svc.TaskConfig.Variables = map[string]string{
 					"secret1": "the secret sauce is mole",
 					"secret2": "the secret agent man is johnny rivers",
 				}
@@ -716,6 +716,63 @@ func TestApplyEnv_MapToString(t *testing.T) {
 	}
 }
 
+func TestApplyEnv_MapToStruct(t *testing.T) {
+	testCases := map[string]struct {
+		inSvc  func(svc *LoadBalancedWebService)
+		wanted func(svc *LoadBalancedWebService)
+	}{
+		"map upserted": {
+			inSvc: func(svc *LoadBalancedWebService) {
+				svc.TaskConfig.Secrets = map[string]Secret{
+					"DB_PASSWORD": {
+						From: aws.String("DB_PASSWORD"),
+					},
+					"API_KEY": {
+						From: aws.String("API_KEY"),
+					},
+				}
+				svc.Environments["test"].TaskConfig.Secrets = map[string]Secret{
+					"DB_PASSWORD": {
+						FromSecretsManager: SecretsManagerSecret{
+							Name: aws.String("/copilot/myapp/test/secrets/db"),
+							Key:  aws.String("password"),
+						},
+					},
+				}
+			},
+			wanted: func(svc *LoadBalancedWebService) {
+				svc.TaskConfig.Secrets = map[string]Secret{
+					"DB_PASSWORD": { // Overridden.
+						FromSecretsManager: SecretsManagerSecret{
+							Name: aws.String("/copilot/myapp/test/secrets/db"),
+							Key:  aws.String("password"),
+						},
+					},
+					"API_KEY": { // Kept.
+						From: aws.String("API_KEY"),
+					},
+				}
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var inSvc, wantedSvc LoadBalancedWebService
+			inSvc.Environments = map[string]*LoadBalancedWebServiceConfig{
+				"test": {},
+			}
+
+			tc.inSvc(&inSvc)
+			tc.wanted(&wantedSvc)
+
+			got, err := inSvc.ApplyEnv("test")
+
+			require.NoError(t, err)
+			require.Equal(t, &wantedSvc, got)
+		})
+	}
+}
+
 func TestApplyEnv_MapToPStruct(t *testing.T) {
 	testCases := map[string]struct {
 		inSvc  func(svc *LoadBalancedWebService)
@@ -33,6 +33,7 @@ type BackendServiceConfig struct {
 	Network          NetworkConfig             `yaml:"network"`
 	PublishConfig    PublishConfig             `yaml:"publish"`
 	TaskDefOverrides []OverrideRule            `yaml:"taskdef_overrides"`
+	DeployConfig     DeployConfig              `yaml:"deploy"`
 }
 
 // BackendServiceProps represents the configuration needed to create a backend service.
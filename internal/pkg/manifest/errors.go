@@ -81,6 +81,18 @@ func (e *errFieldMutualExclusive) Error() string {
 	return fmt.Sprintf(`must specify one, not both, of "%s" and "%s"`, e.firstField, e.secondField)
 }
 
+type errFieldsMutualExclusive struct {
+	fields []string
+}
+
+func (e *errFieldsMutualExclusive) Error() string {
+	quotedFields := make([]string, len(e.fields))
+	for i, f := range e.fields {
+		quotedFields[i] = strconv.Quote(f)
+	}
+	return fmt.Sprintf(`must specify only one of %s`, english.WordSeries(quotedFields, "and"))
+}
+
 type errMinGreaterThanMax struct {
 	min int
 	max int
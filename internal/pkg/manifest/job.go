@@ -43,14 +43,59 @@ type ScheduledJobConfig struct {
 	Sidecars                map[string]*SidecarConfig `yaml:"sidecars"` // NOTE: keep the pointers because `mergo` doesn't automatically deep merge map's value unless it's a pointer type.
 	On                      JobTriggerConfig          `yaml:"on,flow"`
 	JobFailureHandlerConfig `yaml:",inline"`
+	JobConcurrencyConfig    `yaml:",inline"`
 	Network                 NetworkConfig  `yaml:"network"`
 	PublishConfig           PublishConfig  `yaml:"publish"`
 	TaskDefOverrides        []OverrideRule `yaml:"taskdef_overrides"`
 }
 
 // JobTriggerConfig represents the configuration for the event that triggers the job.
+// Exactly one of the trigger sources must be specified.
 type JobTriggerConfig struct {
-	Schedule *string `yaml:"schedule"`
+	Schedule     *string                `yaml:"schedule"`
+	S3           *S3JobTrigger          `yaml:"s3"`
+	Topic        *TopicJobTrigger       `yaml:"topic"`
+	EventPattern map[string]interface{} `yaml:"event_pattern"`
+	After        *string                `yaml:"after"` // Name of a job whose successful executions trigger this job.
+}
+
+// S3JobTrigger represents an S3 object upload that triggers the job.
+// The bucket must have Amazon EventBridge notifications turned on.
+type S3JobTrigger struct {
+	Bucket *string `yaml:"bucket"`
+	Prefix *string `yaml:"prefix"`
+}
+
+// TopicJobTrigger represents an SNS topic published by a Copilot service whose messages trigger the job.
+type TopicJobTrigger struct {
+	Name    *string `yaml:"name"`
+	Service *string `yaml:"service"`
+}
+
+// IsEmpty returns true if no trigger source is specified.
+func (c JobTriggerConfig) IsEmpty() bool {
+	return len(c.sources()) == 0
+}
+
+// sources returns the names of the trigger sources that are specified.
+func (c JobTriggerConfig) sources() []string {
+	var sources []string
+	if c.Schedule != nil {
+		sources = append(sources, "schedule")
+	}
+	if c.S3 != nil {
+		sources = append(sources, "s3")
+	}
+	if c.Topic != nil {
+		sources = append(sources, "topic")
+	}
+	if c.EventPattern != nil {
+		sources = append(sources, "event_pattern")
+	}
+	if c.After != nil {
+		sources = append(sources, "after")
+	}
+	return sources
 }
 
 // JobFailureHandlerConfig represents the error handling configuration for the job.
@@ -59,6 +104,12 @@ type JobFailureHandlerConfig struct {
 	Retries *int    `yaml:"retries"`
 }
 
+// JobConcurrencyConfig represents the configuration to limit overlapping executions of the job.
+// A new execution is skipped if the number of executions in flight already reaches the limit.
+type JobConcurrencyConfig struct {
+	Concurrency *int `yaml:"concurrency"`
+}
+
 // ScheduledJobProps contains properties for creating a new scheduled job manifest.
 type ScheduledJobProps struct {
 	*WorkloadProps
@@ -27,6 +27,19 @@ const (
 	GRPCProtocol = "gRPC" // GRPCProtocol is the HTTP protocol version for gRPC.
 )
 
+// Deployment strategies for a load balanced web service.
+const (
+	DeploymentStrategyRolling   = "rolling"
+	DeploymentStrategyBlueGreen = "blue_green"
+)
+
+// Traffic shifting types for a blue/green deployment.
+const (
+	TrafficShiftingAllAtOnce = "all_at_once"
+	TrafficShiftingCanary    = "canary"
+	TrafficShiftingLinear    = "linear"
+)
+
 var (
 	errUnmarshalHealthCheckArgs = errors.New("can't unmarshal healthcheck field into string or compose-style map")
 )
@@ -60,6 +73,8 @@ type LoadBalancedWebServiceConfig struct {
 	PublishConfig    PublishConfig                    `yaml:"publish"`
 	TaskDefOverrides []OverrideRule                   `yaml:"taskdef_overrides"`
 	NLBConfig        NetworkLoadBalancerConfiguration `yaml:"nlb"`
+	DeployConfig     DeployConfig                     `yaml:"deploy"`
+	Deployment       DeploymentConfiguration          `yaml:"deployment"`
 }
 
 // LoadBalancedWebServiceProps contains properties for creating a new load balanced fargate service manifest.
@@ -237,6 +252,38 @@ func (c *NetworkLoadBalancerConfiguration) IsEmpty() bool {
 	return c.Port == nil && c.HealthCheck.IsEmpty() && c.TargetContainer == nil && c.TargetPort == nil && c.SSLPolicy == nil && c.Aliases.IsEmpty()
 }
 
+// DeploymentConfiguration holds options for how new versions of a load balanced web service are rolled out.
+type DeploymentConfiguration struct {
+	Strategy        *string         `yaml:"strategy"`
+	TrafficShifting TrafficShifting `yaml:"traffic_shifting"`
+	TestPort        *uint16         `yaml:"test_port"`
+	TerminationWait *time.Duration  `yaml:"termination_wait"`
+	RollbackAlarms  []string        `yaml:"rollback_alarms"`
+}
+
+// IsEmpty returns empty if the struct has all zero members.
+func (d *DeploymentConfiguration) IsEmpty() bool {
+	return d.Strategy == nil && d.TrafficShifting.IsEmpty() && d.TestPort == nil && d.TerminationWait == nil && len(d.RollbackAlarms) == 0
+}
+
+// IsBlueGreen returns true if the service should be deployed with CodeDeploy blue/green deployments.
+func (d *DeploymentConfiguration) IsBlueGreen() bool {
+	return aws.StringValue(d.Strategy) == DeploymentStrategyBlueGreen
+}
+
+// TrafficShifting holds options for how traffic is shifted from the old to the new version of a service
+// during a blue/green deployment.
+type TrafficShifting struct {
+	Type       *string        `yaml:"type"`
+	Percentage *int           `yaml:"percentage"`
+	Interval   *time.Duration `yaml:"interval"`
+}
+
+// IsEmpty returns empty if the struct has all zero members.
+func (t *TrafficShifting) IsEmpty() bool {
+	return t.Type == nil && t.Percentage == nil && t.Interval == nil
+}
+
 // IPNet represents an IP network string. For example: 10.1.0.0/16
 type IPNet string
 
@@ -17,6 +17,8 @@ const (
 	GithubV1ProviderName   = "GitHubV1"
 	CodeCommitProviderName = "CodeCommit"
 	BitbucketProviderName  = "Bitbucket"
+	ECRProviderName        = "ECR"
+	S3ProviderName         = "S3"
 
 	pipelineManifestPath = "cicd/pipeline.yml"
 )
@@ -92,6 +94,34 @@ func (p *bitbucketProvider) Properties() map[string]interface{} {
 	return structs.Map(p.properties)
 }
 
+type ecrProvider struct {
+	properties *ECRProperties
+}
+
+func (p *ecrProvider) Name() string {
+	return ECRProviderName
+}
+func (p *ecrProvider) String() string {
+	return ECRProviderName
+}
+func (p *ecrProvider) Properties() map[string]interface{} {
+	return structs.Map(p.properties)
+}
+
+type s3Provider struct {
+	properties *S3Properties
+}
+
+func (p *s3Provider) Name() string {
+	return S3ProviderName
+}
+func (p *s3Provider) String() string {
+	return S3ProviderName
+}
+func (p *s3Provider) Properties() map[string]interface{} {
+	return structs.Map(p.properties)
+}
+
 // GitHubV1Properties contain information for configuring a Githubv1
 // source provider.
 type GitHubV1Properties struct {
@@ -123,6 +153,22 @@ type CodeCommitProperties struct {
 	Branch        string `structs:"branch" yaml:"branch"`
 }
 
+// ECRProperties contains information for configuring an ECR
+// source provider, triggered when an image tag is pushed to the repository.
+// The pushed image is deployed as the image of the workload.
+type ECRProperties struct {
+	Repository string `structs:"repository" yaml:"repository"`
+	Tag        string `structs:"tag" yaml:"tag"`
+	Workload   string `structs:"workload" yaml:"workload"`
+}
+
+// S3Properties contains information for configuring an S3
+// source provider, triggered when a zip file is uploaded to the object key.
+type S3Properties struct {
+	Bucket string `structs:"bucket" yaml:"bucket"`
+	Key    string `structs:"key" yaml:"key"`
+}
+
 // NewProvider creates a source provider based on the type of
 // the provided provider-specific configurations
 func NewProvider(configs interface{}) (Provider, error) {
@@ -143,6 +189,14 @@ func NewProvider(configs interface{}) (Provider, error) {
 		return &bitbucketProvider{
 			properties: props,
 		}, nil
+	case *ECRProperties:
+		return &ecrProvider{
+			properties: props,
+		}, nil
+	case *S3Properties:
+		return &s3Provider{
+			properties: props,
+		}, nil
 	default:
 		return nil, &ErrUnknownProvider{unknownProviderProperties: props}
 	}
@@ -178,14 +232,74 @@ type Source struct {
 
 // Build defines the build project to build and test image.
 type Build struct {
-	Image string `yaml:"image"`
+	Image       string            `yaml:"image"`
+	ComputeType string            `yaml:"compute_type,omitempty"`
+	Privileged  *bool             `yaml:"privileged,omitempty"`
+	Env         map[string]string `yaml:"env,omitempty"`
+	Commands    []string          `yaml:"commands,omitempty"`
+	Cache       *BuildCache       `yaml:"cache,omitempty"`
+}
+
+// BuildCache defines the cache used by the build project.
+type BuildCache struct {
+	DockerLayers *bool         `yaml:"docker_layers,omitempty"`
+	S3           *BuildS3Cache `yaml:"s3,omitempty"`
+}
+
+// BuildS3Cache defines an S3 location to cache files between builds.
+type BuildS3Cache struct {
+	Location string   `yaml:"location"`
+	Paths    []string `yaml:"paths,omitempty"`
+}
+
+// RendersBuildspec returns true if the buildspec of the pipeline needs to be rendered from the build section,
+// instead of being read from the buildspec file in the workspace.
+func (b *Build) RendersBuildspec() bool {
+	if b == nil {
+		return false
+	}
+	if len(b.Commands) > 0 {
+		return true
+	}
+	return b.Cache != nil && b.Cache.S3 != nil && len(b.Cache.S3.Paths) > 0
 }
 
 // PipelineStage represents a stage in the pipeline manifest
 type PipelineStage struct {
-	Name             string   `yaml:"name"`
-	RequiresApproval bool     `yaml:"requires_approval,omitempty"`
-	TestCommands     []string `yaml:"test_commands,omitempty"`
+	Name                  string                 `yaml:"name"`
+	RequiresApproval      bool                   `yaml:"requires_approval,omitempty"`
+	ApprovalNotifications *ApprovalNotifications `yaml:"approval_notifications,omitempty"`
+	TestCommands          []string               `yaml:"test_commands,omitempty"`
+	Deployments           Deployments            `yaml:"deployments,omitempty"`
+	PostDeployments       PostDeployments        `yaml:"post_deployments,omitempty"`
+}
+
+// ApprovalNotifications represents where to send a notification when the manual approval of a stage is pending.
+type ApprovalNotifications struct {
+	TopicARN string   `yaml:"topic_arn,omitempty"` // An existing SNS topic to publish the notifications to.
+	Emails   []string `yaml:"emails,omitempty"`
+	Webhook  string   `yaml:"webhook,omitempty"` // A Slack or Amazon Chime incoming webhook URL.
+}
+
+// Deployments represent the workloads deployed in a pipeline stage, keyed by workload name.
+type Deployments map[string]*Deployment
+
+// Deployment represents the configuration for the deployment of a workload in a pipeline stage.
+type Deployment struct {
+	DependsOn []string `yaml:"depends_on,omitempty"`
+}
+
+// PostDeployments represent the checks run against the environment of a pipeline stage
+// after its workloads are deployed, keyed by name.
+type PostDeployments map[string]*PostDeployment
+
+// PostDeployment represents a check run after the workloads of a pipeline stage are deployed.
+// The check either runs commands in a CodeBuild project, or runs a one-off task from a container image.
+type PostDeployment struct {
+	Commands  []string          `yaml:"commands,omitempty"`
+	Image     string            `yaml:"image,omitempty"`
+	Command   string            `yaml:"command,omitempty"`
+	Variables map[string]string `yaml:"variables,omitempty"`
 }
 
 // NewPipelineManifest returns a pipeline manifest object.
@@ -237,6 +351,9 @@ func UnmarshalPipeline(in []byte) (*PipelineManifest, error) {
 	// TODO: #221 Do more validations
 	switch version {
 	case Ver1:
+		if err := pm.Validate(); err != nil {
+			return nil, err
+		}
 		return &pm, nil
 	}
 	// we should never reach here, this is just to make the compiler happy
@@ -39,6 +39,19 @@ func TestNewProvider(t *testing.T) {
 				Branch:        defaultCCBranch,
 			},
 		},
+		"successfully create ECR provider": {
+			providerConfig: &ECRProperties{
+				Repository: "my-app/api",
+				Tag:        "latest",
+				Workload:   "api",
+			},
+		},
+		"successfully create S3 provider": {
+			providerConfig: &S3Properties{
+				Bucket: "my-bucket",
+				Key:    "source/api.zip",
+			},
+		},
 	}
 
 	for name, tc := range testCases {
@@ -303,6 +316,68 @@ stages:
 				},
 			},
 		},
+		"valid pipeline.yml with deployments": {
+			inContent: `
+name: pipepiper
+version: 1
+
+source:
+  provider: GitHub
+  properties:
+    repository: aws/somethingCool
+    branch: main
+
+stages:
+    -
+      name: chicken
+      deployments:
+        migration:
+        api:
+          depends_on: [migration]
+`,
+			expectedManifest: &PipelineManifest{
+				Name:    "pipepiper",
+				Version: Ver1,
+				Source: &Source{
+					ProviderName: "GitHub",
+					Properties: map[string]interface{}{
+						"repository": "aws/somethingCool",
+						"branch":     defaultGHBranch,
+					},
+				},
+				Stages: []PipelineStage{
+					{
+						Name: "chicken",
+						Deployments: Deployments{
+							"migration": nil,
+							"api": &Deployment{
+								DependsOn: []string{"migration"},
+							},
+						},
+					},
+				},
+			},
+		},
+		"invalid deployments in pipeline.yml": {
+			inContent: `
+name: pipepiper
+version: 1
+
+source:
+  provider: GitHub
+  properties:
+    repository: aws/somethingCool
+    branch: main
+
+stages:
+    -
+      name: chicken
+      deployments:
+        api:
+          depends_on: [api]
+`,
+			expectedErr: errors.New(`validate stage "chicken": validate "deployments": deployment api cannot depend on itself`),
+		},
 	}
 
 	for name, tc := range testCases {
@@ -317,3 +392,42 @@ stages:
 		})
 	}
 }
+
+func TestBuild_RendersBuildspec(t *testing.T) {
+	testCases := map[string]struct {
+		in     *Build
+		wanted bool
+	}{
+		"nil build": {
+			wanted: false,
+		},
+		"build without commands": {
+			in: &Build{
+				Image: "aws/codebuild/standard:3.0",
+			},
+			wanted: false,
+		},
+		"build with commands": {
+			in: &Build{
+				Commands: []string{"make test"},
+			},
+			wanted: true,
+		},
+		"build with s3 cache paths": {
+			in: &Build{
+				Cache: &BuildCache{
+					S3: &BuildS3Cache{
+						Location: "my-bucket",
+						Paths:    []string{"/root/.m2/**/*"},
+					},
+				},
+			},
+			wanted: true,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, tc.in.RendersBuildspec())
+		})
+	}
+}
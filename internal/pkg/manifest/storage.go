@@ -10,6 +10,13 @@ import (
 	"gopkg.in/yaml.v3"
 )
 
+// Throughput modes of managed EFS.
+const (
+	EFSThroughputModeBursting    = "bursting"
+	EFSThroughputModeElastic     = "elastic"
+	EFSThroughputModeProvisioned = "provisioned"
+)
+
 var (
 	errUnmarshalEFSOpts = errors.New(`cannot unmarshal "efs" field into bool or map`)
 )
@@ -17,12 +24,13 @@ var (
 // Storage represents the options for external and native storage.
 type Storage struct {
 	Ephemeral *int               `yaml:"ephemeral"`
-	Volumes   map[string]*Volume `yaml:"volumes"` // NOTE: keep the pointers because `mergo` doesn't automatically deep merge map's value unless it's a pointer type.
+	Volumes   map[string]*Volume `yaml:"volumes"`    // NOTE: keep the pointers because `mergo` doesn't automatically deep merge map's value unless it's a pointer type.
+	EnvAddons []string           `yaml:"env_addons"` // Names of environment storage addons that the workload can access.
 }
 
 // IsEmpty returns empty if the struct has all zero members.
 func (s *Storage) IsEmpty() bool {
-	return s.Ephemeral == nil && s.Volumes == nil
+	return s.Ephemeral == nil && s.Volumes == nil && s.EnvAddons == nil
 }
 
 // Volume is an abstraction which merges the MountPoint and Volumes concepts from the ECS Task Definition
@@ -63,11 +71,28 @@ type EFSVolumeConfiguration struct {
 	AuthConfig    AuthorizationConfig `yaml:"auth"`     // Auth config for BYO EFS.
 	UID           *uint32             `yaml:"uid"`      // UID for managed EFS.
 	GID           *uint32             `yaml:"gid"`      // GID for managed EFS.
+
+	FromWorkload          *string      `yaml:"from_workload"`          // Name of the workload whose managed EFS directory to mount.
+	Backups               *bool        `yaml:"backups"`                // Whether managed EFS is backed up automatically.
+	Lifecycle             EFSLifecycle `yaml:"lifecycle"`              // Storage class transitions of managed EFS.
+	ThroughputMode        *string      `yaml:"throughput_mode"`        // Throughput mode of managed EFS.
+	ProvisionedThroughput *int         `yaml:"provisioned_throughput"` // Throughput in MiB/s of managed EFS in provisioned mode.
 }
 
 // IsEmpty returns empty if the struct has all zero members.
 func (e *EFSVolumeConfiguration) IsEmpty() bool {
-	return e.FileSystemID == nil && e.RootDirectory == nil && e.AuthConfig.IsEmpty() && e.UID == nil && e.GID == nil
+	return e.FileSystemID == nil && e.RootDirectory == nil && e.AuthConfig.IsEmpty() && e.EmptyManagedConfig()
+}
+
+// EFSLifecycle holds options to move files between the storage classes of managed EFS.
+type EFSLifecycle struct {
+	TransitionToIA      *int  `yaml:"transition_to_ia"`      // Days without access after which files move to Infrequent Access.
+	TransitionToPrimary *bool `yaml:"transition_to_primary"` // Whether files move back to Standard on their first access.
+}
+
+// IsEmpty returns empty if the struct has all zero members.
+func (l *EFSLifecycle) IsEmpty() bool {
+	return l.TransitionToIA == nil && l.TransitionToPrimary == nil
 }
 
 // EFSConfigOrBool contains custom unmarshaling logic for the `efs` field in the manifest.
@@ -112,14 +137,14 @@ func (e *EFSConfigOrBool) UnmarshalYAML(value *yaml.Node) error {
 	return nil
 }
 
-// UseManagedFS returns true if the user has specified EFS as a bool, or has only specified UID and GID.
+// UseManagedFS returns true if the user has specified EFS as a bool, or has only specified managed EFS options such as UID and GID.
 func (e *EFSConfigOrBool) UseManagedFS() bool {
 	// Respect explicitly enabled or disabled value first.
 	if e.Enabled != nil {
 		return aws.BoolValue(e.Enabled)
 	}
-	// Check whether we're implicitly enabling managed EFS via UID/GID.
-	return !e.Advanced.EmptyUIDConfig()
+	// Check whether we're implicitly enabling managed EFS via its options.
+	return !e.Advanced.EmptyManagedConfig()
 }
 
 // Disabled returns true if Enabled is explicitly set to false.
@@ -144,27 +169,51 @@ func (e *EFSVolumeConfiguration) EmptyUIDConfig() bool {
 	return e.UID == nil && e.GID == nil
 }
 
+// EmptyManagedConfig returns true if the `uid`, `gid`, `from_workload` and file system options are empty.
+// These fields are mutually exclusive with BYO EFS. If they are nonempty, then we should use managed EFS instead.
+func (e *EFSVolumeConfiguration) EmptyManagedConfig() bool {
+	return e.EmptyUIDConfig() && e.FromWorkload == nil && e.EmptyFileSystemConfig()
+}
+
+// EmptyFileSystemConfig returns true if the backup, lifecycle and throughput options of managed EFS are empty.
+func (e *EFSVolumeConfiguration) EmptyFileSystemConfig() bool {
+	return e.Backups == nil && e.Lifecycle.IsEmpty() && e.ThroughputMode == nil && e.ProvisionedThroughput == nil
+}
+
 func (e *EFSVolumeConfiguration) unsetBYOConfig() {
 	e.FileSystemID = nil
 	e.AuthConfig = AuthorizationConfig{}
 	e.RootDirectory = nil
 }
 
-func (e *EFSVolumeConfiguration) unsetUIDConfig() {
+func (e *EFSVolumeConfiguration) unsetManagedConfig() {
 	e.UID = nil
 	e.GID = nil
+	e.FromWorkload = nil
+	e.Backups = nil
+	e.Lifecycle = EFSLifecycle{}
+	e.ThroughputMode = nil
+	e.ProvisionedThroughput = nil
 }
 
 func (e *EFSVolumeConfiguration) isValid() error {
-	if !e.EmptyBYOConfig() && !e.EmptyUIDConfig() {
-		return &errFieldMutualExclusive{
-			firstField:  "uid/gid",
-			secondField: "id/root_dir/auth",
-		}
+	if !e.EmptyBYOConfig() && !e.EmptyManagedConfig() {
+		return e.errManagedWithBYOConfig()
 	}
 	return nil
 }
 
+func (e *EFSVolumeConfiguration) errManagedWithBYOConfig() error {
+	managedFields := "uid/gid"
+	if e.EmptyUIDConfig() {
+		managedFields = "from_workload/backups/lifecycle/throughput_mode/provisioned_throughput"
+	}
+	return &errFieldMutualExclusive{
+		firstField:  managedFields,
+		secondField: "id/root_dir/auth",
+	}
+}
+
 // AuthorizationConfig holds options relating to access points and IAM authorization.
 type AuthorizationConfig struct {
 	IAM           *bool   `yaml:"iam"`             // Default true
@@ -47,6 +47,29 @@ efs:
 				},
 			},
 		},
+		"with managed FS options shared from another workload": {
+			manifest: []byte(`
+efs:
+  from_workload: api
+  backups: false
+  lifecycle:
+    transition_to_ia: 7
+    transition_to_primary: true
+  throughput_mode: elastic`),
+			want: testVolume{
+				EFS: EFSConfigOrBool{
+					Advanced: EFSVolumeConfiguration{
+						FromWorkload: aws.String("api"),
+						Backups:      aws.Bool(false),
+						Lifecycle: EFSLifecycle{
+							TransitionToIA:      aws.Int(7),
+							TransitionToPrimary: aws.Bool(true),
+						},
+						ThroughputMode: aws.String("elastic"),
+					},
+				},
+			},
+		},
 		"with just managed": {
 			manifest: []byte(`
 efs: true`),
@@ -85,6 +108,13 @@ efs:
   id: 1`),
 			wantErr: `must specify one, not both, of "uid/gid" and "id/root_dir/auth"`,
 		},
+		"invalid managed FS options with BYO EFS": {
+			manifest: []byte(`
+efs:
+  id: fs-12345
+  throughput_mode: elastic`),
+			wantErr: `must specify one, not both, of "from_workload/backups/lifecycle/throughput_mode/provisioned_throughput" and "id/root_dir/auth"`,
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -103,6 +133,10 @@ efs:
 				require.Equal(t, tc.want.EFS.Advanced.AuthConfig, v.EFS.Advanced.AuthConfig)
 				require.Equal(t, tc.want.EFS.Advanced.UID, v.EFS.Advanced.UID)
 				require.Equal(t, tc.want.EFS.Advanced.GID, v.EFS.Advanced.GID)
+				require.Equal(t, tc.want.EFS.Advanced.FromWorkload, v.EFS.Advanced.FromWorkload)
+				require.Equal(t, tc.want.EFS.Advanced.Backups, v.EFS.Advanced.Backups)
+				require.Equal(t, tc.want.EFS.Advanced.Lifecycle, v.EFS.Advanced.Lifecycle)
+				require.Equal(t, tc.want.EFS.Advanced.ThroughputMode, v.EFS.Advanced.ThroughputMode)
 			} else {
 				require.EqualError(t, err, tc.wantErr)
 			}
@@ -207,6 +241,22 @@ func Test_UseManagedFS(t *testing.T) {
 			},
 			want: true,
 		},
+		"with from_workload set": {
+			in: EFSConfigOrBool{
+				Advanced: EFSVolumeConfiguration{
+					FromWorkload: aws.String("api"),
+				},
+			},
+			want: true,
+		},
+		"with file system options set": {
+			in: EFSConfigOrBool{
+				Advanced: EFSVolumeConfiguration{
+					Backups: aws.Bool(false),
+				},
+			},
+			want: true,
+		},
 		"empty": {
 			in:   EFSConfigOrBool{},
 			want: false,
@@ -263,6 +263,22 @@ func (qs *QueueScaling) AcceptableBacklogPerTask() (int, error) {
 	return int(v), nil
 }
 
+// DeployConfig represents the configuration for how a service is deployed.
+type DeployConfig struct {
+	PreHooks []DeployHook `yaml:"pre_hooks"`
+}
+
+// IsEmpty returns empty if the struct has all zero members.
+func (d *DeployConfig) IsEmpty() bool {
+	return len(d.PreHooks) == 0
+}
+
+// DeployHook represents a one-off task that runs with the new task definition of the service
+// and an overridden command during a deployment.
+type DeployHook struct {
+	Command CommandOverride `yaml:"command"`
+}
+
 // ServiceDockerfileBuildRequired returns if the service container image should be built from local Dockerfile.
 func ServiceDockerfileBuildRequired(svc interface{}) (bool, error) {
 	return dockerfileBuildRequired("service", svc)
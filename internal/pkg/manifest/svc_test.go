@@ -65,6 +65,17 @@ logging:
   secretOptions:
     LOG_TOKEN: LOG_TOKEN
   configFilePath: /extra.conf
+deploy:
+  pre_hooks:
+    - command: ./migrate up
+deployment:
+  strategy: blue_green
+  traffic_shifting:
+    type: canary
+    percentage: 10
+    interval: 5m
+  test_port: 8080
+  rollback_alarms: ["frontend-5xx"]
 environments:
   test:
     count: 3
@@ -124,8 +135,8 @@ environments:
 							Variables: map[string]string{
 								"LOG_LEVEL": "WARN",
 							},
-							Secrets: map[string]string{
-								"DB_PASSWORD": "MYSQL_DB_PASSWORD",
+							Secrets: map[string]Secret{
+								"DB_PASSWORD": {From: aws.String("MYSQL_DB_PASSWORD")},
 							},
 						},
 						Sidecars: map[string]*SidecarConfig{
@@ -165,6 +176,25 @@ environments:
 								},
 							},
 						},
+						DeployConfig: DeployConfig{
+							PreHooks: []DeployHook{
+								{
+									Command: CommandOverride{
+										String: aws.String("./migrate up"),
+									},
+								},
+							},
+						},
+						Deployment: DeploymentConfiguration{
+							Strategy: aws.String(DeploymentStrategyBlueGreen),
+							TrafficShifting: TrafficShifting{
+								Type:       aws.String(TrafficShiftingCanary),
+								Percentage: aws.Int(10),
+								Interval:   durationp(5 * time.Minute),
+							},
+							TestPort:       aws.Uint16(8080),
+							RollbackAlarms: []string{"frontend-5xx"},
+						},
 					},
 					Environments: map[string]*LoadBalancedWebServiceConfig{
 						"test": {
@@ -262,8 +292,8 @@ secrets:
 							ExecuteCommand: ExecuteCommand{
 								Enable: aws.Bool(false),
 							},
-							Secrets: map[string]string{
-								"API_TOKEN": "SUBS_API_TOKEN",
+							Secrets: map[string]Secret{
+								"API_TOKEN": {From: aws.String("SUBS_API_TOKEN")},
 							},
 						},
 						Network: NetworkConfig{
@@ -27,6 +27,7 @@ var defaultTransformers = []mergo.Transformers{
 	efsConfigOrBoolTransformer{},
 	efsVolumeConfigurationTransformer{},
 	sqsQueueOrBoolTransformer{},
+	jobTriggerConfigTransformer{},
 }
 
 // See a complete list of `reflect.Kind` here: https://pkg.go.dev/reflect#Kind.
@@ -286,12 +287,12 @@ func (t efsVolumeConfigurationTransformer) Transformer(typ reflect.Type) func(ds
 	}
 	return func(dst, src reflect.Value) error {
 		dstStruct, srcStruct := dst.Interface().(EFSVolumeConfiguration), src.Interface().(EFSVolumeConfiguration)
-		if !srcStruct.EmptyUIDConfig() {
+		if !srcStruct.EmptyManagedConfig() {
 			dstStruct.unsetBYOConfig()
 		}
 
 		if !srcStruct.EmptyBYOConfig() {
-			dstStruct.unsetUIDConfig()
+			dstStruct.unsetManagedConfig()
 		}
 
 		if dst.CanSet() { // For extra safety to prevent panicking.
@@ -326,6 +327,45 @@ func (q sqsQueueOrBoolTransformer) Transformer(typ reflect.Type) func(dst, src r
 	}
 }
 
+type jobTriggerConfigTransformer struct{}
+
+// Transformer returns custom merge logic for JobTriggerConfig's fields.
+func (t jobTriggerConfigTransformer) Transformer(typ reflect.Type) func(dst, src reflect.Value) error {
+	if typ != reflect.TypeOf(JobTriggerConfig{}) {
+		return nil
+	}
+	return func(dst, src reflect.Value) error {
+		dstStruct, srcStruct := dst.Interface().(JobTriggerConfig), src.Interface().(JobTriggerConfig)
+
+		if srcStruct.Schedule != nil {
+			dstStruct.S3, dstStruct.Topic, dstStruct.EventPattern, dstStruct.After = nil, nil, nil, nil
+		}
+
+		if srcStruct.S3 != nil {
+			dstStruct.Schedule, dstStruct.Topic, dstStruct.EventPattern, dstStruct.After = nil, nil, nil, nil
+		}
+
+		if srcStruct.Topic != nil {
+			dstStruct.Schedule, dstStruct.S3, dstStruct.EventPattern, dstStruct.After = nil, nil, nil, nil
+		}
+
+		if srcStruct.EventPattern != nil {
+			dstStruct.Schedule, dstStruct.S3, dstStruct.Topic, dstStruct.After = nil, nil, nil, nil
+			// The basic merge combines both patterns key by key, so the override has to replace the pattern as a whole.
+			dstStruct.EventPattern = srcStruct.EventPattern
+		}
+
+		if srcStruct.After != nil {
+			dstStruct.Schedule, dstStruct.S3, dstStruct.Topic, dstStruct.EventPattern = nil, nil, nil, nil
+		}
+
+		if dst.CanSet() { // For extra safety to prevent panicking.
+			dst.Set(reflect.ValueOf(dstStruct))
+		}
+		return nil
+	}
+}
+
 type basicTransformer struct{}
 
 // Transformer returns custom merge logic for volume's fields.
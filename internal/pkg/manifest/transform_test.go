@@ -738,6 +738,30 @@ func TestEfsVolumeConfigurationTransformer_Transformer(t *testing.T) {
 				e.GID = aws.Uint32(53589793)
 			},
 		},
+		"managed file system options set to empty if BYO config is not empty": {
+			original: func(e *EFSVolumeConfiguration) {
+				e.FromWorkload = aws.String("api")
+				e.Backups = aws.Bool(false)
+				e.ThroughputMode = aws.String("elastic")
+			},
+			override: func(e *EFSVolumeConfiguration) {
+				e.FileSystemID = aws.String("mockFileSystem")
+			},
+			wanted: func(e *EFSVolumeConfiguration) {
+				e.FileSystemID = aws.String("mockFileSystem")
+			},
+		},
+		"BYO config set to empty if managed file system options are not empty": {
+			original: func(e *EFSVolumeConfiguration) {
+				e.FileSystemID = aws.String("mockFileSystem")
+			},
+			override: func(e *EFSVolumeConfiguration) {
+				e.Lifecycle.TransitionToIA = aws.Int(7)
+			},
+			wanted: func(e *EFSVolumeConfiguration) {
+				e.Lifecycle.TransitionToIA = aws.Int(7)
+			},
+		},
 	}
 
 	for name, tc := range testCases {
@@ -819,3 +843,108 @@ func TestSQSQueueOrBoolTransformer_Transformer(t *testing.T) {
 		})
 	}
 }
+
+func TestJobTriggerConfigTransformer_Transformer(t *testing.T) {
+	testCases := map[string]struct {
+		original func(c *JobTriggerConfig)
+		override func(c *JobTriggerConfig)
+		wanted   func(c *JobTriggerConfig)
+	}{
+		"schedule set to empty if s3 is not nil": {
+			original: func(c *JobTriggerConfig) {
+				c.Schedule = aws.String("@daily")
+			},
+			override: func(c *JobTriggerConfig) {
+				c.S3 = &S3JobTrigger{
+					Bucket: aws.String("uploads"),
+				}
+			},
+			wanted: func(c *JobTriggerConfig) {
+				c.S3 = &S3JobTrigger{
+					Bucket: aws.String("uploads"),
+				}
+			},
+		},
+		"event pattern set to empty if topic is not nil": {
+			original: func(c *JobTriggerConfig) {
+				c.EventPattern = map[string]interface{}{
+					"source": []interface{}{"aws.ecr"},
+				}
+			},
+			override: func(c *JobTriggerConfig) {
+				c.Topic = &TopicJobTrigger{
+					Name:    aws.String("orders"),
+					Service: aws.String("api"),
+				}
+			},
+			wanted: func(c *JobTriggerConfig) {
+				c.Topic = &TopicJobTrigger{
+					Name:    aws.String("orders"),
+					Service: aws.String("api"),
+				}
+			},
+		},
+		"event pattern replaced as a whole if overridden": {
+			original: func(c *JobTriggerConfig) {
+				c.EventPattern = map[string]interface{}{
+					"source":      []interface{}{"aws.ecr"},
+					"detail-type": []interface{}{"ECR Image Action"},
+				}
+			},
+			override: func(c *JobTriggerConfig) {
+				c.EventPattern = map[string]interface{}{
+					"source": []interface{}{"aws.s3"},
+				}
+			},
+			wanted: func(c *JobTriggerConfig) {
+				c.EventPattern = map[string]interface{}{
+					"source": []interface{}{"aws.s3"},
+				}
+			},
+		},
+		"schedule set to empty if after is not nil": {
+			original: func(c *JobTriggerConfig) {
+				c.Schedule = aws.String("@daily")
+			},
+			override: func(c *JobTriggerConfig) {
+				c.After = aws.String("extract")
+			},
+			wanted: func(c *JobTriggerConfig) {
+				c.After = aws.String("extract")
+			},
+		},
+		"s3 set to empty if schedule is not nil": {
+			original: func(c *JobTriggerConfig) {
+				c.S3 = &S3JobTrigger{
+					Bucket: aws.String("uploads"),
+				}
+			},
+			override: func(c *JobTriggerConfig) {
+				c.Schedule = aws.String("@daily")
+			},
+			wanted: func(c *JobTriggerConfig) {
+				c.Schedule = aws.String("@daily")
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var dst, override, wanted JobTriggerConfig
+
+			tc.original(&dst)
+			tc.override(&override)
+			tc.wanted(&wanted)
+
+			// Perform default merge.
+			err := mergo.Merge(&dst, override, mergo.WithOverride)
+			require.NoError(t, err)
+
+			// Use jobTriggerConfigTransformer.
+			err = mergo.Merge(&dst, override, mergo.WithOverride, mergo.WithTransformers(jobTriggerConfigTransformer{}))
+			require.NoError(t, err)
+
+			require.Equal(t, wanted, dst)
+		})
+	}
+}
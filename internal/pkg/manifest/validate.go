@@ -7,13 +7,16 @@ import (
 	"errors"
 	"fmt"
 	"net"
+	"net/url"
 	"path/filepath"
 	"regexp"
 	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/copilot-cli/internal/pkg/graph"
 	"github.com/dustin/go-humanize/english"
 )
@@ -30,6 +33,12 @@ const (
 	ephemeralMaxValueGiB = 200
 
 	envFileExt = ".env"
+
+	// Limits imposed by CodeDeploy on blue/green deployments.
+	maxBlueGreenTerminationWait = 48 * time.Hour
+	// Traffic must be shifted before the deployment function times out after 15 minutes,
+	// leaving time for the new tasks to become healthy.
+	maxBlueGreenTrafficShift = 10 * time.Minute
 )
 
 var (
@@ -45,7 +54,15 @@ var (
 
 	httpProtocolVersions = []string{"GRPC", "HTTP1", "HTTP2"}
 
+	buildComputeTypes = []string{"BUILD_GENERAL1_SMALL", "BUILD_GENERAL1_MEDIUM", "BUILD_GENERAL1_LARGE", "BUILD_GENERAL1_2XLARGE"}
+
+	efsThroughputModes    = []string{EFSThroughputModeBursting, EFSThroughputModeElastic, EFSThroughputModeProvisioned}
+	efsTransitionToIADays = []string{"1", "7", "14", "30", "60", "90", "180", "270", "365"}
+
 	invalidTaskDefOverridePathRegexp = []string{`Family`, `ContainerDefinitions\[\d+\].Name`}
+
+	deploymentStrategies = []string{DeploymentStrategyRolling, DeploymentStrategyBlueGreen}
+	trafficShiftingTypes = []string{TrafficShiftingAllAtOnce, TrafficShiftingCanary, TrafficShiftingLinear}
 )
 
 // Validate returns nil if LoadBalancedWebService is configured correctly.
@@ -116,6 +133,9 @@ func (l LoadBalancedWebServiceConfig) Validate() error {
 			return fmt.Errorf(`validate "taskdef_overrides[%d]": %w`, ind, err)
 		}
 	}
+	if err = l.DeployConfig.Validate(); err != nil {
+		return fmt.Errorf(`validate "deploy": %w`, err)
+	}
 	if l.TaskConfig.IsWindows() {
 		if err = validateWindows(validateWindowsOpts{
 			execEnabled: aws.BoolValue(l.ExecuteCommand.Enable),
@@ -135,6 +155,36 @@ func (l LoadBalancedWebServiceConfig) Validate() error {
 	if err = l.NLBConfig.Validate(); err != nil {
 		return fmt.Errorf(`validate "nlb": %w`, err)
 	}
+	if err = l.Deployment.Validate(); err != nil {
+		return fmt.Errorf(`validate "deployment": %w`, err)
+	}
+	if l.Deployment.IsBlueGreen() && !l.NLBConfig.IsEmpty() {
+		return fmt.Errorf(`"nlb" cannot be specified with a %s deployment strategy`, DeploymentStrategyBlueGreen)
+	}
+	if l.Deployment.IsBlueGreen() {
+		// The scaling policies track the metrics of a single target group, while CodeDeploy swaps them on every deployment.
+		count := l.Count.AdvancedCount
+		if count.Requests != nil {
+			return fmt.Errorf(`"count.requests" cannot be specified with a %s deployment strategy`, DeploymentStrategyBlueGreen)
+		}
+		if count.ResponseTime != nil {
+			return fmt.Errorf(`"count.response_time" cannot be specified with a %s deployment strategy`, DeploymentStrategyBlueGreen)
+		}
+		// ECS can't update the network configuration, capacity providers or ECS Exec of a service deployed by CodeDeploy,
+		// so they are fixed to their defaults to never change after the service is created.
+		if count.Spot != nil || count.Range.RangeConfig.SpotFrom != nil {
+			return fmt.Errorf(`"count.spot" and "count.range.spot_from" cannot be specified with a %s deployment strategy`, DeploymentStrategyBlueGreen)
+		}
+		if placement := l.Network.VPC.Placement; placement != nil && *placement != PublicSubnetPlacement {
+			return fmt.Errorf(`"network.vpc.placement" %s cannot be specified with a %s deployment strategy`, *placement, DeploymentStrategyBlueGreen)
+		}
+		if len(l.Network.VPC.SecurityGroups) != 0 {
+			return fmt.Errorf(`"network.vpc.security_groups" cannot be specified with a %s deployment strategy`, DeploymentStrategyBlueGreen)
+		}
+		if aws.BoolValue(l.ExecuteCommand.Enable) || !l.ExecuteCommand.Config.IsEmpty() {
+			return fmt.Errorf(`"exec" cannot be specified with a %s deployment strategy`, DeploymentStrategyBlueGreen)
+		}
+	}
 	return nil
 }
 
@@ -189,6 +239,9 @@ func (b BackendServiceConfig) Validate() error {
 			return fmt.Errorf(`validate "taskdef_overrides[%d]": %w`, ind, err)
 		}
 	}
+	if err = b.DeployConfig.Validate(); err != nil {
+		return fmt.Errorf(`validate "deploy": %w`, err)
+	}
 	if b.TaskConfig.IsWindows() {
 		if err = validateWindows(validateWindowsOpts{
 			execEnabled: aws.BoolValue(b.ExecuteCommand.Enable),
@@ -291,6 +344,9 @@ func (w WorkerServiceConfig) Validate() error {
 			return fmt.Errorf(`validate "taskdef_overrides[%d]": %w`, ind, err)
 		}
 	}
+	if err = w.DeployConfig.Validate(); err != nil {
+		return fmt.Errorf(`validate "deploy": %w`, err)
+	}
 	if w.TaskConfig.IsWindows() {
 		if err = validateWindows(validateWindowsOpts{
 			execEnabled: aws.BoolValue(w.ExecuteCommand.Enable),
@@ -319,6 +375,9 @@ func (s ScheduledJob) Validate() error {
 	if err = s.Workload.Validate(); err != nil {
 		return err
 	}
+	if s.On.After != nil && aws.StringValue(s.On.After) == aws.StringValue(s.Name) {
+		return errors.New(`validate "on": "after" cannot reference the job itself`)
+	}
 	if err = validateContainerDeps(validateDependenciesOpts{
 		sidecarConfig:     s.Sidecars,
 		imageConfig:       s.ImageConfig.Image,
@@ -359,6 +418,9 @@ func (s ScheduledJobConfig) Validate() error {
 	if err = s.JobFailureHandlerConfig.Validate(); err != nil {
 		return err
 	}
+	if err = s.JobConcurrencyConfig.Validate(); err != nil {
+		return err
+	}
 	if err = s.PublishConfig.Validate(); err != nil {
 		return fmt.Errorf(`validate "publish": %w`, err)
 	}
@@ -623,6 +685,11 @@ func (t TaskConfig) Validate() error {
 	if err = t.Storage.Validate(); err != nil {
 		return fmt.Errorf(`validate "storage": %w`, err)
 	}
+	for name, secret := range t.Secrets {
+		if err = secret.Validate(); err != nil {
+			return fmt.Errorf(`validate "secrets[%s]": %w`, name, err)
+		}
+	}
 	if t.EnvFile != nil {
 		envFile := aws.StringValue(t.EnvFile)
 		if filepath.Ext(envFile) != envFileExt {
@@ -632,6 +699,28 @@ func (t TaskConfig) Validate() error {
 	return nil
 }
 
+// Validate returns nil if Secret is configured correctly.
+func (s Secret) Validate() error {
+	if s.IsSecretsManagerName() {
+		return s.FromSecretsManager.Validate()
+	}
+	return nil
+}
+
+// Validate returns nil if SecretsManagerSecret is configured correctly.
+func (s SecretsManagerSecret) Validate() error {
+	if s.IsEmpty() {
+		return nil
+	}
+	if aws.StringValue(s.Name) == "" {
+		return &errFieldMustBeSpecified{
+			missingField:      "secretsmanager",
+			conditionalFields: []string{"key"},
+		}
+	}
+	return nil
+}
+
 // Validate returns nil if PlatformArgsOrString is configured correctly.
 func (p PlatformArgsOrString) Validate() error {
 	if p.IsEmpty() {
@@ -864,6 +953,11 @@ func (s Storage) Validate() error {
 			hasManagedVolume = true
 		}
 	}
+	for i, name := range s.EnvAddons {
+		if name == "" {
+			return fmt.Errorf(`validate "env_addons[%d]": name cannot be empty`, i)
+		}
+	}
 	return nil
 }
 
@@ -902,11 +996,8 @@ func (e EFSVolumeConfiguration) Validate() error {
 	if e.IsEmpty() {
 		return nil
 	}
-	if !e.EmptyBYOConfig() && !e.EmptyUIDConfig() {
-		return &errFieldMutualExclusive{
-			firstField:  "uid/gid",
-			secondField: "id/root_dir/auth",
-		}
+	if !e.EmptyBYOConfig() && !e.EmptyManagedConfig() {
+		return e.errManagedWithBYOConfig()
 	}
 	if e.UID != nil && e.GID == nil {
 		return &errFieldMustBeSpecified{
@@ -923,6 +1014,27 @@ func (e EFSVolumeConfiguration) Validate() error {
 	if e.UID != nil && *e.UID == 0 {
 		return fmt.Errorf(`"uid" must not be 0`)
 	}
+	if e.FromWorkload != nil && aws.StringValue(e.FromWorkload) == "" {
+		return fmt.Errorf(`"from_workload" must not be empty`)
+	}
+	if err := e.Lifecycle.Validate(); err != nil {
+		return fmt.Errorf(`validate "lifecycle": %w`, err)
+	}
+	if e.ThroughputMode != nil && !contains(aws.StringValue(e.ThroughputMode), efsThroughputModes) {
+		return fmt.Errorf(`"throughput_mode" %s must be one of %s`, aws.StringValue(e.ThroughputMode), english.WordSeries(efsThroughputModes, "or"))
+	}
+	isProvisioned := aws.StringValue(e.ThroughputMode) == EFSThroughputModeProvisioned
+	if isProvisioned && e.ProvisionedThroughput == nil {
+		return fmt.Errorf(`"provisioned_throughput" must be specified when "throughput_mode" is %s`, EFSThroughputModeProvisioned)
+	}
+	if e.ProvisionedThroughput != nil {
+		if !isProvisioned {
+			return fmt.Errorf(`"provisioned_throughput" can only be specified when "throughput_mode" is %s`, EFSThroughputModeProvisioned)
+		}
+		if aws.IntValue(e.ProvisionedThroughput) < 1 {
+			return fmt.Errorf(`"provisioned_throughput" must be at least 1 MiB/s`)
+		}
+	}
 	if err := e.AuthConfig.Validate(); err != nil {
 		return fmt.Errorf(`validate "auth": %w`, err)
 	}
@@ -941,6 +1053,17 @@ func (e EFSVolumeConfiguration) Validate() error {
 	return nil
 }
 
+// Validate returns nil if EFSLifecycle is configured correctly.
+func (l EFSLifecycle) Validate() error {
+	if l.TransitionToIA == nil {
+		return nil
+	}
+	if days := strconv.Itoa(aws.IntValue(l.TransitionToIA)); !contains(days, efsTransitionToIADays) {
+		return fmt.Errorf(`"transition_to_ia" %s must be one of %s days`, days, english.WordSeries(efsTransitionToIADays, "or"))
+	}
+	return nil
+}
+
 // Validate returns nil if AuthorizationConfig is configured correctly.
 func (a AuthorizationConfig) Validate() error {
 	if a.IsEmpty() {
@@ -970,6 +1093,11 @@ func (s SidecarConfig) Validate() error {
 	if err := s.DependsOn.Validate(); err != nil {
 		return fmt.Errorf(`validate "depends_on": %w`, err)
 	}
+	for name, secret := range s.Secrets {
+		if err := secret.Validate(); err != nil {
+			return fmt.Errorf(`validate "secrets[%s]": %w`, name, err)
+		}
+	}
 	return s.ImageOverride.Validate()
 }
 
@@ -1080,14 +1208,62 @@ func (r RequestDrivenWebServiceHttpConfig) Validate() error {
 
 // Validate returns nil if JobTriggerConfig is configured correctly.
 func (c JobTriggerConfig) Validate() error {
-	if c.Schedule == nil {
+	switch sources := c.sources(); len(sources) {
+	case 0:
 		return &errFieldMustBeSpecified{
 			missingField: "schedule",
 		}
+	case 1:
+	default:
+		return &errFieldsMutualExclusive{
+			fields: sources,
+		}
+	}
+	if c.S3 != nil {
+		if err := c.S3.Validate(); err != nil {
+			return fmt.Errorf(`validate "s3": %w`, err)
+		}
+	}
+	if c.Topic != nil {
+		if err := c.Topic.Validate(); err != nil {
+			return fmt.Errorf(`validate "topic": %w`, err)
+		}
+	}
+	if c.EventPattern != nil && len(c.EventPattern) == 0 {
+		return errors.New(`"event_pattern" cannot be empty`)
+	}
+	if c.After != nil && aws.StringValue(c.After) == "" {
+		return errors.New(`"after" cannot be empty`)
 	}
 	return nil
 }
 
+// Validate returns nil if JobConcurrencyConfig is configured correctly.
+func (c JobConcurrencyConfig) Validate() error {
+	if c.Concurrency != nil && aws.IntValue(c.Concurrency) < 1 {
+		return errors.New(`"concurrency" must be greater than or equal to 1`)
+	}
+	return nil
+}
+
+// Validate returns nil if S3JobTrigger is configured correctly.
+func (s S3JobTrigger) Validate() error {
+	if aws.StringValue(s.Bucket) == "" {
+		return &errFieldMustBeSpecified{
+			missingField: "bucket",
+		}
+	}
+	return nil
+}
+
+// Validate returns nil if TopicJobTrigger is configured correctly.
+func (t TopicJobTrigger) Validate() error {
+	return TopicSubscription{
+		Name:    t.Name,
+		Service: t.Service,
+	}.Validate()
+}
+
 // Validate returns nil if JobFailureHandlerConfig is configured correctly.
 func (JobFailureHandlerConfig) Validate() error {
 	return nil
@@ -1182,6 +1358,281 @@ func (r OverrideRule) Validate() error {
 	return nil
 }
 
+// Validate returns nil if DeployConfig is configured correctly.
+func (d DeployConfig) Validate() error {
+	for ind, hook := range d.PreHooks {
+		if err := hook.Validate(); err != nil {
+			return fmt.Errorf(`validate "pre_hooks[%d]": %w`, ind, err)
+		}
+	}
+	return nil
+}
+
+// Validate returns nil if DeployHook is configured correctly.
+func (h DeployHook) Validate() error {
+	if aws.StringValue(h.Command.String) == "" && len(h.Command.StringSlice) == 0 {
+		return &errFieldMustBeSpecified{
+			missingField: "command",
+		}
+	}
+	return nil
+}
+
+// Validate returns nil if DeploymentConfiguration is configured correctly.
+func (d DeploymentConfiguration) Validate() error {
+	if d.IsEmpty() {
+		return nil
+	}
+	if d.Strategy != nil && !contains(aws.StringValue(d.Strategy), deploymentStrategies) {
+		return fmt.Errorf(`"strategy" %s must be one of %s`, aws.StringValue(d.Strategy), english.WordSeries(deploymentStrategies, "or"))
+	}
+	if !d.IsBlueGreen() {
+		fields := []struct {
+			name  string
+			isSet bool
+		}{
+			{"traffic_shifting", !d.TrafficShifting.IsEmpty()},
+			{"test_port", d.TestPort != nil},
+			{"termination_wait", d.TerminationWait != nil},
+			{"rollback_alarms", len(d.RollbackAlarms) != 0},
+		}
+		for _, field := range fields {
+			if field.isSet {
+				return fmt.Errorf(`"%s" can only be specified when "strategy" is %s`, field.name, DeploymentStrategyBlueGreen)
+			}
+		}
+		return nil
+	}
+	if err := d.TrafficShifting.Validate(); err != nil {
+		return fmt.Errorf(`validate "traffic_shifting": %w`, err)
+	}
+	if d.TestPort == nil {
+		// Each blue/green service of an environment needs its own test listener on the load balancer.
+		return fmt.Errorf(`"test_port" must be specified when "strategy" is %s`, DeploymentStrategyBlueGreen)
+	}
+	if port := aws.Uint16Value(d.TestPort); port == 80 || port == 443 {
+		return fmt.Errorf(`"test_port" %d is already used by the load balancer's production listeners`, port)
+	}
+	if d.TerminationWait != nil {
+		wait := *d.TerminationWait
+		if wait%time.Minute != 0 {
+			return fmt.Errorf(`"termination_wait" %s must be a whole number of minutes`, wait)
+		}
+		if wait > maxBlueGreenTerminationWait {
+			return fmt.Errorf(`"termination_wait" %s cannot be longer than %s`, wait, maxBlueGreenTerminationWait)
+		}
+	}
+	return nil
+}
+
+// Validate returns nil if TrafficShifting is configured correctly.
+func (t TrafficShifting) Validate() error {
+	if t.IsEmpty() {
+		return nil
+	}
+	typ := aws.StringValue(t.Type)
+	if !contains(typ, trafficShiftingTypes) {
+		return fmt.Errorf(`"type" %s must be one of %s`, typ, english.WordSeries(trafficShiftingTypes, "or"))
+	}
+	if typ == TrafficShiftingAllAtOnce {
+		if t.Percentage != nil || t.Interval != nil {
+			return fmt.Errorf(`"percentage" and "interval" cannot be specified when "type" is %s`, TrafficShiftingAllAtOnce)
+		}
+		return nil
+	}
+	if t.Percentage == nil {
+		return &errFieldMustBeSpecified{
+			missingField:      "percentage",
+			conditionalFields: []string{"type"},
+		}
+	}
+	if t.Interval == nil {
+		return &errFieldMustBeSpecified{
+			missingField:      "interval",
+			conditionalFields: []string{"type"},
+		}
+	}
+	if pct := aws.IntValue(t.Percentage); pct < 1 || pct > 99 {
+		return fmt.Errorf(`"percentage" %d must be between 1 and 99`, pct)
+	}
+	interval := *t.Interval
+	if interval < time.Minute || interval%time.Minute != 0 {
+		return fmt.Errorf(`"interval" %s must be a whole number of minutes`, interval)
+	}
+	if shift := t.duration(); shift > maxBlueGreenTrafficShift {
+		return fmt.Errorf(`traffic shifting takes %s which is longer than %s: increase "percentage" or decrease "interval"`, shift, maxBlueGreenTrafficShift)
+	}
+	return nil
+}
+
+// duration returns how long it takes to shift all the traffic to the new tasks.
+func (t TrafficShifting) duration() time.Duration {
+	interval := *t.Interval
+	if aws.StringValue(t.Type) == TrafficShiftingCanary {
+		return interval
+	}
+	pct := aws.IntValue(t.Percentage)
+	steps := (100 + pct - 1) / pct
+	return interval * time.Duration(steps-1)
+}
+
+// Validate returns nil if PipelineManifest is configured correctly.
+func (p PipelineManifest) Validate() error {
+	if p.Build != nil {
+		if err := p.Build.Validate(); err != nil {
+			return fmt.Errorf(`validate "build": %w`, err)
+		}
+	}
+	for _, stage := range p.Stages {
+		if err := stage.Validate(); err != nil {
+			return fmt.Errorf(`validate stage "%s": %w`, stage.Name, err)
+		}
+	}
+	return nil
+}
+
+// Validate returns nil if Build is configured correctly.
+func (b Build) Validate() error {
+	if b.ComputeType != "" && !contains(b.ComputeType, buildComputeTypes) {
+		return fmt.Errorf(`"compute_type" %s must be one of %s`, b.ComputeType, english.WordSeries(buildComputeTypes, "or"))
+	}
+	if b.Cache != nil {
+		if err := b.Cache.Validate(); err != nil {
+			return fmt.Errorf(`validate "cache": %w`, err)
+		}
+	}
+	return nil
+}
+
+// Validate returns nil if BuildCache is configured correctly.
+func (c BuildCache) Validate() error {
+	if c.S3 == nil {
+		return nil
+	}
+	if aws.BoolValue(c.DockerLayers) {
+		return &errFieldMutualExclusive{
+			firstField:  "docker_layers",
+			secondField: "s3",
+		}
+	}
+	if c.S3.Location == "" {
+		return &errFieldMustBeSpecified{
+			missingField:      "location",
+			conditionalFields: []string{"s3"},
+		}
+	}
+	return nil
+}
+
+// Validate returns nil if PipelineStage is configured correctly.
+func (s PipelineStage) Validate() error {
+	if s.ApprovalNotifications != nil {
+		if !s.RequiresApproval {
+			return errors.New(`"requires_approval" must be true if "approval_notifications" is specified`)
+		}
+		if err := s.ApprovalNotifications.Validate(); err != nil {
+			return fmt.Errorf(`validate "approval_notifications": %w`, err)
+		}
+	}
+	if err := s.Deployments.Validate(); err != nil {
+		return fmt.Errorf(`validate "deployments": %w`, err)
+	}
+	for name, postDeployment := range s.PostDeployments {
+		if !isValidSubSvcName(name) {
+			return fmt.Errorf("post deployment name %s must start with a letter, contain only lower-case letters, numbers, and hyphens, and have no consecutive or trailing hyphen", name)
+		}
+		if postDeployment == nil {
+			postDeployment = &PostDeployment{}
+		}
+		if err := postDeployment.Validate(); err != nil {
+			return fmt.Errorf(`validate "post_deployments[%s]": %w`, name, err)
+		}
+	}
+	return nil
+}
+
+// Validate returns nil if ApprovalNotifications is configured correctly.
+func (a ApprovalNotifications) Validate() error {
+	if a.TopicARN == "" && len(a.Emails) == 0 && a.Webhook == "" {
+		return &errAtLeastOneFieldMustBeSpecified{
+			missingFields:    []string{"topic_arn", "emails", "webhook"},
+			conditionalField: "approval_notifications",
+		}
+	}
+	if a.TopicARN != "" {
+		if _, err := arn.Parse(a.TopicARN); err != nil {
+			return fmt.Errorf(`parse "topic_arn" %s: %w`, a.TopicARN, err)
+		}
+	}
+	if a.Webhook != "" {
+		if u, err := url.Parse(a.Webhook); err != nil || u.Scheme != "https" || u.Host == "" {
+			return fmt.Errorf(`"webhook" %s must be an HTTPS URL`, a.Webhook)
+		}
+	}
+	return nil
+}
+
+// Validate returns nil if PostDeployment is configured correctly.
+func (p PostDeployment) Validate() error {
+	if len(p.Commands) == 0 && p.Image == "" {
+		return &errFieldMutualExclusive{
+			firstField:  "commands",
+			secondField: "image",
+			mustExist:   true,
+		}
+	}
+	if len(p.Commands) != 0 && p.Image != "" {
+		return &errFieldMutualExclusive{
+			firstField:  "commands",
+			secondField: "image",
+		}
+	}
+	if p.Image == "" {
+		if p.Command != "" {
+			return &errFieldMustBeSpecified{
+				missingField:      "image",
+				conditionalFields: []string{"command"},
+			}
+		}
+		if len(p.Variables) != 0 {
+			return &errFieldMustBeSpecified{
+				missingField:      "image",
+				conditionalFields: []string{"variables"},
+			}
+		}
+	}
+	return nil
+}
+
+// Validate returns nil if Deployments are configured correctly.
+func (d Deployments) Validate() error {
+	deploymentGraph := graph.New()
+	for name, deployment := range d {
+		if deployment == nil {
+			continue
+		}
+		for _, dep := range deployment.DependsOn {
+			if _, ok := d[dep]; !ok {
+				return fmt.Errorf("dependency deployment %s of %s does not exist", dep, name)
+			}
+			deploymentGraph.Add(graph.Edge{
+				From: name,
+				To:   dep,
+			})
+		}
+	}
+	cycle, ok := deploymentGraph.IsAcyclic()
+	if ok {
+		return nil
+	}
+	if len(cycle) == 1 {
+		return fmt.Errorf("deployment %s cannot depend on itself", cycle[0])
+	}
+	// Stablize unit tests.
+	sort.SliceStable(cycle, func(i, j int) bool { return cycle[i] < cycle[j] })
+	return fmt.Errorf("circular deployment dependency chain includes the following deployments: %s", cycle)
+}
+
 type validateDependenciesOpts struct {
 	mainContainerName string
 	sidecarConfig     map[string]*SidecarConfig
@@ -14,6 +14,7 @@ import (
 )
 
 func TestLoadBalancedWebService_Validate(t *testing.T) {
+	mockResponseTime := 2 * time.Second
 	testImageConfig := ImageWithPortAndHealthcheck{
 		ImageWithPort: ImageWithPort{
 			Image: Image{
@@ -197,6 +198,171 @@ func TestLoadBalancedWebService_Validate(t *testing.T) {
 			},
 			wantedErrorMsgPrefix: `validate ARM: `,
 		},
+		"error if fail to validate deployment": {
+			lbConfig: LoadBalancedWebService{
+				Workload: Workload{Name: aws.String("mockName")},
+				LoadBalancedWebServiceConfig: LoadBalancedWebServiceConfig{
+					ImageConfig: testImageConfig,
+					Deployment: DeploymentConfiguration{
+						Strategy: aws.String("recreate"),
+					},
+				},
+			},
+			wantedErrorMsgPrefix: `validate "deployment": `,
+		},
+		"error if blue/green deployments are used with a network load balancer": {
+			lbConfig: LoadBalancedWebService{
+				Workload: Workload{Name: aws.String("mockName")},
+				LoadBalancedWebServiceConfig: LoadBalancedWebServiceConfig{
+					ImageConfig: testImageConfig,
+					NLBConfig: NetworkLoadBalancerConfiguration{
+						Port: aws.String("443/tcp"),
+					},
+					Deployment: DeploymentConfiguration{
+						Strategy: aws.String("blue_green"),
+						TestPort: aws.Uint16(8080),
+					},
+				},
+			},
+			wantedError: errors.New(`"nlb" cannot be specified with a blue_green deployment strategy`),
+		},
+		"error if blue/green deployments are used with request-based autoscaling": {
+			lbConfig: LoadBalancedWebService{
+				Workload: Workload{Name: aws.String("mockName")},
+				LoadBalancedWebServiceConfig: LoadBalancedWebServiceConfig{
+					ImageConfig: testImageConfig,
+					TaskConfig: TaskConfig{
+						Count: Count{
+							AdvancedCount: AdvancedCount{
+								Range:        Range{Value: (*IntRangeBand)(aws.String("1-10"))},
+								Requests:     aws.Int(100),
+								workloadType: LoadBalancedWebServiceType,
+							},
+						},
+					},
+					Deployment: DeploymentConfiguration{
+						Strategy: aws.String("blue_green"),
+						TestPort: aws.Uint16(8080),
+					},
+				},
+			},
+			wantedError: errors.New(`"count.requests" cannot be specified with a blue_green deployment strategy`),
+		},
+		"error if blue/green deployments are used with response time autoscaling": {
+			lbConfig: LoadBalancedWebService{
+				Workload: Workload{Name: aws.String("mockName")},
+				LoadBalancedWebServiceConfig: LoadBalancedWebServiceConfig{
+					ImageConfig: testImageConfig,
+					TaskConfig: TaskConfig{
+						Count: Count{
+							AdvancedCount: AdvancedCount{
+								Range:        Range{Value: (*IntRangeBand)(aws.String("1-10"))},
+								ResponseTime: &mockResponseTime,
+								workloadType: LoadBalancedWebServiceType,
+							},
+						},
+					},
+					Deployment: DeploymentConfiguration{
+						Strategy: aws.String("blue_green"),
+						TestPort: aws.Uint16(8080),
+					},
+				},
+			},
+			wantedError: errors.New(`"count.response_time" cannot be specified with a blue_green deployment strategy`),
+		},
+		"error if blue/green deployments are used with spot capacity": {
+			lbConfig: LoadBalancedWebService{
+				Workload: Workload{Name: aws.String("mockName")},
+				LoadBalancedWebServiceConfig: LoadBalancedWebServiceConfig{
+					ImageConfig: testImageConfig,
+					TaskConfig: TaskConfig{
+						Count: Count{
+							AdvancedCount: AdvancedCount{
+								Spot:         aws.Int(2),
+								workloadType: LoadBalancedWebServiceType,
+							},
+						},
+					},
+					Deployment: DeploymentConfiguration{
+						Strategy: aws.String("blue_green"),
+						TestPort: aws.Uint16(8080),
+					},
+				},
+			},
+			wantedError: errors.New(`"count.spot" and "count.range.spot_from" cannot be specified with a blue_green deployment strategy`),
+		},
+		"error if blue/green deployments are used in private subnets": {
+			lbConfig: LoadBalancedWebService{
+				Workload: Workload{Name: aws.String("mockName")},
+				LoadBalancedWebServiceConfig: LoadBalancedWebServiceConfig{
+					ImageConfig: testImageConfig,
+					Network: NetworkConfig{
+						VPC: vpcConfig{
+							Placement: &PrivateSubnetPlacement,
+						},
+					},
+					Deployment: DeploymentConfiguration{
+						Strategy: aws.String("blue_green"),
+						TestPort: aws.Uint16(8080),
+					},
+				},
+			},
+			wantedError: errors.New(`"network.vpc.placement" private cannot be specified with a blue_green deployment strategy`),
+		},
+		"error if blue/green deployments are used with additional security groups": {
+			lbConfig: LoadBalancedWebService{
+				Workload: Workload{Name: aws.String("mockName")},
+				LoadBalancedWebServiceConfig: LoadBalancedWebServiceConfig{
+					ImageConfig: testImageConfig,
+					Network: NetworkConfig{
+						VPC: vpcConfig{
+							Placement:      &PublicSubnetPlacement,
+							SecurityGroups: []string{"sg-1234"},
+						},
+					},
+					Deployment: DeploymentConfiguration{
+						Strategy: aws.String("blue_green"),
+						TestPort: aws.Uint16(8080),
+					},
+				},
+			},
+			wantedError: errors.New(`"network.vpc.security_groups" cannot be specified with a blue_green deployment strategy`),
+		},
+		"error if blue/green deployments are used with ECS Exec": {
+			lbConfig: LoadBalancedWebService{
+				Workload: Workload{Name: aws.String("mockName")},
+				LoadBalancedWebServiceConfig: LoadBalancedWebServiceConfig{
+					ImageConfig: testImageConfig,
+					TaskConfig: TaskConfig{
+						ExecuteCommand: ExecuteCommand{
+							Enable: aws.Bool(true),
+						},
+					},
+					Deployment: DeploymentConfiguration{
+						Strategy: aws.String("blue_green"),
+						TestPort: aws.Uint16(8080),
+					},
+				},
+			},
+			wantedError: errors.New(`"exec" cannot be specified with a blue_green deployment strategy`),
+		},
+		"success if blue/green deployments are used in the default public subnets": {
+			lbConfig: LoadBalancedWebService{
+				Workload: Workload{Name: aws.String("mockName")},
+				LoadBalancedWebServiceConfig: LoadBalancedWebServiceConfig{
+					ImageConfig: testImageConfig,
+					Network: NetworkConfig{
+						VPC: vpcConfig{
+							Placement: &PublicSubnetPlacement,
+						},
+					},
+					Deployment: DeploymentConfiguration{
+						Strategy: aws.String("blue_green"),
+						TestPort: aws.Uint16(8080),
+					},
+				},
+			},
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -750,6 +916,18 @@ func TestScheduledJob_Validate(t *testing.T) {
 			},
 			wantedError: fmt.Errorf(`"name" must be specified`),
 		},
+		"error if the job runs after itself": {
+			config: ScheduledJob{
+				Workload: Workload{Name: aws.String("mockWorkload")},
+				ScheduledJobConfig: ScheduledJobConfig{
+					ImageConfig: testImageConfig,
+					On: JobTriggerConfig{
+						After: aws.String("mockWorkload"),
+					},
+				},
+			},
+			wantedError: fmt.Errorf(`validate "on": "after" cannot reference the job itself`),
+		},
 		"error if fail to validate dependencies": {
 			config: ScheduledJob{
 				Workload: Workload{Name: aws.String("mockWorkload")},
@@ -997,6 +1175,173 @@ func TestNetworkLoadBalancerConfiguration_Validate(t *testing.T) {
 	}
 }
 
+func TestDeploymentConfiguration_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		in     DeploymentConfiguration
+		wanted error
+	}{
+		"success if empty": {},
+		"should return an error if the strategy is invalid": {
+			in: DeploymentConfiguration{
+				Strategy: aws.String("recreate"),
+			},
+			wanted: errors.New(`"strategy" recreate must be one of rolling or blue_green`),
+		},
+		"should return an error if blue/green fields are specified with a rolling strategy": {
+			in: DeploymentConfiguration{
+				Strategy: aws.String("rolling"),
+				TestPort: aws.Uint16(8080),
+			},
+			wanted: errors.New(`"test_port" can only be specified when "strategy" is blue_green`),
+		},
+		"should return an error if blue/green fields are specified without a strategy": {
+			in: DeploymentConfiguration{
+				RollbackAlarms: []string{"my-alarm"},
+			},
+			wanted: errors.New(`"rollback_alarms" can only be specified when "strategy" is blue_green`),
+		},
+		"should return an error if the traffic shifting type is invalid": {
+			in: DeploymentConfiguration{
+				Strategy: aws.String("blue_green"),
+				TrafficShifting: TrafficShifting{
+					Type: aws.String("exponential"),
+				},
+			},
+			wanted: errors.New(`validate "traffic_shifting": "type" exponential must be one of all_at_once, canary or linear`),
+		},
+		"should return an error if an all at once traffic shifting has a percentage": {
+			in: DeploymentConfiguration{
+				Strategy: aws.String("blue_green"),
+				TrafficShifting: TrafficShifting{
+					Type:       aws.String("all_at_once"),
+					Percentage: aws.Int(10),
+				},
+			},
+			wanted: errors.New(`validate "traffic_shifting": "percentage" and "interval" cannot be specified when "type" is all_at_once`),
+		},
+		"should return an error if a canary traffic shifting has no percentage": {
+			in: DeploymentConfiguration{
+				Strategy: aws.String("blue_green"),
+				TrafficShifting: TrafficShifting{
+					Type:     aws.String("canary"),
+					Interval: durationp(5 * time.Minute),
+				},
+			},
+			wanted: errors.New(`validate "traffic_shifting": "percentage" must be specified if "type" is specified`),
+		},
+		"should return an error if a linear traffic shifting has no interval": {
+			in: DeploymentConfiguration{
+				Strategy: aws.String("blue_green"),
+				TrafficShifting: TrafficShifting{
+					Type:       aws.String("linear"),
+					Percentage: aws.Int(10),
+				},
+			},
+			wanted: errors.New(`validate "traffic_shifting": "interval" must be specified if "type" is specified`),
+		},
+		"should return an error if the percentage is out of range": {
+			in: DeploymentConfiguration{
+				Strategy: aws.String("blue_green"),
+				TrafficShifting: TrafficShifting{
+					Type:       aws.String("linear"),
+					Percentage: aws.Int(100),
+					Interval:   durationp(time.Minute),
+				},
+			},
+			wanted: errors.New(`validate "traffic_shifting": "percentage" 100 must be between 1 and 99`),
+		},
+		"should return an error if the interval is not a whole number of minutes": {
+			in: DeploymentConfiguration{
+				Strategy: aws.String("blue_green"),
+				TrafficShifting: TrafficShifting{
+					Type:       aws.String("canary"),
+					Percentage: aws.Int(10),
+					Interval:   durationp(90 * time.Second),
+				},
+			},
+			wanted: errors.New(`validate "traffic_shifting": "interval" 1m30s must be a whole number of minutes`),
+		},
+		"should return an error if a canary traffic shifting takes too long": {
+			in: DeploymentConfiguration{
+				Strategy: aws.String("blue_green"),
+				TrafficShifting: TrafficShifting{
+					Type:       aws.String("canary"),
+					Percentage: aws.Int(10),
+					Interval:   durationp(15 * time.Minute),
+				},
+			},
+			wanted: errors.New(`validate "traffic_shifting": traffic shifting takes 15m0s which is longer than 10m0s: increase "percentage" or decrease "interval"`),
+		},
+		"should return an error if a linear traffic shifting takes too long": {
+			in: DeploymentConfiguration{
+				Strategy: aws.String("blue_green"),
+				TrafficShifting: TrafficShifting{
+					Type:       aws.String("linear"),
+					Percentage: aws.Int(30),
+					Interval:   durationp(4 * time.Minute),
+				},
+			},
+			wanted: errors.New(`validate "traffic_shifting": traffic shifting takes 12m0s which is longer than 10m0s: increase "percentage" or decrease "interval"`),
+		},
+		"should return an error if the test port is missing": {
+			in: DeploymentConfiguration{
+				Strategy: aws.String("blue_green"),
+			},
+			wanted: errors.New(`"test_port" must be specified when "strategy" is blue_green`),
+		},
+		"should return an error if the test port collides with a production listener": {
+			in: DeploymentConfiguration{
+				Strategy: aws.String("blue_green"),
+				TestPort: aws.Uint16(443),
+			},
+			wanted: errors.New(`"test_port" 443 is already used by the load balancer's production listeners`),
+		},
+		"should return an error if the termination wait is too long": {
+			in: DeploymentConfiguration{
+				Strategy:        aws.String("blue_green"),
+				TestPort:        aws.Uint16(8080),
+				TerminationWait: durationp(72 * time.Hour),
+			},
+			wanted: errors.New(`"termination_wait" 72h0m0s cannot be longer than 48h0m0s`),
+		},
+		"success with a canary blue/green deployment": {
+			in: DeploymentConfiguration{
+				Strategy: aws.String("blue_green"),
+				TrafficShifting: TrafficShifting{
+					Type:       aws.String("canary"),
+					Percentage: aws.Int(10),
+					Interval:   durationp(5 * time.Minute),
+				},
+				TestPort:        aws.Uint16(8080),
+				TerminationWait: durationp(10 * time.Minute),
+				RollbackAlarms:  []string{"my-alarm"},
+			},
+		},
+		"success with a linear blue/green deployment": {
+			in: DeploymentConfiguration{
+				Strategy: aws.String("blue_green"),
+				TrafficShifting: TrafficShifting{
+					Type:       aws.String("linear"),
+					Percentage: aws.Int(20),
+					Interval:   durationp(2 * time.Minute),
+				},
+				TestPort: aws.Uint16(9000),
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.in.Validate()
+
+			if tc.wanted != nil {
+				require.EqualError(t, err, tc.wanted.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestIPNet_Validate(t *testing.T) {
 	testCases := map[string]struct {
 		in     IPNet
@@ -1070,6 +1415,18 @@ func TestTaskConfig_Validate(t *testing.T) {
 			},
 			wantedError: fmt.Errorf("environment file foo must have a .env file extension"),
 		},
+		"error if the secrets manager name of a secret is missing": {
+			TaskConfig: TaskConfig{
+				Secrets: map[string]Secret{
+					"DB_PASSWORD": {
+						FromSecretsManager: SecretsManagerSecret{
+							Key: aws.String("password"),
+						},
+					},
+				},
+			},
+			wantedError: fmt.Errorf(`validate "secrets[DB_PASSWORD]": "secretsmanager" must be specified if "key" is specified`),
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -1462,6 +1819,12 @@ func TestStorage_Validate(t *testing.T) {
 			},
 			wantedError: fmt.Errorf(`validate "ephemeral": ephemeral storage must be between 20 GiB and 200 GiB`),
 		},
+		"error if an environment addon name is empty": {
+			Storage: Storage{
+				EnvAddons: []string{"users", ""},
+			},
+			wantedError: fmt.Errorf(`validate "env_addons[1]": name cannot be empty`),
+		},
 		"error if fail to validate volumes": {
 			Storage: Storage{
 				Volumes: map[string]*Volume{
@@ -1611,6 +1974,58 @@ func TestEFSVolumeConfiguration_Validate(t *testing.T) {
 			},
 			wantedError: fmt.Errorf(`"uid" must not be 0`),
 		},
+		"error if managed file system options are specified with id/root_dir/auth": {
+			EFSVolumeConfiguration: EFSVolumeConfiguration{
+				FileSystemID: aws.String("fs-1234"),
+				Backups:      aws.Bool(false),
+			},
+			wantedError: fmt.Errorf(`must specify one, not both, of "from_workload/backups/lifecycle/throughput_mode/provisioned_throughput" and "id/root_dir/auth"`),
+		},
+		"error if from_workload is empty": {
+			EFSVolumeConfiguration: EFSVolumeConfiguration{
+				FromWorkload: aws.String(""),
+			},
+			wantedError: fmt.Errorf(`"from_workload" must not be empty`),
+		},
+		"error if transition_to_ia is not a supported number of days": {
+			EFSVolumeConfiguration: EFSVolumeConfiguration{
+				Lifecycle: EFSLifecycle{
+					TransitionToIA: aws.Int(10),
+				},
+			},
+			wantedError: fmt.Errorf(`validate "lifecycle": "transition_to_ia" 10 must be one of 1, 7, 14, 30, 60, 90, 180, 270 or 365 days`),
+		},
+		"error if throughput_mode is invalid": {
+			EFSVolumeConfiguration: EFSVolumeConfiguration{
+				ThroughputMode: aws.String("fast"),
+			},
+			wantedError: fmt.Errorf(`"throughput_mode" fast must be one of bursting, elastic or provisioned`),
+		},
+		"error if provisioned throughput mode is missing provisioned_throughput": {
+			EFSVolumeConfiguration: EFSVolumeConfiguration{
+				ThroughputMode: aws.String("provisioned"),
+			},
+			wantedError: fmt.Errorf(`"provisioned_throughput" must be specified when "throughput_mode" is provisioned`),
+		},
+		"error if provisioned_throughput is specified without provisioned throughput mode": {
+			EFSVolumeConfiguration: EFSVolumeConfiguration{
+				ThroughputMode:        aws.String("elastic"),
+				ProvisionedThroughput: aws.Int(128),
+			},
+			wantedError: fmt.Errorf(`"provisioned_throughput" can only be specified when "throughput_mode" is provisioned`),
+		},
+		"valid managed file system options": {
+			EFSVolumeConfiguration: EFSVolumeConfiguration{
+				FromWorkload: aws.String("api"),
+				Backups:      aws.Bool(false),
+				Lifecycle: EFSLifecycle{
+					TransitionToIA:      aws.Int(7),
+					TransitionToPrimary: aws.Bool(true),
+				},
+				ThroughputMode:        aws.String("provisioned"),
+				ProvisionedThroughput: aws.Int(128),
+			},
+		},
 		"error if AuthorizationConfig is not configured correctly": {
 			EFSVolumeConfiguration: EFSVolumeConfiguration{
 				AuthConfig: AuthorizationConfig{
@@ -1967,6 +2382,101 @@ func TestJobTriggerConfig_Validate(t *testing.T) {
 			in:     &JobTriggerConfig{},
 			wanted: errors.New(`"schedule" must be specified`),
 		},
+		"should return an error if more than one trigger is specified": {
+			in: &JobTriggerConfig{
+				Schedule: aws.String("@daily"),
+				S3: &S3JobTrigger{
+					Bucket: aws.String("uploads"),
+				},
+			},
+			wanted: errors.New(`must specify only one of "schedule" and "s3"`),
+		},
+		"should return an error if s3 bucket is empty": {
+			in: &JobTriggerConfig{
+				S3: &S3JobTrigger{
+					Prefix: aws.String("reports/"),
+				},
+			},
+			wanted: errors.New(`validate "s3": "bucket" must be specified`),
+		},
+		"should return an error if topic service is empty": {
+			in: &JobTriggerConfig{
+				Topic: &TopicJobTrigger{
+					Name: aws.String("orders"),
+				},
+			},
+			wanted: errors.New(`validate "topic": "service" must be specified`),
+		},
+		"should return an error if event pattern is empty": {
+			in: &JobTriggerConfig{
+				EventPattern: map[string]interface{}{},
+			},
+			wanted: errors.New(`"event_pattern" cannot be empty`),
+		},
+		"should return an error if after is empty": {
+			in: &JobTriggerConfig{
+				After: aws.String(""),
+			},
+			wanted: errors.New(`"after" cannot be empty`),
+		},
+		"should return an error if both schedule and after are specified": {
+			in: &JobTriggerConfig{
+				Schedule: aws.String("@daily"),
+				After:    aws.String("extract"),
+			},
+			wanted: errors.New(`must specify only one of "schedule" and "after"`),
+		},
+		"success with after": {
+			in: &JobTriggerConfig{
+				After: aws.String("extract"),
+			},
+		},
+		"success with a topic": {
+			in: &JobTriggerConfig{
+				Topic: &TopicJobTrigger{
+					Name:    aws.String("orders"),
+					Service: aws.String("api"),
+				},
+			},
+		},
+		"success with an event pattern": {
+			in: &JobTriggerConfig{
+				EventPattern: map[string]interface{}{
+					"source": []interface{}{"aws.ecr"},
+				},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.in.Validate()
+
+			if tc.wanted != nil {
+				require.EqualError(t, err, tc.wanted.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestJobConcurrencyConfig_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		in     JobConcurrencyConfig
+		wanted error
+	}{
+		"should return an error if concurrency is less than 1": {
+			in: JobConcurrencyConfig{
+				Concurrency: aws.Int(0),
+			},
+			wanted: errors.New(`"concurrency" must be greater than or equal to 1`),
+		},
+		"success if concurrency is not specified": {},
+		"success": {
+			in: JobConcurrencyConfig{
+				Concurrency: aws.Int(1),
+			},
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -2129,6 +2639,325 @@ func TestOverrideRule_Validate(t *testing.T) {
 	}
 }
 
+func TestDeployConfig_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		in     DeployConfig
+		wanted error
+	}{
+		"should return an error if a pre hook has no command": {
+			in: DeployConfig{
+				PreHooks: []DeployHook{
+					{
+						Command: CommandOverride{
+							StringSlice: []string{"./migrate", "up"},
+						},
+					},
+					{},
+				},
+			},
+			wanted: errors.New(`validate "pre_hooks[1]": "command" must be specified`),
+		},
+		"should return an error if a pre hook has an empty command": {
+			in: DeployConfig{
+				PreHooks: []DeployHook{
+					{
+						Command: CommandOverride{
+							String: aws.String(""),
+						},
+					},
+				},
+			},
+			wanted: errors.New(`validate "pre_hooks[0]": "command" must be specified`),
+		},
+		"success": {
+			in: DeployConfig{
+				PreHooks: []DeployHook{
+					{
+						Command: CommandOverride{
+							String: aws.String("./migrate up"),
+						},
+					},
+				},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.in.Validate()
+
+			if tc.wanted != nil {
+				require.EqualError(t, err, tc.wanted.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestPipelineManifest_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		in     PipelineManifest
+		wanted error
+	}{
+		"should return an error if build compute type is invalid": {
+			in: PipelineManifest{
+				Build: &Build{
+					ComputeType: "BUILD_GENERAL1_TINY",
+				},
+			},
+			wanted: errors.New(`validate "build": "compute_type" BUILD_GENERAL1_TINY must be one of BUILD_GENERAL1_SMALL, BUILD_GENERAL1_MEDIUM, BUILD_GENERAL1_LARGE or BUILD_GENERAL1_2XLARGE`),
+		},
+		"should return an error if both docker layer and s3 caches are enabled": {
+			in: PipelineManifest{
+				Build: &Build{
+					Cache: &BuildCache{
+						DockerLayers: aws.Bool(true),
+						S3: &BuildS3Cache{
+							Location: "my-bucket/cache",
+						},
+					},
+				},
+			},
+			wanted: errors.New(`validate "build": validate "cache": must specify one, not both, of "docker_layers" and "s3"`),
+		},
+		"should return an error if s3 cache location is missing": {
+			in: PipelineManifest{
+				Build: &Build{
+					Cache: &BuildCache{
+						S3: &BuildS3Cache{
+							Paths: []string{"/root/.m2/**/*"},
+						},
+					},
+				},
+			},
+			wanted: errors.New(`validate "build": validate "cache": "location" must be specified if "s3" is specified`),
+		},
+		"should return an error if a deployment depends on a deployment that does not exist": {
+			in: PipelineManifest{
+				Stages: []PipelineStage{
+					{
+						Name: "test",
+						Deployments: Deployments{
+							"api": &Deployment{
+								DependsOn: []string{"migration"},
+							},
+						},
+					},
+				},
+			},
+			wanted: errors.New(`validate stage "test": validate "deployments": dependency deployment migration of api does not exist`),
+		},
+		"should return an error if a deployment depends on itself": {
+			in: PipelineManifest{
+				Stages: []PipelineStage{
+					{
+						Name: "test",
+						Deployments: Deployments{
+							"api": &Deployment{
+								DependsOn: []string{"api"},
+							},
+						},
+					},
+				},
+			},
+			wanted: errors.New(`validate stage "test": validate "deployments": deployment api cannot depend on itself`),
+		},
+		"should return an error if deployments have a circular dependency": {
+			in: PipelineManifest{
+				Stages: []PipelineStage{
+					{
+						Name: "test",
+						Deployments: Deployments{
+							"migration": &Deployment{
+								DependsOn: []string{"frontend"},
+							},
+							"api": &Deployment{
+								DependsOn: []string{"migration"},
+							},
+							"frontend": &Deployment{
+								DependsOn: []string{"api"},
+							},
+						},
+					},
+				},
+			},
+			wanted: errors.New(`validate stage "test": validate "deployments": circular deployment dependency chain includes the following deployments: [api frontend migration]`),
+		},
+		"should return an error if a post deployment name is invalid": {
+			in: PipelineManifest{
+				Stages: []PipelineStage{
+					{
+						Name: "test",
+						PostDeployments: PostDeployments{
+							"smoke test": &PostDeployment{
+								Commands: []string{"make smoke"},
+							},
+						},
+					},
+				},
+			},
+			wanted: errors.New(`validate stage "test": post deployment name smoke test must start with a letter, contain only lower-case letters, numbers, and hyphens, and have no consecutive or trailing hyphen`),
+		},
+		"should return an error if a post deployment name does not start with a lower-case letter": {
+			in: PipelineManifest{
+				Stages: []PipelineStage{
+					{
+						Name: "test",
+						PostDeployments: PostDeployments{
+							"Smoke-Test": &PostDeployment{
+								Commands: []string{"make smoke"},
+							},
+						},
+					},
+				},
+			},
+			wanted: errors.New(`validate stage "test": post deployment name Smoke-Test must start with a letter, contain only lower-case letters, numbers, and hyphens, and have no consecutive or trailing hyphen`),
+		},
+		"should return an error if a post deployment has neither commands nor image": {
+			in: PipelineManifest{
+				Stages: []PipelineStage{
+					{
+						Name: "test",
+						PostDeployments: PostDeployments{
+							"smoke": nil,
+						},
+					},
+				},
+			},
+			wanted: errors.New(`validate stage "test": validate "post_deployments[smoke]": must specify one of "commands" and "image"`),
+		},
+		"should return an error if a post deployment has both commands and image": {
+			in: PipelineManifest{
+				Stages: []PipelineStage{
+					{
+						Name: "test",
+						PostDeployments: PostDeployments{
+							"smoke": &PostDeployment{
+								Commands: []string{"make smoke"},
+								Image:    "public.ecr.aws/badgoose/smoke:latest",
+							},
+						},
+					},
+				},
+			},
+			wanted: errors.New(`validate stage "test": validate "post_deployments[smoke]": must specify one, not both, of "commands" and "image"`),
+		},
+		"should return an error if a post deployment sets a command without an image": {
+			in: PipelineManifest{
+				Stages: []PipelineStage{
+					{
+						Name: "test",
+						PostDeployments: PostDeployments{
+							"smoke": &PostDeployment{
+								Commands: []string{"make smoke"},
+								Command:  "./smoke.sh",
+							},
+						},
+					},
+				},
+			},
+			wanted: errors.New(`validate stage "test": validate "post_deployments[smoke]": "image" must be specified if "command" is specified`),
+		},
+		"should return an error if approval notifications are specified without requiring approval": {
+			in: PipelineManifest{
+				Stages: []PipelineStage{
+					{
+						Name: "prod",
+						ApprovalNotifications: &ApprovalNotifications{
+							Emails: []string{"release@example.com"},
+						},
+					},
+				},
+			},
+			wanted: errors.New(`validate stage "prod": "requires_approval" must be true if "approval_notifications" is specified`),
+		},
+		"should return an error if approval notifications are empty": {
+			in: PipelineManifest{
+				Stages: []PipelineStage{
+					{
+						Name:                  "prod",
+						RequiresApproval:      true,
+						ApprovalNotifications: &ApprovalNotifications{},
+					},
+				},
+			},
+			wanted: errors.New(`validate stage "prod": validate "approval_notifications": must specify at least one of "topic_arn", "emails" or "webhook" if "approval_notifications" is specified`),
+		},
+		"should return an error if the approval topic is not an ARN": {
+			in: PipelineManifest{
+				Stages: []PipelineStage{
+					{
+						Name:             "prod",
+						RequiresApproval: true,
+						ApprovalNotifications: &ApprovalNotifications{
+							TopicARN: "my-topic",
+						},
+					},
+				},
+			},
+			wanted: errors.New(`validate stage "prod": validate "approval_notifications": parse "topic_arn" my-topic: arn: invalid prefix`),
+		},
+		"should return an error if the approval webhook is not an HTTPS URL": {
+			in: PipelineManifest{
+				Stages: []PipelineStage{
+					{
+						Name:             "prod",
+						RequiresApproval: true,
+						ApprovalNotifications: &ApprovalNotifications{
+							Webhook: "http://hooks.slack.com/services/T000/B000/XXXX",
+						},
+					},
+				},
+			},
+			wanted: errors.New(`validate stage "prod": validate "approval_notifications": "webhook" http://hooks.slack.com/services/T000/B000/XXXX must be an HTTPS URL`),
+		},
+		"should return nil if approval notifications are valid": {
+			in: PipelineManifest{
+				Stages: []PipelineStage{
+					{
+						Name:             "prod",
+						RequiresApproval: true,
+						ApprovalNotifications: &ApprovalNotifications{
+							TopicARN: "arn:aws:sns:us-west-2:123456789012:releases",
+							Emails:   []string{"release@example.com"},
+							Webhook:  "https://hooks.slack.com/services/T000/B000/XXXX",
+						},
+					},
+				},
+			},
+		},
+		"should return nil if deployments are ordered": {
+			in: PipelineManifest{
+				Stages: []PipelineStage{
+					{
+						Name: "test",
+						Deployments: Deployments{
+							"migration": nil,
+							"api": &Deployment{
+								DependsOn: []string{"migration"},
+							},
+							"frontend": &Deployment{
+								DependsOn: []string{"api"},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.in.Validate()
+
+			if tc.wanted != nil {
+				require.EqualError(t, err, tc.wanted.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestValidateLoadBalancerTarget(t *testing.T) {
 	testCases := map[string]struct {
 		in     validateTargetContainerOpts
@@ -47,6 +47,7 @@ type WorkerServiceConfig struct {
 	PublishConfig    PublishConfig             `yaml:"publish"`
 	Network          NetworkConfig             `yaml:"network"`
 	TaskDefOverrides []OverrideRule            `yaml:"taskdef_overrides"`
+	DeployConfig     DeployConfig              `yaml:"deploy"`
 }
 
 // SubscribeConfig represents the configurable options for setting up subscriptions.
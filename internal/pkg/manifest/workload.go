@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"path/filepath"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -96,6 +97,7 @@ var (
 	errUnmarshalEntryPoint = errors.New(`unable to unmarshal "entrypoint" into string or slice of strings`)
 	errUnmarshalAlias      = errors.New(`unable to unmarshal "alias" into string or slice of strings`)
 	errUnmarshalCommand    = errors.New(`unable to unmarshal "command" into string or slice of strings`)
+	errUnmarshalSecret     = errors.New(`unable to unmarshal "secrets" entry into string or Secrets Manager configuration`)
 )
 
 // WorkloadManifest represents a workload manifest.
@@ -493,7 +495,7 @@ type SidecarConfig struct {
 	Essential     *bool                `yaml:"essential"`
 	CredsParam    *string              `yaml:"credentialsParameter"`
 	Variables     map[string]string    `yaml:"variables"`
-	Secrets       map[string]string    `yaml:"secrets"`
+	Secrets       map[string]Secret    `yaml:"secrets"`
 	MountPoints   []SidecarMountPoint  `yaml:"mount_points"`
 	DockerLabels  map[string]string    `yaml:"labels"`
 	DependsOn     DependsOn            `yaml:"depends_on"`
@@ -510,10 +512,65 @@ type TaskConfig struct {
 	ExecuteCommand ExecuteCommand       `yaml:"exec"`
 	Variables      map[string]string    `yaml:"variables"`
 	EnvFile        *string              `yaml:"env_file"`
-	Secrets        map[string]string    `yaml:"secrets"`
+	Secrets        map[string]Secret    `yaml:"secrets"`
 	Storage        Storage              `yaml:"storage"`
 }
 
+// Secret represents an identifier for sensitive data injected into a container.
+// It is either the name or ARN of an SSM parameter or Secrets Manager secret, or the name of a
+// Secrets Manager secret along with the JSON key to select from its value.
+type Secret struct {
+	From               *string
+	FromSecretsManager SecretsManagerSecret
+}
+
+// SecretsManagerSecret represents a secret in Secrets Manager, and optionally a JSON key in its value.
+type SecretsManagerSecret struct {
+	Name *string `yaml:"secretsmanager"`
+	Key  *string `yaml:"key"`
+}
+
+// UnmarshalYAML overrides the default YAML unmarshaling logic for the Secret
+// struct, allowing it to perform more complex unmarshaling behavior.
+// This method implements the yaml.Unmarshaler (v3) interface.
+func (s *Secret) UnmarshalYAML(value *yaml.Node) error {
+	if err := value.Decode(&s.FromSecretsManager); err != nil {
+		switch err.(type) {
+		case *yaml.TypeError:
+			break
+		default:
+			return err
+		}
+	}
+	if !s.FromSecretsManager.IsEmpty() {
+		// Unmarshaled successfully to s.FromSecretsManager, unset s.From, and return.
+		s.From = nil
+		return nil
+	}
+	if err := value.Decode(&s.From); err != nil {
+		return errUnmarshalSecret
+	}
+	return nil
+}
+
+// IsSecretsManagerName returns true if the secret is referenced by its name in Secrets Manager.
+func (s *Secret) IsSecretsManagerName() bool {
+	return !s.FromSecretsManager.IsEmpty()
+}
+
+// Value returns the SSM parameter name or ARN of the secret, or the name of the secret in Secrets Manager.
+func (s *Secret) Value() string {
+	if s.IsSecretsManagerName() {
+		return aws.StringValue(s.FromSecretsManager.Name)
+	}
+	return aws.StringValue(s.From)
+}
+
+// IsEmpty returns true if the secret isn't configured.
+func (s *SecretsManagerSecret) IsEmpty() bool {
+	return s.Name == nil && s.Key == nil
+}
+
 // ContainerPlatform returns the platform for the service.
 func (t *TaskConfig) ContainerPlatform() string {
 	if t.Platform.IsEmpty() {
@@ -535,6 +592,37 @@ func (t TaskConfig) IsARM() bool {
 	return IsArmArch(t.Platform.Arch())
 }
 
+// SharedEFSWorkloads returns the sorted names of the workloads whose managed EFS directories are mounted with "from_workload".
+func (t TaskConfig) SharedEFSWorkloads() []string {
+	var names []string
+	for _, volume := range t.Storage.Volumes {
+		if volume == nil || !volume.EFS.UseManagedFS() || volume.EFS.Advanced.FromWorkload == nil {
+			continue
+		}
+		names = append(names, aws.StringValue(volume.EFS.Advanced.FromWorkload))
+	}
+	sort.Strings(names)
+	return names
+}
+
+// EnvAddons returns the names of the environment storage addons that the workload accesses.
+func (t TaskConfig) EnvAddons() []string {
+	return t.Storage.EnvAddons
+}
+
+// SetsManagedEFSOptions returns true if a managed EFS volume configures the file system shared by the environment's workloads.
+func (t TaskConfig) SetsManagedEFSOptions() bool {
+	for _, volume := range t.Storage.Volumes {
+		if volume == nil || volume.EmptyVolume() || !volume.EFS.UseManagedFS() {
+			continue
+		}
+		if !volume.EFS.Advanced.EmptyFileSystemConfig() {
+			return true
+		}
+	}
+	return false
+}
+
 // PublishConfig represents the configurable options for setting up publishers.
 type PublishConfig struct {
 	Topics []Topic `yaml:"topics"`
@@ -354,6 +354,54 @@ func TestPlatformArgsOrString_UnmarshalYAML(t *testing.T) {
 	}
 }
 
+func TestSecret_UnmarshalYAML(t *testing.T) {
+	testCases := map[string]struct {
+		inContent []byte
+
+		wantedSecrets map[string]Secret
+		wantedError   error
+	}{
+		"unmarshals ssm parameters and secrets manager secrets": {
+			inContent: []byte(`secrets:
+  GITHUB_TOKEN: GITHUB_TOKEN
+  DB_PASSWORD:
+    secretsmanager: /copilot/myapp/test/secrets/db
+    key: password
+`),
+			wantedSecrets: map[string]Secret{
+				"GITHUB_TOKEN": {
+					From: aws.String("GITHUB_TOKEN"),
+				},
+				"DB_PASSWORD": {
+					FromSecretsManager: SecretsManagerSecret{
+						Name: aws.String("/copilot/myapp/test/secrets/db"),
+						Key:  aws.String("password"),
+					},
+				},
+			},
+		},
+		"error if unmarshalable": {
+			inContent: []byte(`secrets:
+  DB_PASSWORD:
+    - /copilot/myapp/test/secrets/db
+`),
+			wantedError: errUnmarshalSecret,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			p := TaskConfig{}
+			err := yaml.Unmarshal(tc.inContent, &p)
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedSecrets, p.Secrets)
+		})
+	}
+}
+
 func TestPlatformArgsOrString_OS(t *testing.T) {
 	linux := PlatformString("linux/amd64")
 	testCases := map[string]struct {
@@ -921,3 +969,119 @@ topics: abc
 		})
 	}
 }
+
+func TestTaskConfig_SharedEFSWorkloads(t *testing.T) {
+	testCases := map[string]struct {
+		in     TaskConfig
+		wanted []string
+	}{
+		"no volumes": {},
+		"ignores volumes that don't mount the directory of another workload": {
+			in: TaskConfig{
+				Storage: Storage{
+					Volumes: map[string]*Volume{
+						"scratch": {},
+						"data": {
+							EFS: EFSConfigOrBool{
+								Enabled: aws.Bool(true),
+							},
+						},
+						"byo": {
+							EFS: EFSConfigOrBool{
+								Advanced: EFSVolumeConfiguration{
+									FileSystemID: aws.String("fs-1234"),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"returns the sorted names of the workloads": {
+			in: TaskConfig{
+				Storage: Storage{
+					Volumes: map[string]*Volume{
+						"uploads": {
+							EFS: EFSConfigOrBool{
+								Advanced: EFSVolumeConfiguration{
+									FromWorkload: aws.String("api"),
+								},
+							},
+						},
+						"archive": {
+							EFS: EFSConfigOrBool{
+								Advanced: EFSVolumeConfiguration{
+									FromWorkload: aws.String("etl"),
+								},
+							},
+						},
+						"assets": {
+							EFS: EFSConfigOrBool{
+								Advanced: EFSVolumeConfiguration{
+									FromWorkload: aws.String("admin"),
+								},
+							},
+						},
+					},
+				},
+			},
+			wanted: []string{"admin", "api", "etl"},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// WHEN
+			got := tc.in.SharedEFSWorkloads()
+
+			// THEN
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
+func TestTaskConfig_SetsManagedEFSOptions(t *testing.T) {
+	testCases := map[string]struct {
+		in     TaskConfig
+		wanted bool
+	}{
+		"no volumes": {},
+		"managed volume without file system settings": {
+			in: TaskConfig{
+				Storage: Storage{
+					Volumes: map[string]*Volume{
+						"data": {
+							EFS: EFSConfigOrBool{
+								Enabled: aws.Bool(true),
+							},
+						},
+					},
+				},
+			},
+		},
+		"managed volume with file system settings": {
+			in: TaskConfig{
+				Storage: Storage{
+					Volumes: map[string]*Volume{
+						"data": {
+							EFS: EFSConfigOrBool{
+								Advanced: EFSVolumeConfiguration{
+									Backups: aws.Bool(true),
+								},
+							},
+						},
+					},
+				},
+			},
+			wanted: true,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// WHEN
+			got := tc.in.SetsManagedEFSOptions()
+
+			// THEN
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
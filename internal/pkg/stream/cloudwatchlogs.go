@@ -0,0 +1,203 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package stream
+
+import (
+	"fmt"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	awsecs "github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatchlogs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+)
+
+const (
+	// ECS task statuses before the containers of a task start running.
+	ecsTaskProvisioningStatus = "PROVISIONING"
+	ecsTaskPendingStatus      = "PENDING"
+
+	// Format of the log stream names created by the awslogs driver for Copilot containers.
+	// For example: copilot/frontend/4082490ee6c245e09d2145010aa1ba8d.
+	fmtTaskLogStreamName = "copilot/%s/%s"
+)
+
+// ECSTaskLister is the interface to list ECS tasks started by a particular entity.
+type ECSTaskLister interface {
+	TasksStartedBy(cluster, family, startedBy string) ([]*ecs.Task, error)
+}
+
+// ECSTaskLogs is a batch of new log lines emitted by one-off ECS tasks.
+type ECSTaskLogs struct {
+	Messages []string
+}
+
+// ECSTaskLogStreamerConfig holds the configuration to find one-off ECS tasks and their logs.
+type ECSTaskLogStreamerConfig struct {
+	Cluster   string // Name of the cluster where the tasks run.
+	Family    string // Family of the task definition used by the tasks.
+	StartedBy string // Value of "startedBy" set when the tasks were run.
+	Container string // Name of the container to read logs from.
+	LogGroup  string // Name of the log group where the container sends its logs.
+}
+
+// ECSTaskLogStreamer is a Streamer for the logs of one-off ECS tasks until all the tasks are stopped.
+type ECSTaskLogStreamer struct {
+	tasks     ECSTaskLister
+	logs      LogEventsGetter
+	conf      ECSTaskLogStreamerConfig
+	clock     clock
+	rand      func(n int) int
+	startTime time.Time
+
+	subscribers         []chan ECSTaskLogs
+	once                sync.Once
+	done                chan struct{}
+	isDone              bool
+	streamLastEventTime map[string]int64
+	eventsToFlush       []ECSTaskLogs
+	mu                  sync.Mutex
+
+	retries int
+}
+
+// NewECSTaskLogStreamer creates a new ECSTaskLogStreamer that streams the logs of tasks
+// created after startTime until all of them are stopped.
+func NewECSTaskLogStreamer(tasks ECSTaskLister, logs LogEventsGetter, conf ECSTaskLogStreamerConfig, startTime time.Time) *ECSTaskLogStreamer {
+	return &ECSTaskLogStreamer{
+		tasks:     tasks,
+		logs:      logs,
+		conf:      conf,
+		clock:     realClock{},
+		rand:      rand.Intn,
+		startTime: startTime,
+		done:      make(chan struct{}),
+	}
+}
+
+// Subscribe returns a read-only channel that will receive log lines from the ECSTaskLogStreamer.
+func (s *ECSTaskLogStreamer) Subscribe() <-chan ECSTaskLogs {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	c := make(chan ECSTaskLogs)
+	s.subscribers = append(s.subscribers, c)
+	if s.isDone {
+		// If the streamer is already done streaming, any new subscription requests should just return a closed channel.
+		close(c)
+	}
+	return c
+}
+
+// Fetch retrieves and stores new log lines from the tasks created since the start time.
+// If an error occurs while listing the tasks, returns a wrapped err.
+// Logs are best-effort: failures to retrieve them are retried until all the tasks are stopped.
+// Otherwise, returns the time the next Fetch should be attempted.
+func (s *ECSTaskLogStreamer) Fetch() (next time.Time, err error) {
+	tasks, err := s.tasks.TasksStartedBy(s.conf.Cluster, s.conf.Family, s.conf.StartedBy)
+	if err != nil {
+		if request.IsErrorThrottle(err) {
+			s.retries += 1
+			return nextFetchDate(s.clock, s.rand, s.retries), nil
+		}
+		return next, fmt.Errorf("list tasks started by %s: %w", s.conf.StartedBy, err)
+	}
+	var logStreams []string
+	stopped := true
+	for _, task := range tasks {
+		if aws.TimeValue(task.CreatedAt).Before(s.startTime) {
+			continue
+		}
+		status := aws.StringValue(task.LastStatus)
+		if status != awsecs.DesiredStatusStopped {
+			stopped = false
+		}
+		if status == ecsTaskProvisioningStatus || status == ecsTaskPendingStatus {
+			// The containers haven't started yet, so there are no log streams to read from.
+			continue
+		}
+		taskID, err := ecs.TaskID(aws.StringValue(task.TaskArn))
+		if err != nil {
+			return next, err
+		}
+		logStreams = append(logStreams, fmt.Sprintf(fmtTaskLogStreamName, s.conf.Container, taskID))
+	}
+	if len(logStreams) == 0 {
+		// No task has started running yet.
+		s.retries = 0
+		return nextFetchDate(s.clock, s.rand, 0), nil
+	}
+	out, err := s.logs.LogEvents(cloudwatchlogs.LogEventsOpts{
+		LogGroup:            s.conf.LogGroup,
+		LogStreams:          logStreams,
+		StreamLastEventTime: s.streamLastEventTime,
+	})
+	if err != nil {
+		s.retries += 1
+		if stopped {
+			s.markDone()
+		}
+		return nextFetchDate(s.clock, s.rand, s.retries), nil
+	}
+	s.retries = 0
+	s.streamLastEventTime = out.StreamLastEventTime
+	if len(out.Events) != 0 {
+		var msgs []string
+		for _, event := range out.Events {
+			msgs = append(msgs, event.Message)
+		}
+		s.eventsToFlush = append(s.eventsToFlush, ECSTaskLogs{
+			Messages: msgs,
+		})
+	}
+	if stopped {
+		// All the tasks exited and their last log lines were retrieved.
+		s.markDone()
+	}
+	return nextFetchDate(s.clock, s.rand, 0), nil
+}
+
+// Notify flushes all new log lines to the streamer's subscribers.
+func (s *ECSTaskLogStreamer) Notify() {
+	// Copy current list of subscribers over, so that we can we add more subscribers while
+	// notifying previous subscribers of older events.
+	s.mu.Lock()
+	var subs []chan ECSTaskLogs
+	subs = append(subs, s.subscribers...)
+	s.mu.Unlock()
+
+	for _, event := range s.eventsToFlush {
+		for _, sub := range subs {
+			sub <- event
+		}
+	}
+	s.eventsToFlush = nil // reset after flushing all events.
+}
+
+// Close closes all subscribed channels notifying them that no more events will be sent.
+func (s *ECSTaskLogStreamer) Close() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, sub := range s.subscribers {
+		close(sub)
+	}
+	s.isDone = true
+}
+
+// Done returns a channel that's closed when there are no more events that can be fetched.
+func (s *ECSTaskLogStreamer) Done() <-chan struct{} {
+	return s.done
+}
+
+func (s *ECSTaskLogStreamer) markDone() {
+	// In stream.Stream, it's possible that both the <-Done() event is available as well as another Fetch()
+	// call. In order to guarantee that we don't try to close the same stream multiple times, we wrap it with a
+	// sync.Once.
+	s.once.Do(func() {
+		close(s.done)
+	})
+}
@@ -0,0 +1,260 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package stream
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatchlogs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/stretchr/testify/require"
+)
+
+type mockECSTaskLister struct {
+	out []*ecs.Task
+	err error
+}
+
+func (m mockECSTaskLister) TasksStartedBy(cluster, family, startedBy string) ([]*ecs.Task, error) {
+	return m.out, m.err
+}
+
+func TestECSTaskLogStreamer_Subscribe(t *testing.T) {
+	t.Run("allow new subscriptions if the streamer is still active", func(t *testing.T) {
+		// GIVEN
+		streamer := &ECSTaskLogStreamer{}
+
+		// WHEN
+		_ = streamer.Subscribe()
+		_ = streamer.Subscribe()
+
+		// THEN
+		require.Equal(t, 2, len(streamer.subscribers), "expected number of subscribers to match")
+	})
+	t.Run("new subscriptions on a finished streamer should return closed channels", func(t *testing.T) {
+		// GIVEN
+		streamer := &ECSTaskLogStreamer{isDone: true}
+
+		// WHEN
+		ch := streamer.Subscribe()
+		_, ok := <-ch
+
+		// THEN
+		require.False(t, ok, "channel should be closed")
+	})
+}
+
+func TestECSTaskLogStreamer_Fetch(t *testing.T) {
+	startDate := time.Date(2020, time.November, 23, 18, 0, 0, 0, time.UTC)
+	conf := ECSTaskLogStreamerConfig{
+		Cluster:   "my-cluster",
+		Family:    "phonetool-test-api",
+		StartedBy: "copilot-pre-hook",
+		Container: "api",
+		LogGroup:  "/copilot/phonetool-test-api",
+	}
+	newStreamer := func(tasks ECSTaskLister, logs LogEventsGetter) *ECSTaskLogStreamer {
+		return &ECSTaskLogStreamer{
+			tasks:     tasks,
+			logs:      logs,
+			conf:      conf,
+			clock:     fakeClock{startDate},
+			rand:      func(n int) int { return n },
+			startTime: startDate,
+			done:      make(chan struct{}),
+		}
+	}
+
+	t.Run("returns a wrapped error on list tasks call failure", func(t *testing.T) {
+		// GIVEN
+		streamer := newStreamer(mockECSTaskLister{err: errors.New("some error")}, &mockLogEventsGetter{})
+
+		// WHEN
+		_, err := streamer.Fetch()
+
+		// THEN
+		require.EqualError(t, err, "list tasks started by copilot-pre-hook: some error")
+	})
+	t.Run("retries with a backoff if the list tasks call is throttled", func(t *testing.T) {
+		// GIVEN
+		streamer := newStreamer(mockECSTaskLister{err: awserr.New("RequestThrottled", "throttled", nil)}, &mockLogEventsGetter{})
+
+		// WHEN
+		next, err := streamer.Fetch()
+
+		// THEN
+		require.NoError(t, err)
+		require.Equal(t, startDate.Add(8*time.Second), next)
+		require.Equal(t, 1, streamer.retries)
+	})
+	t.Run("does not retrieve logs until a task created after the start time is running", func(t *testing.T) {
+		// GIVEN
+		logs := &mockLogEventsGetter{}
+		streamer := newStreamer(mockECSTaskLister{
+			out: []*ecs.Task{
+				{
+					TaskArn:    aws.String("arn:aws:ecs:us-west-2:123456789012:task/my-cluster/old"),
+					LastStatus: aws.String("STOPPED"),
+					CreatedAt:  aws.Time(startDate.Add(-1 * time.Hour)),
+				},
+				{
+					TaskArn:    aws.String("arn:aws:ecs:us-west-2:123456789012:task/my-cluster/new"),
+					LastStatus: aws.String("PROVISIONING"),
+					CreatedAt:  aws.Time(startDate.Add(time.Second)),
+				},
+			},
+		}, logs)
+
+		// WHEN
+		_, err := streamer.Fetch()
+
+		// THEN
+		require.NoError(t, err)
+		require.Nil(t, logs.in, "logs should not be retrieved")
+		require.Nil(t, streamer.eventsToFlush)
+		select {
+		case <-streamer.Done():
+			require.Fail(t, "streamer should not be done")
+		default:
+		}
+	})
+	t.Run("stores new log lines of running tasks", func(t *testing.T) {
+		// GIVEN
+		logs := &mockLogEventsGetter{
+			out: &cloudwatchlogs.LogEventsOutput{
+				Events: []*cloudwatchlogs.Event{
+					{
+						Message: "applying migration 1",
+					},
+					{
+						Message: "applying migration 2",
+					},
+				},
+				StreamLastEventTime: map[string]int64{
+					"copilot/api/1234": 100,
+				},
+			},
+		}
+		streamer := newStreamer(mockECSTaskLister{
+			out: []*ecs.Task{
+				{
+					TaskArn:    aws.String("arn:aws:ecs:us-west-2:123456789012:task/my-cluster/1234"),
+					LastStatus: aws.String("RUNNING"),
+					CreatedAt:  aws.Time(startDate.Add(time.Second)),
+				},
+			},
+		}, logs)
+
+		// WHEN
+		_, err := streamer.Fetch()
+
+		// THEN
+		require.NoError(t, err)
+		require.Equal(t, cloudwatchlogs.LogEventsOpts{
+			LogGroup:   "/copilot/phonetool-test-api",
+			LogStreams: []string{"copilot/api/1234"},
+		}, *logs.in)
+		require.Equal(t, []ECSTaskLogs{
+			{
+				Messages: []string{"applying migration 1", "applying migration 2"},
+			},
+		}, streamer.eventsToFlush)
+		require.Equal(t, map[string]int64{"copilot/api/1234": 100}, streamer.streamLastEventTime)
+		select {
+		case <-streamer.Done():
+			require.Fail(t, "streamer should not be done")
+		default:
+		}
+	})
+	t.Run("is done once all the tasks are stopped", func(t *testing.T) {
+		// GIVEN
+		logs := &mockLogEventsGetter{
+			out: &cloudwatchlogs.LogEventsOutput{
+				Events: []*cloudwatchlogs.Event{
+					{
+						Message: "done",
+					},
+				},
+			},
+		}
+		streamer := newStreamer(mockECSTaskLister{
+			out: []*ecs.Task{
+				{
+					TaskArn:    aws.String("arn:aws:ecs:us-west-2:123456789012:task/my-cluster/1234"),
+					LastStatus: aws.String("STOPPED"),
+					CreatedAt:  aws.Time(startDate.Add(time.Second)),
+				},
+			},
+		}, logs)
+		streamer.streamLastEventTime = map[string]int64{"copilot/api/1234": 100}
+
+		// WHEN
+		_, err := streamer.Fetch()
+
+		// THEN
+		require.NoError(t, err)
+		require.Equal(t, map[string]int64{"copilot/api/1234": 100}, logs.in.StreamLastEventTime)
+		require.Equal(t, []ECSTaskLogs{
+			{
+				Messages: []string{"done"},
+			},
+		}, streamer.eventsToFlush)
+		<-streamer.Done()
+	})
+	t.Run("ignores log retrieval errors", func(t *testing.T) {
+		// GIVEN
+		streamer := newStreamer(mockECSTaskLister{
+			out: []*ecs.Task{
+				{
+					TaskArn:    aws.String("arn:aws:ecs:us-west-2:123456789012:task/my-cluster/1234"),
+					LastStatus: aws.String("STOPPED"),
+					CreatedAt:  aws.Time(startDate.Add(time.Second)),
+				},
+			},
+		}, &mockLogEventsGetter{
+			err: errors.New("no log stream found in log group /copilot/phonetool-test-api"),
+		})
+
+		// WHEN
+		_, err := streamer.Fetch()
+
+		// THEN
+		require.NoError(t, err)
+		require.Nil(t, streamer.eventsToFlush)
+		<-streamer.Done()
+	})
+}
+
+func TestECSTaskLogStreamer_Notify(t *testing.T) {
+	// GIVEN
+	wantedEvents := []ECSTaskLogs{
+		{
+			Messages: []string{"hello"},
+		},
+		{
+			Messages: []string{"world"},
+		},
+	}
+	sub := make(chan ECSTaskLogs, 2)
+	streamer := &ECSTaskLogStreamer{
+		subscribers:   []chan ECSTaskLogs{sub},
+		eventsToFlush: wantedEvents,
+	}
+
+	// WHEN
+	streamer.Notify()
+	close(sub) // Close the channel to stop expecting to receive new events.
+
+	// THEN
+	var actualEvents []ECSTaskLogs
+	for event := range sub {
+		actualEvents = append(actualEvents, event)
+	}
+	require.ElementsMatch(t, wantedEvents, actualEvents)
+	require.Nil(t, streamer.eventsToFlush, "expected events to be reset after notifying")
+}
@@ -0,0 +1,134 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package stream
+
+import (
+	"fmt"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/copilot-cli/internal/pkg/aws/codedeploy"
+)
+
+// CodeDeployDeploymentDescriber is the interface to describe a CodeDeploy deployment of an ECS service.
+type CodeDeployDeploymentDescriber interface {
+	ECSDeployment(deploymentID string) (*codedeploy.ECSDeployment, error)
+}
+
+// BlueGreenDeployment is a description of a CodeDeploy blue/green deployment of an ECS service.
+type BlueGreenDeployment struct {
+	Status       string
+	ErrorMessage string
+	TaskSets     []codedeploy.ECSTaskSet
+}
+
+// BlueGreenDeploymentStreamer is a Streamer for BlueGreenDeployment descriptions until the traffic is shifted or the deployment fails.
+type BlueGreenDeploymentStreamer struct {
+	client       CodeDeployDeploymentDescriber
+	clock        clock
+	rand         func(n int) int
+	deploymentID string
+
+	subscribers   []chan BlueGreenDeployment
+	once          sync.Once
+	done          chan struct{}
+	isDone        bool
+	eventsToFlush []BlueGreenDeployment
+	mu            sync.Mutex
+
+	retries int
+}
+
+// NewBlueGreenDeploymentStreamer creates a new BlueGreenDeploymentStreamer that streams descriptions
+// of a CodeDeploy deployment until all the traffic is shifted, or the deployment fails or is stopped.
+func NewBlueGreenDeploymentStreamer(client CodeDeployDeploymentDescriber, deploymentID string) *BlueGreenDeploymentStreamer {
+	return &BlueGreenDeploymentStreamer{
+		client:       client,
+		clock:        realClock{},
+		rand:         rand.Intn,
+		deploymentID: deploymentID,
+		done:         make(chan struct{}),
+	}
+}
+
+// Subscribe returns a read-only channel that will receive deployment descriptions from the BlueGreenDeploymentStreamer.
+func (s *BlueGreenDeploymentStreamer) Subscribe() <-chan BlueGreenDeployment {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	c := make(chan BlueGreenDeployment)
+	s.subscribers = append(s.subscribers, c)
+	if s.isDone {
+		// If the streamer is already done streaming, any new subscription requests should just return a closed channel.
+		close(c)
+	}
+	return c
+}
+
+// Fetch retrieves and stores the latest description of the deployment.
+// If an error occurs while describing the deployment, returns a wrapped err.
+// A failed or stopped deployment is not an error: its description, along with its error message, is flushed to
+// the subscribers and the streamer is done, so that the failure is reported by the stack that triggered the deployment.
+// Otherwise, returns the time the next Fetch should be attempted.
+func (s *BlueGreenDeploymentStreamer) Fetch() (next time.Time, err error) {
+	out, err := s.client.ECSDeployment(s.deploymentID)
+	if err != nil {
+		if request.IsErrorThrottle(err) {
+			s.retries += 1
+			return nextFetchDate(s.clock, s.rand, s.retries), nil
+		}
+		return next, fmt.Errorf("fetch deployment description: %w", err)
+	}
+	s.retries = 0
+	s.eventsToFlush = append(s.eventsToFlush, BlueGreenDeployment{
+		Status:       out.Status,
+		ErrorMessage: out.ErrorMessage,
+		TaskSets:     out.TaskSets,
+	})
+	if !out.IsDone() && !out.IsTrafficShifted() {
+		// Once the traffic is shifted, the deployment may wait for a long time before terminating the original tasks.
+		return nextFetchDate(s.clock, s.rand, 0), nil
+	}
+	// In stream.Stream, it's possible that both the <-Done() event is available as well as another Fetch()
+	// call. In order to guarantee that we don't try to close the same stream multiple times, we wrap it with a
+	// sync.Once.
+	s.once.Do(func() {
+		close(s.done)
+	})
+	return nextFetchDate(s.clock, s.rand, 0), nil
+}
+
+// Notify flushes all new events to the streamer's subscribers.
+func (s *BlueGreenDeploymentStreamer) Notify() {
+	// Copy current list of subscribers over, so that we can we add more subscribers while
+	// notifying previous subscribers of older events.
+	s.mu.Lock()
+	var subs []chan BlueGreenDeployment
+	subs = append(subs, s.subscribers...)
+	s.mu.Unlock()
+
+	for _, event := range s.eventsToFlush {
+		for _, sub := range subs {
+			sub <- event
+		}
+	}
+	s.eventsToFlush = nil // reset after flushing all events.
+}
+
+// Close closes all subscribed channels notifying them that no more events will be sent.
+func (s *BlueGreenDeploymentStreamer) Close() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, sub := range s.subscribers {
+		close(sub)
+	}
+	s.isDone = true
+}
+
+// Done returns a channel that's closed when there are no more events that can be fetched.
+func (s *BlueGreenDeploymentStreamer) Done() <-chan struct{} {
+	return s.done
+}
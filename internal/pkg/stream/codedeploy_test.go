@@ -0,0 +1,225 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package stream
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/copilot-cli/internal/pkg/aws/codedeploy"
+	"github.com/stretchr/testify/require"
+)
+
+type mockCodeDeployDeploymentDescriber struct {
+	out *codedeploy.ECSDeployment
+	err error
+}
+
+func (m mockCodeDeployDeploymentDescriber) ECSDeployment(_ string) (*codedeploy.ECSDeployment, error) {
+	return m.out, m.err
+}
+
+func TestBlueGreenDeploymentStreamer_Subscribe(t *testing.T) {
+	t.Run("allow new subscriptions if the streamer is still active", func(t *testing.T) {
+		// GIVEN
+		streamer := &BlueGreenDeploymentStreamer{}
+
+		// WHEN
+		_ = streamer.Subscribe()
+		_ = streamer.Subscribe()
+
+		// THEN
+		require.Equal(t, 2, len(streamer.subscribers), "expected number of subscribers to match")
+	})
+	t.Run("new subscriptions on a finished streamer should return closed channels", func(t *testing.T) {
+		// GIVEN
+		streamer := &BlueGreenDeploymentStreamer{isDone: true}
+
+		// WHEN
+		ch := streamer.Subscribe()
+		_, ok := <-ch
+
+		// THEN
+		require.False(t, ok, "channel should be closed")
+	})
+}
+
+func TestBlueGreenDeploymentStreamer_Fetch(t *testing.T) {
+	startDate := time.Date(2020, time.November, 23, 18, 0, 0, 0, time.UTC)
+	newStreamer := func(client CodeDeployDeploymentDescriber) *BlueGreenDeploymentStreamer {
+		return &BlueGreenDeploymentStreamer{
+			client:       client,
+			clock:        fakeClock{startDate},
+			rand:         func(n int) int { return n },
+			deploymentID: "d-ABCDEF123",
+			done:         make(chan struct{}),
+		}
+	}
+
+	t.Run("returns a wrapped error on describe deployment call failure", func(t *testing.T) {
+		// GIVEN
+		streamer := newStreamer(mockCodeDeployDeploymentDescriber{err: errors.New("some error")})
+
+		// WHEN
+		_, err := streamer.Fetch()
+
+		// THEN
+		require.EqualError(t, err, "fetch deployment description: some error")
+	})
+	t.Run("retries with a backoff if the describe deployment call is throttled", func(t *testing.T) {
+		// GIVEN
+		streamer := newStreamer(mockCodeDeployDeploymentDescriber{err: awserr.New("RequestThrottled", "throttled", nil)})
+
+		// WHEN
+		next, err := streamer.Fetch()
+
+		// THEN
+		require.NoError(t, err)
+		require.Equal(t, startDate.Add(8*time.Second), next)
+		require.Equal(t, 1, streamer.retries)
+	})
+	t.Run("stores the description of an in progress deployment", func(t *testing.T) {
+		// GIVEN
+		streamer := newStreamer(mockCodeDeployDeploymentDescriber{
+			out: &codedeploy.ECSDeployment{
+				ID:     "d-ABCDEF123",
+				Status: "InProgress",
+				TaskSets: []codedeploy.ECSTaskSet{
+					{
+						Label:         "Blue",
+						DesiredCount:  2,
+						RunningCount:  2,
+						TrafficWeight: 90,
+					},
+					{
+						Label:         "Green",
+						DesiredCount:  2,
+						RunningCount:  2,
+						TrafficWeight: 10,
+					},
+				},
+			},
+		})
+
+		// WHEN
+		next, err := streamer.Fetch()
+
+		// THEN
+		require.NoError(t, err)
+		require.Equal(t, startDate.Add(4*time.Second), next)
+		require.Equal(t, []BlueGreenDeployment{
+			{
+				Status: "InProgress",
+				TaskSets: []codedeploy.ECSTaskSet{
+					{
+						Label:         "Blue",
+						DesiredCount:  2,
+						RunningCount:  2,
+						TrafficWeight: 90,
+					},
+					{
+						Label:         "Green",
+						DesiredCount:  2,
+						RunningCount:  2,
+						TrafficWeight: 10,
+					},
+				},
+			},
+		}, streamer.eventsToFlush)
+		select {
+		case <-streamer.Done():
+			require.Fail(t, "streamer should not be done")
+		default:
+		}
+	})
+	t.Run("is done once the deployment succeeds", func(t *testing.T) {
+		// GIVEN
+		streamer := newStreamer(mockCodeDeployDeploymentDescriber{
+			out: &codedeploy.ECSDeployment{
+				ID:     "d-ABCDEF123",
+				Status: "Succeeded",
+			},
+		})
+
+		// WHEN
+		_, err := streamer.Fetch()
+
+		// THEN
+		require.NoError(t, err)
+		require.Equal(t, []BlueGreenDeployment{{Status: "Succeeded"}}, streamer.eventsToFlush)
+		<-streamer.Done()
+	})
+	t.Run("is done once the traffic is shifted and the original tasks wait to be terminated", func(t *testing.T) {
+		// GIVEN
+		streamer := newStreamer(mockCodeDeployDeploymentDescriber{
+			out: &codedeploy.ECSDeployment{
+				ID:                     "d-ABCDEF123",
+				Status:                 "InProgress",
+				TerminationWaitStarted: true,
+			},
+		})
+
+		// WHEN
+		_, err := streamer.Fetch()
+
+		// THEN
+		require.NoError(t, err)
+		require.Equal(t, []BlueGreenDeployment{{Status: "InProgress"}}, streamer.eventsToFlush)
+		<-streamer.Done()
+	})
+	t.Run("is done without an error if the deployment is stopped", func(t *testing.T) {
+		// GIVEN
+		streamer := newStreamer(mockCodeDeployDeploymentDescriber{
+			out: &codedeploy.ECSDeployment{
+				ID:           "d-ABCDEF123",
+				Status:       "Stopped",
+				ErrorMessage: "One or more alarms have been activated",
+			},
+		})
+
+		// WHEN
+		_, err := streamer.Fetch()
+
+		// THEN
+		require.NoError(t, err)
+		require.Equal(t, []BlueGreenDeployment{
+			{
+				Status:       "Stopped",
+				ErrorMessage: "One or more alarms have been activated",
+			},
+		}, streamer.eventsToFlush, "expected the error message to be kept for the subscribers")
+		<-streamer.Done()
+	})
+}
+
+func TestBlueGreenDeploymentStreamer_Notify(t *testing.T) {
+	// GIVEN
+	wantedEvents := []BlueGreenDeployment{
+		{
+			Status: "InProgress",
+		},
+		{
+			Status: "Succeeded",
+		},
+	}
+	sub := make(chan BlueGreenDeployment, 2)
+	streamer := &BlueGreenDeploymentStreamer{
+		subscribers:   []chan BlueGreenDeployment{sub},
+		eventsToFlush: wantedEvents,
+	}
+
+	// WHEN
+	streamer.Notify()
+	close(sub) // Close the channel to stop expecting to receive new events.
+
+	// THEN
+	var actualEvents []BlueGreenDeployment
+	for event := range sub {
+		actualEvents = append(actualEvents, event)
+	}
+	require.ElementsMatch(t, wantedEvents, actualEvents)
+	require.Nil(t, streamer.eventsToFlush, "expected events to be reset after notifying")
+}
@@ -0,0 +1,222 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package stream
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatchlogs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
+)
+
+const (
+	codeBuildActionProvider = "CodeBuild"
+	actionStatusInProgress  = "InProgress"
+	fmtCodeBuildLogGroup    = "/aws/codebuild/%s"
+	maxBuildLogLines        = 5 // Total number of lines we want to display at most from the log of a build.
+)
+
+// PipelineExecutionDescriber is the interface to describe the execution of a pipeline.
+type PipelineExecutionDescriber interface {
+	GetPipelineState(name string) (*codepipeline.PipelineState, error)
+	PipelineExecution(pipelineName, executionID string) (*codepipeline.PipelineExecution, error)
+	ListActionExecutions(pipelineName, executionID string) ([]codepipeline.ActionExecution, error)
+}
+
+// LogEventsGetter is the interface to retrieve CloudWatch log events.
+type LogEventsGetter interface {
+	LogEvents(opts cloudwatchlogs.LogEventsOpts) (*cloudwatchlogs.LogEventsOutput, error)
+}
+
+// PipelineStageExecution is the execution of the actions of a stage during a pipeline execution.
+type PipelineStageExecution struct {
+	Name    string
+	Actions []codepipeline.ActionExecution
+}
+
+// PipelineExecution is a description of a pipeline execution.
+type PipelineExecution struct {
+	Status string
+	Stages []PipelineStageExecution
+	// The latest lines of the log of the running CodeBuild action, if any.
+	BuildAction string
+	BuildLogs   []string
+}
+
+// PipelineExecutionStreamer is a Streamer for PipelineExecution descriptions until the execution is done.
+type PipelineExecutionStreamer struct {
+	client      PipelineExecutionDescriber
+	logs        LogEventsGetter
+	clock       clock
+	rand        func(n int) int
+	pipeline    string
+	executionID string
+
+	stageNames    []string
+	subscribers   []chan PipelineExecution
+	once          sync.Once
+	done          chan struct{}
+	isDone        bool
+	eventsToFlush []PipelineExecution
+	mu            sync.Mutex
+
+	retries int
+}
+
+// NewPipelineExecutionStreamer creates a new PipelineExecutionStreamer that streams descriptions
+// of the pipeline execution until the execution is done.
+func NewPipelineExecutionStreamer(client PipelineExecutionDescriber, logs LogEventsGetter, pipeline, executionID string) *PipelineExecutionStreamer {
+	return &PipelineExecutionStreamer{
+		client:      client,
+		logs:        logs,
+		clock:       realClock{},
+		rand:        rand.Intn,
+		pipeline:    pipeline,
+		executionID: executionID,
+		done:        make(chan struct{}),
+	}
+}
+
+// Subscribe returns a read-only channel that will receive execution descriptions from the PipelineExecutionStreamer.
+func (s *PipelineExecutionStreamer) Subscribe() <-chan PipelineExecution {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	c := make(chan PipelineExecution)
+	s.subscribers = append(s.subscribers, c)
+	if s.isDone {
+		// If the streamer is already done streaming, any new subscription requests should just return a closed channel.
+		close(c)
+	}
+	return c
+}
+
+// Fetch retrieves and stores the status of the pipeline execution, the executions of its actions,
+// and the latest lines of the log of the running build.
+// If an error occurs, returns a wrapped err.
+// Otherwise, returns the time the next Fetch should be attempted.
+func (s *PipelineExecutionStreamer) Fetch() (next time.Time, err error) {
+	if s.stageNames == nil {
+		state, err := s.client.GetPipelineState(s.pipeline)
+		if err != nil {
+			return s.handleFetchErr("fetch pipeline state", err)
+		}
+		for _, stage := range state.StageStates {
+			s.stageNames = append(s.stageNames, stage.StageName)
+		}
+	}
+	execution, err := s.client.PipelineExecution(s.pipeline, s.executionID)
+	if err != nil {
+		return s.handleFetchErr("fetch pipeline execution", err)
+	}
+	actions, err := s.client.ListActionExecutions(s.pipeline, s.executionID)
+	if err != nil {
+		return s.handleFetchErr("fetch action executions", err)
+	}
+	s.retries = 0
+
+	stages := make([]PipelineStageExecution, len(s.stageNames))
+	for i, name := range s.stageNames {
+		stages[i].Name = name
+	}
+	var build *codepipeline.ActionExecution
+	for i, action := range actions {
+		for j := range stages {
+			if stages[j].Name == action.StageName {
+				stages[j].Actions = append(stages[j].Actions, action)
+			}
+		}
+		if action.Provider == codeBuildActionProvider && action.ExternalExecutionID != "" {
+			build = &actions[i]
+		}
+	}
+	ev := PipelineExecution{
+		Status: execution.Status,
+		Stages: stages,
+	}
+	if build != nil && build.Status == actionStatusInProgress {
+		ev.BuildAction = build.ActionName
+		ev.BuildLogs = s.buildLogs(build.ExternalExecutionID)
+	}
+	s.eventsToFlush = append(s.eventsToFlush, ev)
+	if execution.IsDone() {
+		// The execution is done, notify that there is no need for another Fetch call beyond this point.
+		s.once.Do(func() {
+			close(s.done)
+		})
+	}
+	return nextFetchDate(s.clock, s.rand, 0), nil
+}
+
+// Notify flushes all new events to the streamer's subscribers.
+func (s *PipelineExecutionStreamer) Notify() {
+	// Copy current list of subscribers over, so that we can we add more subscribers while
+	// notifying previous subscribers of older events.
+	s.mu.Lock()
+	var subs []chan PipelineExecution
+	subs = append(subs, s.subscribers...)
+	s.mu.Unlock()
+
+	for _, event := range s.eventsToFlush {
+		for _, sub := range subs {
+			sub <- event
+		}
+	}
+	s.eventsToFlush = nil // reset after flushing all events.
+}
+
+// Close closes all subscribed channels notifying them that no more events will be sent.
+func (s *PipelineExecutionStreamer) Close() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, sub := range s.subscribers {
+		close(sub)
+	}
+	s.isDone = true
+}
+
+// Done returns a channel that's closed when there are no more events that can be fetched.
+func (s *PipelineExecutionStreamer) Done() <-chan struct{} {
+	return s.done
+}
+
+// handleFetchErr backs off if the request was throttled, otherwise it returns the wrapped err.
+func (s *PipelineExecutionStreamer) handleFetchErr(msg string, err error) (next time.Time, fetchErr error) {
+	var throttled awserr.Error
+	if errors.As(err, &throttled) && request.IsErrorThrottle(throttled) {
+		s.retries += 1
+		return nextFetchDate(s.clock, s.rand, s.retries), nil
+	}
+	return next, fmt.Errorf("%s: %w", msg, err)
+}
+
+// buildLogs returns the latest lines of the log of a CodeBuild build given its ID "<project>:<uuid>".
+// The log stream of a build is created after the build starts, so the lines are retrieved on a best-effort basis.
+func (s *PipelineExecutionStreamer) buildLogs(buildID string) []string {
+	parts := strings.SplitN(buildID, ":", 2)
+	if len(parts) != 2 {
+		return nil
+	}
+	out, err := s.logs.LogEvents(cloudwatchlogs.LogEventsOpts{
+		LogGroup:   fmt.Sprintf(fmtCodeBuildLogGroup, parts[0]),
+		LogStreams: []string{parts[1]},
+		Limit:      aws.Int64(maxBuildLogLines),
+	})
+	if err != nil {
+		return nil
+	}
+	var lines []string
+	for _, event := range out.Events {
+		lines = append(lines, strings.TrimRight(event.Message, "\n"))
+	}
+	return lines
+}
@@ -0,0 +1,241 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package stream
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatchlogs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
+	"github.com/stretchr/testify/require"
+)
+
+type mockCodePipeline struct {
+	stateOut     *codepipeline.PipelineState
+	stateErr     error
+	executionOut *codepipeline.PipelineExecution
+	executionErr error
+	actionsOut   []codepipeline.ActionExecution
+	actionsErr   error
+}
+
+func (m mockCodePipeline) GetPipelineState(name string) (*codepipeline.PipelineState, error) {
+	return m.stateOut, m.stateErr
+}
+
+func (m mockCodePipeline) PipelineExecution(pipelineName, executionID string) (*codepipeline.PipelineExecution, error) {
+	return m.executionOut, m.executionErr
+}
+
+func (m mockCodePipeline) ListActionExecutions(pipelineName, executionID string) ([]codepipeline.ActionExecution, error) {
+	return m.actionsOut, m.actionsErr
+}
+
+type mockLogEventsGetter struct {
+	in  *cloudwatchlogs.LogEventsOpts
+	out *cloudwatchlogs.LogEventsOutput
+	err error
+}
+
+func (m *mockLogEventsGetter) LogEvents(opts cloudwatchlogs.LogEventsOpts) (*cloudwatchlogs.LogEventsOutput, error) {
+	m.in = &opts
+	return m.out, m.err
+}
+
+func TestPipelineExecutionStreamer_Subscribe(t *testing.T) {
+	t.Run("allow new subscriptions if pipeline streamer is still active", func(t *testing.T) {
+		// GIVEN
+		streamer := &PipelineExecutionStreamer{}
+
+		// WHEN
+		_ = streamer.Subscribe()
+		_ = streamer.Subscribe()
+
+		// THEN
+		require.Equal(t, 2, len(streamer.subscribers), "expected number of subscribers to match")
+	})
+	t.Run("new subscriptions on a finished pipeline streamer should return closed channels", func(t *testing.T) {
+		// GIVEN
+		streamer := &PipelineExecutionStreamer{isDone: true}
+
+		// WHEN
+		ch := streamer.Subscribe()
+		_, ok := <-ch
+
+		// THEN
+		require.False(t, ok, "channel should be closed")
+	})
+}
+
+func TestPipelineExecutionStreamer_Fetch(t *testing.T) {
+	state := &codepipeline.PipelineState{
+		StageStates: []*codepipeline.StageState{
+			{StageName: "Source"},
+			{StageName: "Build"},
+			{StageName: "DeployTo-test"},
+		},
+	}
+	startDate := time.Date(2021, time.June, 1, 12, 0, 0, 0, time.UTC)
+	t.Run("returns a wrapped error on get pipeline execution call failure", func(t *testing.T) {
+		// GIVEN
+		m := mockCodePipeline{
+			stateOut:     state,
+			executionErr: errors.New("some error"),
+		}
+		streamer := NewPipelineExecutionStreamer(m, &mockLogEventsGetter{}, "my-pipeline", "1234")
+
+		// WHEN
+		_, err := streamer.Fetch()
+
+		// THEN
+		require.EqualError(t, err, "fetch pipeline execution: some error")
+	})
+	t.Run("backs off if the request is throttled", func(t *testing.T) {
+		// GIVEN
+		m := mockCodePipeline{
+			stateOut:     state,
+			executionErr: awserr.New("RequestThrottled", "throttled", nil),
+		}
+		streamer := NewPipelineExecutionStreamer(m, &mockLogEventsGetter{}, "my-pipeline", "1234")
+		streamer.clock = fakeClock{fakeNow: startDate}
+		streamer.rand = func(n int) int { return n }
+
+		// WHEN
+		next, err := streamer.Fetch()
+
+		// THEN
+		require.NoError(t, err)
+		require.Equal(t, startDate.Add(8*time.Second), next)
+		require.Equal(t, 1, streamer.retries)
+	})
+	t.Run("stores the actions of each stage and the logs of the running build", func(t *testing.T) {
+		// GIVEN
+		m := mockCodePipeline{
+			stateOut: state,
+			executionOut: &codepipeline.PipelineExecution{
+				ID:     "1234",
+				Status: "InProgress",
+			},
+			actionsOut: []codepipeline.ActionExecution{
+				{
+					StageName:  "Source",
+					ActionName: "SourceCodeFor-dinder",
+					Provider:   "CodeStarSourceConnection",
+					Status:     "Succeeded",
+					StartedAt:  startDate,
+					UpdatedAt:  startDate.Add(time.Minute),
+				},
+				{
+					StageName:           "Build",
+					ActionName:          "Build",
+					Provider:            "CodeBuild",
+					Status:              "InProgress",
+					StartedAt:           startDate.Add(time.Minute),
+					UpdatedAt:           startDate.Add(time.Minute),
+					ExternalExecutionID: "my-pipeline-BuildProject:5678",
+				},
+			},
+		}
+		logs := &mockLogEventsGetter{
+			out: &cloudwatchlogs.LogEventsOutput{
+				Events: []*cloudwatchlogs.Event{
+					{Message: "[Container] Running command make test\n"},
+					{Message: "ok  	github.com/aws/copilot-cli/internal/pkg/stream\n"},
+				},
+			},
+		}
+		streamer := NewPipelineExecutionStreamer(m, logs, "my-pipeline", "1234")
+
+		// WHEN
+		_, err := streamer.Fetch()
+
+		// THEN
+		require.NoError(t, err)
+		require.Equal(t, &cloudwatchlogs.LogEventsOpts{
+			LogGroup:   "/aws/codebuild/my-pipeline-BuildProject",
+			LogStreams: []string{"5678"},
+			Limit:      aws.Int64(5),
+		}, logs.in)
+		require.Equal(t, []PipelineExecution{
+			{
+				Status: "InProgress",
+				Stages: []PipelineStageExecution{
+					{
+						Name:    "Source",
+						Actions: m.actionsOut[:1],
+					},
+					{
+						Name:    "Build",
+						Actions: m.actionsOut[1:],
+					},
+					{
+						Name: "DeployTo-test",
+					},
+				},
+				BuildAction: "Build",
+				BuildLogs: []string{
+					"[Container] Running command make test",
+					"ok  	github.com/aws/copilot-cli/internal/pkg/stream",
+				},
+			},
+		}, streamer.eventsToFlush)
+		select {
+		case <-streamer.Done():
+			require.Fail(t, "Done() should not be closed while the execution is in progress")
+		default:
+		}
+	})
+	t.Run("closes Done once the execution is done", func(t *testing.T) {
+		// GIVEN
+		m := mockCodePipeline{
+			stateOut: state,
+			executionOut: &codepipeline.PipelineExecution{
+				ID:     "1234",
+				Status: "Failed",
+			},
+		}
+		streamer := NewPipelineExecutionStreamer(m, &mockLogEventsGetter{}, "my-pipeline", "1234")
+
+		// WHEN
+		_, err := streamer.Fetch()
+
+		// THEN
+		require.NoError(t, err)
+		require.Equal(t, "Failed", streamer.eventsToFlush[0].Status)
+		<-streamer.Done()
+	})
+}
+
+func TestPipelineExecutionStreamer_Notify(t *testing.T) {
+	// GIVEN
+	wantedEvents := []PipelineExecution{
+		{
+			Status: "InProgress",
+		},
+		{
+			Status: "Succeeded",
+		},
+	}
+	sub := make(chan PipelineExecution, 2)
+	streamer := &PipelineExecutionStreamer{
+		subscribers:   []chan PipelineExecution{sub},
+		eventsToFlush: wantedEvents,
+	}
+
+	// WHEN
+	streamer.Notify()
+	close(sub) // Close the channel to stop expecting to receive new events.
+
+	// THEN
+	var actualEvents []PipelineExecution
+	for event := range sub {
+		actualEvents = append(actualEvents, event)
+	}
+	require.ElementsMatch(t, wantedEvents, actualEvents)
+	require.Nil(t, streamer.eventsToFlush)
+}
@@ -14,6 +14,7 @@ var (
 	errVPCGetterNil     = errors.New("vpc getter is not set")
 	errClusterGetterNil = errors.New("cluster getter is not set")
 	errStarterNil       = errors.New("starter is not set")
+	errDescriberNil     = errors.New("workload describer is not set")
 )
 
 type errRunTask struct {
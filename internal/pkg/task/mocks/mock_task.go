@@ -150,6 +150,74 @@ func (mr *MockDefaultClusterGetterMockRecorder) DefaultCluster() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DefaultCluster", reflect.TypeOf((*MockDefaultClusterGetter)(nil).DefaultCluster))
 }
 
+// MockWorkloadDescriber is a mock of WorkloadDescriber interface.
+type MockWorkloadDescriber struct {
+	ctrl     *gomock.Controller
+	recorder *MockWorkloadDescriberMockRecorder
+}
+
+// MockWorkloadDescriberMockRecorder is the mock recorder for MockWorkloadDescriber.
+type MockWorkloadDescriberMockRecorder struct {
+	mock *MockWorkloadDescriber
+}
+
+// NewMockWorkloadDescriber creates a new mock instance.
+func NewMockWorkloadDescriber(ctrl *gomock.Controller) *MockWorkloadDescriber {
+	mock := &MockWorkloadDescriber{ctrl: ctrl}
+	mock.recorder = &MockWorkloadDescriberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWorkloadDescriber) EXPECT() *MockWorkloadDescriberMockRecorder {
+	return m.recorder
+}
+
+// ClusterARN mocks base method.
+func (m *MockWorkloadDescriber) ClusterARN(app, env string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClusterARN", app, env)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClusterARN indicates an expected call of ClusterARN.
+func (mr *MockWorkloadDescriberMockRecorder) ClusterARN(app, env interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClusterARN", reflect.TypeOf((*MockWorkloadDescriber)(nil).ClusterARN), app, env)
+}
+
+// NetworkConfiguration mocks base method.
+func (m *MockWorkloadDescriber) NetworkConfiguration(app, env, workload string) (*ecs.NetworkConfiguration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NetworkConfiguration", app, env, workload)
+	ret0, _ := ret[0].(*ecs.NetworkConfiguration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NetworkConfiguration indicates an expected call of NetworkConfiguration.
+func (mr *MockWorkloadDescriberMockRecorder) NetworkConfiguration(app, env, workload interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NetworkConfiguration", reflect.TypeOf((*MockWorkloadDescriber)(nil).NetworkConfiguration), app, env, workload)
+}
+
+// TaskDefinition mocks base method.
+func (m *MockWorkloadDescriber) TaskDefinition(app, env, workload string) (*ecs.TaskDefinition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TaskDefinition", app, env, workload)
+	ret0, _ := ret[0].(*ecs.TaskDefinition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TaskDefinition indicates an expected call of TaskDefinition.
+func (mr *MockWorkloadDescriberMockRecorder) TaskDefinition(app, env, workload interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TaskDefinition", reflect.TypeOf((*MockWorkloadDescriber)(nil).TaskDefinition), app, env, workload)
+}
+
 // MockEnvironmentDescriber is a mock of EnvironmentDescriber interface.
 type MockEnvironmentDescriber struct {
 	ctrl     *gomock.Controller
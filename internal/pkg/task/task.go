@@ -33,6 +33,13 @@ type DefaultClusterGetter interface {
 	DefaultCluster() (string, error)
 }
 
+// WorkloadDescriber wraps methods of describing a deployed Copilot workload.
+type WorkloadDescriber interface {
+	ClusterARN(app, env string) (string, error)
+	TaskDefinition(app, env, workload string) (*ecs.TaskDefinition, error)
+	NetworkConfiguration(app, env, workload string) (*ecs.NetworkConfiguration, error)
+}
+
 type EnvironmentDescriber interface {
 	Describe() (*describe.EnvDescription, error)
 }
@@ -0,0 +1,99 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package task
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+)
+
+// WorkloadRunner can run an Amazon ECS task with the existing task definition and network configuration
+// of a deployed Copilot workload. No new task definition is registered, so the tasks have the same roles,
+// secrets, subnets and security groups as the workload.
+type WorkloadRunner struct {
+	// Count of the tasks to be launched.
+	Count int
+
+	// App, Env and Workload whose task definition will be used to launch the tasks.
+	App      string
+	Env      string
+	Workload string
+
+	// Overrides of the workload's main container. Optional.
+	Command []string
+	EnvVars map[string]string
+
+	// Interfaces to interact with dependencies. Must not be nil.
+	Describer WorkloadDescriber
+	Starter   Runner
+}
+
+// Run runs tasks with the task definition of the workload, and returns the tasks.
+func (r *WorkloadRunner) Run() ([]*Task, error) {
+	if err := r.validateDependencies(); err != nil {
+		return nil, err
+	}
+
+	cluster, err := r.Describer.ClusterARN(r.App, r.Env)
+	if err != nil {
+		return nil, fmt.Errorf("get cluster for environment %s: %w", r.Env, err)
+	}
+
+	taskDef, err := r.Describer.TaskDefinition(r.App, r.Env, r.Workload)
+	if err != nil {
+		return nil, fmt.Errorf("get task definition of %s: %w", r.Workload, err)
+	}
+
+	networkConfig, err := r.Describer.NetworkConfiguration(r.App, r.Env, r.Workload)
+	if err != nil {
+		return nil, fmt.Errorf("get network configuration of %s: %w", r.Workload, err)
+	}
+
+	platformVersion := "LATEST"
+	if taskDef.RuntimePlatform != nil && IsValidWindowsOS(aws.StringValue(taskDef.RuntimePlatform.OperatingSystemFamily)) {
+		platformVersion = "1.0.0"
+	}
+
+	var overrides []ecs.ContainerOverride
+	if len(r.Command) != 0 || len(r.EnvVars) != 0 {
+		overrides = append(overrides, ecs.ContainerOverride{
+			Name:    r.Workload, // NOTE: refer to workload's CloudFormation template. The container name is set to be the workload's name.
+			Command: r.Command,
+			EnvVars: r.EnvVars,
+		})
+	}
+
+	ecsTasks, err := r.Starter.RunTask(ecs.RunTaskInput{
+		Cluster:            cluster,
+		Count:              r.Count,
+		Subnets:            networkConfig.Subnets,
+		SecurityGroups:     networkConfig.SecurityGroups,
+		AssignPublicIP:     networkConfig.AssignPublicIp,
+		TaskFamilyName:     aws.StringValue(taskDef.TaskDefinitionArn),
+		StartedBy:          startedBy,
+		PlatformVersion:    platformVersion,
+		ContainerOverrides: overrides,
+	})
+	if err != nil {
+		return nil, &errRunTask{
+			groupName: r.Workload,
+			parentErr: err,
+		}
+	}
+	return convertECSTasks(ecsTasks), nil
+}
+
+func (r *WorkloadRunner) validateDependencies() error {
+	if r.Describer == nil {
+		return errDescriberNil
+	}
+
+	if r.Starter == nil {
+		return errStarterNil
+	}
+
+	return nil
+}
@@ -0,0 +1,185 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package task
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awsecs "github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/task/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWorkloadRunner_Run(t *testing.T) {
+	const (
+		inApp      = "my-app"
+		inEnv      = "prod"
+		inWorkload = "api"
+		taskDefARN = "arn:aws:ecs:us-west-2:123456789:task-definition/my-app-prod-api:3"
+	)
+	mockDescriberValid := func(m *mocks.MockWorkloadDescriber) {
+		m.EXPECT().ClusterARN(inApp, inEnv).Return("cluster-1", nil)
+		m.EXPECT().TaskDefinition(inApp, inEnv, inWorkload).Return(&ecs.TaskDefinition{
+			TaskDefinitionArn: aws.String(taskDefARN),
+		}, nil)
+		m.EXPECT().NetworkConfiguration(inApp, inEnv, inWorkload).Return(&ecs.NetworkConfiguration{
+			AssignPublicIp: "DISABLED",
+			Subnets:        []string{"subnet-1", "subnet-2"},
+			SecurityGroups: []string{"sg-1"},
+		}, nil)
+	}
+
+	testCases := map[string]struct {
+		inCommand []string
+		inEnvVars map[string]string
+
+		mockDescriber func(m *mocks.MockWorkloadDescriber)
+		mockStarter   func(m *mocks.MockRunner)
+
+		wantedError error
+		wantedTasks []*Task
+	}{
+		"failed to get cluster": {
+			mockDescriber: func(m *mocks.MockWorkloadDescriber) {
+				m.EXPECT().ClusterARN(inApp, inEnv).Return("", errors.New("some error"))
+			},
+			mockStarter: func(m *mocks.MockRunner) {},
+			wantedError: fmt.Errorf("get cluster for environment prod: some error"),
+		},
+		"failed to get task definition": {
+			mockDescriber: func(m *mocks.MockWorkloadDescriber) {
+				m.EXPECT().ClusterARN(inApp, inEnv).Return("cluster-1", nil)
+				m.EXPECT().TaskDefinition(inApp, inEnv, inWorkload).Return(nil, errors.New("some error"))
+			},
+			mockStarter: func(m *mocks.MockRunner) {},
+			wantedError: fmt.Errorf("get task definition of api: some error"),
+		},
+		"failed to get network configuration": {
+			mockDescriber: func(m *mocks.MockWorkloadDescriber) {
+				m.EXPECT().ClusterARN(inApp, inEnv).Return("cluster-1", nil)
+				m.EXPECT().TaskDefinition(inApp, inEnv, inWorkload).Return(&ecs.TaskDefinition{}, nil)
+				m.EXPECT().NetworkConfiguration(inApp, inEnv, inWorkload).Return(nil, errors.New("some error"))
+			},
+			mockStarter: func(m *mocks.MockRunner) {},
+			wantedError: fmt.Errorf("get network configuration of api: some error"),
+		},
+		"failed to run tasks": {
+			mockDescriber: mockDescriberValid,
+			mockStarter: func(m *mocks.MockRunner) {
+				m.EXPECT().RunTask(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: fmt.Errorf("run task api: some error"),
+		},
+		"run tasks with the workload's task definition and network configuration": {
+			mockDescriber: mockDescriberValid,
+			mockStarter: func(m *mocks.MockRunner) {
+				m.EXPECT().RunTask(ecs.RunTaskInput{
+					Cluster:         "cluster-1",
+					Count:           1,
+					Subnets:         []string{"subnet-1", "subnet-2"},
+					SecurityGroups:  []string{"sg-1"},
+					AssignPublicIP:  "DISABLED",
+					TaskFamilyName:  taskDefARN,
+					StartedBy:       startedBy,
+					PlatformVersion: "LATEST",
+				}).Return([]*ecs.Task{
+					{
+						TaskArn: aws.String("task-1"),
+					},
+				}, nil)
+			},
+			wantedTasks: []*Task{
+				{
+					TaskARN: "task-1",
+				},
+			},
+		},
+		"run tasks with command and environment variable overrides": {
+			inCommand: []string{"rake", "db:migrate"},
+			inEnvVars: map[string]string{
+				"RAILS_ENV": "production",
+			},
+			mockDescriber: func(m *mocks.MockWorkloadDescriber) {
+				m.EXPECT().ClusterARN(inApp, inEnv).Return("cluster-1", nil)
+				m.EXPECT().TaskDefinition(inApp, inEnv, inWorkload).Return(&ecs.TaskDefinition{
+					TaskDefinitionArn: aws.String(taskDefARN),
+					RuntimePlatform: &awsecs.RuntimePlatform{
+						OperatingSystemFamily: aws.String("WINDOWS_SERVER_2019_CORE"),
+					},
+				}, nil)
+				m.EXPECT().NetworkConfiguration(inApp, inEnv, inWorkload).Return(&ecs.NetworkConfiguration{
+					AssignPublicIp: "ENABLED",
+					Subnets:        []string{"subnet-1"},
+				}, nil)
+			},
+			mockStarter: func(m *mocks.MockRunner) {
+				m.EXPECT().RunTask(ecs.RunTaskInput{
+					Cluster:         "cluster-1",
+					Count:           1,
+					Subnets:         []string{"subnet-1"},
+					AssignPublicIP:  "ENABLED",
+					TaskFamilyName:  taskDefARN,
+					StartedBy:       startedBy,
+					PlatformVersion: "1.0.0",
+					ContainerOverrides: []ecs.ContainerOverride{
+						{
+							Name:    "api",
+							Command: []string{"rake", "db:migrate"},
+							EnvVars: map[string]string{
+								"RAILS_ENV": "production",
+							},
+						},
+					},
+				}).Return([]*ecs.Task{
+					{
+						TaskArn: aws.String("task-1"),
+					},
+				}, nil)
+			},
+			wantedTasks: []*Task{
+				{
+					TaskARN: "task-1",
+				},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockDescriber := mocks.NewMockWorkloadDescriber(ctrl)
+			mockStarter := mocks.NewMockRunner(ctrl)
+			tc.mockDescriber(mockDescriber)
+			tc.mockStarter(mockStarter)
+
+			runner := &WorkloadRunner{
+				Count: 1,
+
+				App:      inApp,
+				Env:      inEnv,
+				Workload: inWorkload,
+
+				Command: tc.inCommand,
+				EnvVars: tc.inEnvVars,
+
+				Describer: mockDescriber,
+				Starter:   mockStarter,
+			}
+
+			tasks, err := runner.Run()
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedTasks, tasks)
+			}
+		})
+	}
+}
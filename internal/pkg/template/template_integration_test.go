@@ -54,6 +54,18 @@ func TestTemplate_ParseScheduledJob(t *testing.T) {
 				ServiceDiscoveryEndpoint: "test.app.local",
 			},
 		},
+		"renders with a concurrency limit": {
+			opts: template.WorkloadOpts{
+				StateMachine: &template.StateMachineOpts{
+					Concurrency: aws.Int(1),
+				},
+				Network: template.NetworkOpts{
+					AssignPublicIP: template.EnablePublicIP,
+					SubnetsType:    template.PublicSubnetsPlacement,
+				},
+				ServiceDiscoveryEndpoint: "test.app.local",
+			},
+		},
 		"renders with options and addons": {
 			opts: template.WorkloadOpts{
 				StateMachine: &template.StateMachineOpts{
@@ -298,6 +310,26 @@ DiscoveryServiceArn:
 				ServiceDiscoveryEndpoint: "test.app.local",
 			},
 		},
+		"renders a valid template with a blue/green deployment": {
+			opts: template.WorkloadOpts{
+				HTTPHealthCheck: defaultHttpHealthCheck,
+				Network: template.NetworkOpts{
+					AssignPublicIP: template.EnablePublicIP,
+					SubnetsType:    template.PublicSubnetsPlacement,
+				},
+				AllowedSourceIps: []string{"10.0.0.0/24"},
+				BlueGreen: &template.BlueGreenDeploymentOpts{
+					TrafficRouting: &template.TrafficRoutingOpts{
+						Type:            "TimeBasedLinear",
+						Percentage:      20,
+						IntervalMinutes: 2,
+					},
+					TestPort:       8080,
+					RollbackAlarms: []string{"my-alarm"},
+				},
+				ServiceDiscoveryEndpoint: "test.app.local",
+			},
+		},
 	}
 
 	for name, tc := range testCases {
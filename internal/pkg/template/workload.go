@@ -87,6 +87,9 @@ var (
 		"subscribe",
 		"nlb",
 		"vpc-connector",
+		"pre-hooks",
+		"target-group-base-properties",
+		"blue-green",
 	}
 
 	// Operating systems to determine Fargate platform versions.
@@ -105,6 +108,63 @@ type WorkloadNestedStackOpts struct {
 	SecurityGroupOutputs []string
 }
 
+// Secret is a secret to inject into a container.
+type Secret interface {
+	RequiresSub() bool
+	ValueFrom() string
+}
+
+// ssmOrSecretARN is a secret referenced by the name or ARN of an SSM parameter, or the ARN of a Secrets Manager secret.
+type ssmOrSecretARN struct {
+	value string
+}
+
+// SecretFromSSMOrARN returns a Secret whose value is the name or ARN of an SSM parameter or the ARN of a Secrets Manager secret.
+func SecretFromSSMOrARN(value string) Secret {
+	return ssmOrSecretARN{
+		value: value,
+	}
+}
+
+// RequiresSub returns false, the value can be used as is.
+func (s ssmOrSecretARN) RequiresSub() bool {
+	return false
+}
+
+// ValueFrom returns the name or ARN of the secret.
+func (s ssmOrSecretARN) ValueFrom() string {
+	return s.value
+}
+
+// secretsManagerName is a secret referenced by its name in Secrets Manager, and optionally a JSON key in its value.
+type secretsManagerName struct {
+	name string
+	key  string
+}
+
+// SecretFromSecretsManager returns a Secret referenced by its name in Secrets Manager.
+// If key is not empty, only the value of the JSON key is injected.
+func SecretFromSecretsManager(name, key string) Secret {
+	return secretsManagerName{
+		name: name,
+		key:  key,
+	}
+}
+
+// RequiresSub returns true, the ARN of the secret has to be substituted with the partition, region and account of the stack.
+func (s secretsManagerName) RequiresSub() bool {
+	return true
+}
+
+// ValueFrom returns the resource part of the ARN of the secret, followed by the JSON key if there is one.
+// ECS expects the key in the format "<name>:<json-key>:<version-stage>:<version-id>".
+func (s secretsManagerName) ValueFrom() string {
+	if s.key == "" {
+		return s.name
+	}
+	return fmt.Sprintf("%s:%s::", s.name, s.key)
+}
+
 // SidecarOpts holds configuration that's needed if the service has sidecar containers.
 type SidecarOpts struct {
 	Name         *string
@@ -114,7 +174,7 @@ type SidecarOpts struct {
 	Protocol     *string
 	CredsParam   *string
 	Variables    map[string]string
-	Secrets      map[string]string
+	Secrets      map[string]Secret
 	Storage      SidecarStorageOpts
 	DockerLabels map[string]string
 	DependsOn    map[string]string
@@ -135,6 +195,9 @@ type StorageOpts struct {
 	MountPoints       []*MountPoint
 	EFSPerms          []*EFSPermission
 	ManagedVolumeInfo *ManagedVolumeCreationInfo // Used for delegating CreationInfo for Copilot-managed EFS.
+	ManagedFSOptions  *ManagedFileSystemOptions  // Settings of the Copilot-managed EFS file system updated through the env controller.
+	EnvAddons         []string                   // Names of the environment storage addons that the workload can access.
+	EnvAddonOutputs   []string                   // Other outputs exported by these addons, injected as environment variables.
 }
 
 // requiresEFSCreation returns true if managed volume information is specified; false otherwise.
@@ -171,6 +234,16 @@ type ManagedVolumeCreationInfo struct {
 	GID     *uint32
 }
 
+// ManagedFileSystemOptions holds the values of the environment stack parameters that configure the Copilot-managed EFS file system.
+// Empty values reset the settings to the defaults of the environment.
+type ManagedFileSystemOptions struct {
+	BackupPolicy          string // ENABLED or DISABLED.
+	TransitionToIA        string // For example, AFTER_30_DAYS.
+	TransitionToPrimary   string // AFTER_1_ACCESS or NONE.
+	ThroughputMode        string // bursting, elastic or provisioned.
+	ProvisionedThroughput string // Throughput in MiB/s in provisioned mode.
+}
+
 // EFSVolumeConfiguration contains information about how to specify externally managed file systems.
 type EFSVolumeConfiguration struct {
 	// EFSVolumeConfiguration
@@ -194,6 +267,27 @@ type LogConfigOpts struct {
 	Secrets        map[string]string
 }
 
+// DeployHookOpts holds configuration for a one-off task that runs with the service's task definition
+// before the service is updated.
+type DeployHookOpts struct {
+	Command []string
+}
+
+// BlueGreenDeploymentOpts holds configuration for blue/green deployments of a service with CodeDeploy.
+type BlueGreenDeploymentOpts struct {
+	TrafficRouting         *TrafficRoutingOpts // Nil if all the traffic is shifted at once.
+	TestPort               uint16
+	TerminationWaitMinutes int
+	RollbackAlarms         []string
+}
+
+// TrafficRoutingOpts holds configuration for how CodeDeploy shifts traffic in increments.
+type TrafficRoutingOpts struct {
+	Type            string // Either "TimeBasedCanary" or "TimeBasedLinear".
+	Percentage      int
+	IntervalMinutes int
+}
+
 // HTTPHealthCheckOpts holds configuration that's needed for HTTP Health Check.
 type HTTPHealthCheckOpts struct {
 	HealthCheckPath     string
@@ -267,10 +361,11 @@ type AutoscalingQueueDelayOpts struct {
 // ExecuteCommandOpts holds configuration that's needed for ECS Execute Command.
 type ExecuteCommandOpts struct{}
 
-// StateMachineOpts holds configuration needed for State Machine retries and timeout.
+// StateMachineOpts holds configuration needed for State Machine retries, timeout and concurrency.
 type StateMachineOpts struct {
-	Timeout *int
-	Retries *int
+	Timeout     *int
+	Retries     *int
+	Concurrency *int // Maximum number of executions in flight, beyond which new executions are skipped.
 }
 
 // PublishOpts holds configuration needed if the service has publishers.
@@ -368,7 +463,7 @@ func (p RuntimePlatformOpts) isEmpty() bool {
 type WorkloadOpts struct {
 	// Additional options that are common between **all** workload templates.
 	Variables                map[string]string
-	Secrets                  map[string]string
+	Secrets                  map[string]Secret
 	Aliases                  []string
 	Tags                     map[string]string        // Used by App Runner workloads to tag App Runner service resources
 	NestedStack              *WorkloadNestedStackOpts // Outputs from nested stacks such as the addons stack.
@@ -398,6 +493,8 @@ type WorkloadOpts struct {
 	DeregistrationDelay *int64
 	AllowedSourceIps    []string
 	NLB                 *NetworkLoadBalancer
+	PreHooks            []*DeployHookOpts
+	BlueGreen           *BlueGreenDeploymentOpts
 
 	// Lambda functions.
 	RulePriorityLambda             string
@@ -407,9 +504,13 @@ type WorkloadOpts struct {
 	BacklogPerTaskCalculatorLambda string
 	NLBCertValidatorFunctionLambda string
 	NLBCustomDomainFunctionLambda  string
+	PreHookLambda                  string
+	BlueGreenDeploymentLambda      string
 
 	// Additional options for job templates.
-	ScheduleExpression string
+	ScheduleExpression string             // Set if the job is triggered on a schedule.
+	EventPattern       string             // JSON-encoded EventBridge event pattern, set if the job is triggered by events.
+	TopicTrigger       *TopicSubscription // Set if the job is triggered by messages published to a topic.
 	StateMachine       *StateMachineOpts
 
 	// Additional options for request driven web service templates.
@@ -494,6 +595,7 @@ func withSvcParsingFuncs() ParseOption {
 		return t.Funcs(map[string]interface{}{
 			"toSnakeCase":         ToSnakeCaseFunc,
 			"hasSecrets":          hasSecrets,
+			"hasManagedPolicies":  hasManagedPolicies,
 			"fmtSlice":            FmtSliceFunc,
 			"quoteSlice":          QuoteSliceFunc,
 			"randomUUID":          randomUUIDFunc,
@@ -502,6 +604,7 @@ func withSvcParsingFuncs() ParseOption {
 			"jsonQueueURIs":       generateQueueURIJSON,
 			"envControllerParams": envControllerParameters,
 			"logicalIDSafe":       StripNonAlphaNumFunc,
+			"envVarName":          EnvVarNameFunc,
 			"wordSeries":          english.WordSeries,
 			"pluralWord":          english.PluralWord,
 			"contains":            contains,
@@ -519,6 +622,16 @@ func hasSecrets(opts WorkloadOpts) bool {
 	return false
 }
 
+func hasManagedPolicies(opts WorkloadOpts) bool {
+	if opts.NestedStack != nil && (len(opts.NestedStack.PolicyOutputs) > 0) {
+		return true
+	}
+	if opts.Storage != nil && (len(opts.Storage.EnvAddons) > 0) {
+		return true
+	}
+	return false
+}
+
 func randomUUIDFunc() (string, error) {
 	id, err := uuid.NewRandom()
 	if err != nil {
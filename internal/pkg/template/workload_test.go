@@ -4,6 +4,7 @@
 package template
 
 import (
+	"encoding/json"
 	"fmt"
 	"testing"
 
@@ -57,6 +58,9 @@ func TestTemplate_ParseSvc(t *testing.T) {
 					"templates/workloads/partials/cf/subscribe.yml":                       []byte("subscribe"),
 					"templates/workloads/partials/cf/nlb.yml":                             []byte("nlb"),
 					"templates/workloads/partials/cf/vpc-connector.yml":                   []byte("vpc-connector"),
+					"templates/workloads/partials/cf/pre-hooks.yml":                       []byte("pre-hooks"),
+					"templates/workloads/partials/cf/target-group-base-properties.yml":    []byte("target-group-base-properties"),
+					"templates/workloads/partials/cf/blue-green.yml":                      []byte("blue-green"),
 				}
 			},
 			wantedContent: `  loggroup
@@ -87,6 +91,9 @@ func TestTemplate_ParseSvc(t *testing.T) {
   subscribe
   nlb
   vpc-connector
+  pre-hooks
+  target-group-base-properties
+  blue-green
 `,
 		},
 	}
@@ -111,6 +118,81 @@ func TestTemplate_ParseSvc(t *testing.T) {
 	}
 }
 
+func TestTemplate_ParseStateMachineDefinition(t *testing.T) {
+	const path = "workloads/partials/cf/state-machine-definition.json.yml"
+	type state struct {
+		Type    string
+		Next    string
+		Default string
+		Error   string
+		Choices []map[string]interface{}
+	}
+	type definition struct {
+		StartAt string
+		States  map[string]state
+	}
+	testCases := map[string]struct {
+		opts WorkloadOpts
+
+		wantedStartAt string
+		wantedStates  map[string]state
+	}{
+		"runs the task directly without a concurrency limit": {
+			opts: WorkloadOpts{},
+
+			wantedStartAt: "Run Fargate Task",
+			wantedStates: map[string]state{
+				"Run Fargate Task": {Type: "Task"},
+			},
+		},
+		"checks running executions and fails skipped runs with a concurrency limit": {
+			opts: WorkloadOpts{
+				StateMachine: &StateMachineOpts{
+					Concurrency: func(i int) *int { return &i }(2),
+				},
+			},
+
+			wantedStartAt: "Check Running Executions",
+			wantedStates: map[string]state{
+				"Check Running Executions": {
+					Type: "Task",
+					Next: "Within Concurrency Limit",
+				},
+				"Within Concurrency Limit": {
+					Type:    "Choice",
+					Default: "Run Fargate Task",
+					Choices: []map[string]interface{}{
+						{
+							"Variable":           "$.RunningExecutions.Count",
+							"NumericGreaterThan": float64(2),
+							"Next":               "Skip Run",
+						},
+					},
+				},
+				"Skip Run": {
+					Type:  "Fail",
+					Error: "ConcurrencyLimitReached",
+				},
+				"Run Fargate Task": {Type: "Task"},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// WHEN
+			content, err := New().Parse(path, tc.opts)
+			require.NoError(t, err)
+
+			// THEN
+			var got definition
+			require.NoError(t, json.Unmarshal(content.Bytes(), &got), "state machine definition should be valid JSON")
+			require.Equal(t, tc.wantedStartAt, got.StartAt)
+			require.Equal(t, tc.wantedStates, got.States)
+		})
+	}
+}
+
 func TestHasSecrets(t *testing.T) {
 	testCases := map[string]struct {
 		in     WorkloadOpts
@@ -122,14 +204,14 @@ func TestHasSecrets(t *testing.T) {
 		},
 		"no secrets": {
 			in: WorkloadOpts{
-				Secrets: map[string]string{},
+				Secrets: map[string]Secret{},
 			},
 			wanted: false,
 		},
 		"service has secrets": {
 			in: WorkloadOpts{
-				Secrets: map[string]string{
-					"hello": "world",
+				Secrets: map[string]Secret{
+					"hello": SecretFromSSMOrARN("world"),
 				},
 			},
 			wanted: true,
@@ -151,6 +233,74 @@ func TestHasSecrets(t *testing.T) {
 	}
 }
 
+func TestHasManagedPolicies(t *testing.T) {
+	testCases := map[string]struct {
+		in     WorkloadOpts
+		wanted bool
+	}{
+		"no policies": {
+			in: WorkloadOpts{
+				NestedStack: &WorkloadNestedStackOpts{
+					VariableOutputs: []string{"MyTableName"},
+				},
+				Storage: &StorageOpts{},
+			},
+			wanted: false,
+		},
+		"nested has policies": {
+			in: WorkloadOpts{
+				NestedStack: &WorkloadNestedStackOpts{
+					PolicyOutputs: []string{"MyTableAccessPolicy"},
+				},
+			},
+			wanted: true,
+		},
+		"workload accesses environment addons": {
+			in: WorkloadOpts{
+				Storage: &StorageOpts{
+					EnvAddons: []string{"users"},
+				},
+			},
+			wanted: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, hasManagedPolicies(tc.in))
+		})
+	}
+}
+
+func TestSecret_ValueFrom(t *testing.T) {
+	testCases := map[string]struct {
+		in                Secret
+		wantedRequiresSub bool
+		wantedValueFrom   string
+	}{
+		"ssm parameter name": {
+			in:              SecretFromSSMOrARN("/copilot/myapp/test/secrets/db_password"),
+			wantedValueFrom: "/copilot/myapp/test/secrets/db_password",
+		},
+		"secrets manager name": {
+			in:                SecretFromSecretsManager("/copilot/myapp/test/secrets/db", ""),
+			wantedRequiresSub: true,
+			wantedValueFrom:   "/copilot/myapp/test/secrets/db",
+		},
+		"secrets manager name with json key": {
+			in:                SecretFromSecretsManager("/copilot/myapp/test/secrets/db", "password"),
+			wantedRequiresSub: true,
+			wantedValueFrom:   "/copilot/myapp/test/secrets/db:password::",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wantedRequiresSub, tc.in.RequiresSub())
+			require.Equal(t, tc.wantedValueFrom, tc.in.ValueFrom())
+		})
+	}
+}
+
 func TestTemplate_ParseNetwork(t *testing.T) {
 	type cfn struct {
 		Resources struct {
@@ -0,0 +1,287 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package progress
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/codedeploy"
+	"github.com/aws/copilot-cli/internal/pkg/stream"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"golang.org/x/sync/errgroup"
+)
+
+// codeDeployDeploymentIDPrefix is the prefix of all CodeDeploy deployment IDs.
+const codeDeployDeploymentIDPrefix = "d-"
+
+// BlueGreenDeploymentSubscriber is the interface to subscribe channels to CodeDeploy blue/green deployment descriptions.
+type BlueGreenDeploymentSubscriber interface {
+	Subscribe() <-chan stream.BlueGreenDeployment
+}
+
+// BlueGreenDeploymentConfig holds the required parameters to create a blue/green deployment component.
+type BlueGreenDeploymentConfig struct {
+	// Common configuration.
+	Description string
+	RenderOpts  RenderOptions
+	Group       *errgroup.Group // Existing group to catch BlueGreenDeploymentStreamer errors.
+	Ctx         context.Context // Context for the BlueGreenDeploymentStreamer.
+
+	// Blue/green deployment action configuration.
+	ActionStreamer  StackSubscriber
+	ActionLogicalID string
+
+	// CodeDeploy configuration.
+	Deployer stream.CodeDeployDeploymentDescriber
+}
+
+// ListeningBlueGreenDeploymentRenderer returns a component that listens for CloudFormation resource events
+// of a blue/green deployment action, and renders the traffic shifting of the CodeDeploy deployment started by the action.
+func ListeningBlueGreenDeploymentRenderer(conf BlueGreenDeploymentConfig) DynamicRenderer {
+	g := new(errgroup.Group)
+	ctx := context.Background()
+	if conf.Group != nil {
+		g = conf.Group
+	}
+	if conf.Ctx != nil {
+		ctx = conf.Ctx
+	}
+	comp := &blueGreenDeploymentComponent{
+		cfnStream: conf.ActionStreamer.Subscribe(),
+		deployer:  conf.Deployer,
+		logicalID: conf.ActionLogicalID,
+
+		group:      g,
+		ctx:        ctx,
+		renderOpts: conf.RenderOpts,
+		resourceRenderer: ListeningResourceRenderer(conf.ActionStreamer, conf.ActionLogicalID, conf.Description, ResourceRendererOpts{
+			RenderOpts: conf.RenderOpts,
+		}),
+		done: make(chan struct{}),
+	}
+	comp.newDeploymentRender = comp.newListeningTrafficShiftRenderer
+	go comp.Listen()
+	return comp
+}
+
+// ListeningTrafficShiftRenderer renders the task sets of a CodeDeploy blue/green deployment as traffic is shifted.
+func ListeningTrafficShiftRenderer(streamer BlueGreenDeploymentSubscriber, opts RenderOptions) DynamicRenderer {
+	c := &trafficShiftComponent{
+		padding: opts.Padding,
+		stream:  streamer.Subscribe(),
+		done:    make(chan struct{}),
+	}
+	go c.Listen()
+	return c
+}
+
+// blueGreenDeploymentComponent can display a blue/green deployment started with CloudFormation.
+type blueGreenDeploymentComponent struct {
+	// Required inputs.
+	cfnStream <-chan stream.StackEvent             // Subscribed stream to initialize the deploymentRenderer.
+	deployer  stream.CodeDeployDeploymentDescriber // Client needed to create a BlueGreenDeploymentStreamer.
+	logicalID string                               // LogicalID for the deployment action.
+
+	// Optional inputs.
+	group      *errgroup.Group // Existing group to catch BlueGreenDeploymentStreamer errors.
+	ctx        context.Context // Context for the BlueGreenDeploymentStreamer.
+	renderOpts RenderOptions
+
+	// Sub-components.
+	resourceRenderer   DynamicRenderer
+	deploymentRenderer Renderer
+
+	done                chan struct{}
+	mu                  sync.Mutex
+	newDeploymentRender func(deploymentID string) DynamicRenderer // Overriden in tests.
+}
+
+// Listen creates a deploymentRenderer once the deployment action started a CodeDeploy deployment.
+// It closes the Done channel if the CFN resource is Done and the deploymentRenderer is also Done.
+func (c *blueGreenDeploymentComponent) Listen() {
+	renderers := []DynamicRenderer{c.resourceRenderer}
+	var deployment DynamicRenderer
+	for ev := range c.cfnStream {
+		if c.logicalID != ev.LogicalResourceID {
+			continue
+		}
+		if !cloudformation.StackStatus(ev.ResourceStatus).Success() || deployment != nil {
+			continue
+		}
+		if !strings.HasPrefix(ev.PhysicalResourceID, codeDeployDeploymentIDPrefix) {
+			// The action did not start a deployment, for example when the service is created.
+			continue
+		}
+		deployment = c.newDeploymentRender(ev.PhysicalResourceID)
+		c.mu.Lock()
+		c.deploymentRenderer = deployment
+		c.mu.Unlock()
+		renderers = append(renderers, deployment)
+	}
+
+	// Close the done channel once all the renderers are done listening.
+	for _, r := range renderers {
+		<-r.Done()
+	}
+	close(c.done)
+}
+
+// Render writes the status of the CloudFormation deployment action, followed with the traffic shifting
+// of the CodeDeploy deployment if one was started.
+func (c *blueGreenDeploymentComponent) Render(out io.Writer) (numLines int, err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	buf := new(bytes.Buffer)
+
+	nl, err := c.resourceRenderer.Render(buf)
+	if err != nil {
+		return 0, err
+	}
+	numLines += nl
+
+	var deploymentRenderer Renderer = &noopComponent{}
+	if c.deploymentRenderer != nil {
+		deploymentRenderer = c.deploymentRenderer
+	}
+
+	sw := &suffixWriter{
+		buf:    buf,
+		suffix: []byte{'\t', '\t'}, // Add two columns to the deployment renderer so that it aligns with resources.
+	}
+	nl, err = deploymentRenderer.Render(sw)
+	if err != nil {
+		return 0, err
+	}
+	numLines += nl
+
+	if _, err = buf.WriteTo(out); err != nil {
+		return 0, err
+	}
+	return numLines, nil
+}
+
+// Done returns a channel that's closed when there are no more events to Listen.
+func (c *blueGreenDeploymentComponent) Done() <-chan struct{} {
+	return c.done
+}
+
+func (c *blueGreenDeploymentComponent) newListeningTrafficShiftRenderer(deploymentID string) DynamicRenderer {
+	streamer := stream.NewBlueGreenDeploymentStreamer(c.deployer, deploymentID)
+	renderer := ListeningTrafficShiftRenderer(streamer, NestedRenderOptions(c.renderOpts))
+	c.group.Go(func() error {
+		return stream.Stream(c.ctx, streamer)
+	})
+	return renderer
+}
+
+type trafficShiftComponent struct {
+	// Data to render.
+	status   string
+	errMsg   string
+	taskSets []codedeploy.ECSTaskSet
+
+	// Style configuration for the component.
+	padding int
+
+	stream <-chan stream.BlueGreenDeployment // Channel where deployment descriptions are received.
+	done   chan struct{}                     // Channel that's closed when there are no more events to listen on.
+	mu     sync.Mutex                        // Lock used to mutate data to render.
+}
+
+// Listen keeps the latest description of the deployment as events are streamed.
+func (c *trafficShiftComponent) Listen() {
+	for ev := range c.stream {
+		c.mu.Lock()
+		c.status = ev.Status
+		c.errMsg = ev.ErrorMessage
+		if len(ev.TaskSets) > 0 {
+			// Task sets are not reported anymore once the original tasks are terminated, keep the last known ones.
+			c.taskSets = ev.TaskSets
+		}
+		c.mu.Unlock()
+	}
+	close(c.done)
+}
+
+// Render prints the task sets of the deployment as a tableComponent and then the error message as singleLineComponents.
+func (c *trafficShiftComponent) Render(out io.Writer) (numLines int, err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	buf := new(bytes.Buffer)
+
+	nl, err := c.renderTaskSets(buf)
+	if err != nil {
+		return 0, err
+	}
+	numLines += nl
+
+	nl, err = c.renderErrMsg(buf)
+	if err != nil {
+		return 0, err
+	}
+	numLines += nl
+
+	if _, err := buf.WriteTo(out); err != nil {
+		return 0, fmt.Errorf("render traffic shift component to writer: %w", err)
+	}
+	return numLines, nil
+}
+
+// Done returns a channel that's closed when there are no more events to listen.
+func (c *trafficShiftComponent) Done() <-chan struct{} {
+	return c.done
+}
+
+func (c *trafficShiftComponent) renderTaskSets(out io.Writer) (numLines int, err error) {
+	header := []string{"", "Traffic", "Desired", "Running", "Pending"}
+	var rows [][]string
+	for _, ts := range c.taskSets {
+		rows = append(rows, []string{
+			strings.ToUpper(ts.Label),
+			fmt.Sprintf("%s%%", strconv.FormatFloat(ts.TrafficWeight, 'f', -1, 64)),
+			strconv.Itoa(ts.DesiredCount),
+			strconv.Itoa(ts.RunningCount),
+			strconv.Itoa(ts.PendingCount),
+		})
+	}
+	title := "Traffic shifting"
+	if c.status != "" {
+		title = fmt.Sprintf("%s [%s]", title, strings.ToLower(c.status))
+	}
+	table := newTableComponent(color.Faint.Sprintf(title), header, rows)
+	table.Padding = c.padding
+	nl, err := table.Render(out)
+	if err != nil {
+		return 0, fmt.Errorf("render task sets table: %w", err)
+	}
+	return nl, err
+}
+
+func (c *trafficShiftComponent) renderErrMsg(out io.Writer) (numLines int, err error) {
+	if c.errMsg == "" {
+		return 0, nil
+	}
+	components := []Renderer{
+		&singleLineComponent{}, // Add an empty line before rendering the error message.
+		&singleLineComponent{
+			Text:    fmt.Sprintf("%s%s", color.DullRed.Sprintf("✘ "), color.Faint.Sprintf("Deployment error")),
+			Padding: c.padding,
+		},
+	}
+	for _, truncatedMsg := range splitByLength(c.errMsg, maxCellLength) {
+		components = append(components, &singleLineComponent{
+			Text:    truncatedMsg,
+			Padding: c.padding + nestedComponentPadding,
+		})
+	}
+	return renderComponents(out, components)
+}
@@ -0,0 +1,270 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package progress
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/codedeploy"
+	"github.com/aws/copilot-cli/internal/pkg/stream"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/sync/errgroup"
+)
+
+func TestBlueGreenDeploymentComponent_Listen(t *testing.T) {
+	t.Run("should create a deployment renderer once the action started a deployment", func(t *testing.T) {
+		// GIVEN
+		ch := make(chan stream.StackEvent)
+		deploymentDone := make(chan struct{})
+		resourceDone := make(chan struct{})
+		var deploymentIDs []string
+		c := &blueGreenDeploymentComponent{
+			cfnStream: ch,
+			logicalID: "BlueGreenDeploymentAction",
+			group:     new(errgroup.Group),
+			ctx:       context.Background(),
+			done:      make(chan struct{}),
+			resourceRenderer: &mockDynamicRenderer{
+				done: resourceDone,
+			},
+			newDeploymentRender: func(deploymentID string) DynamicRenderer {
+				deploymentIDs = append(deploymentIDs, deploymentID)
+				return &mockDynamicRenderer{
+					done: deploymentDone,
+				}
+			},
+		}
+
+		// WHEN
+		go c.Listen()
+		go func() {
+			ch <- stream.StackEvent{
+				LogicalResourceID:  "BlueGreenDeploymentAction",
+				PhysicalResourceID: "bluegreen/stack/BlueGreenDeploymentAction",
+				ResourceStatus:     "UPDATE_IN_PROGRESS",
+			}
+			ch <- stream.StackEvent{
+				LogicalResourceID:  "Service",
+				PhysicalResourceID: "d-ABCDEF123",
+				ResourceStatus:     "UPDATE_COMPLETE",
+			}
+			ch <- stream.StackEvent{
+				LogicalResourceID:  "BlueGreenDeploymentAction",
+				PhysicalResourceID: "d-ABCDEF123",
+				ResourceStatus:     "UPDATE_COMPLETE",
+			}
+			close(resourceDone)
+			close(deploymentDone)
+			close(ch)
+		}()
+
+		// THEN
+		<-c.done // Wait for listen to exit.
+		require.NotNil(t, c.deploymentRenderer, "expected the deployment renderer to be initialized")
+		require.Equal(t, []string{"d-ABCDEF123"}, deploymentIDs, "expected only one deployment renderer to be created")
+	})
+	t.Run("should not create a deployment renderer if the action did not start a deployment", func(t *testing.T) {
+		// GIVEN
+		ch := make(chan stream.StackEvent)
+		resourceDone := make(chan struct{})
+		c := &blueGreenDeploymentComponent{
+			cfnStream: ch,
+			logicalID: "BlueGreenDeploymentAction",
+			group:     new(errgroup.Group),
+			ctx:       context.Background(),
+			done:      make(chan struct{}),
+			resourceRenderer: &mockDynamicRenderer{
+				done: resourceDone,
+			},
+			newDeploymentRender: func(deploymentID string) DynamicRenderer {
+				require.Fail(t, "unexpected deployment renderer")
+				return nil
+			},
+		}
+
+		// WHEN
+		go c.Listen()
+		go func() {
+			ch <- stream.StackEvent{
+				LogicalResourceID:  "BlueGreenDeploymentAction",
+				PhysicalResourceID: "bluegreen/stack/BlueGreenDeploymentAction",
+				ResourceStatus:     "CREATE_COMPLETE",
+			}
+			close(resourceDone)
+			close(ch)
+		}()
+
+		// THEN
+		<-c.done // Wait for listen to exit.
+		require.Nil(t, c.deploymentRenderer)
+	})
+}
+
+func TestBlueGreenDeploymentComponent_Render(t *testing.T) {
+	t.Run("renders only the resource renderer if there is no deployment", func(t *testing.T) {
+		// GIVEN
+		buf := new(strings.Builder)
+		c := &blueGreenDeploymentComponent{
+			resourceRenderer: &mockDynamicRenderer{
+				content: "resource\n",
+			},
+		}
+
+		// WHEN
+		nl, err := c.Render(buf)
+
+		// THEN
+		require.Nil(t, err)
+		require.Equal(t, 1, nl)
+		require.Equal(t, "resource\n", buf.String())
+	})
+	t.Run("renders both resource and deployment if a deployment was started", func(t *testing.T) {
+		// GIVEN
+		buf := new(strings.Builder)
+		c := &blueGreenDeploymentComponent{
+			resourceRenderer: &mockDynamicRenderer{
+				content: "resource\n",
+			},
+			deploymentRenderer: &mockDynamicRenderer{
+				content: "deployment\n",
+			},
+		}
+
+		// WHEN
+		nl, err := c.Render(buf)
+
+		// THEN
+		require.Nil(t, err)
+		require.Equal(t, 2, nl)
+		require.Equal(t, "resource\n"+
+			"deployment\t\t\n", buf.String())
+	})
+}
+
+func TestTrafficShiftComponent_Listen(t *testing.T) {
+	// GIVEN
+	events := make(chan stream.BlueGreenDeployment)
+	done := make(chan struct{})
+	c := &trafficShiftComponent{
+		stream: events,
+		done:   done,
+	}
+
+	// WHEN
+	go c.Listen()
+	go func() {
+		events <- stream.BlueGreenDeployment{
+			Status: "InProgress",
+			TaskSets: []codedeploy.ECSTaskSet{
+				{
+					Label:         "Blue",
+					DesiredCount:  2,
+					RunningCount:  2,
+					TrafficWeight: 100,
+				},
+			},
+		}
+		events <- stream.BlueGreenDeployment{
+			Status:       "Stopped",
+			ErrorMessage: "One or more alarms have been activated",
+		}
+		close(events)
+	}()
+
+	// THEN
+	<-done // Listen should have closed the channel.
+	require.Equal(t, "Stopped", c.status)
+	require.Equal(t, "One or more alarms have been activated", c.errMsg)
+	require.Equal(t, []codedeploy.ECSTaskSet{
+		{
+			Label:         "Blue",
+			DesiredCount:  2,
+			RunningCount:  2,
+			TrafficWeight: 100,
+		},
+	}, c.taskSets, "expected the last known task sets to be kept")
+}
+
+func TestTrafficShiftComponent_Render(t *testing.T) {
+	testCases := map[string]struct {
+		inStatus   string
+		inErrMsg   string
+		inTaskSets []codedeploy.ECSTaskSet
+
+		wantedNumLines int
+		wantedOut      string
+	}{
+		"renders nothing if the service wasn't picked up by the deployment yet": {
+			inStatus: "Created",
+		},
+		"renders the task sets with their traffic weights": {
+			inStatus: "InProgress",
+			inTaskSets: []codedeploy.ECSTaskSet{
+				{
+					Label:         "Blue",
+					DesiredCount:  2,
+					RunningCount:  2,
+					TrafficWeight: 90,
+				},
+				{
+					Label:         "Green",
+					DesiredCount:  2,
+					RunningCount:  1,
+					PendingCount:  1,
+					TrafficWeight: 10,
+				},
+			},
+
+			wantedNumLines: 4,
+			wantedOut: `Traffic shifting [inprogress]
+         Traffic  Desired  Running  Pending
+  BLUE   90%      2        2        0
+  GREEN  10%      2        1        1
+`,
+		},
+		"renders the error message of a stopped deployment": {
+			inStatus: "Stopped",
+			inErrMsg: "One or more alarms have been activated",
+			inTaskSets: []codedeploy.ECSTaskSet{
+				{
+					Label:         "Blue",
+					DesiredCount:  2,
+					RunningCount:  2,
+					TrafficWeight: 100,
+				},
+			},
+
+			wantedNumLines: 6,
+			wantedOut: `Traffic shifting [stopped]
+        Traffic  Desired  Running  Pending
+  BLUE  100%     2        2        0
+
+✘ Deployment error
+  One or more alarms have been activated
+`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			buf := new(strings.Builder)
+			c := &trafficShiftComponent{
+				status:   tc.inStatus,
+				errMsg:   tc.inErrMsg,
+				taskSets: tc.inTaskSets,
+			}
+
+			// WHEN
+			nl, err := c.Render(buf)
+
+			// THEN
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedNumLines, nl, "number of lines expected did not match")
+			require.Equal(t, tc.wantedOut, buf.String(), "the content written did not match")
+		})
+	}
+}
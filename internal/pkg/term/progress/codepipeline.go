@@ -0,0 +1,174 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package progress
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
+	"github.com/aws/copilot-cli/internal/pkg/stream"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+)
+
+const (
+	pipelineActionStatusInProgress = "InProgress"
+)
+
+// PipelineExecutionSubscriber is the interface to subscribe channels to pipeline execution descriptions.
+type PipelineExecutionSubscriber interface {
+	Subscribe() <-chan stream.PipelineExecution
+}
+
+// ListeningPipelineExecutionRenderer renders the progress of the stages and actions of a pipeline execution,
+// followed by the latest lines of the log of the running build.
+func ListeningPipelineExecutionRenderer(streamer PipelineExecutionSubscriber, opts RenderOptions) DynamicRenderer {
+	c := &pipelineExecutionComponent{
+		padding: opts.Padding,
+		clock:   realClock{},
+		stream:  streamer.Subscribe(),
+		done:    make(chan struct{}),
+	}
+	go c.Listen()
+	return c
+}
+
+type pipelineExecutionComponent struct {
+	// Data to render.
+	execution stream.PipelineExecution
+
+	// Style configuration for the component.
+	padding int
+	clock   clock
+
+	stream <-chan stream.PipelineExecution // Channel where execution events are received.
+	done   chan struct{}                   // Channel that's closed when there are no more events to listen on.
+	mu     sync.Mutex                      // Lock used to mutate data to render.
+}
+
+// Listen updates the pipeline execution as events are streamed.
+func (c *pipelineExecutionComponent) Listen() {
+	for ev := range c.stream {
+		c.mu.Lock()
+		c.execution = ev
+		c.mu.Unlock()
+	}
+	close(c.done)
+}
+
+// Render prints a tableComponent for each stage and then the latest build logs as singleLineComponents.
+func (c *pipelineExecutionComponent) Render(out io.Writer) (numLines int, err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	buf := new(bytes.Buffer)
+
+	nl, err := c.renderStages(buf)
+	if err != nil {
+		return 0, err
+	}
+	numLines += nl
+
+	nl, err = c.renderBuildLogs(buf)
+	if err != nil {
+		return 0, err
+	}
+	numLines += nl
+
+	if _, err := buf.WriteTo(out); err != nil {
+		return 0, fmt.Errorf("render pipeline execution component to writer: %w", err)
+	}
+	return numLines, nil
+}
+
+// Done returns a channel that's closed when there are no more events to listen.
+func (c *pipelineExecutionComponent) Done() <-chan struct{} {
+	return c.done
+}
+
+func (c *pipelineExecutionComponent) renderStages(out io.Writer) (numLines int, err error) {
+	header := []string{"", "Status", "Duration"}
+	for _, stage := range c.execution.Stages {
+		var rows [][]string
+		for _, action := range stage.Actions {
+			rows = append(rows, []string{
+				action.ActionName,
+				prettifyActionStatus(action.Status),
+				c.actionDuration(action),
+			})
+		}
+		if len(rows) == 0 {
+			rows = append(rows, []string{"", "[not started]", ""})
+		}
+		table := newTableComponent(color.Faint.Sprintf(stage.Name), header, rows)
+		table.Padding = c.padding
+		nl, err := table.Render(out)
+		if err != nil {
+			return 0, fmt.Errorf("render stage %s table: %w", stage.Name, err)
+		}
+		numLines += nl
+	}
+	return numLines, nil
+}
+
+func (c *pipelineExecutionComponent) renderBuildLogs(out io.Writer) (numLines int, err error) {
+	if len(c.execution.BuildLogs) == 0 {
+		return 0, nil
+	}
+
+	components := []Renderer{
+		&singleLineComponent{}, // Add an empty line before rendering build logs.
+		&singleLineComponent{
+			Text:    color.Faint.Sprintf("Latest logs of %s", c.execution.BuildAction),
+			Padding: c.padding,
+		},
+	}
+	for _, line := range c.execution.BuildLogs {
+		for _, truncated := range splitByLength(line, maxCellLength) {
+			components = append(components, &singleLineComponent{
+				Text:    truncated,
+				Padding: c.padding + nestedComponentPadding,
+			})
+		}
+	}
+	return renderComponents(out, components)
+}
+
+// actionDuration returns how long the action took, or for how long it's been running if it's still in progress.
+func (c *pipelineExecutionComponent) actionDuration(action codepipeline.ActionExecution) string {
+	if action.StartedAt.IsZero() {
+		return ""
+	}
+	end := action.UpdatedAt
+	if action.Status == pipelineActionStatusInProgress {
+		end = c.clock.now()
+	}
+	return end.Sub(action.StartedAt).Round(time.Second).String()
+}
+
+func prettifyActionStatus(status string) string {
+	// Split the camel case status, e.g. "InProgress" becomes "in progress".
+	var words []string
+	start := 0
+	for i, r := range status {
+		if i > 0 && unicode.IsUpper(r) {
+			words = append(words, status[start:i])
+			start = i
+		}
+	}
+	words = append(words, status[start:])
+	pretty := fmt.Sprintf("[%s]", strings.ToLower(strings.Join(words, " ")))
+	switch status {
+	case "Succeeded":
+		return color.Green.Sprint(pretty)
+	case "Failed", "Abandoned":
+		return color.Red.Sprint(pretty)
+	default:
+		return pretty
+	}
+}
@@ -0,0 +1,130 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package progress
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
+	"github.com/aws/copilot-cli/internal/pkg/stream"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPipelineExecutionComponent_Listen(t *testing.T) {
+	// GIVEN
+	events := make(chan stream.PipelineExecution)
+	done := make(chan struct{})
+	c := &pipelineExecutionComponent{
+		stream: events,
+		done:   done,
+	}
+
+	// WHEN
+	go c.Listen()
+	go func() {
+		events <- stream.PipelineExecution{
+			Status: "InProgress",
+		}
+		events <- stream.PipelineExecution{
+			Status: "Succeeded",
+		}
+		close(events)
+	}()
+
+	// THEN
+	<-done // Listen should have closed the channel.
+	require.Equal(t, stream.PipelineExecution{Status: "Succeeded"}, c.execution, "expected only the latest execution to be stored")
+}
+
+func TestPipelineExecutionComponent_Render(t *testing.T) {
+	testCases := map[string]struct {
+		inExecution stream.PipelineExecution
+
+		wantedNumLines int
+		wantedOut      string
+	}{
+		"should render each stage with the status and duration of its actions": {
+			inExecution: stream.PipelineExecution{
+				Status: "InProgress",
+				Stages: []stream.PipelineStageExecution{
+					{
+						Name: "Source",
+						Actions: []codepipeline.ActionExecution{
+							{
+								ActionName: "SourceCodeFor-dinder",
+								Status:     "Succeeded",
+								StartedAt:  testDate,
+								UpdatedAt:  testDate.Add(30 * time.Second),
+							},
+						},
+					},
+					{
+						Name: "Build",
+						Actions: []codepipeline.ActionExecution{
+							{
+								ActionName: "Build",
+								Status:     "InProgress",
+								StartedAt:  testDate.Add(30 * time.Second),
+								UpdatedAt:  testDate.Add(30 * time.Second),
+							},
+						},
+					},
+					{
+						Name: "DeployTo-test",
+					},
+				},
+			},
+
+			wantedNumLines: 9,
+			wantedOut: `Source
+                        Status       Duration
+  SourceCodeFor-dinder  [succeeded]  30s
+Build
+         Status         Duration
+  Build  [in progress]  1m30s
+DeployTo-test
+    Status         Duration
+    [not started]  
+`,
+		},
+		"should render the latest build logs": {
+			inExecution: stream.PipelineExecution{
+				Status:      "InProgress",
+				BuildAction: "Build",
+				BuildLogs: []string{
+					"[Container] Running command make test",
+				},
+			},
+
+			wantedNumLines: 3,
+			wantedOut: `
+Latest logs of Build
+  [Container] Running command make test
+`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			buf := new(strings.Builder)
+			c := &pipelineExecutionComponent{
+				execution: tc.inExecution,
+				clock: &fakeClock{
+					wantedValues: []time.Time{testDate.Add(2 * time.Minute)},
+				},
+			}
+
+			// WHEN
+			nl, err := c.Render(buf)
+
+			// THEN
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedNumLines, nl, "number of lines expected did not match")
+			require.Equal(t, tc.wantedOut, buf.String(), "the content written did not match")
+		})
+	}
+}
@@ -0,0 +1,280 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package progress
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/stream"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	maxTaskLogLinesToDisplay = 10 // Total number of log lines we want to display at most for a one-off task.
+
+	// How long to wait for the last log lines of a hook once its CloudFormation resource is done.
+	taskLogsGracePeriod = 10 * time.Second
+)
+
+// ECSTaskLogsSubscriber is the interface to subscribe channels to the logs of ECS tasks.
+type ECSTaskLogsSubscriber interface {
+	Subscribe() <-chan stream.ECSTaskLogs
+}
+
+// PreHookConfig holds the required parameters to create a pre-deployment hook component.
+type PreHookConfig struct {
+	// Common configuration.
+	Description string
+	RenderOpts  RenderOptions
+	Group       *errgroup.Group // Existing group to catch ECSTaskLogStreamer errors.
+	Ctx         context.Context // Context for the ECSTaskLogStreamer.
+
+	// Pre-deployment hook action configuration.
+	ActionStreamer  StackSubscriber
+	ActionLogicalID string
+
+	// Task logs configuration.
+	TaskLister stream.ECSTaskLister
+	LogsGetter stream.LogEventsGetter
+	LogsConfig stream.ECSTaskLogStreamerConfig
+}
+
+// ListeningPreHookRenderer returns a component that listens for CloudFormation resource events
+// of a pre-deployment hook action, and renders the logs of the one-off task run by the hook.
+func ListeningPreHookRenderer(conf PreHookConfig) DynamicRenderer {
+	g := new(errgroup.Group)
+	ctx := context.Background()
+	if conf.Group != nil {
+		g = conf.Group
+	}
+	if conf.Ctx != nil {
+		ctx = conf.Ctx
+	}
+	comp := &preHookComponent{
+		cfnStream:  conf.ActionStreamer.Subscribe(),
+		taskLister: conf.TaskLister,
+		logsGetter: conf.LogsGetter,
+		logsConf:   conf.LogsConfig,
+		logicalID:  conf.ActionLogicalID,
+
+		group:       g,
+		ctx:         ctx,
+		renderOpts:  conf.RenderOpts,
+		gracePeriod: taskLogsGracePeriod,
+		resourceRenderer: ListeningResourceRenderer(conf.ActionStreamer, conf.ActionLogicalID, conf.Description, ResourceRendererOpts{
+			RenderOpts: conf.RenderOpts,
+		}),
+		done: make(chan struct{}),
+	}
+	comp.newLogsRender = comp.newListeningTaskLogsRenderer
+	go comp.Listen()
+	return comp
+}
+
+// ListeningTaskLogsRenderer renders the latest log lines of one-off ECS tasks.
+func ListeningTaskLogsRenderer(streamer ECSTaskLogsSubscriber, opts RenderOptions) DynamicRenderer {
+	c := &taskLogsComponent{
+		padding:     opts.Padding,
+		maxLenLines: maxTaskLogLinesToDisplay,
+		stream:      streamer.Subscribe(),
+		done:        make(chan struct{}),
+	}
+	go c.Listen()
+	return c
+}
+
+// preHookComponent can display a pre-deployment hook created with CloudFormation.
+type preHookComponent struct {
+	// Required inputs.
+	cfnStream  <-chan stream.StackEvent        // Subscribed stream to initialize the logsRenderer.
+	taskLister stream.ECSTaskLister            // Client needed to find the task run by the hook.
+	logsGetter stream.LogEventsGetter          // Client needed to read the logs of the task.
+	logsConf   stream.ECSTaskLogStreamerConfig // Where to find the task and its logs.
+	logicalID  string                          // LogicalID for the hook action.
+
+	// Optional inputs.
+	group       *errgroup.Group // Existing group to catch ECSTaskLogStreamer errors.
+	ctx         context.Context // Context for the ECSTaskLogStreamer.
+	renderOpts  RenderOptions
+	gracePeriod time.Duration // How long to wait for the logs once the hook action is done.
+
+	// Sub-components.
+	resourceRenderer DynamicRenderer
+	logsRenderer     Renderer
+
+	done          chan struct{}
+	mu            sync.Mutex
+	newLogsRender func(time.Time) (DynamicRenderer, context.CancelFunc) // Overriden in tests.
+}
+
+// Listen creates a logsRenderer when the hook action starts running.
+// It closes the Done channel if the CFN resource is Done and the logsRenderer is also Done.
+func (c *preHookComponent) Listen() {
+	renderers := []DynamicRenderer{c.resourceRenderer}
+	var logs DynamicRenderer
+	var cancelLogs context.CancelFunc
+	var stop sync.Once
+	for ev := range c.cfnStream {
+		if c.logicalID != ev.LogicalResourceID {
+			continue
+		}
+		status := cloudformation.StackStatus(ev.ResourceStatus)
+		switch {
+		case status.UpsertInProgress() && logs == nil:
+			logs, cancelLogs = c.newLogsRender(ev.Timestamp)
+			c.mu.Lock()
+			c.logsRenderer = logs
+			c.mu.Unlock()
+			renderers = append(renderers, logs)
+		case !status.InProgress() && logs != nil:
+			// The task is stopped by the time the hook action is done, let the logs renderer catch up and then stop it.
+			// If the task never ran, there are no logs to wait for and the renderer would otherwise never be done.
+			stop.Do(func() {
+				go c.stopLogs(logs, cancelLogs)
+			})
+		}
+	}
+	if logs != nil {
+		stop.Do(func() {
+			go c.stopLogs(logs, cancelLogs)
+		})
+	}
+
+	// Close the done channel once all the renderers are done listening.
+	for _, r := range renderers {
+		<-r.Done()
+	}
+	close(c.done)
+}
+
+// Render writes the status of the CloudFormation hook action, followed with the latest logs of the task.
+func (c *preHookComponent) Render(out io.Writer) (numLines int, err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	buf := new(bytes.Buffer)
+
+	nl, err := c.resourceRenderer.Render(buf)
+	if err != nil {
+		return 0, err
+	}
+	numLines += nl
+
+	var logsRenderer Renderer = &noopComponent{}
+	if c.logsRenderer != nil {
+		logsRenderer = c.logsRenderer
+	}
+
+	sw := &suffixWriter{
+		buf:    buf,
+		suffix: []byte{'\t', '\t'}, // Add two columns to the logs renderer so that it aligns with resources.
+	}
+	nl, err = logsRenderer.Render(sw)
+	if err != nil {
+		return 0, err
+	}
+	numLines += nl
+
+	if _, err = buf.WriteTo(out); err != nil {
+		return 0, err
+	}
+	return numLines, nil
+}
+
+// Done returns a channel that's closed when there are no more events to Listen.
+func (c *preHookComponent) Done() <-chan struct{} {
+	return c.done
+}
+
+func (c *preHookComponent) newListeningTaskLogsRenderer(startTime time.Time) (DynamicRenderer, context.CancelFunc) {
+	streamer := stream.NewECSTaskLogStreamer(c.taskLister, c.logsGetter, c.logsConf, startTime)
+	renderer := ListeningTaskLogsRenderer(streamer, NestedRenderOptions(c.renderOpts))
+	ctx, cancel := context.WithCancel(c.ctx)
+	c.group.Go(func() error {
+		if err := stream.Stream(ctx, streamer); err != nil {
+			if errors.Is(err, context.Canceled) {
+				// The task logs streamer was canceled on purpose, do not return an error.
+				// This occurs once the hook action is done.
+				return nil
+			}
+			return err
+		}
+		return nil
+	})
+	return renderer, cancel
+}
+
+func (c *preHookComponent) stopLogs(logs DynamicRenderer, cancel context.CancelFunc) {
+	select {
+	case <-logs.Done():
+	case <-time.After(c.gracePeriod):
+	}
+	cancel()
+}
+
+type taskLogsComponent struct {
+	// Data to render.
+	lines []string
+
+	// Style configuration for the component.
+	padding     int
+	maxLenLines int
+
+	stream <-chan stream.ECSTaskLogs // Channel where log lines are received.
+	done   chan struct{}             // Channel that's closed when there are no more events to listen on.
+	mu     sync.Mutex                // Lock used to mutate data to render.
+}
+
+// Listen keeps the latest log lines as events are streamed.
+func (c *taskLogsComponent) Listen() {
+	for ev := range c.stream {
+		c.mu.Lock()
+		c.lines = append(c.lines, ev.Messages...)
+		if len(c.lines) > c.maxLenLines {
+			c.lines = c.lines[len(c.lines)-c.maxLenLines:]
+		}
+		c.mu.Unlock()
+	}
+	close(c.done)
+}
+
+// Render prints a title followed by the latest log lines as singleLineComponents.
+func (c *taskLogsComponent) Render(out io.Writer) (numLines int, err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if len(c.lines) == 0 {
+		return 0, nil
+	}
+	components := []Renderer{
+		&singleLineComponent{
+			Text:    color.Faint.Sprintf("Latest task logs"),
+			Padding: c.padding,
+		},
+	}
+	for _, line := range c.lines {
+		// Tabs would break the alignment of the columns.
+		line = strings.ReplaceAll(strings.TrimRight(line, "\n"), "\t", " ")
+		for _, truncatedLine := range splitByLength(line, maxCellLength) {
+			components = append(components, &singleLineComponent{
+				Text:    truncatedLine,
+				Padding: c.padding + nestedComponentPadding,
+			})
+		}
+	}
+	return renderComponents(out, components)
+}
+
+// Done returns a channel that's closed when there are no more events to listen.
+func (c *taskLogsComponent) Done() <-chan struct{} {
+	return c.done
+}
@@ -0,0 +1,257 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package progress
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/stream"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/sync/errgroup"
+)
+
+func TestPreHookComponent_Listen(t *testing.T) {
+	t.Run("should create a logs renderer once the hook action goes into in progress and stop it when it's done", func(t *testing.T) {
+		// GIVEN
+		ch := make(chan stream.StackEvent)
+		logsDone := make(chan struct{})
+		resourceDone := make(chan struct{})
+		canceled := make(chan struct{})
+		var numRenderers int
+		c := &preHookComponent{
+			cfnStream:   ch,
+			logicalID:   "PreHookAction0",
+			group:       new(errgroup.Group),
+			ctx:         context.Background(),
+			gracePeriod: time.Hour,
+			done:        make(chan struct{}),
+			resourceRenderer: &mockDynamicRenderer{
+				done: resourceDone,
+			},
+			newLogsRender: func(t time.Time) (DynamicRenderer, context.CancelFunc) {
+				numRenderers += 1
+				return &mockDynamicRenderer{
+					done: logsDone,
+				}, func() {
+					close(canceled)
+				}
+			},
+		}
+
+		// WHEN
+		go c.Listen()
+		go func() {
+			ch <- stream.StackEvent{
+				LogicalResourceID: "PreHookAction0",
+				ResourceStatus:    "UPDATE_IN_PROGRESS",
+			}
+			ch <- stream.StackEvent{
+				LogicalResourceID: "PreHookAction0",
+				ResourceStatus:    "UPDATE_IN_PROGRESS",
+			}
+			close(logsDone)
+			ch <- stream.StackEvent{
+				LogicalResourceID: "PreHookAction0",
+				ResourceStatus:    "UPDATE_COMPLETE",
+			}
+			close(resourceDone)
+			close(ch)
+		}()
+
+		// THEN
+		<-c.done // Wait for listen to exit.
+		<-canceled
+		require.NotNil(t, c.logsRenderer, "expected the logs renderer to be initialized")
+		require.Equal(t, 1, numRenderers, "expected only one logs renderer to be created")
+	})
+	t.Run("should stop the logs renderer after the grace period if it's not done", func(t *testing.T) {
+		// GIVEN
+		ch := make(chan stream.StackEvent)
+		logsDone := make(chan struct{})
+		resourceDone := make(chan struct{})
+		c := &preHookComponent{
+			cfnStream: ch,
+			logicalID: "PreHookAction0",
+			group:     new(errgroup.Group),
+			ctx:       context.Background(),
+			done:      make(chan struct{}),
+			resourceRenderer: &mockDynamicRenderer{
+				done: resourceDone,
+			},
+			newLogsRender: func(t time.Time) (DynamicRenderer, context.CancelFunc) {
+				return &mockDynamicRenderer{
+					done: logsDone,
+				}, func() {
+					close(logsDone)
+				}
+			},
+		}
+
+		// WHEN
+		go c.Listen()
+		go func() {
+			ch <- stream.StackEvent{
+				LogicalResourceID: "PreHookAction0",
+				ResourceStatus:    "CREATE_IN_PROGRESS",
+			}
+			ch <- stream.StackEvent{
+				LogicalResourceID:    "PreHookAction0",
+				ResourceStatus:       "CREATE_FAILED",
+				ResourceStatusReason: "Failed to run task: RESOURCE:MEMORY",
+			}
+			close(resourceDone)
+			close(ch)
+		}()
+
+		// THEN
+		<-c.done // Wait for listen to exit.
+		require.NotNil(t, c.logsRenderer, "expected the logs renderer to be initialized")
+	})
+	t.Run("should not create a logs renderer if the hook action never goes in create or update in progress", func(t *testing.T) {
+		// GIVEN
+		ch := make(chan stream.StackEvent)
+		resourceDone := make(chan struct{})
+		c := &preHookComponent{
+			cfnStream: ch,
+			logicalID: "PreHookAction0",
+			group:     new(errgroup.Group),
+			ctx:       context.Background(),
+			done:      make(chan struct{}),
+			resourceRenderer: &mockDynamicRenderer{
+				done: resourceDone,
+			},
+			newLogsRender: func(t time.Time) (DynamicRenderer, context.CancelFunc) {
+				return &mockDynamicRenderer{}, func() {}
+			},
+		}
+
+		// WHEN
+		go c.Listen()
+		go func() {
+			ch <- stream.StackEvent{
+				LogicalResourceID: "Service",
+				ResourceStatus:    "UPDATE_IN_PROGRESS",
+			}
+			ch <- stream.StackEvent{
+				LogicalResourceID: "PreHookAction0",
+				ResourceStatus:    "DELETE_COMPLETE",
+			}
+			close(resourceDone)
+			close(ch)
+		}()
+
+		// THEN
+		<-c.done // Wait for listen to exit.
+		require.Nil(t, c.logsRenderer, "expected the logs renderer to be nil")
+	})
+}
+
+func TestPreHookComponent_Render(t *testing.T) {
+	t.Run("renders only the resource renderer if the hook action hasn't started", func(t *testing.T) {
+		// GIVEN
+		buf := new(strings.Builder)
+		c := &preHookComponent{
+			resourceRenderer: &mockDynamicRenderer{
+				content: "resource\n",
+			},
+		}
+
+		// WHEN
+		nl, err := c.Render(buf)
+
+		// THEN
+		require.Nil(t, err)
+		require.Equal(t, 1, nl)
+		require.Equal(t, "resource\n", buf.String())
+	})
+	t.Run("renders both resource and logs if the hook action is in progress", func(t *testing.T) {
+		// GIVEN
+		buf := new(strings.Builder)
+		c := &preHookComponent{
+			resourceRenderer: &mockDynamicRenderer{
+				content: "resource\n",
+			},
+			logsRenderer: &mockDynamicRenderer{
+				content: "logs\n",
+			},
+		}
+
+		// WHEN
+		nl, err := c.Render(buf)
+
+		// THEN
+		require.Nil(t, err)
+		require.Equal(t, 2, nl)
+		require.Equal(t, "resource\n"+
+			"logs\t\t\n", buf.String())
+	})
+}
+
+func TestTaskLogsComponent_Listen(t *testing.T) {
+	// GIVEN
+	events := make(chan stream.ECSTaskLogs)
+	done := make(chan struct{})
+	c := &taskLogsComponent{
+		maxLenLines: 2,
+		stream:      events,
+		done:        done,
+	}
+
+	// WHEN
+	go c.Listen()
+	go func() {
+		events <- stream.ECSTaskLogs{
+			Messages: []string{"line1", "line2"},
+		}
+		events <- stream.ECSTaskLogs{
+			Messages: []string{"line3"},
+		}
+		close(events)
+	}()
+
+	// THEN
+	<-done // Listen should have closed the channel.
+	require.Equal(t, []string{"line2", "line3"}, c.lines, "expected max len lines to be respected")
+}
+
+func TestTaskLogsComponent_Render(t *testing.T) {
+	testCases := map[string]struct {
+		inLines []string
+
+		wantedNumLines int
+		wantedOut      string
+	}{
+		"renders nothing if there are no logs": {},
+		"renders the latest log lines": {
+			inLines: []string{"applying migration 1", "applying\tmigration 2\n"},
+
+			wantedNumLines: 3,
+			wantedOut: `Latest task logs
+  applying migration 1
+  applying migration 2
+`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			buf := new(strings.Builder)
+			c := &taskLogsComponent{
+				lines: tc.inLines,
+			}
+
+			// WHEN
+			nl, err := c.Render(buf)
+
+			// THEN
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedNumLines, nl)
+			require.Equal(t, tc.wantedOut, buf.String())
+		})
+	}
+}
@@ -58,3 +58,13 @@ type errHasExistingApplication struct {
 func (e *errHasExistingApplication) Error() string {
 	return fmt.Sprintf("this workspace is already registered with application %s", e.existingAppName)
 }
+
+// errDuplicatePipelineName means two pipeline manifests in the workspace use the same name.
+type errDuplicatePipelineName struct {
+	name  string
+	paths []string
+}
+
+func (e *errDuplicatePipelineName) Error() string {
+	return fmt.Sprintf("pipeline name %s is used by both %s and %s", e.name, e.paths[0], e.paths[1])
+}
@@ -9,8 +9,12 @@
 //  │   ├── .workspace                 (workspace summary)
 //  │   └── my-service
 //  │   │   └── manifest.yml           (service manifest)
-//  │   ├── buildspec.yml              (buildspec for the pipeline's build stage)
-//  │   └── pipeline.yml               (pipeline manifest)
+//  │   ├── pipelines
+//  │   │   └── my-pipeline
+//  │   │       ├── manifest.yml       (pipeline manifest)
+//  │   │       └── buildspec.yml      (buildspec for the pipeline's build stage)
+//  │   ├── buildspec.yml              (legacy buildspec for the pipeline's build stage)
+//  │   └── pipeline.yml               (legacy pipeline manifest)
 //  └── my-service-src                 (customer service code)
 package workspace
 
@@ -35,6 +39,8 @@ const (
 	SummaryFileName = ".workspace"
 
 	addonsDirName             = "addons"
+	environmentsDirName       = "environments"
+	pipelinesDirName          = "pipelines"
 	maximumParentDirsToSearch = 5
 	pipelineFileName          = "pipeline.yml"
 	manifestFileName          = "manifest.yml"
@@ -50,6 +56,22 @@ type Summary struct {
 	Application string `yaml:"application"` // Name of the application.
 }
 
+// PipelineManifest holds identifying information about a pipeline manifest file.
+type PipelineManifest struct {
+	Name string // Name of the pipeline inside the manifest file.
+	Path string // Path to the manifest file for the pipeline.
+}
+
+// IsLegacy returns true if the manifest is the single pipeline manifest under copilot/pipeline.yml.
+func (m PipelineManifest) IsLegacy() bool {
+	return filepath.Base(m.Path) == pipelineFileName
+}
+
+// BuildspecPath returns the path to the buildspec file that accompanies the pipeline manifest.
+func (m PipelineManifest) BuildspecPath() string {
+	return filepath.Join(filepath.Dir(m.Path), buildspecFileName)
+}
+
 // Workspace typically represents a Git repository where the user has its infrastructure-as-code files as well as source files.
 type Workspace struct {
 	workingDir string
@@ -202,21 +224,94 @@ func (ws *Workspace) ReadWorkloadManifest(mftDirName string) (WorkloadManifest,
 	return mft, nil
 }
 
-// ReadPipelineManifest returns the contents of the pipeline manifest under copilot/pipeline.yml.
-func (ws *Workspace) ReadPipelineManifest() ([]byte, error) {
-	pmPath, err := ws.pipelineManifestPath()
+// ListPipelines returns all the pipeline manifests in the workspace.
+// The legacy manifest under copilot/pipeline.yml is listed first, followed by the manifests under
+// copilot/pipelines/{name}/manifest.yml sorted by name.
+// If there are no pipelines in the workspace, returns ErrNoPipelineInWorkspace.
+// If two manifests use the same pipeline name, returns an error.
+func (ws *Workspace) ListPipelines() ([]PipelineManifest, error) {
+	var pipelines []PipelineManifest
+	legacyPath, err := ws.pipelineManifestPath()
+	if err != nil {
+		return nil, err
+	}
+	legacyExists, err := ws.fsUtils.Exists(legacyPath)
+	if err != nil {
+		return nil, err
+	}
+	if legacyExists {
+		name, err := ws.pipelineName(legacyPath)
+		if err != nil {
+			return nil, err
+		}
+		pipelines = append(pipelines, PipelineManifest{
+			Name: name,
+			Path: legacyPath,
+		})
+	}
+
+	copilotPath, err := ws.copilotDirPath()
+	if err != nil {
+		return nil, err
+	}
+	pipelinesPath := filepath.Join(copilotPath, pipelinesDirName)
+	pipelinesDirExists, err := ws.fsUtils.DirExists(pipelinesPath)
 	if err != nil {
 		return nil, err
 	}
-	manifestExists, err := ws.fsUtils.Exists(pmPath)
+	if pipelinesDirExists {
+		files, err := ws.fsUtils.ReadDir(pipelinesPath)
+		if err != nil {
+			return nil, fmt.Errorf("read directory %s: %w", pipelinesPath, err)
+		}
+		for _, f := range files {
+			if !f.IsDir() {
+				continue
+			}
+			mftPath := filepath.Join(pipelinesPath, f.Name(), manifestFileName)
+			exists, err := ws.fsUtils.Exists(mftPath)
+			if err != nil {
+				return nil, err
+			}
+			if !exists {
+				continue
+			}
+			name, err := ws.pipelineName(mftPath)
+			if err != nil {
+				return nil, err
+			}
+			pipelines = append(pipelines, PipelineManifest{
+				Name: name,
+				Path: mftPath,
+			})
+		}
+	}
+	if len(pipelines) == 0 {
+		return nil, ErrNoPipelineInWorkspace
+	}
+	paths := make(map[string]string)
+	for _, pipeline := range pipelines {
+		if path, ok := paths[pipeline.Name]; ok {
+			return nil, &errDuplicatePipelineName{
+				name:  pipeline.Name,
+				paths: []string{path, pipeline.Path},
+			}
+		}
+		paths[pipeline.Name] = pipeline.Path
+	}
+	return pipelines, nil
+}
 
+// ReadPipelineManifest returns the contents of the pipeline manifest at the given path.
+func (ws *Workspace) ReadPipelineManifest(path string) ([]byte, error) {
+	manifestExists, err := ws.fsUtils.Exists(path)
 	if err != nil {
 		return nil, err
 	}
 	if !manifestExists {
 		return nil, ErrNoPipelineInWorkspace
 	}
-	return ws.read(pipelineFileName)
+	return ws.fsUtils.ReadFile(path)
 }
 
 // WriteServiceManifest writes the service's manifest under the copilot/{name}/ directory.
@@ -237,24 +332,24 @@ func (ws *Workspace) WriteJobManifest(marshaler encoding.BinaryMarshaler, name s
 	return ws.write(data, name, manifestFileName)
 }
 
-// WritePipelineBuildspec writes the pipeline buildspec under the copilot/ directory.
+// WritePipelineBuildspec writes the pipeline buildspec under the copilot/pipelines/{name}/ directory.
 // If successful returns the full path of the file, otherwise returns an empty string and the error.
-func (ws *Workspace) WritePipelineBuildspec(marshaler encoding.BinaryMarshaler) (string, error) {
+func (ws *Workspace) WritePipelineBuildspec(marshaler encoding.BinaryMarshaler, name string) (string, error) {
 	data, err := marshaler.MarshalBinary()
 	if err != nil {
 		return "", fmt.Errorf("marshal pipeline buildspec to binary: %w", err)
 	}
-	return ws.write(data, buildspecFileName)
+	return ws.write(data, pipelinesDirName, name, buildspecFileName)
 }
 
-// WritePipelineManifest writes the pipeline manifest under the copilot directory.
+// WritePipelineManifest writes the pipeline manifest under the copilot/pipelines/{name}/ directory.
 // If successful returns the full path of the file, otherwise returns an empty string and the error.
-func (ws *Workspace) WritePipelineManifest(marshaler encoding.BinaryMarshaler) (string, error) {
+func (ws *Workspace) WritePipelineManifest(marshaler encoding.BinaryMarshaler, name string) (string, error) {
 	data, err := marshaler.MarshalBinary()
 	if err != nil {
 		return "", fmt.Errorf("marshal pipeline manifest to binary: %w", err)
 	}
-	return ws.write(data, pipelineFileName)
+	return ws.write(data, pipelinesDirName, name, manifestFileName)
 }
 
 // DeleteWorkspaceFile removes the .workspace file under copilot/ directory.
@@ -297,6 +392,74 @@ func (ws *Workspace) WriteAddon(content encoding.BinaryMarshaler, svc, name stri
 	return ws.write(data, svc, addonsDirName, fname)
 }
 
+// ReadEnvironmentAddonsDir returns a list of file names under the "environments/addons/" directory.
+// These addons are shared by all the workloads in an environment.
+func (ws *Workspace) ReadEnvironmentAddonsDir() ([]string, error) {
+	return ws.ReadAddonsDir(environmentsDirName)
+}
+
+// ReadEnvironmentAddon returns the contents of a file under the "environments/addons/" directory.
+func (ws *Workspace) ReadEnvironmentAddon(fname string) ([]byte, error) {
+	return ws.ReadAddon(environmentsDirName, fname)
+}
+
+// WriteEnvironmentAddon writes the content of an addon file under "environments/addons/{name}.yml".
+// If successful returns the full path of the file, otherwise an empty string and an error.
+func (ws *Workspace) WriteEnvironmentAddon(content encoding.BinaryMarshaler, name string) (string, error) {
+	return ws.WriteAddon(content, environmentsDirName, name)
+}
+
+// File is a file under the copilot directory.
+type File struct {
+	Path string // Path to the file relative to the root of the workspace, separated by slashes.
+	Data []byte
+}
+
+// Name returns the path to the file relative to the root of the workspace.
+func (f File) Name() string {
+	return f.Path
+}
+
+// Content returns the contents of the file.
+func (f File) Content() []byte {
+	return f.Data
+}
+
+// ReadCopilotDir returns all the files under the copilot directory, sorted by path.
+func (ws *Workspace) ReadCopilotDir() ([]File, error) {
+	copilotPath, err := ws.copilotDirPath()
+	if err != nil {
+		return nil, err
+	}
+	wsPath := filepath.Dir(copilotPath)
+	var files []File
+	err = ws.fsUtils.Walk(copilotPath, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			return nil
+		}
+		data, err := ws.fsUtils.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("read file %s: %w", path, err)
+		}
+		rel, err := filepath.Rel(wsPath, path)
+		if err != nil {
+			return err
+		}
+		files = append(files, File{
+			Path: filepath.ToSlash(rel),
+			Data: data,
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return files, nil
+}
+
 // FileStat wraps the os.Stat function.
 type FileStat interface {
 	Stat(name string) (os.FileInfo, error)
@@ -335,6 +498,20 @@ func (ws *Workspace) pipelineManifestPath() (string, error) {
 	return pipelineManifestPath, nil
 }
 
+func (ws *Workspace) pipelineName(path string) (string, error) {
+	data, err := ws.fsUtils.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("read pipeline manifest %s: %w", path, err)
+	}
+	pipeline := struct {
+		Name string `yaml:"name"`
+	}{}
+	if err := yaml.Unmarshal(data, &pipeline); err != nil {
+		return "", fmt.Errorf(`unmarshal pipeline manifest %s to retrieve "name": %w`, path, err)
+	}
+	return pipeline.Name, nil
+}
+
 func (ws *Workspace) summaryPath() (string, error) {
 	copilotPath, err := ws.copilotDirPath()
 	if err != nil {
@@ -709,6 +709,56 @@ func TestWorkspace_ReadAddonsDir(t *testing.T) {
 	}
 }
 
+func TestWorkspace_ReadCopilotDir(t *testing.T) {
+	testCases := map[string]struct {
+		copilotDirPath string
+		fs             func() afero.Fs
+
+		wantedFiles []File
+		wantedErr   error
+	}{
+		"returns every file under the copilot directory": {
+			copilotDirPath: "/ws/copilot",
+			fs: func() afero.Fs {
+				fs := afero.NewMemMapFs()
+				fs.MkdirAll("/ws/copilot/webhook/addons", 0755)
+				fs.MkdirAll("/ws/copilot/pipelines/release", 0755)
+				afero.WriteFile(fs, "/ws/copilot/.workspace", []byte("application: my-app"), 0644)
+				afero.WriteFile(fs, "/ws/copilot/webhook/manifest.yml", []byte("name: webhook"), 0644)
+				afero.WriteFile(fs, "/ws/copilot/webhook/addons/table.yml", []byte("Resources:"), 0644)
+				afero.WriteFile(fs, "/ws/copilot/pipelines/release/manifest.yml", []byte("name: release"), 0644)
+				afero.WriteFile(fs, "/ws/webhook/Dockerfile", []byte("FROM nginx"), 0644)
+				return fs
+			},
+			wantedFiles: []File{
+				{Path: "copilot/.workspace", Data: []byte("application: my-app")},
+				{Path: "copilot/pipelines/release/manifest.yml", Data: []byte("name: release")},
+				{Path: "copilot/webhook/addons/table.yml", Data: []byte("Resources:")},
+				{Path: "copilot/webhook/manifest.yml", Data: []byte("name: webhook")},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ws := &Workspace{
+				copilotDir: tc.copilotDirPath,
+				fsUtils: &afero.Afero{
+					Fs: tc.fs(),
+				},
+			}
+
+			// WHEN
+			files, err := ws.ReadCopilotDir()
+
+			// THEN
+			require.Equal(t, tc.wantedErr, err)
+			require.Equal(t, tc.wantedFiles, files)
+		})
+	}
+}
+
 func TestWorkspace_WriteAddon(t *testing.T) {
 	testCases := map[string]struct {
 		marshaler   mockBinaryMarshaler
@@ -768,6 +818,38 @@ func TestWorkspace_WriteAddon(t *testing.T) {
 	}
 }
 
+func TestWorkspace_EnvironmentAddons(t *testing.T) {
+	// GIVEN
+	fs := afero.NewMemMapFs()
+	utils := &afero.Afero{
+		Fs: fs,
+	}
+	utils.MkdirAll("/copilot", 0755)
+	ws := &Workspace{
+		workingDir: "/",
+		copilotDir: "/copilot",
+		fsUtils:    utils,
+	}
+
+	// WHEN
+	path, err := ws.WriteEnvironmentAddon(mockBinaryMarshaler{content: []byte("Resources:")}, "users")
+
+	// THEN
+	require.NoError(t, err)
+	require.Equal(t, "/copilot/environments/addons/users.yml", path)
+
+	fnames, err := ws.ReadEnvironmentAddonsDir()
+	require.NoError(t, err)
+	require.Equal(t, []string{"users.yml"}, fnames)
+
+	content, err := ws.ReadEnvironmentAddon("users.yml")
+	require.NoError(t, err)
+	require.Equal(t, []byte("Resources:"), content)
+
+	_, err = ws.WriteEnvironmentAddon(mockBinaryMarshaler{content: []byte("Resources:")}, "users")
+	require.EqualError(t, err, (&ErrFileExists{FileName: "/copilot/environments/addons/users.yml"}).Error())
+}
+
 func TestWorkspace_ReadPipelineManifest(t *testing.T) {
 	copilotDir := "/copilot"
 	testCases := map[string]struct {
@@ -806,7 +888,7 @@ func TestWorkspace_ReadPipelineManifest(t *testing.T) {
 			}
 
 			// WHEN
-			_, err := ws.ReadPipelineManifest()
+			_, err := ws.ReadPipelineManifest("/copilot/pipeline.yml")
 
 			// THEN
 			if tc.expectedError != nil {
@@ -818,6 +900,109 @@ func TestWorkspace_ReadPipelineManifest(t *testing.T) {
 	}
 }
 
+func TestWorkspace_ListPipelines(t *testing.T) {
+	copilotDir := "/copilot"
+	testCases := map[string]struct {
+		fs              func() afero.Fs
+		wantedPipelines []PipelineManifest
+		wantedErr       error
+	}{
+		"returns ErrNoPipelineInWorkspace when there are no pipelines": {
+			fs: func() afero.Fs {
+				fs := afero.NewMemMapFs()
+				fs.Mkdir(copilotDir, 0755)
+				return fs
+			},
+			wantedErr: ErrNoPipelineInWorkspace,
+		},
+		"lists the legacy pipeline followed by the pipelines under the pipelines directory": {
+			fs: func() afero.Fs {
+				fs := afero.NewMemMapFs()
+				fs.MkdirAll("/copilot/pipelines/release", 0755)
+				fs.MkdirAll("/copilot/pipelines/main", 0755)
+				fs.MkdirAll("/copilot/pipelines/empty", 0755)
+				afero.WriteFile(fs, "/copilot/pipeline.yml", []byte("name: pipeline-legacy"), 0644)
+				afero.WriteFile(fs, "/copilot/pipelines/release/manifest.yml", []byte("name: release"), 0644)
+				afero.WriteFile(fs, "/copilot/pipelines/main/manifest.yml", []byte("name: main"), 0644)
+				afero.WriteFile(fs, "/copilot/pipelines/README.md", []byte("hello"), 0644)
+				return fs
+			},
+			wantedPipelines: []PipelineManifest{
+				{
+					Name: "pipeline-legacy",
+					Path: "/copilot/pipeline.yml",
+				},
+				{
+					Name: "main",
+					Path: "/copilot/pipelines/main/manifest.yml",
+				},
+				{
+					Name: "release",
+					Path: "/copilot/pipelines/release/manifest.yml",
+				},
+			},
+		},
+		"returns an error if the legacy pipeline and a pipeline under the pipelines directory have the same name": {
+			fs: func() afero.Fs {
+				fs := afero.NewMemMapFs()
+				fs.MkdirAll("/copilot/pipelines/release", 0755)
+				afero.WriteFile(fs, "/copilot/pipeline.yml", []byte("name: release"), 0644)
+				afero.WriteFile(fs, "/copilot/pipelines/release/manifest.yml", []byte("name: release"), 0644)
+				return fs
+			},
+			wantedErr: errors.New("pipeline name release is used by both /copilot/pipeline.yml and /copilot/pipelines/release/manifest.yml"),
+		},
+		"returns an error if two pipelines under the pipelines directory have the same name": {
+			fs: func() afero.Fs {
+				fs := afero.NewMemMapFs()
+				fs.MkdirAll("/copilot/pipelines/main", 0755)
+				fs.MkdirAll("/copilot/pipelines/release", 0755)
+				afero.WriteFile(fs, "/copilot/pipelines/main/manifest.yml", []byte("name: release"), 0644)
+				afero.WriteFile(fs, "/copilot/pipelines/release/manifest.yml", []byte("name: release"), 0644)
+				return fs
+			},
+			wantedErr: errors.New("pipeline name release is used by both /copilot/pipelines/main/manifest.yml and /copilot/pipelines/release/manifest.yml"),
+		},
+		"returns an error if a manifest is malformed": {
+			fs: func() afero.Fs {
+				fs := afero.NewMemMapFs()
+				fs.MkdirAll("/copilot/pipelines/release", 0755)
+				afero.WriteFile(fs, "/copilot/pipelines/release/manifest.yml", []byte("name: [release"), 0644)
+				return fs
+			},
+			wantedErr: errors.New(`unmarshal pipeline manifest /copilot/pipelines/release/manifest.yml to retrieve "name": yaml: line 1: did not find expected ',' or ']'`),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ws := &Workspace{
+				copilotDir: copilotDir,
+				fsUtils:    &afero.Afero{Fs: tc.fs()},
+			}
+
+			// WHEN
+			pipelines, err := ws.ListPipelines()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedPipelines, pipelines)
+			}
+		})
+	}
+}
+
+func TestPipelineManifest_BuildspecPath(t *testing.T) {
+	require.Equal(t, "/copilot/buildspec.yml", PipelineManifest{Path: "/copilot/pipeline.yml"}.BuildspecPath())
+	require.True(t, PipelineManifest{Path: "/copilot/pipeline.yml"}.IsLegacy())
+	require.Equal(t, "/copilot/pipelines/release/buildspec.yml", PipelineManifest{Path: "/copilot/pipelines/release/manifest.yml"}.BuildspecPath())
+	require.False(t, PipelineManifest{Path: "/copilot/pipelines/release/manifest.yml"}.IsLegacy())
+}
+
 func TestWorkspace_DeleteWorkspaceFile(t *testing.T) {
 	testCases := map[string]struct {
 		copilotDir string